@@ -18,15 +18,26 @@ import (
 	"fmt"
 	"net/http"
 	"runtime"
+	"strings"
 	"time"
 
 	apiv1 "github.com/prometheus/alertmanager/api/v1"
 	apiv2 "github.com/prometheus/alertmanager/api/v2"
+	"github.com/prometheus/alertmanager/audit"
 	"github.com/prometheus/alertmanager/cluster"
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/history"
+	"github.com/prometheus/alertmanager/maintenance"
+	"github.com/prometheus/alertmanager/notify/escalation"
+	"github.com/prometheus/alertmanager/notify/groupnotes"
+	"github.com/prometheus/alertmanager/notify/receivermute"
+	"github.com/prometheus/alertmanager/notify/shadow"
+	"github.com/prometheus/alertmanager/notify/standby"
 	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/quota"
 	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
@@ -43,6 +54,9 @@ type API struct {
 	concurrencyLimitExceeded prometheus.Counter
 	timeout                  time.Duration
 	inFlightSem              chan struct{}
+	ingestsInFlight          prometheus.Gauge
+	ingestLimitExceeded      prometheus.Counter
+	ingestSem                chan struct{}
 }
 
 // Options for the creation of an API object. Alerts, Silences, and StatusFunc
@@ -65,6 +79,13 @@ type Options struct {
 	// larger. Status code 503 is served for GET requests that would exceed
 	// the concurrency limit.
 	Concurrency int
+	// IngestConcurrency bounds the number of concurrent alert-ingestion
+	// requests (POST .../alerts) admitted to either API version. The zero
+	// value (and negative values) result in a limit of GOMAXPROCS or 8,
+	// whichever is larger. Status code 429 is served for requests that
+	// would exceed the limit, so a misbehaving client backs off instead
+	// of piling up queued alerts in memory.
+	IngestConcurrency int
 	// Logger is used for logging, if nil, no logging will happen.
 	Logger log.Logger
 	// Registry is used to register Prometheus metrics. If nil, no metrics
@@ -74,6 +95,35 @@ type Options struct {
 	// according to the current active configuration. Alerts returned are
 	// filtered by the arguments provided to the function.
 	GroupFunc func(func(*dispatch.Route) bool, func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[model.Fingerprint][]string)
+	// History, if set, is served under APIv1's
+	// /alert/:fingerprint/history.
+	History *history.Log
+	// ReceiverMuteTracker, if set, backs APIv1's
+	// /receivers/:name/mute and /receivers/:name/unmute endpoints.
+	ReceiverMuteTracker *receivermute.Tracker
+	// GroupNotesTracker, if set, backs APIv1's /groups/:key/notes
+	// endpoints.
+	GroupNotesTracker *groupnotes.Tracker
+	// EscalationTracker, if set, backs APIv1's /groups/:key/ack endpoint,
+	// which acknowledges a group's notification to suppress its route's
+	// configured escalation_receiver.
+	EscalationTracker *escalation.Tracker
+	// QuotaTracker, if set, attributes alerts ingested through either API
+	// version to a tenant and rejects any that would exceed its quota.
+	QuotaTracker *quota.Tracker
+	// StandbyTracker, if set, backs APIv1's /notifications/disable
+	// endpoint, which toggles instance-wide warm-standby mode.
+	StandbyTracker *standby.Tracker
+	// MaintenanceTracker, if set, backs APIv1's /maintenance/readonly and
+	// /maintenance/drain endpoints, which toggle instance-wide read-only
+	// and drain mode.
+	MaintenanceTracker *maintenance.Tracker
+	// ShadowTracker, if set, backs APIv1's /notifications/shadow
+	// endpoint, which lists notifications suppressed by dry-run mode.
+	ShadowTracker *shadow.Tracker
+	// Audit, if set, records silence mutations made through either API
+	// version and backs APIv1's /audit query endpoint.
+	Audit *audit.Log
 }
 
 func (o Options) validate() error {
@@ -109,6 +159,13 @@ func New(opts Options) (*API, error) {
 			concurrency = 8
 		}
 	}
+	ingestConcurrency := opts.IngestConcurrency
+	if ingestConcurrency < 1 {
+		ingestConcurrency = runtime.GOMAXPROCS(0)
+		if ingestConcurrency < 8 {
+			ingestConcurrency = 8
+		}
+	}
 
 	v1 := apiv1.New(
 		opts.Alerts,
@@ -118,6 +175,16 @@ func New(opts Options) (*API, error) {
 		log.With(l, "version", "v1"),
 		opts.Registry,
 	)
+	v1.SetHistory(opts.History)
+	v1.SetReceiverMuteTracker(opts.ReceiverMuteTracker)
+	v1.SetGroupFunc(opts.GroupFunc)
+	v1.SetGroupNotesTracker(opts.GroupNotesTracker)
+	v1.SetEscalationTracker(opts.EscalationTracker)
+	v1.SetQuotaTracker(opts.QuotaTracker)
+	v1.SetShadowTracker(opts.ShadowTracker)
+	v1.SetStandbyTracker(opts.StandbyTracker)
+	v1.SetMaintenanceTracker(opts.MaintenanceTracker)
+	v1.SetAudit(opts.Audit)
 
 	v2, err := apiv2.NewAPI(
 		opts.Alerts,
@@ -132,6 +199,8 @@ func New(opts Options) (*API, error) {
 	if err != nil {
 		return nil, err
 	}
+	v2.SetQuotaTracker(opts.QuotaTracker)
+	v2.SetAudit(opts.Audit)
 
 	// TODO(beorn7): For now, this hardcodes the method="get" label. Other
 	// methods should get the same instrumentation.
@@ -145,6 +214,14 @@ func New(opts Options) (*API, error) {
 		Help:        "Total number of times an HTTP request failed because the concurrency limit was reached.",
 		ConstLabels: prometheus.Labels{"method": "get"},
 	})
+	ingestsInFlight := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "alertmanager_http_alert_ingests_in_flight",
+		Help: "Current number of alert-ingestion HTTP requests being processed.",
+	})
+	ingestLimitExceeded := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_http_alert_ingest_limit_exceeded_total",
+		Help: "Total number of times an alert-ingestion HTTP request was rejected because the ingest concurrency limit was reached.",
+	})
 	if opts.Registry != nil {
 		if err := opts.Registry.Register(requestsInFlight); err != nil {
 			return nil, err
@@ -152,6 +229,12 @@ func New(opts Options) (*API, error) {
 		if err := opts.Registry.Register(concurrencyLimitExceeded); err != nil {
 			return nil, err
 		}
+		if err := opts.Registry.Register(ingestsInFlight); err != nil {
+			return nil, err
+		}
+		if err := opts.Registry.Register(ingestLimitExceeded); err != nil {
+			return nil, err
+		}
 	}
 
 	return &API{
@@ -161,6 +244,9 @@ func New(opts Options) (*API, error) {
 		concurrencyLimitExceeded: concurrencyLimitExceeded,
 		timeout:                  opts.Timeout,
 		inFlightSem:              make(chan struct{}, concurrency),
+		ingestsInFlight:          ingestsInFlight,
+		ingestLimitExceeded:      ingestLimitExceeded,
+		ingestSem:                make(chan struct{}, ingestConcurrency),
 	}, nil
 }
 
@@ -171,7 +257,9 @@ func New(opts Options) (*API, error) {
 // the newly created http.ServeMux. If a timeout has been set on construction of
 // API, it is enforced for all HTTP request going through this mux. The same is
 // true for the concurrency limit, with the exception that it is only applied to
-// GET requests.
+// GET requests. POST requests ingesting alerts are instead subject to a
+// separate ingest concurrency limit, served as 429 rather than 503 so clients
+// know to back off and retry.
 func (api *API) Register(r *route.Router, routePrefix string) *http.ServeMux {
 	api.v1.Register(r.WithPrefix("/api/v1"))
 
@@ -195,15 +283,32 @@ func (api *API) Register(r *route.Router, routePrefix string) *http.ServeMux {
 }
 
 // Update config and resolve timeout of each API. APIv2 also needs
-// setAlertStatus to be updated.
-func (api *API) Update(cfg *config.Config, setAlertStatus func(model.LabelSet)) {
-	api.v1.Update(cfg)
+// setAlertStatus to be updated. tmpl is used by APIv1 to render receiver
+// notification previews.
+func (api *API) Update(cfg *config.Config, setAlertStatus func(model.LabelSet, model.LabelSet), tmpl *template.Template) {
+	api.v1.Update(cfg, tmpl)
 	api.v2.Update(cfg, setAlertStatus)
 }
 
 func (api *API) limitHandler(h http.Handler) http.Handler {
 	concLimiter := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
-		if req.Method == http.MethodGet { // Only limit concurrency of GETs.
+		switch {
+		case isAlertIngest(req): // Bound ingest queueing so a misbehaving Prometheus can't OOM us.
+			select {
+			case api.ingestSem <- struct{}{}: // All good, carry on.
+				api.ingestsInFlight.Inc()
+				defer func() {
+					<-api.ingestSem
+					api.ingestsInFlight.Dec()
+				}()
+			default:
+				api.ingestLimitExceeded.Inc()
+				http.Error(rsp, fmt.Sprintf(
+					"Limit of concurrent alert-ingestion requests reached (%d), try again later.\n", cap(api.ingestSem),
+				), http.StatusTooManyRequests)
+				return
+			}
+		case req.Method == http.MethodGet: // Only limit concurrency of GETs.
 			select {
 			case api.inFlightSem <- struct{}{}: // All good, carry on.
 				api.requestsInFlight.Inc()
@@ -228,3 +333,9 @@ func (api *API) limitHandler(h http.Handler) http.Handler {
 		"Exceeded configured timeout of %v.\n", api.timeout,
 	))
 }
+
+// isAlertIngest reports whether req is a POST submitting new alerts, the only
+// request type the ingest concurrency limit applies to.
+func isAlertIngest(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/alerts")
+}
@@ -17,9 +17,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -31,13 +33,24 @@ import (
 	"github.com/prometheus/common/version"
 
 	"github.com/prometheus/alertmanager/api/metrics"
+	"github.com/prometheus/alertmanager/audit"
 	"github.com/prometheus/alertmanager/cluster"
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/history"
+	"github.com/prometheus/alertmanager/maintenance"
+	"github.com/prometheus/alertmanager/notify/escalation"
+	"github.com/prometheus/alertmanager/notify/groupnotes"
+	"github.com/prometheus/alertmanager/notify/preview"
+	"github.com/prometheus/alertmanager/notify/receivermute"
+	"github.com/prometheus/alertmanager/notify/shadow"
+	"github.com/prometheus/alertmanager/notify/standby"
 	"github.com/prometheus/alertmanager/pkg/labels"
 	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/quota"
 	"github.com/prometheus/alertmanager/silence"
 	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -67,14 +80,25 @@ func setCORS(w http.ResponseWriter) {
 
 // API provides registration of handlers for API routes.
 type API struct {
-	alerts   provider.Alerts
-	silences *silence.Silences
-	config   *config.Config
-	route    *dispatch.Route
-	uptime   time.Time
-	peer     *cluster.Peer
-	logger   log.Logger
-	m        *metrics.Alerts
+	alerts      provider.Alerts
+	silences    *silence.Silences
+	config      *config.Config
+	route       *dispatch.Route
+	uptime      time.Time
+	peer        *cluster.Peer
+	logger      log.Logger
+	m           *metrics.Alerts
+	tmpl        *template.Template
+	history     *history.Log
+	muted       *receivermute.Tracker
+	groupFunc   groupsFn
+	groupNotes  *groupnotes.Tracker
+	escalation  *escalation.Tracker
+	quota       *quota.Tracker
+	standby     *standby.Tracker
+	maintenance *maintenance.Tracker
+	shadow      *shadow.Tracker
+	audit       *audit.Log
 
 	getAlertStatus getAlertStatusFn
 
@@ -83,6 +107,10 @@ type API struct {
 
 type getAlertStatusFn func(model.Fingerprint) types.AlertStatus
 
+// groupsFn returns the currently active alert groups, filtered by the
+// given route and alert predicates.
+type groupsFn func(func(*dispatch.Route) bool, func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[model.Fingerprint][]string)
+
 // New returns a new API.
 func New(
 	alerts provider.Alerts,
@@ -120,24 +148,156 @@ func (api *API) Register(r *route.Router) {
 	r.Options("/*path", wrap(func(w http.ResponseWriter, r *http.Request) {}))
 
 	r.Get("/status", wrap(api.status))
+	r.Get("/routes", wrap(api.routes))
+	r.Post("/routes/test", wrap(api.testRoute))
 	r.Get("/receivers", wrap(api.receivers))
+	r.Post("/receivers/:name/preview", wrap(api.previewReceiver))
+	r.Post("/receivers/:name/mute", wrap(api.muteReceiver))
+	r.Del("/receivers/:name/mute", wrap(api.unmuteReceiver))
+
+	r.Post("/notifications/disable", wrap(api.disableNotifications))
+	r.Del("/notifications/disable", wrap(api.enableNotifications))
+	r.Get("/notifications/shadow", wrap(api.listShadowNotifications))
+
+	r.Post("/maintenance/readonly", wrap(api.enableReadOnly))
+	r.Del("/maintenance/readonly", wrap(api.disableReadOnly))
+	r.Post("/maintenance/drain", wrap(api.enableDrain))
+	r.Del("/maintenance/drain", wrap(api.disableDrain))
 
 	r.Get("/alerts", wrap(api.listAlerts))
 	r.Post("/alerts", wrap(api.addAlerts))
+	r.Post("/alerts/test", wrap(api.testAlert))
+	r.Get("/alert/:fingerprint/history", wrap(api.alertHistory))
+	r.Post("/alert/:fingerprint/snooze", wrap(api.snoozeAlert))
+	r.Get("/audit", wrap(api.listAudit))
 
 	r.Get("/silences", wrap(api.listSilences))
 	r.Post("/silences", wrap(api.setSilence))
 	r.Get("/silence/:sid", wrap(api.getSilence))
 	r.Del("/silence/:sid", wrap(api.delSilence))
+
+	r.Get("/groups", wrap(api.groups))
+	r.Get("/alerts/groups", wrap(api.alertGroups))
+	r.Post("/groups/:key/notes", wrap(api.setGroupNotes))
+	r.Del("/groups/:key/notes", wrap(api.clearGroupNotes))
+	r.Post("/groups/:key/ack", wrap(api.ackGroup))
+}
+
+// SetHistory configures the per-alert lifecycle log served under
+// /alert/:fingerprint/history. Passing nil disables the endpoint, which
+// then responds as if no history had ever been recorded.
+func (api *API) SetHistory(h *history.Log) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.history = h
+}
+
+// SetAudit configures the log of sent notifications and API mutations
+// served under /audit, and recorded into by setSilence/delSilence. Passing
+// nil disables the endpoint, which then responds as if nothing had ever
+// been recorded.
+func (api *API) SetAudit(a *audit.Log) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.audit = a
+}
+
+// SetReceiverMuteTracker configures the tracker used to mute and query the
+// mute status of receivers. Passing nil disables the mute/unmute endpoints,
+// which then respond as though no receiver were ever muted.
+func (api *API) SetReceiverMuteTracker(t *receivermute.Tracker) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.muted = t
+}
+
+// SetShadowTracker configures the tracker that records notifications
+// suppressed by dry-run mode, backing /notifications/shadow. Passing nil
+// disables the endpoint, which then responds as if nothing had ever been
+// suppressed.
+func (api *API) SetShadowTracker(t *shadow.Tracker) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.shadow = t
+}
+
+// SetGroupFunc configures the function used to list currently active alert
+// groups, backing /groups. Passing nil disables the endpoint.
+func (api *API) SetGroupFunc(f groupsFn) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.groupFunc = f
+}
+
+// SetGroupNotesTracker configures the tracker used to attach and query
+// free-form notes on active alert groups, backing
+// /groups/:key/notes. Passing nil disables the endpoint, which then
+// responds as though no group ever had notes attached.
+func (api *API) SetGroupNotesTracker(t *groupnotes.Tracker) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.groupNotes = t
+}
+
+// SetEscalationTracker configures the tracker used to record
+// acknowledgements of active alert groups, backing /groups/:key/ack.
+// Passing nil disables the endpoint, which then responds as though the
+// group were never acknowledged -- so any escalation_receiver configured
+// for it will always fire once its escalation_timeout elapses.
+func (api *API) SetEscalationTracker(t *escalation.Tracker) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.escalation = t
+}
+
+// SetQuotaTracker configures the tracker consulted to attribute ingested
+// alerts to a tenant and enforce its alert quota. Passing nil disables both.
+func (api *API) SetQuotaTracker(t *quota.Tracker) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.quota = t
 }
 
-// Update sets the configuration string to a new value.
-func (api *API) Update(cfg *config.Config) {
+// SetStandbyTracker configures the tracker consulted to suppress delivery
+// instance-wide while warm-standby mode is enabled, backing
+// /notifications/disable. Passing nil disables the endpoint, which then
+// responds as though standby mode could never be toggled.
+func (api *API) SetStandbyTracker(t *standby.Tracker) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.standby = t
+}
+
+// SetMaintenanceTracker configures the tracker consulted to reject silence
+// mutations while read-only mode is enabled and new alerts while drain mode
+// is enabled, backing /maintenance/readonly and /maintenance/drain. Passing
+// nil disables both endpoints, which then respond as though maintenance
+// mode could never be toggled.
+func (api *API) SetMaintenanceTracker(t *maintenance.Tracker) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.maintenance = t
+}
+
+// Update sets the configuration string to a new value and the template used
+// to render receiver notification previews.
+func (api *API) Update(cfg *config.Config, tmpl *template.Template) {
 	api.mtx.Lock()
 	defer api.mtx.Unlock()
 
 	api.config = cfg
-	api.route = dispatch.NewRoute(cfg.Route, nil)
+	api.route = dispatch.NewRoute(cfg.Route, nil, config.ReceiversByName(cfg.Receivers))
+	api.tmpl = tmpl
 }
 
 type errorType string
@@ -156,27 +316,307 @@ func (e *apiError) Error() string {
 	return fmt.Sprintf("%s: %s", e.typ, e.err)
 }
 
+// receiverStatus is the API representation of a configured receiver,
+// including whether it is currently muted.
+type receiverStatus struct {
+	Name       string     `json:"name"`
+	Muted      bool       `json:"muted"`
+	MutedUntil *time.Time `json:"mutedUntil,omitempty"`
+}
+
 func (api *API) receivers(w http.ResponseWriter, req *http.Request) {
 	api.mtx.RLock()
 	defer api.mtx.RUnlock()
 
-	receivers := make([]string, 0, len(api.config.Receivers))
+	receivers := make([]receiverStatus, 0, len(api.config.Receivers))
 	for _, r := range api.config.Receivers {
-		receivers = append(receivers, r.Name)
+		rs := receiverStatus{Name: r.Name}
+		if api.muted != nil {
+			if muted, until := api.muted.Muted(r.Name); muted {
+				rs.Muted = true
+				rs.MutedUntil = &until
+			}
+		}
+		receivers = append(receivers, rs)
 	}
 
 	api.respond(w, receivers)
 }
 
+// muteReceiverRequest is the body of a receiver mute request.
+type muteReceiverRequest struct {
+	// Duration is a parsed Go duration string, e.g. "2h". Mandatory.
+	Duration string `json:"duration"`
+}
+
+// muteReceiver temporarily suppresses delivery through a receiver, e.g.
+// while its downstream integration is known to be unreachable, without
+// affecting any other receiver.
+func (api *API) muteReceiver(w http.ResponseWriter, r *http.Request) {
+	name := route.Param(r.Context(), "name")
+
+	if api.muted == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("receiver muting is not enabled"),
+		}, nil)
+		return
+	}
+
+	durationStr := r.FormValue("duration")
+	if durationStr == "" {
+		var req muteReceiverRequest
+		if err := api.receive(r, &req); err != nil && err != io.EOF {
+			api.respondError(w, apiError{
+				typ: errorBadData,
+				err: err,
+			}, nil)
+			return
+		}
+		durationStr = req.Duration
+	}
+	if durationStr == "" {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("duration is required"),
+		}, nil)
+		return
+	}
+
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: fmt.Errorf("invalid duration %q: %s", durationStr, err),
+		}, nil)
+		return
+	}
+	if duration <= 0 {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("duration must be positive"),
+		}, nil)
+		return
+	}
+
+	api.mtx.RLock()
+	_, known := api.findReceiver(name)
+	api.mtx.RUnlock()
+	if !known {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: fmt.Errorf("receiver %q not found", name),
+		}, nil)
+		return
+	}
+
+	api.muted.Mute(name, duration)
+	api.respond(w, nil)
+}
+
+// unmuteReceiver lifts any active mute on a receiver.
+func (api *API) unmuteReceiver(w http.ResponseWriter, r *http.Request) {
+	name := route.Param(r.Context(), "name")
+
+	if api.muted == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("receiver muting is not enabled"),
+		}, nil)
+		return
+	}
+
+	api.muted.Unmute(name)
+	api.respond(w, nil)
+}
+
+// disableNotifications enables instance-wide warm-standby mode: alerts keep
+// being ingested and tracked as usual, but no notification is delivered to
+// any receiver until notifications are re-enabled.
+func (api *API) disableNotifications(w http.ResponseWriter, r *http.Request) {
+	if api.standby == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("notification suppression is not enabled"),
+		}, nil)
+		return
+	}
+
+	api.standby.SetEnabled(true)
+	api.respond(w, nil)
+}
+
+// enableNotifications lifts instance-wide warm-standby mode, resuming
+// normal notification delivery.
+func (api *API) enableNotifications(w http.ResponseWriter, r *http.Request) {
+	if api.standby == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("notification suppression is not enabled"),
+		}, nil)
+		return
+	}
+
+	api.standby.SetEnabled(false)
+	api.respond(w, nil)
+}
+
+// enableReadOnly puts the instance into read-only mode: silence mutations
+// and configuration reloads are rejected, but alert and silence queries
+// keep working as normal. Intended for use around cluster migrations and
+// restores, where a specific instance must not be written to.
+func (api *API) enableReadOnly(w http.ResponseWriter, r *http.Request) {
+	if api.maintenance == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("maintenance mode is not enabled"),
+		}, nil)
+		return
+	}
+
+	api.maintenance.SetReadOnly(true)
+	api.respond(w, nil)
+}
+
+// disableReadOnly lifts read-only mode, resuming normal silence mutations
+// and configuration reloads.
+func (api *API) disableReadOnly(w http.ResponseWriter, r *http.Request) {
+	if api.maintenance == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("maintenance mode is not enabled"),
+		}, nil)
+		return
+	}
+
+	api.maintenance.SetReadOnly(false)
+	api.respond(w, nil)
+}
+
+// enableDrain puts the instance into drain mode: new alerts are rejected at
+// ingestion, but alert groups already being tracked keep flushing as usual,
+// so in-flight notifications finish instead of being cut off mid-group.
+func (api *API) enableDrain(w http.ResponseWriter, r *http.Request) {
+	if api.maintenance == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("maintenance mode is not enabled"),
+		}, nil)
+		return
+	}
+
+	api.maintenance.SetDraining(true)
+	api.respond(w, nil)
+}
+
+// disableDrain lifts drain mode, resuming normal alert ingestion.
+func (api *API) disableDrain(w http.ResponseWriter, r *http.Request) {
+	if api.maintenance == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("maintenance mode is not enabled"),
+		}, nil)
+		return
+	}
+
+	api.maintenance.SetDraining(false)
+	api.respond(w, nil)
+}
+
+// listShadowNotifications returns the notifications most recently
+// suppressed by dry-run mode, so a routing-tree change being rehearsed in
+// staging can be inspected without scrolling through logs.
+func (api *API) listShadowNotifications(w http.ResponseWriter, r *http.Request) {
+	api.mtx.RLock()
+	t := api.shadow
+	api.mtx.RUnlock()
+
+	var records []shadow.Record
+	if t != nil {
+		records = t.List()
+	}
+
+	api.respond(w, records)
+}
+
+// findReceiver returns the configured receiver named name, if any. The
+// caller must hold at least a read lock on api.mtx.
+func (api *API) findReceiver(name string) (*config.Receiver, bool) {
+	for _, r := range api.config.Receivers {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// previewReceiverRequest is the body of a receiver preview request: the alert
+// group to render the receiver's integrations against.
+type previewReceiverRequest struct {
+	Alerts []*types.Alert `json:"alerts"`
+}
+
+func (api *API) previewReceiver(w http.ResponseWriter, r *http.Request) {
+	name := route.Param(r.Context(), "name")
+
+	var req previewReceiverRequest
+	if err := api.receive(r, &req); err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: err,
+		}, nil)
+		return
+	}
+	if len(req.Alerts) == 0 {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("at least one alert is required"),
+		}, nil)
+		return
+	}
+
+	api.mtx.RLock()
+	rcv, found := api.findReceiver(name)
+	tmpl := api.tmpl
+	api.mtx.RUnlock()
+
+	if !found {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: fmt.Errorf("receiver %q not found", name),
+		}, nil)
+		return
+	}
+	if tmpl == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("notification templates not loaded yet"),
+		}, nil)
+		return
+	}
+
+	results, err := preview.Render(rcv, tmpl, req.Alerts, api.logger)
+	if err != nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: err,
+		}, nil)
+		return
+	}
+
+	api.respond(w, results)
+}
+
 func (api *API) status(w http.ResponseWriter, req *http.Request) {
 	api.mtx.RLock()
 
 	var status = struct {
-		ConfigYAML    string            `json:"configYAML"`
-		ConfigJSON    *config.Config    `json:"configJSON"`
-		VersionInfo   map[string]string `json:"versionInfo"`
-		Uptime        time.Time         `json:"uptime"`
-		ClusterStatus *clusterStatus    `json:"clusterStatus"`
+		ConfigYAML            string            `json:"configYAML"`
+		ConfigJSON            *config.Config    `json:"configJSON"`
+		VersionInfo           map[string]string `json:"versionInfo"`
+		Uptime                time.Time         `json:"uptime"`
+		ClusterStatus         *clusterStatus    `json:"clusterStatus"`
+		NotificationsDisabled bool              `json:"notificationsDisabled"`
 	}{
 		ConfigYAML: api.config.String(),
 		ConfigJSON: api.config,
@@ -188,8 +628,9 @@ func (api *API) status(w http.ResponseWriter, req *http.Request) {
 			"buildDate": version.BuildDate,
 			"goVersion": version.GoVersion,
 		},
-		Uptime:        api.uptime,
-		ClusterStatus: getClusterStatus(api.peer),
+		Uptime:                api.uptime,
+		ClusterStatus:         getClusterStatus(api.peer),
+		NotificationsDisabled: api.standby != nil && api.standby.Enabled(),
 	}
 
 	api.mtx.RUnlock()
@@ -223,6 +664,351 @@ func getClusterStatus(p *cluster.Peer) *clusterStatus {
 	return s
 }
 
+// routeNode is the API representation of one node of the compiled routing
+// tree, including its fully inherited RouteOpts, so an operator can
+// understand how a given node will behave without reconstructing
+// inheritance from the raw YAML by hand.
+type routeNode struct {
+	Receiver            string        `json:"receiver"`
+	Matchers            string        `json:"matchers,omitempty"`
+	Continue            bool          `json:"continue,omitempty"`
+	GroupBy             []string      `json:"groupBy,omitempty"`
+	GroupByAll          bool          `json:"groupByAll,omitempty"`
+	GroupWait           time.Duration `json:"groupWait"`
+	GroupInterval       time.Duration `json:"groupInterval"`
+	RepeatInterval      time.Duration `json:"repeatInterval"`
+	MuteTimeIntervals   []string      `json:"muteTimeIntervals,omitempty"`
+	ActiveTimeIntervals []string      `json:"activeTimeIntervals,omitempty"`
+	Routes              []*routeNode  `json:"routes,omitempty"`
+}
+
+// buildRouteTree recursively converts a compiled *dispatch.Route into its
+// API representation.
+func buildRouteTree(r *dispatch.Route) *routeNode {
+	var groupBy []string
+	for ln := range r.RouteOpts.GroupBy {
+		groupBy = append(groupBy, string(ln))
+	}
+	sort.Strings(groupBy)
+
+	n := &routeNode{
+		Receiver:            r.RouteOpts.Receiver,
+		Matchers:            r.Matchers.String(),
+		Continue:            r.Continue,
+		GroupBy:             groupBy,
+		GroupByAll:          r.RouteOpts.GroupByAll,
+		GroupWait:           r.RouteOpts.GroupWait,
+		GroupInterval:       r.RouteOpts.GroupInterval,
+		RepeatInterval:      r.RouteOpts.RepeatInterval,
+		MuteTimeIntervals:   r.RouteOpts.MuteTimeIntervals,
+		ActiveTimeIntervals: r.RouteOpts.ActiveTimeIntervals,
+	}
+	for _, sr := range r.Routes {
+		n.Routes = append(n.Routes, buildRouteTree(sr))
+	}
+	return n
+}
+
+// routes serves the compiled routing tree, including inherited RouteOpts
+// at every node, so an operator can inspect the effective configuration
+// without reconstructing it from the raw YAML by hand.
+func (api *API) routes(w http.ResponseWriter, r *http.Request) {
+	api.mtx.RLock()
+	tree := buildRouteTree(api.route)
+	api.mtx.RUnlock()
+
+	api.respond(w, tree)
+}
+
+// testRouteRequest is the body of a route match test request.
+type testRouteRequest struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// routeMatchTrace is the API representation of one node of a
+// dispatch.RouteMatch diagnostic trace, recording whether the node
+// matched and which sibling nodes were skipped because an earlier one
+// matched without continue, so an operator can see why an alert did or
+// did not land on a given receiver.
+type routeMatchTrace struct {
+	Route    string             `json:"route"`
+	Receiver string             `json:"receiver"`
+	Matched  bool               `json:"matched"`
+	Skipped  bool               `json:"skipped,omitempty"`
+	Children []*routeMatchTrace `json:"children,omitempty"`
+}
+
+// resolveReceiver returns the receiver name that lset resolves to under
+// mr, rendering RouteOpts.ReceiverTemplate if set and falling back to
+// RouteOpts.ReceiverFallback if rendering fails.
+func resolveReceiver(mr *dispatch.Route, lset model.LabelSet) string {
+	receiver, err := mr.RouteOpts.ResolveReceiver(lset)
+	if err != nil {
+		return mr.RouteOpts.ReceiverFallback
+	}
+	return receiver
+}
+
+// buildRouteMatchTrace recursively converts a *dispatch.RouteMatch into
+// its API representation, resolving each node's receiver against lset.
+func buildRouteMatchTrace(t *dispatch.RouteMatch, lset model.LabelSet) *routeMatchTrace {
+	n := &routeMatchTrace{
+		Route:    t.Route.Key(),
+		Receiver: resolveReceiver(t.Route, lset),
+		Matched:  t.Matched,
+		Skipped:  t.Skipped,
+	}
+	for _, c := range t.Children {
+		n.Children = append(n.Children, buildRouteMatchTrace(c, lset))
+	}
+	return n
+}
+
+// testRouteResponse is the full diagnostic response for a route match
+// test: the flattened receivers Match would return, plus the trace of
+// every node considered and whether it matched or was skipped.
+type testRouteResponse struct {
+	Matches []routeMatch     `json:"matches"`
+	Trace   *routeMatchTrace `json:"trace"`
+}
+
+// testRoute reports which routes a label set would match, without
+// injecting any alert, so an operator can iterate on a routing tree
+// change before firing real alerts. If the "trace" query parameter is
+// set, the response also includes the full match path through the
+// routing tree, including nodes that did not match or were skipped, to
+// diagnose why an alert landed on an unexpected receiver.
+func (api *API) testRoute(w http.ResponseWriter, r *http.Request) {
+	var req testRouteRequest
+	if err := api.receive(r, &req); err != nil && err != io.EOF {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: err,
+		}, nil)
+		return
+	}
+
+	labels := model.LabelSet{}
+	for name, value := range req.Labels {
+		labels[model.LabelName(name)] = model.LabelValue(value)
+	}
+	annotations := model.LabelSet{}
+	for name, value := range req.Annotations {
+		annotations[model.LabelName(name)] = model.LabelValue(value)
+	}
+
+	api.mtx.RLock()
+	matchedRoutes, trace := api.route.MatchTrace(labels, annotations)
+	api.mtx.RUnlock()
+
+	matches := make([]routeMatch, 0, len(matchedRoutes))
+	for _, mr := range matchedRoutes {
+		var groupBy []string
+		for ln := range mr.RouteOpts.GroupBy {
+			groupBy = append(groupBy, string(ln))
+		}
+		sort.Strings(groupBy)
+		matches = append(matches, routeMatch{
+			Route:         mr.Key(),
+			Receiver:      resolveReceiver(mr, labels),
+			GroupBy:       groupBy,
+			GroupByAll:    mr.RouteOpts.GroupByAll,
+			GroupWait:     mr.RouteOpts.GroupWait,
+			GroupInterval: mr.RouteOpts.GroupInterval,
+		})
+	}
+
+	if r.URL.Query().Get("trace") != "" {
+		api.respond(w, testRouteResponse{Matches: matches, Trace: buildRouteMatchTrace(trace, labels)})
+		return
+	}
+
+	api.respond(w, matches)
+}
+
+// groupSummary is the API representation of one active aggregation group.
+type groupSummary struct {
+	Key      string            `json:"key"`
+	Labels   map[string]string `json:"labels"`
+	Receiver string            `json:"receiver"`
+	Notes    string            `json:"notes,omitempty"`
+	Alerts   int               `json:"alerts"`
+}
+
+// groups serves the currently active alert groups, including any notes
+// attached to them via /groups/:key/notes, so an operator can see at a
+// glance which incidents have context attached.
+func (api *API) groups(w http.ResponseWriter, r *http.Request) {
+	api.mtx.RLock()
+	groupFunc := api.groupFunc
+	notes := api.groupNotes
+	api.mtx.RUnlock()
+
+	if groupFunc == nil {
+		api.respond(w, []groupSummary{})
+		return
+	}
+
+	alertGroups, _ := groupFunc(
+		func(*dispatch.Route) bool { return true },
+		func(*types.Alert, time.Time) bool { return true },
+	)
+
+	res := make([]groupSummary, 0, len(alertGroups))
+	for _, g := range alertGroups {
+		summary := groupSummary{
+			Key:      g.Key,
+			Labels:   make(map[string]string, len(g.Labels)),
+			Receiver: g.Receiver,
+			Alerts:   len(g.Alerts),
+		}
+		for ln, lv := range g.Labels {
+			summary.Labels[string(ln)] = string(lv)
+		}
+		if notes != nil {
+			summary.Notes, _ = notes.Get(g.Key)
+		}
+		res = append(res, summary)
+	}
+
+	api.respond(w, res)
+}
+
+// alertGroup is a single notification group as GET /alerts/groups reports
+// it: the receiver and GroupBy labels a running Dispatcher groups these
+// alerts under, the alerts themselves, and when the group's next
+// notification is scheduled to fire.
+type alertGroup struct {
+	Labels    map[string]string `json:"labels"`
+	Receiver  string            `json:"receiver"`
+	NextFlush time.Time         `json:"nextFlush"`
+	Alerts    []*Alert          `json:"alerts"`
+}
+
+// alertGroups serves GET /alerts/groups, exposing alerts organized by the
+// same group keys and receivers the dispatcher uses to notify, including
+// GroupBy labels and next-flush timing, so an external UI can render
+// exactly what will be notified together and when, without reimplementing
+// the dispatcher's own grouping logic.
+func (api *API) alertGroups(w http.ResponseWriter, r *http.Request) {
+	api.mtx.RLock()
+	groupFunc := api.groupFunc
+	api.mtx.RUnlock()
+
+	if groupFunc == nil {
+		api.respond(w, []alertGroup{})
+		return
+	}
+
+	groups, receivers := groupFunc(
+		func(*dispatch.Route) bool { return true },
+		func(*types.Alert, time.Time) bool { return true },
+	)
+
+	res := make([]alertGroup, 0, len(groups))
+	for _, g := range groups {
+		group := alertGroup{
+			Labels:    make(map[string]string, len(g.Labels)),
+			Receiver:  g.Receiver,
+			NextFlush: g.NextFlush,
+			Alerts:    make([]*Alert, 0, len(g.Alerts)),
+		}
+		for ln, lv := range g.Labels {
+			group.Labels[string(ln)] = string(lv)
+		}
+		for _, a := range g.Alerts {
+			group.Alerts = append(group.Alerts, &Alert{
+				Alert:       &a.Alert,
+				Status:      api.getAlertStatus(a.Fingerprint()),
+				Receivers:   receivers[a.Fingerprint()],
+				Fingerprint: a.Fingerprint().String(),
+			})
+		}
+		res = append(res, group)
+	}
+
+	api.respond(w, res)
+}
+
+// setGroupNotesRequest is the body of a group notes request.
+type setGroupNotesRequest struct {
+	Notes string `json:"notes"`
+}
+
+// setGroupNotes attaches a free-form note to an active group, e.g. "driver
+// rollback in progress", so the context follows the incident into every
+// subsequent notification for the group.
+func (api *API) setGroupNotes(w http.ResponseWriter, r *http.Request) {
+	key := route.Param(r.Context(), "key")
+
+	api.mtx.RLock()
+	notes := api.groupNotes
+	api.mtx.RUnlock()
+
+	if notes == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("group notes are not enabled"),
+		}, nil)
+		return
+	}
+
+	var req setGroupNotesRequest
+	if err := api.receive(r, &req); err != nil && err != io.EOF {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: err,
+		}, nil)
+		return
+	}
+
+	notes.Set(key, req.Notes)
+	api.respond(w, nil)
+}
+
+// clearGroupNotes removes any note attached to an active group.
+func (api *API) clearGroupNotes(w http.ResponseWriter, r *http.Request) {
+	key := route.Param(r.Context(), "key")
+
+	api.mtx.RLock()
+	notes := api.groupNotes
+	api.mtx.RUnlock()
+
+	if notes == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("group notes are not enabled"),
+		}, nil)
+		return
+	}
+
+	notes.Clear(key)
+	api.respond(w, nil)
+}
+
+// ackGroup records an acknowledgement of an active group's notification,
+// so its route's configured escalation_receiver, if any, is not notified
+// once escalation_timeout elapses.
+func (api *API) ackGroup(w http.ResponseWriter, r *http.Request) {
+	key := route.Param(r.Context(), "key")
+
+	api.mtx.RLock()
+	esc := api.escalation
+	api.mtx.RUnlock()
+
+	if esc == nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: errors.New("escalation is not enabled"),
+		}, nil)
+		return
+	}
+
+	esc.Ack(key, time.Now())
+	api.respond(w, nil)
+}
+
 func (api *API) listAlerts(w http.ResponseWriter, r *http.Request) {
 	var (
 		err            error
@@ -287,6 +1073,30 @@ func (api *API) listAlerts(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	limit := -1
+	if limitParam := r.FormValue("limit"); limitParam != "" {
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil || limit < 0 {
+			api.respondError(w, apiError{
+				typ: errorBadData,
+				err: fmt.Errorf("limit must be a non-negative integer, got %q", limitParam),
+			}, nil)
+			return
+		}
+	}
+
+	offset := 0
+	if offsetParam := r.FormValue("offset"); offsetParam != "" {
+		offset, err = strconv.Atoi(offsetParam)
+		if err != nil || offset < 0 {
+			api.respondError(w, apiError{
+				typ: errorBadData,
+				err: fmt.Errorf("offset must be a non-negative integer, got %q", offsetParam),
+			}, nil)
+			return
+		}
+	}
+
 	if receiverParam := r.FormValue("receiver"); receiverParam != "" {
 		receiverFilter, err = regexp.Compile("^(?:" + receiverParam + ")$")
 		if err != nil {
@@ -301,7 +1111,7 @@ func (api *API) listAlerts(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	alerts := api.alerts.GetPending()
+	alerts := api.alertsToScan(matchers)
 	defer alerts.Close()
 
 	api.mtx.RLock()
@@ -313,11 +1123,7 @@ func (api *API) listAlerts(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 
-		routes := api.route.Match(a.Labels)
-		receivers := make([]string, 0, len(routes))
-		for _, r := range routes {
-			receivers = append(receivers, r.RouteOpts.Receiver)
-		}
+		receivers := api.route.MatchingReceivers(a.Labels, a.Annotations)
 
 		if receiverFilter != nil && !receiversMatchFilter(receivers, receiverFilter) {
 			continue
@@ -371,9 +1177,66 @@ func (api *API) listAlerts(w http.ResponseWriter, r *http.Request) {
 	sort.Slice(res, func(i, j int) bool {
 		return res[i].Fingerprint < res[j].Fingerprint
 	})
+	res = paginate(res, offset, limit)
 	api.respond(w, res)
 }
 
+// paginate returns the slice of alerts starting at offset and containing at
+// most limit entries, applied after sorting so pages are stable across
+// requests. limit < 0 means unlimited; offset beyond the end returns an
+// empty, non-nil slice rather than panicking.
+func paginate(alerts []*Alert, offset, limit int) []*Alert {
+	if offset >= len(alerts) {
+		return []*Alert{}
+	}
+	alerts = alerts[offset:]
+	if limit >= 0 && limit < len(alerts) {
+		alerts = alerts[:limit]
+	}
+	return alerts
+}
+
+// labelIndexed is implemented by alert providers that maintain an inverted
+// label index, letting callers narrow a scan to alerts with a given label
+// value instead of scanning every active alert.
+type labelIndexed interface {
+	ByLabel(name model.LabelName, value model.LabelValue) []*types.Alert
+}
+
+// alertsToScan returns an iterator over the alerts that need to be considered
+// for matchers. If api.alerts supports label-indexed lookups and matchers
+// contains at least one plain equality matcher, the iterator is narrowed to
+// the (possibly much smaller) set of alerts carrying that label value,
+// instead of every pending alert; matchFilterLabels is still applied to
+// every candidate afterwards, so the result is identical either way.
+func (api *API) alertsToScan(matchers []*labels.Matcher) provider.AlertIterator {
+	indexed, ok := api.alerts.(labelIndexed)
+	if !ok {
+		return api.alerts.GetPending()
+	}
+
+	var candidates []*types.Alert
+	for _, m := range matchers {
+		if m.Type != labels.MatchEqual {
+			continue
+		}
+		byLabel := indexed.ByLabel(model.LabelName(m.Name), model.LabelValue(m.Value))
+		if candidates == nil || len(byLabel) < len(candidates) {
+			candidates = byLabel
+		}
+	}
+	if candidates == nil {
+		return api.alerts.GetPending()
+	}
+
+	ch := make(chan *types.Alert, len(candidates))
+	for _, a := range candidates {
+		ch <- a
+	}
+	close(ch)
+	return provider.NewAlertIterator(ch, make(chan struct{}), nil)
+}
+
 func receiversMatchFilter(receivers []string, filter *regexp.Regexp) bool {
 	for _, r := range receivers {
 		if filter.MatchString(r) {
@@ -393,6 +1256,17 @@ func alertMatchesFilterLabels(a *model.Alert, matchers []*labels.Matcher) bool {
 }
 
 func (api *API) addAlerts(w http.ResponseWriter, r *http.Request) {
+	api.mtx.RLock()
+	m := api.maintenance
+	api.mtx.RUnlock()
+	if m != nil && m.Draining() {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("instance is in drain mode, not accepting new alerts"),
+		}, nil)
+		return
+	}
+
 	var alerts []*types.Alert
 	if err := api.receive(r, &alerts); err != nil {
 		api.respondError(w, apiError{
@@ -410,11 +1284,24 @@ func (api *API) insertAlerts(w http.ResponseWriter, r *http.Request, alerts ...*
 
 	api.mtx.RLock()
 	resolveTimeout := time.Duration(api.config.Global.ResolveTimeout)
+	sizeLimits := types.SizeLimits{
+		MaxLabelValueLength:      api.config.Global.MaxLabelValueLength,
+		MaxAnnotationValueLength: api.config.Global.MaxAnnotationValueLength,
+		Truncate:                 api.config.Global.TruncateOversizedValues,
+	}
+	quotaTracker := api.quota
 	api.mtx.RUnlock()
 
 	for _, alert := range alerts {
 		alert.UpdatedAt = now
 
+		if alert.Value == nil {
+			alert.Value = types.ParseFloatAnnotation(alert.Annotations, "value")
+		}
+		if alert.Threshold == nil {
+			alert.Threshold = types.ParseFloatAnnotation(alert.Annotations, "threshold")
+		}
+
 		// Ensure StartsAt is set.
 		if alert.StartsAt.IsZero() {
 			if alert.EndsAt.IsZero() {
@@ -444,11 +1331,25 @@ func (api *API) insertAlerts(w http.ResponseWriter, r *http.Request, alerts ...*
 	for _, a := range alerts {
 		removeEmptyLabels(a.Labels)
 
+		if err := sizeLimits.Enforce(a); err != nil {
+			validationErrs.Add(err)
+			api.m.Invalid().Inc()
+			continue
+		}
 		if err := a.Validate(); err != nil {
 			validationErrs.Add(err)
 			api.m.Invalid().Inc()
 			continue
 		}
+		if quotaTracker != nil {
+			tenant := quotaTracker.Tenant(a.Labels)
+			if err := quotaTracker.CheckAlert(tenant); err != nil {
+				validationErrs.Add(err)
+				api.m.Invalid().Inc()
+				continue
+			}
+			quotaTracker.ObserveAlert(tenant)
+		}
 		validAlerts = append(validAlerts, a)
 	}
 	if err := api.alerts.Put(validAlerts...); err != nil {
@@ -470,6 +1371,335 @@ func (api *API) insertAlerts(w http.ResponseWriter, r *http.Request, alerts ...*
 	api.respond(w, nil)
 }
 
+// syntheticTestAlertName is the alertname given to every synthetic test
+// alert, so it is unambiguous in notifications, dashboards, and logs that
+// the alert did not come from a real source.
+const syntheticTestAlertName = "AlertmanagerSyntheticTest"
+
+// testAlertRequest is the body of a synthetic test alert request. Labels,
+// if given, are merged onto the synthetic alert on top of alertname, so an
+// operator can exercise a specific route by setting e.g. {"team": "x"}.
+type testAlertRequest struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// routeMatch describes one routing node a synthetic test alert matched on
+// its way through the routing tree.
+type routeMatch struct {
+	Route         string        `json:"route"`
+	Receiver      string        `json:"receiver"`
+	GroupBy       []string      `json:"groupBy,omitempty"`
+	GroupByAll    bool          `json:"groupByAll,omitempty"`
+	GroupWait     time.Duration `json:"groupWait"`
+	GroupInterval time.Duration `json:"groupInterval"`
+}
+
+// testAlertResponse is the trace returned for a synthetic test alert: the
+// alert that was injected and every route it matched. Actual notification
+// delivery happens asynchronously afterwards, following the matched
+// routes' normal group_wait/group_interval timing, the same as for any
+// other alert.
+type testAlertResponse struct {
+	Fingerprint string            `json:"fingerprint"`
+	Labels      map[string]string `json:"labels"`
+	Matches     []routeMatch      `json:"matches"`
+}
+
+// testAlert injects a clearly-labeled synthetic alert and reports how it
+// matched the routing tree, so operators can verify a new receiver or route
+// is reachable without waiting for a real incident.
+func (api *API) testAlert(w http.ResponseWriter, r *http.Request) {
+	var req testAlertRequest
+	if err := api.receive(r, &req); err != nil && err != io.EOF {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: err,
+		}, nil)
+		return
+	}
+
+	labels := model.LabelSet{}
+	for name, value := range req.Labels {
+		labels[model.LabelName(name)] = model.LabelValue(value)
+	}
+	labels["alertname"] = syntheticTestAlertName
+
+	now := time.Now()
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels: labels,
+			Annotations: model.LabelSet{
+				"summary": "This is a synthetic test alert injected via the API. It is not a real incident.",
+			},
+			StartsAt: now,
+			EndsAt:   now.Add(5 * time.Minute),
+		},
+		UpdatedAt: now,
+	}
+
+	api.mtx.RLock()
+	matchedRoutes := api.route.Match(labels, alert.Annotations)
+	api.mtx.RUnlock()
+
+	matches := make([]routeMatch, 0, len(matchedRoutes))
+	for _, mr := range matchedRoutes {
+		var groupBy []string
+		for ln := range mr.RouteOpts.GroupBy {
+			groupBy = append(groupBy, string(ln))
+		}
+		sort.Strings(groupBy)
+		matches = append(matches, routeMatch{
+			Route:         mr.Key(),
+			Receiver:      resolveReceiver(mr, labels),
+			GroupBy:       groupBy,
+			GroupByAll:    mr.RouteOpts.GroupByAll,
+			GroupWait:     mr.RouteOpts.GroupWait,
+			GroupInterval: mr.RouteOpts.GroupInterval,
+		})
+	}
+
+	if err := alert.Validate(); err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: err,
+		}, nil)
+		return
+	}
+	if err := api.alerts.Put(alert); err != nil {
+		api.respondError(w, apiError{
+			typ: errorInternal,
+			err: err,
+		}, nil)
+		return
+	}
+
+	respLabels := make(map[string]string, len(labels))
+	for name, value := range labels {
+		respLabels[string(name)] = string(value)
+	}
+
+	api.respond(w, testAlertResponse{
+		Fingerprint: alert.Fingerprint().String(),
+		Labels:      respLabels,
+		Matches:     matches,
+	})
+}
+
+// auditEvent is the API representation of a single audit.Event.
+type auditEvent struct {
+	Type         string    `json:"type"`
+	Timestamp    time.Time `json:"timestamp"`
+	Actor        string    `json:"actor,omitempty"`
+	Receiver     string    `json:"receiver,omitempty"`
+	GroupKey     string    `json:"groupKey,omitempty"`
+	Fingerprints []string  `json:"fingerprints,omitempty"`
+	Outcome      string    `json:"outcome,omitempty"`
+	Detail       string    `json:"detail,omitempty"`
+}
+
+// listAudit answers who silenced what and whether a notification was
+// actually delivered, by returning the recorded audit trail, most recent
+// last. An optional ?limit= caps how many records are returned.
+func (api *API) listAudit(w http.ResponseWriter, r *http.Request) {
+	limit := -1
+	if limitParam := r.FormValue("limit"); limitParam != "" {
+		var err error
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil || limit < 0 {
+			api.respondError(w, apiError{
+				typ: errorBadData,
+				err: fmt.Errorf("limit must be a non-negative integer, got %q", limitParam),
+			}, nil)
+			return
+		}
+	}
+
+	api.mtx.RLock()
+	a := api.audit
+	api.mtx.RUnlock()
+
+	var events []audit.Event
+	if a != nil {
+		events = a.Events(limit)
+	}
+
+	resp := make([]auditEvent, 0, len(events))
+	for _, e := range events {
+		resp = append(resp, auditEvent{
+			Type:         string(e.Type),
+			Timestamp:    e.Timestamp,
+			Actor:        e.Actor,
+			Receiver:     e.Receiver,
+			GroupKey:     e.GroupKey,
+			Fingerprints: e.Fingerprints,
+			Outcome:      e.Outcome,
+			Detail:       e.Detail,
+		})
+	}
+
+	api.respond(w, resp)
+}
+
+// alertHistoryEvent is the API representation of a single history.Event.
+type alertHistoryEvent struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Detail    string    `json:"detail,omitempty"`
+}
+
+// alertHistory answers why a specific alert did or didn't page, by
+// returning its recorded lifecycle events (received, grouped, notified,
+// silenced, resolved) in chronological order.
+func (api *API) alertHistory(w http.ResponseWriter, r *http.Request) {
+	fp, err := model.ParseFingerprint(route.Param(r.Context(), "fingerprint"))
+	if err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: fmt.Errorf("invalid fingerprint %q: %s", route.Param(r.Context(), "fingerprint"), err),
+		}, nil)
+		return
+	}
+
+	api.mtx.RLock()
+	h := api.history
+	api.mtx.RUnlock()
+
+	var events []history.Event
+	if h != nil {
+		events = h.Get(fp)
+	}
+
+	resp := make([]alertHistoryEvent, 0, len(events))
+	for _, e := range events {
+		resp = append(resp, alertHistoryEvent{
+			Type:      string(e.Type),
+			Timestamp: e.Timestamp,
+			Detail:    e.Detail,
+		})
+	}
+
+	api.respond(w, resp)
+}
+
+// snoozeAlert creates a silence matching the given alert's labels from a
+// callback URL rendered into Alert.Links.Snooze (see template.Template),
+// e.g. a "snooze" button on a chat notification. The request is accepted
+// only if it carries a valid, unexpired signature for the fingerprint, so
+// that the endpoint can't be used to silence an arbitrary alert by guessing
+// its fingerprint.
+func (api *API) snoozeAlert(w http.ResponseWriter, r *http.Request) {
+	fp, err := model.ParseFingerprint(route.Param(r.Context(), "fingerprint"))
+	if err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: fmt.Errorf("invalid fingerprint %q: %s", route.Param(r.Context(), "fingerprint"), err),
+		}, nil)
+		return
+	}
+
+	api.mtx.RLock()
+	conf := api.config
+	m := api.maintenance
+	api.mtx.RUnlock()
+
+	if m != nil && m.ReadOnly() {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("instance is in read-only mode, not accepting silence mutations"),
+		}, nil)
+		return
+	}
+
+	if conf == nil || conf.Global == nil || conf.Global.SnoozeSecret == "" {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("snoozing is not configured"),
+		}, nil)
+		return
+	}
+
+	q := r.URL.Query()
+	if !template.VerifySnoozeToken(string(conf.Global.SnoozeSecret), fp.String(), q.Get("expires"), q.Get("sig")) {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("invalid or expired snooze link"),
+		}, nil)
+		return
+	}
+
+	alert, err := api.alerts.Get(fp)
+	if err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: fmt.Errorf("alert %s not found: %s", fp, err),
+		}, nil)
+		return
+	}
+
+	pin := conf.Global.SnoozeLabels
+	matchers := make(types.Matchers, 0, len(alert.Labels))
+	for ln, lv := range alert.Labels {
+		if len(pin) > 0 && !stringsContain(pin, string(ln)) {
+			continue
+		}
+		matchers = append(matchers, &types.Matcher{Name: string(ln), Value: string(lv)})
+	}
+
+	ttl := time.Duration(conf.Global.SnoozeTTL)
+	if ttl <= 0 {
+		ttl = time.Hour
+	}
+	now := time.Now()
+	sil := types.Silence{
+		Matchers:  matchers,
+		StartsAt:  now,
+		EndsAt:    now.Add(ttl),
+		CreatedBy: "snooze-callback",
+		Comment:   fmt.Sprintf("snoozed via callback link for alert %s", fp),
+	}
+
+	psil, err := silenceToProto(&sil)
+	if err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: err,
+		}, nil)
+		return
+	}
+
+	sid, err := api.silences.Set(psil)
+	if err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: err,
+		}, nil)
+		return
+	}
+
+	api.mtx.RLock()
+	a := api.audit
+	api.mtx.RUnlock()
+	if a != nil {
+		a.Record(audit.Event{Type: audit.EventSilenceCreated, Actor: psil.CreatedBy, Detail: sid})
+	}
+
+	api.respond(w, struct {
+		SilenceID string `json:"silenceId"`
+	}{
+		SilenceID: sid,
+	})
+}
+
+// stringsContain reports whether ss contains s.
+func stringsContain(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
 func removeEmptyLabels(ls model.LabelSet) {
 	for k, v := range ls {
 		if string(v) == "" {
@@ -478,7 +1708,23 @@ func removeEmptyLabels(ls model.LabelSet) {
 	}
 }
 
+// setSilence creates or updates a silence (equality and regex matchers,
+// start/end time, creator, comment) via POST /api/v1/silences. Active
+// silences suppress notifications for matching firing alerts in the
+// notify pipeline (see notify.Muter), and expired ones are removed by
+// Silences.GC once past the configured retention.
 func (api *API) setSilence(w http.ResponseWriter, r *http.Request) {
+	api.mtx.RLock()
+	m := api.maintenance
+	api.mtx.RUnlock()
+	if m != nil && m.ReadOnly() {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("instance is in read-only mode, not accepting silence mutations"),
+		}, nil)
+		return
+	}
+
 	var sil types.Silence
 	if err := api.receive(r, &sil); err != nil {
 		api.respondError(w, apiError{
@@ -526,6 +1772,13 @@ func (api *API) setSilence(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	api.mtx.RLock()
+	a := api.audit
+	api.mtx.RUnlock()
+	if a != nil {
+		a.Record(audit.Event{Type: audit.EventSilenceCreated, Actor: psil.CreatedBy, Detail: sid})
+	}
+
 	api.respond(w, struct {
 		SilenceID string `json:"silenceId"`
 	}{
@@ -537,8 +1790,18 @@ func (api *API) getSilence(w http.ResponseWriter, r *http.Request) {
 	sid := route.Param(r.Context(), "sid")
 
 	sils, _, err := api.silences.Query(silence.QIDs(sid))
-	if err != nil || len(sils) == 0 {
-		http.Error(w, fmt.Sprint("Error getting silence: ", err), http.StatusNotFound)
+	if err != nil {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: err,
+		}, nil)
+		return
+	}
+	if len(sils) == 0 {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: fmt.Errorf("silence %q not found", sid),
+		}, nil)
 		return
 	}
 	sil, err := silenceFromProto(sils[0])
@@ -554,6 +1817,17 @@ func (api *API) getSilence(w http.ResponseWriter, r *http.Request) {
 }
 
 func (api *API) delSilence(w http.ResponseWriter, r *http.Request) {
+	api.mtx.RLock()
+	m := api.maintenance
+	api.mtx.RUnlock()
+	if m != nil && m.ReadOnly() {
+		api.respondError(w, apiError{
+			typ: errorBadData,
+			err: errors.New("instance is in read-only mode, not accepting silence mutations"),
+		}, nil)
+		return
+	}
+
 	sid := route.Param(r.Context(), "sid")
 
 	if err := api.silences.Expire(sid); err != nil {
@@ -563,6 +1837,14 @@ func (api *API) delSilence(w http.ResponseWriter, r *http.Request) {
 		}, nil)
 		return
 	}
+
+	api.mtx.RLock()
+	a := api.audit
+	api.mtx.RUnlock()
+	if a != nil {
+		a.Record(audit.Event{Type: audit.EventSilenceDeleted, Detail: sid})
+	}
+
 	api.respond(w, nil)
 }
 
@@ -673,18 +1955,20 @@ func matchFilterLabels(matchers []*labels.Matcher, sms map[string]string) bool {
 
 func silenceToProto(s *types.Silence) (*silencepb.Silence, error) {
 	sil := &silencepb.Silence{
-		Id:        s.ID,
-		StartsAt:  s.StartsAt,
-		EndsAt:    s.EndsAt,
-		UpdatedAt: s.UpdatedAt,
-		Comment:   s.Comment,
-		CreatedBy: s.CreatedBy,
+		Id:         s.ID,
+		StartsAt:   s.StartsAt,
+		EndsAt:     s.EndsAt,
+		UpdatedAt:  s.UpdatedAt,
+		Comment:    s.Comment,
+		CreatedBy:  s.CreatedBy,
+		Recurrence: s.Recurrence,
 	}
 	for _, m := range s.Matchers {
 		matcher := &silencepb.Matcher{
-			Name:    m.Name,
-			Pattern: m.Value,
-			Type:    silencepb.Matcher_EQUAL,
+			Name:         m.Name,
+			Pattern:      m.Value,
+			Type:         silencepb.Matcher_EQUAL,
+			IsAnnotation: m.Annotation,
 		}
 		if m.IsRegex {
 			matcher.Type = silencepb.Matcher_REGEXP
@@ -695,21 +1979,32 @@ func silenceToProto(s *types.Silence) (*silencepb.Silence, error) {
 }
 
 func silenceFromProto(s *silencepb.Silence) (*types.Silence, error) {
+	now := time.Now()
+	state, err := silence.CalcSilenceState(s, now)
+	if err != nil {
+		return nil, err
+	}
+	status := types.SilenceStatus{State: state}
+	if state != types.SilenceStateActive {
+		if next, ok := silence.NextActive(s, now); ok {
+			status.NextActiveAt = &next
+		}
+	}
 	sil := &types.Silence{
-		ID:        s.Id,
-		StartsAt:  s.StartsAt,
-		EndsAt:    s.EndsAt,
-		UpdatedAt: s.UpdatedAt,
-		Status: types.SilenceStatus{
-			State: types.CalcSilenceState(s.StartsAt, s.EndsAt),
-		},
-		Comment:   s.Comment,
-		CreatedBy: s.CreatedBy,
+		ID:         s.Id,
+		StartsAt:   s.StartsAt,
+		EndsAt:     s.EndsAt,
+		UpdatedAt:  s.UpdatedAt,
+		Status:     status,
+		Comment:    s.Comment,
+		CreatedBy:  s.CreatedBy,
+		Recurrence: s.Recurrence,
 	}
 	for _, m := range s.Matchers {
 		matcher := &types.Matcher{
-			Name:  m.Name,
-			Value: m.Pattern,
+			Name:       m.Name,
+			Value:      m.Pattern,
+			Annotation: m.IsAnnotation,
 		}
 		switch m.Type {
 		case silencepb.Matcher_EQUAL:
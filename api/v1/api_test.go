@@ -15,23 +15,43 @@ package v1
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/common/route"
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/history"
+	"github.com/prometheus/alertmanager/maintenance"
+	"github.com/prometheus/alertmanager/notify/escalation"
+	"github.com/prometheus/alertmanager/notify/groupnotes"
+	"github.com/prometheus/alertmanager/notify/preview"
+	"github.com/prometheus/alertmanager/notify/receivermute"
+	"github.com/prometheus/alertmanager/notify/standby"
 	"github.com/prometheus/alertmanager/pkg/labels"
 	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/provider/mem"
+	"github.com/prometheus/alertmanager/quota"
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -57,11 +77,30 @@ func newFakeAlerts(alerts []*types.Alert, withErr bool) *fakeAlerts {
 	return f
 }
 
-func (f *fakeAlerts) Subscribe() provider.AlertIterator           { return nil }
-func (f *fakeAlerts) Get(model.Fingerprint) (*types.Alert, error) { return nil, nil }
+func (f *fakeAlerts) Subscribe() provider.AlertIterator { return nil }
+func (f *fakeAlerts) Get(fp model.Fingerprint) (*types.Alert, error) {
+	i, ok := f.fps[fp]
+	if !ok {
+		return nil, errors.New("alert not found")
+	}
+	return f.alerts[i], f.err
+}
 func (f *fakeAlerts) Put(alerts ...*types.Alert) error {
 	return f.err
 }
+
+// capturingAlerts wraps a fakeAlerts but additionally records every alert
+// passed to Put, for tests that need to inspect what would have been
+// stored.
+type capturingAlerts struct {
+	*fakeAlerts
+	put []*types.Alert
+}
+
+func (f *capturingAlerts) Put(alerts ...*types.Alert) error {
+	f.put = append(f.put, alerts...)
+	return f.fakeAlerts.Put(alerts...)
+}
 func (f *fakeAlerts) GetPending() provider.AlertIterator {
 	ch := make(chan *types.Alert)
 	done := make(chan struct{})
@@ -139,7 +178,7 @@ func TestAddAlerts(t *testing.T) {
 		api.Update(&config.Config{
 			Global: &defaultGlobalConfig,
 			Route:  &route,
-		})
+		}, nil)
 
 		r, err := http.NewRequest("POST", "/api/v1/alerts", bytes.NewReader(b))
 		w := httptest.NewRecorder()
@@ -155,6 +194,114 @@ func TestAddAlerts(t *testing.T) {
 	}
 }
 
+func TestAddAlertsParsesValueAndThresholdFromAnnotations(t *testing.T) {
+	alerts := []model.Alert{{
+		Labels:      model.LabelSet{"alertname": "HighCPU"},
+		Annotations: model.LabelSet{"value": "97.5", "threshold": "90"},
+	}}
+	b, err := json.Marshal(&alerts)
+	require.NoError(t, err)
+
+	alertsProvider := &capturingAlerts{fakeAlerts: newFakeAlerts([]*types.Alert{}, false)}
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider.fakeAlerts), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	route := config.Route{}
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &route,
+	}, nil)
+
+	r, err := http.NewRequest("POST", "/api/v1/alerts", bytes.NewReader(b))
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+
+	api.addAlerts(w, r)
+	require.Equal(t, 200, w.Code)
+
+	require.Len(t, alertsProvider.put, 1)
+	require.NotNil(t, alertsProvider.put[0].Value)
+	require.Equal(t, 97.5, *alertsProvider.put[0].Value)
+	require.NotNil(t, alertsProvider.put[0].Threshold)
+	require.Equal(t, 90.0, *alertsProvider.put[0].Threshold)
+}
+
+func TestAddAlertsSizeLimits(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		truncate bool
+		code     int
+	}{
+		{name: "reject oversized by default", truncate: false, code: 400},
+		{name: "truncate when configured", truncate: true, code: 200},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			alerts := []model.Alert{{
+				Labels: model.LabelSet{"alertname": "test", "toolong": "1234567890"},
+			}}
+			b, err := json.Marshal(&alerts)
+			require.NoError(t, err)
+
+			alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+			api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+			globalConfig := config.DefaultGlobalConfig()
+			globalConfig.MaxLabelValueLength = 4
+			globalConfig.TruncateOversizedValues = tc.truncate
+			route := config.Route{}
+			api.Update(&config.Config{
+				Global: &globalConfig,
+				Route:  &route,
+			}, nil)
+
+			r, err := http.NewRequest("POST", "/api/v1/alerts", bytes.NewReader(b))
+			require.NoError(t, err)
+			w := httptest.NewRecorder()
+
+			api.addAlerts(w, r)
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestAddAlertsTenantQuota(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		limit int
+		code  int
+	}{
+		{name: "reject once tenant quota is reached", limit: 1, code: 400},
+		{name: "allow under quota", limit: 2, code: 200},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			alerts := []model.Alert{{
+				Labels: model.LabelSet{"alertname": "test", "team": "a"},
+			}}
+			b, err := json.Marshal(&alerts)
+			require.NoError(t, err)
+
+			alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+			api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+			defaultGlobalConfig := config.DefaultGlobalConfig()
+			route := config.Route{}
+			api.Update(&config.Config{
+				Global: &defaultGlobalConfig,
+				Route:  &route,
+			}, nil)
+
+			quotaTracker := quota.New(nil)
+			quotaTracker.Configure("team", map[string]quota.Limits{"a": {MaxAlerts: tc.limit}})
+			quotaTracker.ObserveAlert("a")
+			api.SetQuotaTracker(quotaTracker)
+
+			r, err := http.NewRequest("POST", "/api/v1/alerts", bytes.NewReader(b))
+			require.NoError(t, err)
+			w := httptest.NewRecorder()
+
+			api.addAlerts(w, r)
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
 func TestListAlerts(t *testing.T) {
 	now := time.Now()
 	alerts := []*types.Alert{
@@ -258,6 +405,36 @@ func TestListAlerts(t *testing.T) {
 			400,
 			[]string{},
 		},
+		{
+			false,
+			map[string]string{"limit": "2"},
+			200,
+			[]string{"alert1", "alert2"},
+		},
+		{
+			false,
+			map[string]string{"offset": "2"},
+			200,
+			[]string{"alert3", "alert4"},
+		},
+		{
+			false,
+			map[string]string{"offset": "1", "limit": "2"},
+			200,
+			[]string{"alert2", "alert3"},
+		},
+		{
+			false,
+			map[string]string{"offset": "100"},
+			200,
+			[]string{},
+		},
+		{
+			false,
+			map[string]string{"limit": "-1"},
+			400,
+			[]string{},
+		},
 		{
 			true,
 			map[string]string{},
@@ -267,7 +444,7 @@ func TestListAlerts(t *testing.T) {
 	} {
 		alertsProvider := newFakeAlerts(alerts, tc.err)
 		api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
-		api.route = dispatch.NewRoute(&config.Route{Receiver: "def-receiver"}, nil)
+		api.route = dispatch.NewRoute(&config.Route{Receiver: "def-receiver"}, nil, nil)
 
 		r, err := http.NewRequest("GET", "/api/v1/alerts", nil)
 		if err != nil {
@@ -572,6 +749,32 @@ func TestMatchFilterLabels(t *testing.T) {
 	}
 }
 
+func TestAlertsToScanUsesLabelIndex(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, log.NewNopLogger())
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	require.NoError(t, alerts.Put(
+		&types.Alert{Alert: model.Alert{Labels: model.LabelSet{"team": "infra"}}},
+		&types.Alert{Alert: model.Alert{Labels: model.LabelSet{"team": "payments"}}},
+	))
+
+	api := API{alerts: alerts}
+
+	matchers, err := labels.ParseMatchers(`team="infra"`)
+	require.NoError(t, err)
+
+	it := api.alertsToScan(matchers)
+	defer it.Close()
+	var got []*types.Alert
+	for a := range it.Next() {
+		got = append(got, a)
+	}
+	require.Len(t, got, 1)
+	require.Equal(t, model.LabelValue("infra"), got[0].Labels["team"])
+}
+
 func newMatcher(labelSet model.LabelSet) types.Matchers {
 	matchers := make([]*types.Matcher, 0, len(labelSet))
 	for key, val := range labelSet {
@@ -579,3 +782,868 @@ func newMatcher(labelSet model.LabelSet) types.Matchers {
 	}
 	return matchers
 }
+
+func TestPreviewReceiver(t *testing.T) {
+	tmpl, err := template.FromGlobs()
+	require.NoError(t, err)
+	tmpl.ExternalURL, err = url.Parse("http://am")
+	require.NoError(t, err)
+
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &config.Route{},
+		Receivers: []*config.Receiver{
+			{
+				Name: "team-x",
+				SlackConfigs: []*config.SlackConfig{
+					{Channel: "#alerts", Title: `{{ .CommonLabels.alertname }}`},
+				},
+			},
+		},
+	}, tmpl)
+
+	body := `{"alerts":[{"labels":{"alertname":"InstanceDown"}}]}`
+	req, err := http.NewRequest("POST", "/api/v1/receivers/team-x/preview", strings.NewReader(body))
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "name", "team-x"))
+
+	w := httptest.NewRecorder()
+	api.previewReceiver(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var results []preview.Result
+	require.NoError(t, json.Unmarshal(data, &results))
+
+	require.Len(t, results, 1)
+	require.Equal(t, "slack", results[0].Integration)
+	require.Equal(t, "InstanceDown", results[0].Fields["title"])
+}
+
+func TestPreviewReceiverUnknownReceiver(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &config.Route{},
+	}, nil)
+
+	body := `{"alerts":[{"labels":{"alertname":"InstanceDown"}}]}`
+	req, err := http.NewRequest("POST", "/api/v1/receivers/missing/preview", strings.NewReader(body))
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "name", "missing"))
+
+	w := httptest.NewRecorder()
+	api.previewReceiver(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTestAlert(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route: &config.Route{
+			Receiver: "fallback",
+			Routes: []*config.Route{
+				{
+					Receiver: "team-x",
+					Match:    map[string]string{"team": "x"},
+				},
+			},
+		},
+		Receivers: []*config.Receiver{
+			{Name: "fallback"},
+			{Name: "team-x"},
+		},
+	}, nil)
+
+	body := `{"labels":{"team":"x"}}`
+	req, err := http.NewRequest("POST", "/api/v1/alerts/test", strings.NewReader(body))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.testAlert(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var result testAlertResponse
+	require.NoError(t, json.Unmarshal(data, &result))
+
+	require.Equal(t, syntheticTestAlertName, result.Labels["alertname"])
+	require.Equal(t, "x", result.Labels["team"])
+	require.Len(t, result.Matches, 1)
+	require.Equal(t, "team-x", result.Matches[0].Receiver)
+
+	require.Len(t, alertsProvider.alerts, 0) // Put() is stubbed in fakeAlerts and doesn't store.
+}
+
+func TestTestAlertNoBody(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &config.Route{Receiver: "fallback"},
+		Receivers: []*config.Receiver{
+			{Name: "fallback"},
+		},
+	}, nil)
+
+	req, err := http.NewRequest("POST", "/api/v1/alerts/test", strings.NewReader(""))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.testAlert(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRoutes(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route: &config.Route{
+			Receiver: "fallback",
+			Routes: []*config.Route{
+				{
+					Receiver: "team-x",
+					Match:    map[string]string{"team": "x"},
+				},
+			},
+		},
+		Receivers: []*config.Receiver{
+			{Name: "fallback"},
+			{Name: "team-x"},
+		},
+	}, nil)
+
+	req, err := http.NewRequest("GET", "/api/v1/routes", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.routes(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var tree routeNode
+	require.NoError(t, json.Unmarshal(data, &tree))
+
+	require.Equal(t, "fallback", tree.Receiver)
+	require.Len(t, tree.Routes, 1)
+	require.Equal(t, "team-x", tree.Routes[0].Receiver)
+}
+
+func TestTestRoute(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route: &config.Route{
+			Receiver: "fallback",
+			Routes: []*config.Route{
+				{
+					Receiver: "team-x",
+					Match:    map[string]string{"team": "x"},
+				},
+			},
+		},
+		Receivers: []*config.Receiver{
+			{Name: "fallback"},
+			{Name: "team-x"},
+		},
+	}, nil)
+
+	body := `{"labels":{"team":"x"}}`
+	req, err := http.NewRequest("POST", "/api/v1/routes/test", strings.NewReader(body))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.testRoute(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var matches []routeMatch
+	require.NoError(t, json.Unmarshal(data, &matches))
+
+	require.Len(t, matches, 1)
+	require.Equal(t, "team-x", matches[0].Receiver)
+
+	require.Len(t, alertsProvider.alerts, 0) // testRoute must not inject an alert.
+}
+
+func TestTestRouteTrace(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route: &config.Route{
+			Receiver: "fallback",
+			Routes: []*config.Route{
+				{
+					Receiver: "team-x",
+					Match:    map[string]string{"team": "x"},
+				},
+				{
+					Receiver: "team-y",
+					Match:    map[string]string{"team": "y"},
+				},
+			},
+		},
+		Receivers: []*config.Receiver{
+			{Name: "fallback"},
+			{Name: "team-x"},
+			{Name: "team-y"},
+		},
+	}, nil)
+
+	body := `{"labels":{"team":"x"}}`
+	req, err := http.NewRequest("POST", "/api/v1/routes/test?trace=1", strings.NewReader(body))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.testRoute(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var traced testRouteResponse
+	require.NoError(t, json.Unmarshal(data, &traced))
+
+	require.Len(t, traced.Matches, 1)
+	require.Equal(t, "team-x", traced.Matches[0].Receiver)
+
+	require.True(t, traced.Trace.Matched)
+	require.Len(t, traced.Trace.Children, 2)
+	require.True(t, traced.Trace.Children[0].Matched)
+	require.False(t, traced.Trace.Children[0].Skipped)
+	require.False(t, traced.Trace.Children[1].Matched)
+	require.True(t, traced.Trace.Children[1].Skipped) // team-y never evaluated: team-x matched without continue
+}
+
+func TestTestRouteNoBody(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &config.Route{Receiver: "fallback"},
+		Receivers: []*config.Receiver{
+			{Name: "fallback"},
+		},
+	}, nil)
+
+	req, err := http.NewRequest("POST", "/api/v1/routes/test", strings.NewReader(""))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.testRoute(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGroups(t *testing.T) {
+	api := New(newFakeAlerts([]*types.Alert{}, false), nil, nil, nil, nil, nil)
+	api.Update(&config.Config{Route: &config.Route{Receiver: "fallback"}}, nil)
+
+	groupFunc := func(routeFilter func(*dispatch.Route) bool, alertFilter func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[model.Fingerprint][]string) {
+		return dispatch.AlertGroups{
+			{
+				Key:      "group-1",
+				Labels:   model.LabelSet{"alertname": "Foo"},
+				Receiver: "fallback",
+				Alerts:   types.AlertSlice{&types.Alert{}},
+			},
+		}, nil
+	}
+	api.SetGroupFunc(groupFunc)
+
+	tracker := groupnotes.New()
+	tracker.Set("group-1", "driver rollback in progress")
+	api.SetGroupNotesTracker(tracker)
+
+	req, err := http.NewRequest("GET", "/api/v1/groups", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.groups(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var groups []groupSummary
+	require.NoError(t, json.Unmarshal(data, &groups))
+
+	require.Len(t, groups, 1)
+	require.Equal(t, "group-1", groups[0].Key)
+	require.Equal(t, "fallback", groups[0].Receiver)
+	require.Equal(t, "driver rollback in progress", groups[0].Notes)
+	require.Equal(t, 1, groups[0].Alerts)
+}
+
+func TestAlertGroups(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	api.Update(&config.Config{Route: &config.Route{Receiver: "fallback"}}, nil)
+
+	a := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"alertname": "Foo"}}}
+	nextFlush := time.Now().Add(time.Minute)
+	groupFunc := func(routeFilter func(*dispatch.Route) bool, alertFilter func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[model.Fingerprint][]string) {
+		return dispatch.AlertGroups{
+			{
+				Key:       "group-1",
+				Labels:    model.LabelSet{"alertname": "Foo"},
+				Receiver:  "fallback",
+				NextFlush: nextFlush,
+				Alerts:    types.AlertSlice{a},
+			},
+		}, map[model.Fingerprint][]string{a.Fingerprint(): {"fallback"}}
+	}
+	api.SetGroupFunc(groupFunc)
+
+	req, err := http.NewRequest("GET", "/api/v1/alerts/groups", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	api.alertGroups(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var groups []alertGroup
+	require.NoError(t, json.Unmarshal(data, &groups))
+
+	require.Len(t, groups, 1)
+	require.Equal(t, "fallback", groups[0].Receiver)
+	require.Equal(t, map[string]string{"alertname": "Foo"}, groups[0].Labels)
+	require.True(t, nextFlush.Equal(groups[0].NextFlush))
+	require.Len(t, groups[0].Alerts, 1)
+	require.Equal(t, []string{"fallback"}, groups[0].Alerts[0].Receivers)
+}
+
+func TestSetAndClearGroupNotes(t *testing.T) {
+	api := New(newFakeAlerts([]*types.Alert{}, false), nil, nil, nil, nil, nil)
+	api.Update(&config.Config{Route: &config.Route{Receiver: "fallback"}}, nil)
+
+	tracker := groupnotes.New()
+	api.SetGroupNotesTracker(tracker)
+
+	body := `{"notes":"driver rollback in progress"}`
+	req, err := http.NewRequest("POST", "/api/v1/groups/group-1/notes", strings.NewReader(body))
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "key", "group-1"))
+
+	w := httptest.NewRecorder()
+	api.setGroupNotes(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	notes, ok := tracker.Get("group-1")
+	require.True(t, ok)
+	require.Equal(t, "driver rollback in progress", notes)
+
+	req, err = http.NewRequest("DELETE", "/api/v1/groups/group-1/notes", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "key", "group-1"))
+
+	w = httptest.NewRecorder()
+	api.clearGroupNotes(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	_, ok = tracker.Get("group-1")
+	require.False(t, ok)
+}
+
+func TestSetGroupNotesDisabled(t *testing.T) {
+	api := New(newFakeAlerts([]*types.Alert{}, false), nil, nil, nil, nil, nil)
+	api.Update(&config.Config{Route: &config.Route{Receiver: "fallback"}}, nil)
+
+	req, err := http.NewRequest("POST", "/api/v1/groups/group-1/notes", strings.NewReader(`{"notes":"x"}`))
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "key", "group-1"))
+
+	w := httptest.NewRecorder()
+	api.setGroupNotes(w, req)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestAckGroup(t *testing.T) {
+	api := New(newFakeAlerts([]*types.Alert{}, false), nil, nil, nil, nil, nil)
+	api.Update(&config.Config{Route: &config.Route{Receiver: "fallback"}}, nil)
+
+	tracker := escalation.New()
+	api.SetEscalationTracker(tracker)
+
+	req, err := http.NewRequest("POST", "/api/v1/groups/group-1/ack", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "key", "group-1"))
+
+	w := httptest.NewRecorder()
+	api.ackGroup(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	require.True(t, tracker.AckedSince("group-1", time.Unix(0, 0)))
+}
+
+func TestAckGroupDisabled(t *testing.T) {
+	api := New(newFakeAlerts([]*types.Alert{}, false), nil, nil, nil, nil, nil)
+	api.Update(&config.Config{Route: &config.Route{Receiver: "fallback"}}, nil)
+
+	req, err := http.NewRequest("POST", "/api/v1/groups/group-1/ack", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "key", "group-1"))
+
+	w := httptest.NewRecorder()
+	api.ackGroup(w, req)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestAlertHistory(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+
+	h := history.New(0)
+	api.SetHistory(h)
+
+	fp := model.LabelSet{"alertname": "test"}.Fingerprint()
+	h.Add(fp, history.EventReceived, "")
+	h.Add(fp, history.EventNotified, "fallback/webhook")
+
+	req, err := http.NewRequest("GET", "/api/v1/alert/"+fp.String()+"/history", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "fingerprint", fp.String()))
+
+	w := httptest.NewRecorder()
+	api.alertHistory(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var events []alertHistoryEvent
+	require.NoError(t, json.Unmarshal(data, &events))
+
+	require.Len(t, events, 2)
+	require.Equal(t, string(history.EventReceived), events[0].Type)
+	require.Equal(t, string(history.EventNotified), events[1].Type)
+	require.Equal(t, "fallback/webhook", events[1].Detail)
+}
+
+func TestMuteReceiver(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &config.Route{},
+		Receivers: []*config.Receiver{
+			{Name: "team-x"},
+		},
+	}, nil)
+
+	tracker := receivermute.New()
+	api.SetReceiverMuteTracker(tracker)
+
+	req, err := http.NewRequest("POST", "/api/v1/receivers/team-x/mute?duration=2h", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "name", "team-x"))
+
+	w := httptest.NewRecorder()
+	api.muteReceiver(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	muted, _ := tracker.Muted("team-x")
+	require.True(t, muted)
+
+	req, err = http.NewRequest("GET", "/api/v1/receivers", nil)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	api.receivers(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var statuses []receiverStatus
+	require.NoError(t, json.Unmarshal(data, &statuses))
+	require.Len(t, statuses, 1)
+	require.True(t, statuses[0].Muted)
+
+	req, err = http.NewRequest("DELETE", "/api/v1/receivers/team-x/mute", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "name", "team-x"))
+
+	w = httptest.NewRecorder()
+	api.unmuteReceiver(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	muted, _ = tracker.Muted("team-x")
+	require.False(t, muted)
+}
+
+func TestMuteReceiverUnknownReceiver(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global:    &defaultGlobalConfig,
+		Route:     &config.Route{},
+		Receivers: []*config.Receiver{{Name: "team-x"}},
+	}, nil)
+	api.SetReceiverMuteTracker(receivermute.New())
+
+	req, err := http.NewRequest("POST", "/api/v1/receivers/missing/mute?duration=2h", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "name", "missing"))
+
+	w := httptest.NewRecorder()
+	api.muteReceiver(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestMuteReceiverInvalidDuration(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global:    &defaultGlobalConfig,
+		Route:     &config.Route{},
+		Receivers: []*config.Receiver{{Name: "team-x"}},
+	}, nil)
+	api.SetReceiverMuteTracker(receivermute.New())
+
+	req, err := http.NewRequest("POST", "/api/v1/receivers/team-x/mute?duration=notaduration", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "name", "team-x"))
+
+	w := httptest.NewRecorder()
+	api.muteReceiver(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestDisableAndEnableNotifications(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &config.Route{},
+	}, nil)
+
+	tracker := standby.New(false)
+	api.SetStandbyTracker(tracker)
+
+	req, err := http.NewRequest("POST", "/api/v1/notifications/disable", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.disableNotifications(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.True(t, tracker.Enabled())
+
+	req, err = http.NewRequest("DELETE", "/api/v1/notifications/disable", nil)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	api.enableNotifications(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.False(t, tracker.Enabled())
+}
+
+func TestDisableNotificationsNotEnabled(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &config.Route{},
+	}, nil)
+
+	req, err := http.NewRequest("POST", "/api/v1/notifications/disable", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.disableNotifications(w, req)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestAlertHistoryInvalidFingerprint(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+
+	req, err := http.NewRequest("GET", "/api/v1/alert/not-a-fingerprint/history", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "fingerprint", "not-a-fingerprint"))
+
+	w := httptest.NewRecorder()
+	api.alertHistory(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSnoozeAlert(t *testing.T) {
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"alertname": "HighLatency", "severity": "critical"},
+		},
+	}
+	alertsProvider := newFakeAlerts([]*types.Alert{alert}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	silences, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+	api.silences = silences
+	api.Update(&config.Config{
+		Route:  &config.Route{Receiver: "fallback"},
+		Global: &config.GlobalConfig{SnoozeSecret: "secret"},
+	}, nil)
+
+	fp := alert.Fingerprint()
+	expires := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	sig := snoozeTestSig(t, "secret", fp.String(), expires)
+
+	req, err := http.NewRequest("POST", "/api/v1/alert/"+fp.String()+"/snooze?expires="+url.QueryEscape(expires)+"&sig="+sig, nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "fingerprint", fp.String()))
+
+	w := httptest.NewRecorder()
+	api.snoozeAlert(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	sils, _, err := silences.Query()
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+}
+
+func TestSnoozeAlertInvalidSignature(t *testing.T) {
+	alert := &types.Alert{
+		Alert: model.Alert{Labels: model.LabelSet{"alertname": "HighLatency"}},
+	}
+	alertsProvider := newFakeAlerts([]*types.Alert{alert}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	silences, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+	api.silences = silences
+	api.Update(&config.Config{
+		Route:  &config.Route{Receiver: "fallback"},
+		Global: &config.GlobalConfig{SnoozeSecret: "secret"},
+	}, nil)
+
+	fp := alert.Fingerprint()
+	expires := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	req, err := http.NewRequest("POST", "/api/v1/alert/"+fp.String()+"/snooze?expires="+url.QueryEscape(expires)+"&sig=deadbeef", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "fingerprint", fp.String()))
+
+	w := httptest.NewRecorder()
+	api.snoozeAlert(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSnoozeAlertNotConfigured(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	api.Update(&config.Config{Route: &config.Route{Receiver: "fallback"}}, nil)
+
+	req, err := http.NewRequest("POST", "/api/v1/alert/deadbeef/snooze", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "fingerprint", "deadbeef"))
+
+	w := httptest.NewRecorder()
+	api.snoozeAlert(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestRegisterDoesNotPanic registers the API against a live route.Router, the
+// same way cmd/alertmanager wires it up at startup. httprouter panics at
+// registration time if two routes for the same HTTP method and parent path
+// segment disagree on whether that segment is static or a wildcard (e.g.
+// POST /alerts/test alongside POST /alerts/:fingerprint/something), so this
+// is the only kind of test that catches that class of bug; calling handlers
+// directly, as the rest of this file does, never exercises Register.
+func TestRegisterDoesNotPanic(t *testing.T) {
+	alertsProvider := newFakeAlerts(nil, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	silences, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+	api.silences = silences
+
+	require.NotPanics(t, func() {
+		api.Register(route.New())
+	})
+}
+
+// snoozeTestSig mirrors the HMAC computed by template.Template when it
+// renders Alert.Links.Snooze, so tests can produce a valid signature without
+// exporting the signing internals from the template package.
+func snoozeTestSig(t *testing.T, secret, fingerprint, expires string) string {
+	t.Helper()
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fingerprint))
+	mac.Write([]byte(expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestEnableAndDisableReadOnly(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	tracker := maintenance.New()
+	api.SetMaintenanceTracker(tracker)
+
+	req, err := http.NewRequest("POST", "/api/v1/maintenance/readonly", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.enableReadOnly(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.True(t, tracker.ReadOnly())
+
+	req, err = http.NewRequest("DELETE", "/api/v1/maintenance/readonly", nil)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	api.disableReadOnly(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.False(t, tracker.ReadOnly())
+}
+
+func TestEnableReadOnlyNotEnabled(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+
+	req, err := http.NewRequest("POST", "/api/v1/maintenance/readonly", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.enableReadOnly(w, req)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestEnableAndDisableDrain(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	tracker := maintenance.New()
+	api.SetMaintenanceTracker(tracker)
+
+	req, err := http.NewRequest("POST", "/api/v1/maintenance/drain", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.enableDrain(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.True(t, tracker.Draining())
+
+	req, err = http.NewRequest("DELETE", "/api/v1/maintenance/drain", nil)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	api.disableDrain(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.False(t, tracker.Draining())
+}
+
+func TestAddAlertsRejectedWhileDraining(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	defaultGlobalConfig := config.DefaultGlobalConfig()
+	api.Update(&config.Config{
+		Global: &defaultGlobalConfig,
+		Route:  &config.Route{},
+	}, nil)
+
+	tracker := maintenance.New()
+	tracker.SetDraining(true)
+	api.SetMaintenanceTracker(tracker)
+
+	alerts := []model.Alert{{
+		Labels: model.LabelSet{"alertname": "test"},
+	}}
+	b, err := json.Marshal(&alerts)
+	require.NoError(t, err)
+
+	r, err := http.NewRequest("POST", "/api/v1/alerts", bytes.NewReader(b))
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.addAlerts(w, r)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSetSilenceRejectedWhileReadOnly(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	silences, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+	api.silences = silences
+
+	tracker := maintenance.New()
+	tracker.SetReadOnly(true)
+	api.SetMaintenanceTracker(tracker)
+
+	sil := types.Silence{
+		Matchers: types.Matchers{{Name: "alertname", Value: "test"}},
+		StartsAt: time.Now(),
+		EndsAt:   time.Now().Add(time.Hour),
+	}
+	b, err := json.Marshal(&sil)
+	require.NoError(t, err)
+
+	r, err := http.NewRequest("POST", "/api/v1/silences", bytes.NewReader(b))
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	api.setSilence(w, r)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestDelSilenceRejectedWhileReadOnly(t *testing.T) {
+	alertsProvider := newFakeAlerts([]*types.Alert{}, false)
+	api := New(alertsProvider, nil, newGetAlertStatus(alertsProvider), nil, nil, nil)
+	silences, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+	api.silences = silences
+
+	tracker := maintenance.New()
+	tracker.SetReadOnly(true)
+	api.SetMaintenanceTracker(tracker)
+
+	req, err := http.NewRequest("DELETE", "/api/v1/silence/deadbeef", nil)
+	require.NoError(t, err)
+	req = req.WithContext(route.WithParam(req.Context(), "sid", "deadbeef"))
+	w := httptest.NewRecorder()
+	api.delSilence(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
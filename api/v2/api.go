@@ -40,11 +40,14 @@ import (
 	general_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/general"
 	receiver_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/receiver"
 	silence_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/silence"
+	"github.com/prometheus/alertmanager/audit"
 	"github.com/prometheus/alertmanager/cluster"
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/dispatch"
 	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/pkg/tracing"
 	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/quota"
 	"github.com/prometheus/alertmanager/silence"
 	"github.com/prometheus/alertmanager/silence/silencepb"
 	"github.com/prometheus/alertmanager/types"
@@ -69,13 +72,15 @@ type API struct {
 
 	logger log.Logger
 	m      *metrics.Alerts
+	quota  *quota.Tracker
+	audit  *audit.Log
 
 	Handler http.Handler
 }
 
 type groupsFn func(func(*dispatch.Route) bool, func(*types.Alert, time.Time) bool) (dispatch.AlertGroups, map[prometheus_model.Fingerprint][]string)
 type getAlertStatusFn func(prometheus_model.Fingerprint) types.AlertStatus
-type setAlertStatusFn func(prometheus_model.LabelSet)
+type setAlertStatusFn func(prometheus_model.LabelSet, prometheus_model.LabelSet)
 
 // NewAPI returns a new Alertmanager API v2
 func NewAPI(
@@ -138,10 +143,28 @@ func (api *API) Update(cfg *config.Config, setAlertStatus setAlertStatusFn) {
 	defer api.mtx.Unlock()
 
 	api.alertmanagerConfig = cfg
-	api.route = dispatch.NewRoute(cfg.Route, nil)
+	api.route = dispatch.NewRoute(cfg.Route, nil, config.ReceiversByName(cfg.Receivers))
 	api.setAlertStatus = setAlertStatus
 }
 
+// SetQuotaTracker configures the tracker consulted to attribute ingested
+// alerts to a tenant and enforce its alert quota. Passing nil disables both.
+func (api *API) SetQuotaTracker(t *quota.Tracker) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.quota = t
+}
+
+// SetAudit configures the log that silence creations and deletions made
+// through this API are recorded to. Passing nil disables audit recording.
+func (api *API) SetAudit(a *audit.Log) {
+	api.mtx.Lock()
+	defer api.mtx.Unlock()
+
+	api.audit = a
+}
+
 func (api *API) getStatusHandler(params general_ops.GetStatusParams) middleware.Responder {
 	api.mtx.RLock()
 	defer api.mtx.RUnlock()
@@ -235,7 +258,7 @@ func (api *API) getAlertsHandler(params alert_ops.GetAlertsParams) middleware.Re
 		}
 	}
 
-	alerts := api.alerts.GetPending()
+	alerts := api.alertsToScan(matchers)
 	defer alerts.Close()
 
 	alertFilter := api.alertFilter(matchers, *params.Silenced, *params.Inhibited, *params.Active)
@@ -250,7 +273,7 @@ func (api *API) getAlertsHandler(params alert_ops.GetAlertsParams) middleware.Re
 			break
 		}
 
-		routes := api.route.Match(a.Labels)
+		routes := api.route.Match(a.Labels, a.Annotations)
 		receivers := make([]string, 0, len(routes))
 		for _, r := range routes {
 			receivers = append(receivers, r.RouteOpts.Receiver)
@@ -282,11 +305,22 @@ func (api *API) getAlertsHandler(params alert_ops.GetAlertsParams) middleware.Re
 }
 
 func (api *API) postAlertsHandler(params alert_ops.PostAlertsParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	_, span := tracing.Start(ctx, "ingest.alerts")
+	defer span.End()
+
 	alerts := openAPIAlertsToAlerts(params.Alerts)
+	span.SetAttr("num_alerts", len(alerts))
 	now := time.Now()
 
 	api.mtx.RLock()
 	resolveTimeout := time.Duration(api.alertmanagerConfig.Global.ResolveTimeout)
+	sizeLimits := types.SizeLimits{
+		MaxLabelValueLength:      api.alertmanagerConfig.Global.MaxLabelValueLength,
+		MaxAnnotationValueLength: api.alertmanagerConfig.Global.MaxAnnotationValueLength,
+		Truncate:                 api.alertmanagerConfig.Global.TruncateOversizedValues,
+	}
+	quotaTracker := api.quota
 	api.mtx.RUnlock()
 
 	for _, alert := range alerts {
@@ -321,20 +355,36 @@ func (api *API) postAlertsHandler(params alert_ops.PostAlertsParams) middleware.
 	for _, a := range alerts {
 		removeEmptyLabels(a.Labels)
 
+		if err := sizeLimits.Enforce(a); err != nil {
+			validationErrs.Add(err)
+			api.m.Invalid().Inc()
+			continue
+		}
 		if err := a.Validate(); err != nil {
 			validationErrs.Add(err)
 			api.m.Invalid().Inc()
 			continue
 		}
+		if quotaTracker != nil {
+			tenant := quotaTracker.Tenant(a.Labels)
+			if err := quotaTracker.CheckAlert(tenant); err != nil {
+				validationErrs.Add(err)
+				api.m.Invalid().Inc()
+				continue
+			}
+			quotaTracker.ObserveAlert(tenant)
+		}
 		validAlerts = append(validAlerts, a)
 	}
 	if err := api.alerts.Put(validAlerts...); err != nil {
 		level.Error(api.logger).Log("msg", "failed to create alerts", "err", err)
+		span.RecordError(err)
 		return alert_ops.NewPostAlertsInternalServerError().WithPayload(err.Error())
 	}
 
 	if validationErrs.Len() > 0 {
 		level.Error(api.logger).Log("msg", "failed to validate alerts", "err", validationErrs.Error())
+		span.RecordError(validationErrs)
 		return alert_ops.NewPostAlertsBadRequest().WithPayload(validationErrs.Error())
 	}
 
@@ -404,7 +454,7 @@ func (api *API) alertFilter(matchers []*labels.Matcher, silenced, inhibited, act
 		}
 
 		// Set alert's current status based on its label set.
-		api.setAlertStatus(a.Labels)
+		api.setAlertStatus(a.Labels, a.Annotations)
 
 		// Get alert's current status after seeing if it is suppressed.
 		status := api.getAlertStatus(a.Fingerprint())
@@ -469,14 +519,17 @@ func alertToOpenAPIAlert(alert *types.Alert, status types.AlertStatus, receivers
 func openAPIAlertsToAlerts(apiAlerts open_api_models.PostableAlerts) []*types.Alert {
 	alerts := []*types.Alert{}
 	for _, apiAlert := range apiAlerts {
+		annotations := apiLabelSetToModelLabelSet(apiAlert.Annotations)
 		alert := types.Alert{
 			Alert: prometheus_model.Alert{
 				Labels:       apiLabelSetToModelLabelSet(apiAlert.Labels),
-				Annotations:  apiLabelSetToModelLabelSet(apiAlert.Annotations),
+				Annotations:  annotations,
 				StartsAt:     time.Time(apiAlert.StartsAt),
 				EndsAt:       time.Time(apiAlert.EndsAt),
 				GeneratorURL: string(apiAlert.GeneratorURL),
 			},
+			Value:     types.ParseFloatAnnotation(annotations, "value"),
+			Threshold: types.ParseFloatAnnotation(annotations, "threshold"),
 		}
 		alerts = append(alerts, &alert)
 	}
@@ -510,6 +563,47 @@ func apiLabelSetToModelLabelSet(apiLabelSet open_api_models.LabelSet) prometheus
 	return modelLabelSet
 }
 
+// labelIndexed is implemented by alert providers that maintain an inverted
+// label index, letting callers narrow a scan to alerts with a given label
+// value instead of scanning every active alert.
+type labelIndexed interface {
+	ByLabel(name prometheus_model.LabelName, value prometheus_model.LabelValue) []*types.Alert
+}
+
+// alertsToScan returns an iterator over the alerts that need to be considered
+// for matchers. If api.alerts supports label-indexed lookups and matchers
+// contains at least one plain equality matcher, the iterator is narrowed to
+// the (possibly much smaller) set of alerts carrying that label value,
+// instead of every pending alert; matchFilterLabels is still applied to
+// every candidate afterwards, so the result is identical either way.
+func (api *API) alertsToScan(matchers []*labels.Matcher) provider.AlertIterator {
+	indexed, ok := api.alerts.(labelIndexed)
+	if !ok {
+		return api.alerts.GetPending()
+	}
+
+	var candidates []*types.Alert
+	for _, m := range matchers {
+		if m.Type != labels.MatchEqual {
+			continue
+		}
+		byLabel := indexed.ByLabel(prometheus_model.LabelName(m.Name), prometheus_model.LabelValue(m.Value))
+		if candidates == nil || len(byLabel) < len(candidates) {
+			candidates = byLabel
+		}
+	}
+	if candidates == nil {
+		return api.alerts.GetPending()
+	}
+
+	ch := make(chan *types.Alert, len(candidates))
+	for _, a := range candidates {
+		ch <- a
+	}
+	close(ch)
+	return provider.NewAlertIterator(ch, make(chan struct{}), nil)
+}
+
 func receiversMatchFilter(receivers []string, filter *regexp.Regexp) bool {
 	for _, r := range receivers {
 		if filter.MatchString(r) {
@@ -665,6 +759,14 @@ func (api *API) deleteSilenceHandler(params silence_ops.DeleteSilenceParams) mid
 		level.Error(api.logger).Log("msg", "failed to expire silence", "err", err)
 		return silence_ops.NewDeleteSilenceInternalServerError().WithPayload(err.Error())
 	}
+
+	api.mtx.RLock()
+	a := api.audit
+	api.mtx.RUnlock()
+	if a != nil {
+		a.Record(audit.Event{Type: audit.EventSilenceDeleted, Detail: sid})
+	}
+
 	return silence_ops.NewDeleteSilenceOK()
 }
 
@@ -743,6 +845,13 @@ func (api *API) postSilencesHandler(params silence_ops.PostSilencesParams) middl
 		return silence_ops.NewPostSilencesBadRequest().WithPayload(err.Error())
 	}
 
+	api.mtx.RLock()
+	a := api.audit
+	api.mtx.RUnlock()
+	if a != nil {
+		a.Record(audit.Event{Type: audit.EventSilenceCreated, Actor: sil.CreatedBy, Detail: sid})
+	}
+
 	return silence_ops.NewPostSilencesOK().WithPayload(&silence_ops.PostSilencesOKBody{
 		SilenceID: sid,
 	})
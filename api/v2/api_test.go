@@ -14,17 +14,22 @@
 package v2
 
 import (
+	"context"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/log"
 	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
 	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
 	general_ops "github.com/prometheus/alertmanager/api/v2/restapi/operations/general"
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/provider/mem"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -168,3 +173,41 @@ func TestAlertToOpenAPIAlert(t *testing.T) {
 		},
 	}, openAPIAlert)
 }
+
+func drainAlerts(it interface {
+	Next() <-chan *types.Alert
+	Close()
+}) []*types.Alert {
+	defer it.Close()
+	var got []*types.Alert
+	for a := range it.Next() {
+		got = append(got, a)
+	}
+	return got
+}
+
+func TestAlertsToScanUsesLabelIndex(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, log.NewNopLogger())
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	require.NoError(t, alerts.Put(
+		&types.Alert{Alert: model.Alert{Labels: model.LabelSet{"team": "infra"}}},
+		&types.Alert{Alert: model.Alert{Labels: model.LabelSet{"team": "payments"}}},
+	))
+
+	api := API{alerts: alerts}
+
+	matchers, err := labels.ParseMatchers(`team="infra"`)
+	require.NoError(t, err)
+
+	got := drainAlerts(api.alertsToScan(matchers))
+	require.Len(t, got, 1)
+	require.Equal(t, model.LabelValue("infra"), got[0].Labels["team"])
+
+	// No equality matcher: falls back to scanning every pending alert.
+	regexMatchers, err := labels.ParseMatchers(`team=~".*"`)
+	require.NoError(t, err)
+	require.Len(t, drainAlerts(api.alertsToScan(regexMatchers)), 2)
+}
@@ -0,0 +1,246 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit records every sent notification and every API mutation
+// (silence created/deleted, config reloaded) as a JSON line, so that who
+// silenced what and whether a page was actually delivered can be proven
+// after the fact instead of reconstructed from application logs. Records
+// are appended to an optional file, rotating it once it grows past a
+// configurable size, and a bounded in-memory tail is kept for the
+// /api/v1/audit query endpoint.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+// EventType identifies what an Event records.
+type EventType string
+
+const (
+	// EventNotification is recorded for every attempted notification
+	// delivery, successful or not.
+	EventNotification EventType = "notification"
+	// EventSilenceCreated is recorded when a silence is created or updated
+	// via the API.
+	EventSilenceCreated EventType = "silence_created"
+	// EventSilenceDeleted is recorded when a silence is expired via the
+	// API.
+	EventSilenceDeleted EventType = "silence_deleted"
+	// EventConfigReloaded is recorded when the configuration file is
+	// reloaded.
+	EventConfigReloaded EventType = "config_reloaded"
+)
+
+// Event is a single audit record.
+type Event struct {
+	Type      EventType `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	// Actor identifies who performed an API mutation, e.g. the silence's
+	// CreatedBy. Empty for events with no single responsible actor, such
+	// as a notification delivery.
+	Actor string `json:"actor,omitempty"`
+	// Receiver and GroupKey identify which route a notification belongs
+	// to. Only populated for EventNotification.
+	Receiver string `json:"receiver,omitempty"`
+	GroupKey string `json:"groupKey,omitempty"`
+	// Fingerprints lists the alerts a notification covered. Only
+	// populated for EventNotification.
+	Fingerprints []string `json:"fingerprints,omitempty"`
+	// Outcome is "delivered" or "failed" for EventNotification, and empty
+	// otherwise.
+	Outcome string `json:"outcome,omitempty"`
+	// Detail carries event-specific context, e.g. a delivery error or a
+	// silence ID.
+	Detail string `json:"detail,omitempty"`
+}
+
+// DefaultMaxEvents bounds the in-memory tail kept for queries in the
+// absence of an explicit limit.
+const DefaultMaxEvents = 1000
+
+// DefaultMaxFileBytes rotates the audit file once it grows past this size,
+// in the absence of an explicit limit.
+const DefaultMaxFileBytes = 100 * 1024 * 1024
+
+// Log is an append-only audit trail. It is safe for concurrent use. Its
+// zero value is not usable; construct with New.
+type Log struct {
+	logger       log.Logger
+	maxEvents    int
+	maxFileBytes int64
+
+	mtx        sync.Mutex
+	events     []Event
+	path       string
+	file       *os.File
+	fileBytes  int64
+	maxBackups int
+}
+
+// New returns a Log that retains at most maxEvents records for queries,
+// discarding the oldest once the bound is reached, and rotates its output
+// file once it exceeds maxFileBytes, keeping at most maxBackups rotated
+// files (a non-positive maxBackups keeps every rotated file). A
+// non-positive maxEvents or maxFileBytes falls back to
+// DefaultMaxEvents/DefaultMaxFileBytes. File output is disabled until
+// SetFile is called.
+func New(l log.Logger, maxEvents int, maxFileBytes int64, maxBackups int) *Log {
+	if l == nil {
+		l = log.NewNopLogger()
+	}
+	if maxEvents <= 0 {
+		maxEvents = DefaultMaxEvents
+	}
+	if maxFileBytes <= 0 {
+		maxFileBytes = DefaultMaxFileBytes
+	}
+	return &Log{
+		logger:       l,
+		maxEvents:    maxEvents,
+		maxFileBytes: maxFileBytes,
+		maxBackups:   maxBackups,
+	}
+}
+
+// SetFile (re-)configures the file records are appended to as JSON lines.
+// An empty path closes any previously open file and disables file output;
+// the in-memory query tail is unaffected either way.
+func (l *Log) SetFile(path string) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.file != nil {
+		l.file.Close()
+		l.file = nil
+	}
+	l.path = path
+	if path == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	l.file = f
+	l.fileBytes = info.Size()
+	return nil
+}
+
+// Record appends e to the audit trail, stamping its Timestamp with the
+// current time if unset. It is a best-effort operation for file output:
+// write errors are logged but never propagated, so an audit logging outage
+// never affects actual notification delivery or API mutations.
+func (l *Log) Record(e Event) {
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now()
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.events = append(l.events, e)
+	if over := len(l.events) - l.maxEvents; over > 0 {
+		l.events = l.events[over:]
+	}
+
+	if l.file == nil {
+		return
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		level.Error(l.logger).Log("msg", "failed to marshal audit event", "err", err)
+		return
+	}
+	b = append(b, '\n')
+
+	if l.fileBytes > 0 && l.fileBytes+int64(len(b)) > l.maxFileBytes {
+		if err := l.rotate(); err != nil {
+			level.Error(l.logger).Log("msg", "failed to rotate audit log", "err", err)
+		}
+	}
+	n, err := l.file.Write(b)
+	l.fileBytes += int64(n)
+	if err != nil {
+		level.Error(l.logger).Log("msg", "failed to write audit event", "err", err)
+	}
+}
+
+// rotate closes the current audit file, renames it aside with a timestamp
+// suffix, prunes backups beyond maxBackups, and opens a fresh file at the
+// original path. Called with mtx held.
+func (l *Log) rotate() error {
+	l.file.Close()
+
+	backup := fmt.Sprintf("%s.%s", l.path, time.Now().UTC().Format("20060102T150405.000000000Z"))
+	if err := os.Rename(l.path, backup); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	l.file = f
+	l.fileBytes = 0
+
+	if l.maxBackups > 0 {
+		l.pruneBackups()
+	}
+	return nil
+}
+
+// pruneBackups removes rotated files beyond the most recent maxBackups.
+// Called with mtx held.
+func (l *Log) pruneBackups() {
+	dir, pattern := filepath.Split(l.path)
+	matches, err := filepath.Glob(filepath.Join(dir, pattern+".*"))
+	if err != nil || len(matches) <= l.maxBackups {
+		return
+	}
+	sort.Strings(matches)
+	for _, stale := range matches[:len(matches)-l.maxBackups] {
+		os.Remove(stale)
+	}
+}
+
+// Events returns the most recently recorded events, oldest first, at most
+// limit of them. A negative limit returns every retained event; a limit of
+// 0 returns none.
+func (l *Log) Events(limit int) []Event {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	events := l.events
+	if limit >= 0 && limit < len(events) {
+		events = events[len(events)-limit:]
+	}
+	out := make([]Event, len(events))
+	copy(out, events)
+	return out
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordWithoutFileOnlyKeepsInMemoryTail(t *testing.T) {
+	l := New(log.NewNopLogger(), 0, 0, 0)
+
+	l.Record(Event{Type: EventSilenceCreated, Detail: "abc"})
+
+	events := l.Events(-1)
+	require.Len(t, events, 1)
+	require.Equal(t, EventSilenceCreated, events[0].Type)
+	require.Equal(t, "abc", events[0].Detail)
+	require.False(t, events[0].Timestamp.IsZero())
+}
+
+func TestSetFileWritesJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l := New(log.NewNopLogger(), 0, 0, 0)
+	require.NoError(t, l.SetFile(path))
+
+	l.Record(Event{Type: EventNotification, Receiver: "team-a", GroupKey: "{}", Outcome: "delivered"})
+	l.Record(Event{Type: EventNotification, Receiver: "team-a", GroupKey: "{}", Outcome: "failed", Detail: "timeout"})
+
+	require.NoError(t, l.SetFile(""))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var events []Event
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var e Event
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &e))
+		events = append(events, e)
+	}
+	require.NoError(t, sc.Err())
+	require.Len(t, events, 2)
+	require.Equal(t, "delivered", events[0].Outcome)
+	require.Equal(t, "failed", events[1].Outcome)
+	require.Equal(t, "timeout", events[1].Detail)
+}
+
+func TestEventsLimitSemantics(t *testing.T) {
+	l := New(log.NewNopLogger(), 0, 0, 0)
+	for i := 0; i < 3; i++ {
+		l.Record(Event{Type: EventConfigReloaded})
+	}
+
+	require.Len(t, l.Events(-1), 3)
+	require.Len(t, l.Events(0), 0)
+	require.Len(t, l.Events(2), 2)
+	require.Len(t, l.Events(100), 3)
+}
+
+func TestRecordRotatesFileWhenOverMaxSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l := New(log.NewNopLogger(), 0, 1, 1)
+	require.NoError(t, l.SetFile(path))
+
+	l.Record(Event{Type: EventConfigReloaded})
+	l.Record(Event{Type: EventConfigReloaded})
+
+	matches, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+}
+
+func TestMaxEventsBoundsInMemoryTail(t *testing.T) {
+	l := New(log.NewNopLogger(), 2, 0, 0)
+	l.Record(Event{Type: EventConfigReloaded, Detail: "one"})
+	l.Record(Event{Type: EventConfigReloaded, Detail: "two"})
+	l.Record(Event{Type: EventConfigReloaded, Detail: "three"})
+
+	events := l.Events(-1)
+	require.Len(t, events, 2)
+	require.Equal(t, "two", events[0].Detail)
+	require.Equal(t, "three", events[1].Detail)
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package breaker implements a per-receiver circuit breaker: once a
+// receiver's notifications fail enough times in a row, the breaker trips
+// open and further attempts are rejected outright for a cool-down period,
+// instead of retrying (and piling up) against a provider that is already
+// down. After the cool-down, a single half-open probe notification is let
+// through to test whether the receiver has recovered. State is exposed as
+// Prometheus metrics and, via Snapshot, a small status API.
+package breaker
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// State is a circuit breaker's current position in the closed -> open ->
+// half-open -> closed cycle.
+type State int
+
+const (
+	// StateClosed is the normal state: notifications are attempted.
+	StateClosed State = iota
+	// StateOpen rejects every notification until CooldownPeriod has passed.
+	StateOpen
+	// StateHalfOpen has let a single probe notification through and is
+	// waiting to learn whether it succeeded.
+	StateHalfOpen
+)
+
+// String returns the metric/API label for s.
+func (s State) String() string {
+	switch s {
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// Settings configures the breaker for a single receiver. The zero value
+// disables the breaker: Tracker treats a receiver with no Settings as
+// always closed.
+type Settings struct {
+	// FailureThreshold is the number of consecutive delivery failures that
+	// trip the breaker open.
+	FailureThreshold int
+	// CooldownPeriod is how long the breaker stays open before allowing a
+	// half-open probe through.
+	CooldownPeriod time.Duration
+	// FallbackReceiver, if set, is the name of the receiver that should be
+	// notified instead while the breaker is open.
+	FallbackReceiver string
+}
+
+type receiverState struct {
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// Tracker holds per-receiver circuit breaker state and enforces the
+// configured Settings. It is safe for concurrent use. The zero value is not
+// usable; use New.
+type Tracker struct {
+	mtx      sync.Mutex
+	settings map[string]Settings
+	states   map[string]*receiverState
+	now      func() time.Time
+
+	state *prometheus.GaugeVec
+}
+
+// New returns a Tracker with no receivers configured, so Allow always
+// returns true until Configure is called.
+func New(r prometheus.Registerer) *Tracker {
+	t := &Tracker{
+		settings: map[string]Settings{},
+		states:   map[string]*receiverState{},
+		now:      time.Now,
+		state: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "alertmanager_receiver_circuit_breaker_state",
+			Help: "Current circuit breaker state per receiver: 0 closed, 1 open, 2 half-open.",
+		}, []string{"receiver"}),
+	}
+	if r != nil {
+		r.MustRegister(t.state)
+	}
+	return t
+}
+
+// Configure (re-)sets the per-receiver breaker settings, e.g. from a
+// configuration reload. A receiver missing from settings (or the zero
+// Settings) is never tripped open.
+func (t *Tracker) Configure(settings map[string]Settings) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.settings = settings
+}
+
+// Allow reports whether a notification attempt to receiver should proceed.
+// It returns false while the breaker is open and cooling down, and while a
+// half-open probe is in flight; once CooldownPeriod has passed, it flips the
+// breaker to half-open, returns true for exactly one caller, and leaves it to
+// RecordSuccess/RecordFailure to resolve the probe.
+func (t *Tracker) Allow(receiver string) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	cfg, ok := t.settings[receiver]
+	if !ok || cfg.FailureThreshold <= 0 {
+		return true
+	}
+	s, ok := t.states[receiver]
+	if !ok || s.state == StateClosed {
+		return true
+	}
+	if s.state == StateHalfOpen {
+		return false
+	}
+	if t.now().Sub(s.openedAt) < cfg.CooldownPeriod {
+		return false
+	}
+	s.state = StateHalfOpen
+	s.probing = true
+	t.state.WithLabelValues(receiver).Set(float64(StateHalfOpen))
+	return true
+}
+
+// RecordSuccess closes the breaker for receiver, forgetting any prior
+// failure streak.
+func (t *Tracker) RecordSuccess(receiver string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	s := t.stateFor(receiver)
+	s.failures = 0
+	s.state = StateClosed
+	s.probing = false
+	t.state.WithLabelValues(receiver).Set(float64(StateClosed))
+}
+
+// RecordFailure counts a failed delivery to receiver, tripping the breaker
+// open once its configured FailureThreshold is reached. A failed half-open
+// probe reopens the breaker immediately, restarting the cool-down.
+func (t *Tracker) RecordFailure(receiver string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	cfg, ok := t.settings[receiver]
+	if !ok || cfg.FailureThreshold <= 0 {
+		return
+	}
+	s := t.stateFor(receiver)
+	if s.state == StateHalfOpen {
+		s.state = StateOpen
+		s.openedAt = t.now()
+		s.probing = false
+		t.state.WithLabelValues(receiver).Set(float64(StateOpen))
+		return
+	}
+	s.failures++
+	if s.failures >= cfg.FailureThreshold {
+		s.state = StateOpen
+		s.openedAt = t.now()
+		t.state.WithLabelValues(receiver).Set(float64(StateOpen))
+	}
+}
+
+// Fallback returns the receiver configured to take over for receiver while
+// its breaker is open, and whether one is configured.
+func (t *Tracker) Fallback(receiver string) (string, bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	cfg, ok := t.settings[receiver]
+	if !ok || cfg.FallbackReceiver == "" {
+		return "", false
+	}
+	return cfg.FallbackReceiver, true
+}
+
+func (t *Tracker) stateFor(receiver string) *receiverState {
+	s, ok := t.states[receiver]
+	if !ok {
+		s = &receiverState{}
+		t.states[receiver] = s
+	}
+	return s
+}
+
+// Status is a point-in-time snapshot of one receiver's breaker, returned by
+// Snapshot for serving over an API.
+type Status struct {
+	Receiver            string `json:"receiver"`
+	State               string `json:"state"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+}
+
+// Snapshot returns the current breaker status of every receiver that has
+// recorded a failure under configured Settings, or any success, sorted by
+// receiver name.
+func (t *Tracker) Snapshot() []Status {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	out := make([]Status, 0, len(t.states))
+	for receiver, s := range t.states {
+		out = append(out, Status{
+			Receiver:            receiver,
+			State:               s.state.String(),
+			ConsecutiveFailures: s.failures,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Receiver < out[j].Receiver })
+	return out
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowWithoutSettingsIsAlwaysClosed(t *testing.T) {
+	tr := New(nil)
+	require.True(t, tr.Allow("payments"))
+	tr.RecordFailure("payments")
+	require.True(t, tr.Allow("payments"))
+}
+
+func TestTripsOpenAfterThreshold(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {FailureThreshold: 2, CooldownPeriod: time.Minute}})
+
+	require.True(t, tr.Allow("payments"))
+	tr.RecordFailure("payments")
+	require.True(t, tr.Allow("payments"))
+	tr.RecordFailure("payments")
+	require.False(t, tr.Allow("payments"))
+}
+
+func TestHalfOpensAfterCooldown(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {FailureThreshold: 1, CooldownPeriod: time.Minute}})
+	now := time.Now()
+	tr.now = func() time.Time { return now }
+
+	tr.RecordFailure("payments")
+	require.False(t, tr.Allow("payments"))
+
+	now = now.Add(2 * time.Minute)
+	require.True(t, tr.Allow("payments"), "should allow a half-open probe once the cooldown has passed")
+	require.False(t, tr.Allow("payments"), "should not allow a second probe while the first is in flight")
+}
+
+func TestSuccessfulProbeCloses(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {FailureThreshold: 1, CooldownPeriod: time.Minute}})
+	now := time.Now()
+	tr.now = func() time.Time { return now }
+
+	tr.RecordFailure("payments")
+	now = now.Add(2 * time.Minute)
+	require.True(t, tr.Allow("payments"))
+
+	tr.RecordSuccess("payments")
+	require.True(t, tr.Allow("payments"))
+	require.Equal(t, StateClosed, tr.states["payments"].state)
+}
+
+func TestFailedProbeReopens(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {FailureThreshold: 1, CooldownPeriod: time.Minute}})
+	now := time.Now()
+	tr.now = func() time.Time { return now }
+
+	tr.RecordFailure("payments")
+	now = now.Add(2 * time.Minute)
+	require.True(t, tr.Allow("payments"))
+
+	tr.RecordFailure("payments")
+	require.False(t, tr.Allow("payments"))
+}
+
+func TestFallback(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {FailureThreshold: 1, CooldownPeriod: time.Minute, FallbackReceiver: "oncall"}})
+
+	name, ok := tr.Fallback("payments")
+	require.True(t, ok)
+	require.Equal(t, "oncall", name)
+
+	_, ok = tr.Fallback("search")
+	require.False(t, ok)
+}
+
+func TestSnapshot(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {FailureThreshold: 2, CooldownPeriod: time.Minute}})
+	tr.RecordFailure("payments")
+
+	snap := tr.Snapshot()
+	require.Len(t, snap, 1)
+	require.Equal(t, Status{Receiver: "payments", State: "closed", ConsecutiveFailures: 1}, snap[0])
+}
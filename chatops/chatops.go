@@ -0,0 +1,414 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chatops lets a responder act on a notification straight from a
+// chat client. Slack's interactive message buttons and Telegram's inline
+// keyboard callbacks both POST back to a URL of our choosing, carrying
+// whatever opaque value the button was given when the notification was
+// composed. Handler decodes that value into a Command and applies it:
+// acknowledging the group, silencing it, or escalating it.
+package chatops
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Action identifies what a chat command asks Alertmanager to do.
+type Action string
+
+const (
+	ActionAck      Action = "ack"
+	ActionSilence  Action = "silence"
+	ActionEscalate Action = "escalate"
+)
+
+// defaultEscalateLabel is the label Handler sets to "true" on an escalated
+// alert's copy if EscalateLabel is not configured.
+const defaultEscalateLabel = model.LabelName("escalated")
+
+// Command is the decoded intent of a single button press, naming the group
+// it applies to by its labels.
+type Command struct {
+	Action Action
+	Labels model.LabelSet
+	// Minutes is how long to silence the group for. Only used by ActionSilence.
+	Minutes int
+	// User identifies whoever pressed the button, e.g. a Slack or Telegram
+	// username. Used to attribute a silence created by ActionSilence or by
+	// AckSilenceDuration. May be empty if the chat client did not supply one.
+	User string
+}
+
+// Handler implements http.Handler for Slack's interactive message callback
+// and Telegram's bot webhook, turning a button press into a Command and
+// applying it. Its zero value is not usable; construct with the fields set
+// as needed, leaving the signing secrets empty disables verification.
+type Handler struct {
+	Silences *silence.Silences
+	Alerts   provider.Alerts
+	Logger   log.Logger
+	// EscalateLabel is the label set to "true" on an escalated group's
+	// alerts. Defaults to "escalated".
+	EscalateLabel model.LabelName
+
+	// AckSilenceDuration, if greater than 0, creates a silence matching
+	// the acknowledged group's labels for this long whenever ActionAck is
+	// applied, attributed to the acknowledging user, unifying the ack and
+	// silence chat workflows. Zero leaves ActionAck a no-op beyond logging.
+	AckSilenceDuration time.Duration
+
+	// SlackSigningSecret verifies Slack's X-Slack-Signature header.
+	// Requests are accepted unverified if it is empty.
+	SlackSigningSecret string
+	// TelegramSecretToken verifies Telegram's X-Telegram-Bot-Api-Secret-Token
+	// header. Requests are accepted unverified if it is empty.
+	TelegramSecretToken string
+}
+
+func (h *Handler) escalateLabel() model.LabelName {
+	if h.EscalateLabel == "" {
+		return defaultEscalateLabel
+	}
+	return h.EscalateLabel
+}
+
+// ServeHTTP dispatches on the request path: a path ending in "/slack"
+// handles Slack's interactive message callback, one ending in "/telegram"
+// handles a Telegram bot webhook update. Any other path is rejected.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/slack"):
+		h.serveSlack(w, r)
+	case strings.HasSuffix(r.URL.Path, "/telegram"):
+		h.serveTelegram(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *Handler) serveSlack(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if h.SlackSigningSecret != "" && !validSlackSignature(h.SlackSigningSecret, r.Header, body) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	vals, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "invalid form body", http.StatusBadRequest)
+		return
+	}
+	cmd, err := parseSlackCommand([]byte(vals.Get("payload")))
+	if err != nil {
+		level.Warn(h.Logger).Log("msg", "rejecting slack callback", "err", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.apply(cmd)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) serveTelegram(w http.ResponseWriter, r *http.Request) {
+	if h.TelegramSecretToken != "" && !hmac.Equal(
+		[]byte(r.Header.Get("X-Telegram-Bot-Api-Secret-Token")),
+		[]byte(h.TelegramSecretToken),
+	) {
+		http.Error(w, "invalid secret token", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cmd, err := parseTelegramCommand(body)
+	if err != nil {
+		level.Warn(h.Logger).Log("msg", "rejecting telegram callback", "err", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.apply(cmd)
+	w.WriteHeader(http.StatusOK)
+}
+
+// validSlackSignature checks Slack's request signing scheme: see
+// https://api.slack.com/authentication/verifying-requests-from-slack.
+func validSlackSignature(secret string, header http.Header, body []byte) bool {
+	ts := header.Get("X-Slack-Request-Timestamp")
+	sig := header.Get("X-Slack-Signature")
+	if ts == "" || sig == "" {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:", ts)
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(sig))
+}
+
+// slackPayload is the subset of Slack's interactive message callback JSON
+// (sent form-encoded in the "payload" field) that chatops cares about.
+type slackPayload struct {
+	Actions []struct {
+		Value string `json:"value"`
+	} `json:"actions"`
+	User struct {
+		Username string `json:"username"`
+	} `json:"user"`
+}
+
+// slackValue is the JSON we expect to have put in a button's "value" field
+// when the notification was composed.
+type slackValue struct {
+	Action  Action            `json:"action"`
+	Labels  map[string]string `json:"labels"`
+	Minutes int               `json:"minutes"`
+}
+
+func parseSlackCommand(payload []byte) (*Command, error) {
+	var p slackPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, fmt.Errorf("invalid slack payload: %s", err)
+	}
+	if len(p.Actions) == 0 {
+		return nil, fmt.Errorf("slack payload has no actions")
+	}
+	var v slackValue
+	if err := json.Unmarshal([]byte(p.Actions[0].Value), &v); err != nil {
+		return nil, fmt.Errorf("invalid slack action value: %s", err)
+	}
+	return &Command{Action: v.Action, Labels: toLabelSet(v.Labels), Minutes: v.Minutes, User: p.User.Username}, nil
+}
+
+// telegramUpdate is the subset of Telegram's Update JSON that chatops cares
+// about.
+type telegramUpdate struct {
+	CallbackQuery *struct {
+		Data string `json:"data"`
+		From struct {
+			Username string `json:"username"`
+		} `json:"from"`
+	} `json:"callback_query"`
+}
+
+func parseTelegramCommand(body []byte) (*Command, error) {
+	var u telegramUpdate
+	if err := json.Unmarshal(body, &u); err != nil {
+		return nil, fmt.Errorf("invalid telegram update: %s", err)
+	}
+	if u.CallbackQuery == nil {
+		return nil, fmt.Errorf("telegram update has no callback query")
+	}
+	cmd, err := parseTelegramData(u.CallbackQuery.Data)
+	if err != nil {
+		return nil, err
+	}
+	cmd.User = u.CallbackQuery.From.Username
+	return cmd, nil
+}
+
+// parseTelegramData decodes the compact "action|minutes|k=v,k=v" encoding
+// used for a Telegram inline button's callback_data, which Telegram limits
+// to 64 bytes, ruling out the JSON encoding used for Slack.
+func parseTelegramData(data string) (*Command, error) {
+	parts := strings.SplitN(data, "|", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid telegram callback data %q", data)
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid telegram callback data %q: %s", data, err)
+	}
+	labels := model.LabelSet{}
+	if parts[2] != "" {
+		for _, kv := range strings.Split(parts[2], ",") {
+			kvParts := strings.SplitN(kv, "=", 2)
+			if len(kvParts) != 2 {
+				return nil, fmt.Errorf("invalid telegram callback data %q", data)
+			}
+			labels[model.LabelName(kvParts[0])] = model.LabelValue(kvParts[1])
+		}
+	}
+	return &Command{Action: Action(parts[0]), Labels: labels, Minutes: minutes}, nil
+}
+
+func toLabelSet(m map[string]string) model.LabelSet {
+	ls := make(model.LabelSet, len(m))
+	for k, v := range m {
+		ls[model.LabelName(k)] = model.LabelValue(v)
+	}
+	return ls
+}
+
+// apply performs the action a Command asks for, logging failures rather
+// than returning them: by the time apply runs, the chat client has already
+// been told the button press was received.
+func (h *Handler) apply(cmd *Command) {
+	switch cmd.Action {
+	case ActionAck:
+		level.Info(h.Logger).Log("msg", "group acknowledged from chat", "labels", cmd.Labels, "user", cmd.User)
+		h.ackGroup(cmd)
+	case ActionSilence:
+		h.silenceGroup(cmd)
+	case ActionEscalate:
+		h.escalateGroup(cmd)
+	default:
+		level.Warn(h.Logger).Log("msg", "ignoring chat command with unknown action", "action", cmd.Action)
+	}
+}
+
+func (h *Handler) silenceGroup(cmd *Command) {
+	if len(cmd.Labels) == 0 || cmd.Minutes <= 0 {
+		level.Warn(h.Logger).Log("msg", "ignoring chat silence request with no labels or non-positive duration")
+		return
+	}
+	h.createSilence(cmd.Labels, time.Duration(cmd.Minutes)*time.Minute, cmd.User, "Silenced in response to a chat command")
+}
+
+// ackGroup creates a silence for the acknowledged group's labels if
+// AckSilenceDuration is configured, so acknowledging from chat also quiets
+// the group without a separate silence command.
+func (h *Handler) ackGroup(cmd *Command) {
+	if h.AckSilenceDuration <= 0 {
+		return
+	}
+	if len(cmd.Labels) == 0 {
+		level.Warn(h.Logger).Log("msg", "ignoring chat ack with no labels, cannot auto-silence")
+		return
+	}
+	h.createSilence(cmd.Labels, h.AckSilenceDuration, cmd.User, "Silenced automatically on acknowledgement from chat")
+}
+
+// createSilence creates a silence matching labels for duration, attributed
+// to createdBy (falling back to "chatops" if the chat client supplied no
+// user), and logs the outcome.
+func (h *Handler) createSilence(labels model.LabelSet, duration time.Duration, createdBy, comment string) {
+	if h.Silences == nil {
+		level.Warn(h.Logger).Log("msg", "chat requested a silence but no silence store is configured")
+		return
+	}
+	if createdBy == "" {
+		createdBy = "chatops"
+	}
+
+	sil := &silencepb.Silence{
+		StartsAt:  time.Now(),
+		EndsAt:    time.Now().Add(duration),
+		CreatedBy: createdBy,
+		Comment:   comment,
+	}
+	for name, value := range labels {
+		sil.Matchers = append(sil.Matchers, &silencepb.Matcher{
+			Type:    silencepb.Matcher_EQUAL,
+			Name:    string(name),
+			Pattern: string(value),
+		})
+	}
+
+	id, err := h.Silences.Set(sil)
+	if err != nil {
+		level.Error(h.Logger).Log("msg", "failed to create silence requested from chat", "err", err)
+		return
+	}
+	level.Info(h.Logger).Log("msg", "created silence requested from chat", "silence", id, "duration", duration, "created_by", createdBy)
+}
+
+// escalateGroup relabels every currently pending alert matching cmd.Labels
+// with EscalateLabel set to "true" and re-submits them, so that a route
+// matching on that label can hand the group to a different receiver. This
+// reuses the existing label-based routing tree instead of inventing a
+// separate escalation-target concept.
+func (h *Handler) escalateGroup(cmd *Command) {
+	if h.Alerts == nil {
+		level.Warn(h.Logger).Log("msg", "chat requested an escalation but no alert store is configured")
+		return
+	}
+	if len(cmd.Labels) == 0 {
+		level.Warn(h.Logger).Log("msg", "ignoring chat escalation request with no labels")
+		return
+	}
+
+	it := h.Alerts.GetPending()
+	defer it.Close()
+
+	var matched []*types.Alert
+	for a := range it.Next() {
+		if err := it.Err(); err != nil {
+			level.Error(h.Logger).Log("msg", "error iterating alerts", "err", err)
+			continue
+		}
+		if labelsMatch(cmd.Labels, a.Labels) {
+			matched = append(matched, a)
+		}
+	}
+
+	if len(matched) == 0 {
+		level.Warn(h.Logger).Log("msg", "no alerts matched the group to escalate", "labels", cmd.Labels)
+		return
+	}
+
+	escalated := make([]*types.Alert, 0, len(matched))
+	for _, a := range matched {
+		e := *a
+		e.Labels = a.Labels.Clone()
+		e.Labels[h.escalateLabel()] = "true"
+		e.UpdatedAt = time.Now()
+		escalated = append(escalated, &e)
+	}
+	if err := h.Alerts.Put(escalated...); err != nil {
+		level.Error(h.Logger).Log("msg", "failed to escalate group requested from chat", "err", err)
+		return
+	}
+	level.Info(h.Logger).Log("msg", "escalated group from chat", "labels", cmd.Labels, "alerts", len(escalated))
+}
+
+// labelsMatch reports whether alert carries every label in group, i.e.
+// whether it belongs to the group those labels identify.
+func labelsMatch(group, alert model.LabelSet) bool {
+	for name, value := range group {
+		if alert[name] != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,211 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chatops
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/provider/mem"
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestParseSlackCommand(t *testing.T) {
+	payload := `{"actions":[{"value":"{\"action\":\"silence\",\"labels\":{\"alertname\":\"Foo\"},\"minutes\":60}"}]}`
+	cmd, err := parseSlackCommand([]byte(payload))
+	require.NoError(t, err)
+	require.Equal(t, ActionSilence, cmd.Action)
+	require.Equal(t, model.LabelSet{"alertname": "Foo"}, cmd.Labels)
+	require.Equal(t, 60, cmd.Minutes)
+}
+
+func TestParseSlackCommandUser(t *testing.T) {
+	payload := `{"actions":[{"value":"{\"action\":\"ack\",\"labels\":{\"alertname\":\"Foo\"}}"}],"user":{"username":"jane"}}`
+	cmd, err := parseSlackCommand([]byte(payload))
+	require.NoError(t, err)
+	require.Equal(t, ActionAck, cmd.Action)
+	require.Equal(t, "jane", cmd.User)
+}
+
+func TestParseSlackCommandNoActions(t *testing.T) {
+	_, err := parseSlackCommand([]byte(`{"actions":[]}`))
+	require.Error(t, err)
+}
+
+func TestParseTelegramData(t *testing.T) {
+	cmd, err := parseTelegramData("escalate|0|alertname=Foo,severity=critical")
+	require.NoError(t, err)
+	require.Equal(t, ActionEscalate, cmd.Action)
+	require.Equal(t, model.LabelSet{"alertname": "Foo", "severity": "critical"}, cmd.Labels)
+}
+
+func TestParseTelegramDataInvalid(t *testing.T) {
+	for _, data := range []string{"", "ack", "ack|notanumber|"} {
+		_, err := parseTelegramData(data)
+		require.Error(t, err, data)
+	}
+}
+
+func TestValidSlackSignature(t *testing.T) {
+	secret := "shhh"
+	body := []byte(`payload=...`)
+	ts := "1234567890"
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:", ts)
+	mac.Write(body)
+	sig := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	header := http.Header{}
+	header.Set("X-Slack-Request-Timestamp", ts)
+	header.Set("X-Slack-Signature", sig)
+	require.True(t, validSlackSignature(secret, header, body))
+
+	header.Set("X-Slack-Signature", "v0=deadbeef")
+	require.False(t, validSlackSignature(secret, header, body))
+}
+
+func newTestHandler(t *testing.T) (*Handler, *mem.Alerts) {
+	silences, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, log.NewNopLogger())
+	require.NoError(t, err)
+
+	return &Handler{
+		Silences: silences,
+		Alerts:   alerts,
+		Logger:   log.NewNopLogger(),
+	}, alerts
+}
+
+func TestHandlerSlackSilence(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	payload := `{"actions":[{"value":"{\"action\":\"silence\",\"labels\":{\"alertname\":\"Foo\"},\"minutes\":60}"}]}`
+	form := url.Values{"payload": {payload}}
+
+	req := httptest.NewRequest(http.MethodPost, "/-/chatops/slack", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	sils, _, err := h.Silences.Query()
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+	require.Len(t, sils[0].Matchers, 1)
+	require.Equal(t, "alertname", sils[0].Matchers[0].Name)
+	require.Equal(t, "Foo", sils[0].Matchers[0].Pattern)
+}
+
+func TestHandlerTelegramEscalate(t *testing.T) {
+	h, alerts := newTestHandler(t)
+
+	now := time.Now()
+	require.NoError(t, alerts.Put(&types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "Foo", "instance": "a"},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		},
+		UpdatedAt: now,
+	}))
+
+	body := `{"callback_query":{"data":"escalate|0|alertname=Foo"}}`
+	req := httptest.NewRequest(http.MethodPost, "/-/chatops/telegram", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	it := alerts.GetPending()
+	defer it.Close()
+	var found bool
+	for a := range it.Next() {
+		require.NoError(t, it.Err())
+		if a.Labels["alertname"] == "Foo" && a.Labels["escalated"] == "true" {
+			found = true
+		}
+	}
+	require.True(t, found, "expected the alert to have been relabeled as escalated")
+}
+
+func TestHandlerAckCreatesSilence(t *testing.T) {
+	h, _ := newTestHandler(t)
+	h.AckSilenceDuration = time.Hour
+
+	body := `{"callback_query":{"data":"ack|0|alertname=Foo","from":{"username":"jane"}}}`
+	req := httptest.NewRequest(http.MethodPost, "/-/chatops/telegram", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	sils, _, err := h.Silences.Query()
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+	require.Equal(t, "jane", sils[0].CreatedBy)
+	require.Len(t, sils[0].Matchers, 1)
+	require.Equal(t, "alertname", sils[0].Matchers[0].Name)
+	require.Equal(t, "Foo", sils[0].Matchers[0].Pattern)
+}
+
+func TestHandlerAckWithoutSilenceDurationIsNoop(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	body := `{"callback_query":{"data":"ack|0|alertname=Foo"}}`
+	req := httptest.NewRequest(http.MethodPost, "/-/chatops/telegram", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	sils, _, err := h.Silences.Query()
+	require.NoError(t, err)
+	require.Len(t, sils, 0)
+}
+
+func TestHandlerRejectsUnknownPath(t *testing.T) {
+	h, _ := newTestHandler(t)
+	req := httptest.NewRequest(http.MethodPost, "/-/chatops/other", strings.NewReader(""))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandlerRejectsInvalidSlackSignature(t *testing.T) {
+	h, _ := newTestHandler(t)
+	h.SlackSigningSecret = "shhh"
+
+	req := httptest.NewRequest(http.MethodPost, "/-/chatops/slack", strings.NewReader("payload={}"))
+	req.Header.Set("X-Slack-Request-Timestamp", "1234567890")
+	req.Header.Set("X-Slack-Signature", "v0=deadbeef")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
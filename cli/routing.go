@@ -21,6 +21,7 @@ import (
 	"github.com/xlab/treeprint"
 
 	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/dispatch"
 	kingpin "gopkg.in/alecthomas/kingpin.v2"
 )
@@ -58,6 +59,7 @@ func configureRoutingCmd(app *kingpin.CmdClause) {
 	configFlag.ExistingFileVar(&c.configFile)
 	routingShowCmd.Action(execWithTimeout(c.routingShowAction))
 	configureRoutingTestCmd(routingCmd, c)
+	configureRoutingReplayCmd(routingCmd, c)
 }
 
 func (c *routingShow) routingShowAction(ctx context.Context, _ *kingpin.ParseContext) error {
@@ -67,7 +69,7 @@ func (c *routingShow) routingShowAction(ctx context.Context, _ *kingpin.ParseCon
 		kingpin.Fatalf("%s", err)
 		return err
 	}
-	route := dispatch.NewRoute(cfg.Route, nil)
+	route := dispatch.NewRoute(cfg.Route, nil, config.ReceiversByName(cfg.Receivers))
 	tree := treeprint.New()
 	convertRouteToTree(route, tree)
 	fmt.Println("Routing tree:")
@@ -105,7 +107,7 @@ func getMatchingTree(route *dispatch.Route, tree treeprint.Tree, lset models.Lab
 	final := true
 	branch := tree.AddBranch(getRouteTreeSlug(route, false, false))
 	for _, r := range route.Routes {
-		if r.Matchers.Match(convertClientToCommonLabelSet(lset)) {
+		if r.Matchers.Match(convertClientToCommonLabelSet(lset), nil) {
 			getMatchingTree(r, branch, lset)
 			final = false
 			if !r.Continue {
@@ -0,0 +1,121 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/replay"
+)
+
+const routingReplayHelp = `Replay archived routing decisions against a candidate config
+
+Reads a decisionlog file (as written by the "decision_log_file" option) and
+re-evaluates each recorded routing decision against the routing tree in a
+candidate configuration file, reporting how the notification volume per
+receiver would have differed, and which alerts would have started or
+stopped paging.
+
+Example:
+
+./amtool config routes replay --config.file=new.yml decisions.log
+
+`
+
+type routingReplay struct {
+	decisionLog string
+}
+
+// configureRoutingReplayCmd wires up "config routes replay" under cc. It
+// shares rs rather than declaring its own --config.file flag because
+// routingCmd already declares one, and kingpin rejects a child command that
+// redeclares a flag its parent already has.
+func configureRoutingReplayCmd(cc *kingpin.CmdClause, rs *routingShow) {
+	var c routingReplay
+	replayCmd := cc.Command("replay", routingReplayHelp)
+	replayCmd.Arg("decision-log", "Decision log file to replay.").Required().ExistingFileVar(&c.decisionLog)
+	replayCmd.Action(execWithTimeout(func(ctx context.Context, pc *kingpin.ParseContext) error {
+		return c.routingReplayAction(ctx, rs.configFile)
+	}))
+}
+
+func (c *routingReplay) routingReplayAction(ctx context.Context, configFile string) error {
+	if configFile == "" {
+		err := fmt.Errorf("required flag --config.file not provided")
+		kingpin.Fatalf("%v\n", err)
+		return err
+	}
+	cfg, err := loadAlertmanagerConfig(ctx, nil, configFile)
+	if err != nil {
+		kingpin.Fatalf("%v\n", err)
+		return err
+	}
+	route := dispatch.NewRoute(cfg.Route, nil, config.ReceiversByName(cfg.Receivers))
+
+	f, err := os.Open(c.decisionLog)
+	if err != nil {
+		kingpin.Fatalf("%v\n", err)
+		return err
+	}
+	defer f.Close()
+
+	decisions, err := replay.ReadDecisions(f)
+	if err != nil {
+		kingpin.Fatalf("Failed to parse decision log: %v\n", err)
+		return err
+	}
+
+	res := replay.Evaluate(decisions, route)
+
+	fmt.Println("Notifications per receiver, before -> after:")
+	for _, name := range receiverUnion(res.Before, res.After) {
+		fmt.Printf("  %-30s %d -> %d\n", name, res.Before[name], res.After[name])
+	}
+	fmt.Printf("New pages: %d\n", len(res.NewPages))
+	for _, fp := range res.NewPages {
+		fmt.Printf("  %s\n", fp)
+	}
+	fmt.Printf("Removed pages: %d\n", len(res.RemovedPages))
+	for _, fp := range res.RemovedPages {
+		fmt.Printf("  %s\n", fp)
+	}
+
+	return nil
+}
+
+func receiverUnion(a, b replay.ReceiverCounts) []string {
+	seen := map[string]struct{}{}
+	var names []string
+	for name := range a {
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	for name := range b {
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -20,6 +20,7 @@ import (
 	"os"
 	"sync"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	kingpin "gopkg.in/alecthomas/kingpin.v2"
 
@@ -28,9 +29,10 @@ import (
 )
 
 type silenceImportCmd struct {
-	force   bool
-	workers int
-	file    string
+	force        bool
+	skipExisting bool
+	workers      int
+	file         string
 }
 
 const silenceImportHelp = `Import alertmanager silences from JSON file or stdin
@@ -43,6 +45,14 @@ amtool silence query -o json foo > foo.json
 amtool silence import foo.json
 
 JSON data can also come from stdin if no param is specified.
+
+By default, a silence whose ID already exists on the target instance is
+overwritten in place, preserving its ID; this is what makes the command
+useful for restoring a backup taken from the same instance. Pass
+--skip-existing to leave any conflicting silence untouched instead, or
+--force to always create a new silence (with a new ID) regardless of
+whether one with that ID already exists — handy when importing into a
+different instance than the one the export came from.
 `
 
 func configureSilenceImportCmd(cc *kingpin.CmdClause) {
@@ -52,14 +62,29 @@ func configureSilenceImportCmd(cc *kingpin.CmdClause) {
 	)
 
 	importCmd.Flag("force", "Force adding new silences even if it already exists").Short('f').BoolVar(&c.force)
+	importCmd.Flag("skip-existing", "Skip silences whose ID already exists on the target instead of overwriting them").BoolVar(&c.skipExisting)
 	importCmd.Flag("worker", "Number of concurrent workers to use for import").Short('w').Default("8").IntVar(&c.workers)
 	importCmd.Arg("input-file", "JSON file with silences").ExistingFileVar(&c.file)
 	importCmd.Action(execWithTimeout(c.bulkImport))
 }
 
-func addSilenceWorker(ctx context.Context, sclient *silence.Client, silencec <-chan *models.PostableSilence, errc chan<- error) {
+func addSilenceWorker(ctx context.Context, sclient *silence.Client, skipExisting bool, silencec <-chan *models.PostableSilence, errc chan<- error) {
 	for s := range silencec {
 		sid := s.ID
+		if skipExisting && sid != "" {
+			_, err := sclient.GetSilence(silence.NewGetSilenceParams().WithContext(ctx).WithSilenceID(strfmt.UUID(sid)))
+			if err == nil {
+				fmt.Printf("Skipping existing silence id='%v'\n", sid)
+				errc <- nil
+				continue
+			}
+			if _, ok := err.(*silence.GetSilenceNotFound); !ok {
+				fmt.Fprintf(os.Stderr, "Error checking silence id='%v': %v\n", sid, err)
+				errc <- err
+				continue
+			}
+		}
+
 		params := silence.NewPostSilencesParams().WithContext(ctx).WithSilence(s)
 		postOk, err := sclient.PostSilences(params)
 		if _, ok := err.(*silence.PostSilencesNotFound); ok {
@@ -102,7 +127,7 @@ func (c *silenceImportCmd) bulkImport(ctx context.Context, _ *kingpin.ParseConte
 	for w := 0; w < c.workers; w++ {
 		wg.Add(1)
 		go func() {
-			addSilenceWorker(ctx, amclient.Silence, silencec, errc)
+			addSilenceWorker(ctx, amclient.Silence, c.skipExisting, silencec, errc)
 			wg.Done()
 		}()
 	}
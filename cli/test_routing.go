@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/dispatch"
 	"github.com/xlab/treeprint"
 	kingpin "gopkg.in/alecthomas/kingpin.v2"
@@ -50,15 +51,7 @@ func configureRoutingTestCmd(cc *kingpin.CmdClause, c *routingShow) {
 
 // resolveAlertReceivers returns list of receiver names which given LabelSet resolves to.
 func resolveAlertReceivers(mainRoute *dispatch.Route, labels *models.LabelSet) ([]string, error) {
-	var (
-		finalRoutes []*dispatch.Route
-		receivers   []string
-	)
-	finalRoutes = mainRoute.Match(convertClientToCommonLabelSet(*labels))
-	for _, r := range finalRoutes {
-		receivers = append(receivers, r.RouteOpts.Receiver)
-	}
-	return receivers, nil
+	return mainRoute.MatchingReceivers(convertClientToCommonLabelSet(*labels), nil), nil
 }
 
 func printMatchingTree(mainRoute *dispatch.Route, ls models.LabelSet) {
@@ -76,7 +69,7 @@ func (c *routingShow) routingTestAction(ctx context.Context, _ *kingpin.ParseCon
 		return err
 	}
 
-	mainRoute := dispatch.NewRoute(cfg.Route, nil)
+	mainRoute := dispatch.NewRoute(cfg.Route, nil, config.ReceiversByName(cfg.Receivers))
 
 	// Parse labels to LabelSet.
 	ls, err := parseLabels(c.labels)
@@ -54,7 +54,7 @@ func TestRoutingTest(t *testing.T) {
 		if err != nil {
 			t.Fatalf("failed to load test configuration: %v", err)
 		}
-		mainRoute := dispatch.NewRoute(cfg.Route, nil)
+		mainRoute := dispatch.NewRoute(cfg.Route, nil, nil)
 		err = checkResolvedReceivers(mainRoute, test.alert, test.expectedReceivers)
 		if err != nil {
 			t.Fatalf("%v", err)
@@ -596,7 +596,11 @@ func (p *Peer) Peers() []*memberlist.Node {
 	return p.mlist.Members()
 }
 
-// Position returns the position of the peer in the cluster.
+// Position returns the position of the peer in the cluster, ordered by peer
+// name. notify.WaitStage staggers each replica's notification attempt by a
+// multiple of this position, so that in the common case only the replica
+// that wins the race actually sends the notification, while every other
+// replica still has it ready to send if that replica is unreachable.
 func (p *Peer) Position() int {
 	all := p.Peers()
 	sort.Slice(all, func(i, j int) bool {
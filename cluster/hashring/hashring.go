@@ -0,0 +1,150 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hashring assigns a key (typically an alert group's key, or the
+// value of a sharding label) to one member of a cluster. It lets operators
+// pin specific teams' alert groups to specific instances for isolation,
+// instead of every instance processing every group.
+package hashring
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// New builds the Ring named by strategy ("consistent", "rendezvous", or
+// "static"). For "static", assignments maps a key to the member that
+// should own it, falling back to rendezvous hashing for any other key.
+func New(strategy string, assignments map[string]string) (Ring, error) {
+	switch strategy {
+	case "consistent":
+		return Consistent{}, nil
+	case "rendezvous":
+		return Rendezvous{}, nil
+	case "static":
+		return Static{Assignments: assignments}, nil
+	default:
+		return nil, fmt.Errorf("unknown hashring strategy %q", strategy)
+	}
+}
+
+// A Ring decides, out of members, which one owns key. It must be
+// deterministic: every instance evaluating the same members and key must
+// reach the same answer without communicating. Implementations need not be
+// safe for concurrent use unless stated otherwise; callers are expected to
+// pass a fresh, already-sorted-by-caller-or-not members slice on each call.
+type Ring interface {
+	// Owner returns the member of members responsible for key. members must
+	// be non-empty.
+	Owner(members []string, key string) string
+}
+
+// hash maps s across the full uint64 space with good avalanche behavior, so
+// that the handful of virtual nodes each member gets spread out roughly
+// evenly rather than clustering (as e.g. FNV-1a tends to do on short,
+// structurally similar inputs like "<member>-<n>").
+func hash(s string) uint64 {
+	sum := sha256.Sum256([]byte(s))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// Consistent implements consistent hashing with virtual nodes, so that
+// adding or removing a member only reshuffles ownership of the keys that
+// hashed near the changed member, rather than rehashing everything.
+type Consistent struct {
+	// VirtualNodes is the number of virtual nodes placed on the ring per
+	// member. More virtual nodes spread ownership more evenly across
+	// members at the cost of more work per Owner call. Defaults to 100 if
+	// zero.
+	VirtualNodes int
+}
+
+// Owner implements the Ring interface.
+func (c Consistent) Owner(members []string, key string) string {
+	vnodes := c.VirtualNodes
+	if vnodes <= 0 {
+		vnodes = 100
+	}
+
+	type point struct {
+		hash   uint64
+		member string
+	}
+	ring := make([]point, 0, len(members)*vnodes)
+	for _, m := range members {
+		for i := 0; i < vnodes; i++ {
+			ring = append(ring, point{hash: hash(fmt.Sprintf("%s-%d", m, i)), member: m})
+		}
+	}
+	sort.Slice(ring, func(i, j int) bool { return ring[i].hash < ring[j].hash })
+
+	h := hash(key)
+	i := sort.Search(len(ring), func(i int) bool { return ring[i].hash >= h })
+	if i == len(ring) {
+		i = 0
+	}
+	return ring[i].member
+}
+
+// Rendezvous implements rendezvous (highest random weight) hashing: the
+// owner of key is whichever member hashes highest when combined with key.
+// Unlike Consistent, it needs no virtual nodes and every member is
+// considered on every lookup, which keeps ownership maximally spread at
+// the cost of a linear scan over members per Owner call.
+type Rendezvous struct{}
+
+// Owner implements the Ring interface.
+func (Rendezvous) Owner(members []string, key string) string {
+	var (
+		best      string
+		bestScore uint64
+	)
+	for _, m := range members {
+		score := hash(m + "\xff" + key)
+		if best == "" || score > bestScore {
+			best, bestScore = m, score
+		}
+	}
+	return best
+}
+
+// Static assigns a fixed set of keys to specific members, falling back to
+// Fallback for any key not listed in Assignments. This lets an operator
+// pin a specific team's alert groups to a specific instance by name,
+// without relying on where a hash function happens to land.
+type Static struct {
+	// Assignments maps a key (typically a sharding label's value) to the
+	// member that should own it.
+	Assignments map[string]string
+	// Fallback decides ownership for any key absent from Assignments. If
+	// nil, Rendezvous{} is used.
+	Fallback Ring
+}
+
+// Owner implements the Ring interface.
+func (s Static) Owner(members []string, key string) string {
+	if m, ok := s.Assignments[key]; ok {
+		for _, member := range members {
+			if member == m {
+				return m
+			}
+		}
+	}
+	fallback := s.Fallback
+	if fallback == nil {
+		fallback = Rendezvous{}
+	}
+	return fallback.Owner(members, key)
+}
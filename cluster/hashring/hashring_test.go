@@ -0,0 +1,87 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashring
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var members = []string{"alertmanager-0", "alertmanager-1", "alertmanager-2"}
+
+func TestConsistentIsDeterministic(t *testing.T) {
+	c := Consistent{}
+	owner := c.Owner(members, "team-a")
+	for i := 0; i < 100; i++ {
+		require.Equal(t, owner, c.Owner(members, "team-a"))
+	}
+}
+
+func TestConsistentSpreadsKeys(t *testing.T) {
+	c := Consistent{}
+	seen := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		seen[c.Owner(members, "key-"+strconv.Itoa(i))] = true
+	}
+	require.Len(t, seen, len(members))
+}
+
+func TestRendezvousIsDeterministic(t *testing.T) {
+	r := Rendezvous{}
+	owner := r.Owner(members, "team-a")
+	for i := 0; i < 100; i++ {
+		require.Equal(t, owner, r.Owner(members, "team-a"))
+	}
+}
+
+func TestRendezvousSpreadsKeys(t *testing.T) {
+	r := Rendezvous{}
+	seen := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		seen[r.Owner(members, "key-"+strconv.Itoa(i))] = true
+	}
+	require.Len(t, seen, len(members))
+}
+
+func TestStaticHonorsAssignment(t *testing.T) {
+	s := Static{Assignments: map[string]string{"team-a": "alertmanager-2"}}
+	require.Equal(t, "alertmanager-2", s.Owner(members, "team-a"))
+}
+
+func TestStaticFallsBackForUnlistedKey(t *testing.T) {
+	s := Static{Assignments: map[string]string{"team-a": "alertmanager-2"}}
+	owner := s.Owner(members, "team-b")
+	require.Equal(t, Rendezvous{}.Owner(members, "team-b"), owner)
+}
+
+func TestStaticIgnoresAssignmentToAbsentMember(t *testing.T) {
+	s := Static{Assignments: map[string]string{"team-a": "alertmanager-9"}}
+	owner := s.Owner(members, "team-a")
+	require.Equal(t, Rendezvous{}.Owner(members, "team-a"), owner)
+}
+
+func TestNewUnknownStrategy(t *testing.T) {
+	_, err := New("bogus", nil)
+	require.Error(t, err)
+}
+
+func TestNewKnownStrategies(t *testing.T) {
+	for _, strategy := range []string{"consistent", "rendezvous", "static"} {
+		ring, err := New(strategy, nil)
+		require.NoError(t, err)
+		require.NotNil(t, ring)
+	}
+}
@@ -14,7 +14,10 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -27,6 +30,7 @@ import (
 	"sync"
 	"syscall"
 	"time"
+	_ "time/tzdata" // Embed the IANA Time Zone Database so per-route timezones resolve without relying on the host OS having it installed.
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
@@ -39,28 +43,74 @@ import (
 	"github.com/prometheus/common/route"
 	"github.com/prometheus/common/version"
 	"gopkg.in/alecthomas/kingpin.v2"
+	"gopkg.in/yaml.v2"
 
 	"github.com/prometheus/alertmanager/api"
+	"github.com/prometheus/alertmanager/audit"
+	"github.com/prometheus/alertmanager/breaker"
+	"github.com/prometheus/alertmanager/chatops"
 	"github.com/prometheus/alertmanager/cluster"
+	"github.com/prometheus/alertmanager/cluster/hashring"
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/crypto/atrest"
+	"github.com/prometheus/alertmanager/decisionlog"
 	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/federate"
+	"github.com/prometheus/alertmanager/heartbeat"
+	"github.com/prometheus/alertmanager/history"
 	"github.com/prometheus/alertmanager/inhibit"
+	"github.com/prometheus/alertmanager/maintenance"
 	"github.com/prometheus/alertmanager/nflog"
 	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/alerta"
+	"github.com/prometheus/alertmanager/notify/amforward"
+	"github.com/prometheus/alertmanager/notify/archive"
+	"github.com/prometheus/alertmanager/notify/correlate"
 	"github.com/prometheus/alertmanager/notify/email"
+	"github.com/prometheus/alertmanager/notify/email/bounce"
+	"github.com/prometheus/alertmanager/notify/escalation"
+	"github.com/prometheus/alertmanager/notify/exec"
+	"github.com/prometheus/alertmanager/notify/groupnotes"
 	"github.com/prometheus/alertmanager/notify/hipchat"
+	"github.com/prometheus/alertmanager/notify/incident"
+	"github.com/prometheus/alertmanager/notify/irc"
+	"github.com/prometheus/alertmanager/notify/kakaotalk"
+	"github.com/prometheus/alertmanager/notify/linenotify"
+	"github.com/prometheus/alertmanager/notify/msteams"
 	"github.com/prometheus/alertmanager/notify/opsgenie"
 	"github.com/prometheus/alertmanager/notify/pagerduty"
+	"github.com/prometheus/alertmanager/notify/priority"
+	"github.com/prometheus/alertmanager/notify/pubsub"
 	"github.com/prometheus/alertmanager/notify/pushover"
+	"github.com/prometheus/alertmanager/notify/receipt"
+	"github.com/prometheus/alertmanager/notify/receivermute"
+	"github.com/prometheus/alertmanager/notify/shadow"
 	"github.com/prometheus/alertmanager/notify/slack"
+	"github.com/prometheus/alertmanager/notify/smpp"
+	"github.com/prometheus/alertmanager/notify/sns"
+	"github.com/prometheus/alertmanager/notify/sqlreport"
+	"github.com/prometheus/alertmanager/notify/standby"
+	"github.com/prometheus/alertmanager/notify/telegram"
+	"github.com/prometheus/alertmanager/notify/timeline"
 	"github.com/prometheus/alertmanager/notify/victorops"
 	"github.com/prometheus/alertmanager/notify/webhook"
 	"github.com/prometheus/alertmanager/notify/wechat"
+	"github.com/prometheus/alertmanager/notify/xmpp"
+	"github.com/prometheus/alertmanager/notify/zabbix"
+	"github.com/prometheus/alertmanager/notify/zulip"
+	"github.com/prometheus/alertmanager/pkg/timeinterval"
 	"github.com/prometheus/alertmanager/provider/mem"
+	"github.com/prometheus/alertmanager/quota"
+	"github.com/prometheus/alertmanager/ratelimit"
+	"github.com/prometheus/alertmanager/report"
 	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/silencesync"
+	"github.com/prometheus/alertmanager/slo"
 	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 	"github.com/prometheus/alertmanager/ui"
+	"github.com/prometheus/alertmanager/watchdog"
+	"github.com/prometheus/alertmanager/web"
 )
 
 var (
@@ -111,7 +161,7 @@ const defaultClusterAddr = "0.0.0.0:9094"
 
 // buildReceiverIntegrations builds a list of integration notifiers off of a
 // receiver config.
-func buildReceiverIntegrations(nc *config.Receiver, tmpl *template.Template, logger log.Logger) ([]notify.Integration, error) {
+func buildReceiverIntegrations(nc *config.Receiver, tmpl *template.Template, logger log.Logger, bounceTracker *bounce.Tracker, silences *silence.Silences) ([]notify.Integration, error) {
 	var (
 		errs         types.MultiError
 		integrations []notify.Integration
@@ -126,10 +176,12 @@ func buildReceiverIntegrations(nc *config.Receiver, tmpl *template.Template, log
 	)
 
 	for i, c := range nc.WebhookConfigs {
-		add("webhook", i, c, func(l log.Logger) (notify.Notifier, error) { return webhook.New(c, tmpl, l) })
+		add("webhook", i, c, func(l log.Logger) (notify.Notifier, error) { return webhook.New(c, tmpl, l, silences) })
 	}
 	for i, c := range nc.EmailConfigs {
-		add("email", i, c, func(l log.Logger) (notify.Notifier, error) { return email.New(c, tmpl, l), nil })
+		add("email", i, c, func(l log.Logger) (notify.Notifier, error) {
+			return email.New(c, tmpl, l, bounceTracker, nc.Name), nil
+		})
 	}
 	for i, c := range nc.PagerdutyConfigs {
 		add("pagerduty", i, c, func(l log.Logger) (notify.Notifier, error) { return pagerduty.New(c, tmpl, l) })
@@ -152,12 +204,290 @@ func buildReceiverIntegrations(nc *config.Receiver, tmpl *template.Template, log
 	for i, c := range nc.PushoverConfigs {
 		add("pushover", i, c, func(l log.Logger) (notify.Notifier, error) { return pushover.New(c, tmpl, l) })
 	}
+	for i, c := range nc.IRCConfigs {
+		add("irc", i, c, func(l log.Logger) (notify.Notifier, error) { return irc.New(c, tmpl, l) })
+	}
+	for i, c := range nc.XMPPConfigs {
+		add("xmpp", i, c, func(l log.Logger) (notify.Notifier, error) { return xmpp.New(c, tmpl, l) })
+	}
+	for i, c := range nc.ZulipConfigs {
+		add("zulip", i, c, func(l log.Logger) (notify.Notifier, error) { return zulip.New(c, tmpl, l) })
+	}
+	for i, c := range nc.LineNotifyConfigs {
+		add("linenotify", i, c, func(l log.Logger) (notify.Notifier, error) { return linenotify.New(c, tmpl, l) })
+	}
+	for i, c := range nc.KakaoTalkConfigs {
+		add("kakaotalk", i, c, func(l log.Logger) (notify.Notifier, error) { return kakaotalk.New(c, tmpl, l) })
+	}
+	for i, c := range nc.SMPPConfigs {
+		add("smpp", i, c, func(l log.Logger) (notify.Notifier, error) { return smpp.New(c, tmpl, l) })
+	}
+	for i, c := range nc.AlertaConfigs {
+		add("alerta", i, c, func(l log.Logger) (notify.Notifier, error) { return alerta.New(c, tmpl, l) })
+	}
+	for i, c := range nc.ZabbixConfigs {
+		add("zabbix", i, c, func(l log.Logger) (notify.Notifier, error) { return zabbix.New(c, tmpl, l) })
+	}
+	for i, c := range nc.TelegramConfigs {
+		add("telegram", i, c, func(l log.Logger) (notify.Notifier, error) { return telegram.New(c, tmpl, l) })
+	}
+	for i, c := range nc.MSTeamsConfigs {
+		add("msteams", i, c, func(l log.Logger) (notify.Notifier, error) { return msteams.New(c, tmpl, l) })
+	}
+	for i, c := range nc.SNSConfigs {
+		add("sns", i, c, func(l log.Logger) (notify.Notifier, error) { return sns.New(c, tmpl, l) })
+	}
+	for i, c := range nc.PubsubConfigs {
+		add("pubsub", i, c, func(l log.Logger) (notify.Notifier, error) { return pubsub.New(c, tmpl, l) })
+	}
+	for i, c := range nc.ExecConfigs {
+		add("exec", i, c, func(l log.Logger) (notify.Notifier, error) { return exec.New(c, tmpl, l) })
+	}
+	for i, c := range nc.AlertmanagerConfigs {
+		add("alertmanager", i, c, func(l log.Logger) (notify.Notifier, error) { return amforward.New(c, tmpl, l) })
+	}
 	if errs.Len() > 0 {
 		return nil, &errs
 	}
 	return integrations, nil
 }
 
+// canaryAlert returns a synthetic alert used to exercise a receiver's
+// integrations right after its configuration changed, clearly marked as a
+// test so it cannot be mistaken for a real incident.
+func canaryAlert(receiver string) *types.Alert {
+	now := time.Now()
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"alertname": "AlertmanagerCanaryTest",
+				"receiver":  model.LabelValue(receiver),
+			},
+			Annotations: model.LabelSet{
+				"summary": "This is a synthetic test notification sent by Alertmanager after a configuration reload to verify that this receiver is reachable. No action is required.",
+			},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Minute),
+		},
+		UpdatedAt: now,
+	}
+}
+
+// sendCanaryNotifications fires a canary alert through each of the given
+// receiver's integrations in the background. It bypasses the notification
+// pipeline entirely: deliveries are not deduplicated, retried, or recorded
+// in the notification log, so a failing canary never blocks or delays the
+// config reload it is verifying.
+func sendCanaryNotifications(logger log.Logger, name string, integrations []notify.Integration) {
+	sendDirectNotification(logger, name, fmt.Sprintf("canary:%s", name), "canary", canaryAlert(name), integrations)
+}
+
+// budgetAlert returns a synthetic alert describing a route that has
+// exceeded its configured notification_budget, clearly marked as synthetic
+// so it cannot be mistaken for a real incident.
+func budgetAlert(route *dispatch.Route) *types.Alert {
+	now := time.Now()
+	key := route.Key()
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"alertname": "NotificationBudgetExceeded",
+				"route":     model.LabelValue(key),
+			},
+			Annotations: model.LabelSet{
+				"summary": model.LabelValue(fmt.Sprintf(
+					"Route %q has exceeded its configured notification budget of %d over the last 24h.",
+					key, route.RouteOpts.NotificationBudget,
+				)),
+			},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		},
+		UpdatedAt: now,
+	}
+}
+
+// sendBudgetAlert fires a budget-exceeded alert for route through each of
+// the given receiver's integrations in the background, the same way
+// sendCanaryNotifications does for canary alerts.
+func sendBudgetAlert(logger log.Logger, receiver string, route *dispatch.Route, integrations []notify.Integration) {
+	sendDirectNotification(logger, receiver, fmt.Sprintf("budget:%s", route.Key()), "budget alert", budgetAlert(route), integrations)
+}
+
+// sloAlert returns a synthetic alert describing a receiver whose tracked
+// p99 paging latency has exceeded its configured
+// paging_latency_objective, clearly marked as synthetic so it cannot be
+// mistaken for a real incident.
+func sloAlert(receiver string) *types.Alert {
+	now := time.Now()
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"alertname": "PagingLatencySLOBreached",
+				"receiver":  model.LabelValue(receiver),
+			},
+			Annotations: model.LabelSet{
+				"summary": model.LabelValue(fmt.Sprintf(
+					"Receiver %q has exceeded its configured paging_latency_objective.",
+					receiver,
+				)),
+			},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		},
+		UpdatedAt: now,
+	}
+}
+
+// sendSLOAlert fires an SLO-breach alert for receiver through each of the
+// given alertReceiver's integrations in the background, the same way
+// sendBudgetAlert does for notification budgets.
+func sendSLOAlert(logger log.Logger, alertReceiver, receiver string, integrations []notify.Integration) {
+	sendDirectNotification(logger, alertReceiver, fmt.Sprintf("slo:%s", receiver), "SLO alert", sloAlert(receiver), integrations)
+}
+
+// watchdogAlert returns a synthetic alert describing the fact that the
+// configured watchdog_alertname has not been seen within its configured
+// watchdog_timeout, clearly marked as synthetic so it cannot be mistaken
+// for a real incident.
+func watchdogAlert(missingAlertname string, timeout time.Duration) *types.Alert {
+	now := time.Now()
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"alertname": "AlertmanagerWatchdogMissing",
+			},
+			Annotations: model.LabelSet{
+				"summary": model.LabelValue(fmt.Sprintf(
+					"Alertmanager has not seen an alert named %q in the last %s. The monitoring pipeline feeding it may be down.",
+					missingAlertname, timeout,
+				)),
+			},
+			StartsAt: now,
+			EndsAt:   now.Add(time.Hour),
+		},
+		UpdatedAt: now,
+	}
+}
+
+// sendWatchdogAlert fires a watchdog-missing alert through each of the
+// given alertReceiver's integrations in the background, the same way
+// sendSLOAlert does for SLO breaches.
+func sendWatchdogAlert(logger log.Logger, alertReceiver, missingAlertname string, timeout time.Duration, integrations []notify.Integration) {
+	sendDirectNotification(logger, alertReceiver, fmt.Sprintf("watchdog:%s", missingAlertname), "watchdog alert", watchdogAlert(missingAlertname, timeout), integrations)
+}
+
+// sendDirectNotification fires alert through each of the given receiver's
+// integrations in the background, tagging the attempt with groupKey and
+// describing it in log lines as desc. It bypasses the notification
+// pipeline entirely: deliveries are not deduplicated, retried, or recorded
+// in the notification log.
+func sendDirectNotification(logger log.Logger, receiver, groupKey, desc string, alert *types.Alert, integrations []notify.Integration) {
+	ctx := notify.WithReceiverName(context.Background(), receiver)
+	ctx = notify.WithGroupKey(ctx, groupKey)
+	ctx = notify.WithGroupLabels(ctx, alert.Labels)
+	ctx = notify.WithNow(ctx, time.Now())
+
+	for _, integration := range integrations {
+		integration := integration
+		l := log.With(logger, "receiver", receiver, "integration", integration.Name())
+		go func() {
+			if _, err := integration.Notify(ctx, alert); err != nil {
+				level.Warn(l).Log("msg", desc+" notification failed", "err", err)
+				return
+			}
+			level.Info(l).Log("msg", desc+" notification sent")
+		}()
+	}
+}
+
+// startupStages are the state-recovery steps Alertmanager must finish, in
+// this order, before it reports itself ready. Gating readiness on them
+// keeps a load balancer from routing alerts to an instance that hasn't
+// caught up on silences or the notification log yet, which would otherwise
+// re-page groups the cluster already notified about. The "cluster" stage
+// completes immediately in non-clustered mode, and once gossip has settled
+// (see --cluster.settle-timeout) otherwise, so readiness also reflects a
+// clustered instance that hasn't yet converged with its peers.
+var startupStages = []string{"config", "silences", "nflog", "alerts", "cluster"}
+
+// startupTracker records progress through startupStages and backs the
+// /-/ready endpoint, exposing the same progress as a per-stage gauge.
+type startupTracker struct {
+	mtx   sync.Mutex
+	done  map[string]bool
+	ready bool
+
+	stageGauges *prometheus.GaugeVec
+	logger      log.Logger
+}
+
+func newStartupTracker(r prometheus.Registerer, logger log.Logger) *startupTracker {
+	stageGauges := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "alertmanager",
+		Name:      "startup_stage_complete",
+		Help:      "Whether a given startup stage has completed (1) or not (0).",
+	}, []string{"stage"})
+	r.MustRegister(stageGauges)
+
+	t := &startupTracker{
+		done:        make(map[string]bool, len(startupStages)),
+		stageGauges: stageGauges,
+		logger:      logger,
+	}
+	for _, stage := range startupStages {
+		stageGauges.WithLabelValues(stage).Set(0)
+	}
+	return t
+}
+
+// complete marks stage as finished. Stages are expected to be reported in
+// the order they appear in startupStages; it does not itself enforce that
+// order, so callers are responsible for only calling it once their stage's
+// prerequisites have actually completed.
+func (t *startupTracker) complete(stage string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.done[stage] = true
+	t.stageGauges.WithLabelValues(stage).Set(1)
+	level.Info(t.logger).Log("msg", "Startup stage complete", "stage", stage)
+
+	for _, s := range startupStages {
+		if !t.done[s] {
+			return
+		}
+	}
+	if !t.ready {
+		t.ready = true
+		level.Info(t.logger).Log("msg", "Startup state recovery complete, now accepting traffic")
+	}
+}
+
+// isReady reports whether every startup stage has completed.
+func (t *startupTracker) isReady() bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	return t.ready
+}
+
+// configureEncryption (re-)applies cfg to kr, disabling encryption if cfg is
+// nil.
+func configureEncryption(kr *atrest.KeyRing, cfg *config.AtRestEncryptionConfig) error {
+	if cfg == nil {
+		return kr.Configure(nil, "")
+	}
+	keys := make(map[string][]byte, len(cfg.Keys))
+	for id, secret := range cfg.Keys {
+		k, err := base64.StdEncoding.DecodeString(string(secret))
+		if err != nil {
+			return fmt.Errorf("decoding key %q: %w", id, err)
+		}
+		keys[id] = k
+	}
+	return kr.Configure(keys, cfg.CurrentKeyID)
+}
+
 // walkRoute traverses the route tree in depth-first order.
 func walkRoute(r *dispatch.Route, visit func(*dispatch.Route)) {
 	visit(r)
@@ -184,12 +514,21 @@ func run() int {
 		dataDir         = kingpin.Flag("storage.path", "Base path for data storage.").Default("data/").String()
 		retention       = kingpin.Flag("data.retention", "How long to keep data for.").Default("120h").Duration()
 		alertGCInterval = kingpin.Flag("alerts.gc-interval", "Interval between alert GC.").Default("30m").Duration()
+		alertsMaxCount  = kingpin.Flag("alerts.max-alerts", "Maximum number of alerts to keep in memory. Once exceeded, the least-recently-used resolved alerts are evicted. 0 = unbounded.").Default("0").Int()
+		alertsMaxBytes  = kingpin.Flag("alerts.max-bytes", "Approximate maximum memory the alert store may use, evicting the least-recently-used resolved alerts once exceeded. 0 = unbounded.").Default("0").Bytes()
 
 		externalURL    = kingpin.Flag("web.external-url", "The URL under which Alertmanager is externally reachable (for example, if Alertmanager is served via a reverse proxy). Used for generating relative and absolute links back to Alertmanager itself. If the URL has a path portion, it will be used to prefix all HTTP endpoints served by Alertmanager. If omitted, relevant URL components will be derived automatically.").String()
 		routePrefix    = kingpin.Flag("web.route-prefix", "Prefix for the internal routes of web endpoints. Defaults to path of --web.external-url.").String()
 		listenAddress  = kingpin.Flag("web.listen-address", "Address to listen on for the web interface and API.").Default(":9093").String()
 		getConcurrency = kingpin.Flag("web.get-concurrency", "Maximum number of GET requests processed concurrently. If negative or zero, the limit is GOMAXPROC or 8, whichever is larger.").Default("0").Int()
+		notifyWorkers  = kingpin.Flag("dispatch.notify-workers", "Maximum number of aggregation group flushes processed concurrently across the whole dispatcher. If zero, every flush runs on its own goroutine as before, unbounded.").Default("0").Int()
 		httpTimeout    = kingpin.Flag("web.timeout", "Timeout for HTTP requests. If negative or zero, no timeout is set.").Default("0").Duration()
+		webConfigFile  = kingpin.Flag("web.config.file", "Path to a file enabling TLS and/or basic auth or bearer token authentication for the web interface and API. If empty, Alertmanager serves plain HTTP with no authentication.").Default("").String()
+
+		webTitle         = kingpin.Flag("web.title", "Title shown in the UI, overriding the default \"Alertmanager\".").String()
+		webLogoPath      = kingpin.Flag("web.logo-path", "Path to an image file served in place of the default logo/favicon, without rebuilding the UI.").String()
+		webCustomCSSPath = kingpin.Flag("web.custom-css-path", "Path to a CSS file injected into the UI after the default stylesheet, so its rules can override branding.").String()
+		webRunbookLinks  = kingpin.Flag("web.runbook-link", "A name=url pair linking to internal documentation, shown in the UI navigation (may be repeated).").Strings()
 
 		clusterBindAddr = kingpin.Flag("cluster.listen-address", "Listen address for cluster. Set to empty string to disable HA mode.").
 				Default(defaultClusterAddr).String()
@@ -204,6 +543,37 @@ func run() int {
 		settleTimeout        = kingpin.Flag("cluster.settle-timeout", "Maximum time to wait for cluster connections to settle before evaluating notifications.").Default(cluster.DefaultPushPullInterval.String()).Duration()
 		reconnectInterval    = kingpin.Flag("cluster.reconnect-interval", "Interval between attempting to reconnect to lost peers.").Default(cluster.DefaultReconnectInterval.String()).Duration()
 		peerReconnectTimeout = kingpin.Flag("cluster.reconnect-timeout", "Length of time to attempt to reconnect to a lost peer.").Default(cluster.DefaultReconnectTimeout.String()).Duration()
+
+		clusterHashring        = kingpin.Flag("cluster.hashring", "Shard matched routes across cluster members by hash ring instead of every instance processing every alert. One of consistent, rendezvous, static. Empty disables sharding.").Enum("", "consistent", "rendezvous", "static")
+		clusterHashringShardBy = kingpin.Flag("cluster.hashring.shard-label", "Label whose value is hashed to pick the owning instance. If unset or absent on an alert, the matched route's key is hashed instead, sharding whole routes.").String()
+		clusterHashringStatic  = kingpin.Flag("cluster.hashring.static-assignment", "key=instance pair pinning a shard label value (or route key) to a specific instance name for the static hashring strategy (may be repeated).").Strings()
+
+		federatePeers       = kingpin.Flag("federate.peer", "Peer Alertmanager URL to mirror alerts from (may be repeated).").Strings()
+		federateInterval    = kingpin.Flag("federate.interval", "Interval between alert federation pulls.").Default("1m").Duration()
+		federateSourceLabel = kingpin.Flag("federate.source-label", "Label used to tag alerts mirrored from a peer with its URL.").Default(string(federate.DefaultSourceLabel)).String()
+
+		silenceSyncUpstream = kingpin.Flag("silence-sync.upstream", "Upstream Alertmanager URL to one-way mirror active and pending silences from. Set to empty string to disable.").String()
+		silenceSyncInterval = kingpin.Flag("silence-sync.interval", "Interval between silence sync pulls.").Default("1m").Duration()
+
+		canaryOnReload = kingpin.Flag("notify.canary-on-reload", "Send a synthetic test notification through every new or changed receiver after a successful config reload.").Default("false").Bool()
+
+		notificationsDisabled = kingpin.Flag("notifications.disabled", "Start in warm-standby mode: ingest and track alerts as usual, but suppress all notification delivery. Toggleable at runtime via POST/DELETE /api/v1/notifications/disable.").Default("false").Bool()
+
+		startupNotificationDelay = kingpin.Flag("startup-notification-delay", "Window after process start during which repeat notifications for groups already known to the notification log are suppressed, while state recovers and the cluster settles.").Default("0s").Duration()
+
+		drainTimeout = kingpin.Flag("shutdown-drain-timeout", "Maximum time to wait on SIGTERM for pending notification groups to flush and in-flight deliveries to finish before shutting down anyway.").Default("30s").Duration()
+
+		chatopsSlackSigningSecret  = kingpin.Flag("chatops.slack-signing-secret", "Signing secret used to verify inbound Slack interactive message callbacks. Verification is disabled if empty.").String()
+		chatopsTelegramSecretToken = kingpin.Flag("chatops.telegram-secret-token", "Secret token used to verify inbound Telegram bot webhook updates. Verification is disabled if empty.").String()
+		chatopsEscalateLabel       = kingpin.Flag("chatops.escalate-label", "Label set to \"true\" on a group's alerts when a responder escalates it from chat, for a route to match on.").Default("escalated").String()
+		chatopsAckSilenceDuration  = kingpin.Flag("chatops.ack-silence-duration", "If greater than zero, acknowledging a group from chat also creates a silence matching its labels for this long, attributed to the acknowledging user. Zero leaves chat acknowledgement a no-op beyond logging.").Default("0s").Duration()
+
+		debugDecisionLogFile = kingpin.Flag("debug.decision-log-file", "If set, append every routing and notification decision (alert fingerprint, route path, action, reason) as a JSON line to this file, for offline analysis or replay-based regression testing of config changes.").String()
+		debugDecisionLogURL  = kingpin.Flag("debug.decision-log-url", "If set, POST every routing and notification decision as a JSON document to this URL.").String()
+
+		auditLogFile         = kingpin.Flag("audit.log-file", "If set, append every sent notification and every silence created/deleted/config reload as a JSON line to this file, for compliance and delivery proof. Queryable at runtime via GET /api/v1/audit regardless of whether this is set.").String()
+		auditLogMaxSizeBytes = kingpin.Flag("audit.log-max-size-bytes", "Rotate the audit log file once it exceeds this size.").Default("104857600").Int64()
+		auditLogMaxBackups   = kingpin.Flag("audit.log-max-backups", "Maximum number of rotated audit log files to retain. 0 keeps every rotation.").Default("10").Int()
 	)
 
 	promlogflag.AddFlags(kingpin.CommandLine, &promlogConfig)
@@ -223,6 +593,33 @@ func run() int {
 		return 1
 	}
 
+	startup := newStartupTracker(prometheus.DefaultRegisterer, logger)
+
+	// Load and validate the configuration file before touching any on-disk
+	// state, so a broken config fails fast instead of leaving silences or
+	// the notification log partially restored.
+	bootCfg, err := config.LoadFile(*configFile)
+	if err != nil {
+		level.Error(logger).Log("msg", "Loading configuration file failed", "file", *configFile, "err", err)
+		return 1
+	}
+	startup.complete("config")
+
+	webCfg, err := web.LoadFile(*webConfigFile)
+	if err != nil {
+		level.Error(logger).Log("msg", "Loading web config file failed", "file", *webConfigFile, "err", err)
+		return 1
+	}
+
+	// The encryption keyring must be configured before any persisted state
+	// is loaded below, so it is built from the configuration file directly
+	// rather than waiting for configCoordinator's first Reload.
+	encryptionKeyRing := atrest.NewKeyRing()
+	if err := configureEncryption(encryptionKeyRing, bootCfg.Global.AtRestEncryption); err != nil {
+		level.Error(logger).Log("msg", "invalid at_rest_encryption configuration", "err", err)
+		return 1
+	}
+
 	var peer *cluster.Peer
 	if *clusterBindAddr != "" {
 		peer, err = cluster.Create(
@@ -245,33 +642,57 @@ func run() int {
 		clusterEnabled.Set(1)
 	}
 
+	var shardRing hashring.Ring
+	if *clusterHashring != "" {
+		static := map[string]string{}
+		for _, kv := range *clusterHashringStatic {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 {
+				level.Error(logger).Log("msg", "invalid --cluster.hashring.static-assignment, expected key=instance", "value", kv)
+				return 1
+			}
+			static[parts[0]] = parts[1]
+		}
+		shardRing, err = hashring.New(*clusterHashring, static)
+		if err != nil {
+			level.Error(logger).Log("msg", "unable to build hash ring", "err", err)
+			return 1
+		}
+	}
+
 	stopc := make(chan struct{})
 	var wg sync.WaitGroup
-	wg.Add(1)
 
-	notificationLogOpts := []nflog.Option{
-		nflog.WithRetention(*retention),
-		nflog.WithSnapshot(filepath.Join(*dataDir, "nflog")),
-		nflog.WithMaintenance(15*time.Minute, stopc, wg.Done),
-		nflog.WithMetrics(prometheus.DefaultRegisterer),
-		nflog.WithLogger(log.With(logger, "component", "nflog")),
-	}
+	marker := types.NewMarker(prometheus.DefaultRegisterer)
 
-	notificationLog, err := nflog.New(notificationLogOpts...)
-	if err != nil {
-		level.Error(logger).Log("err", err)
-		return 1
-	}
-	if peer != nil {
-		c := peer.AddState("nfl", notificationLog, prometheus.DefaultRegisterer)
-		notificationLog.SetBroadcast(c.Broadcast)
+	quotaTracker := quota.New(prometheus.DefaultRegisterer)
+	standbyTracker := standby.New(*notificationsDisabled)
+	maintenanceTracker := maintenance.New()
+	breakerTracker := breaker.New(prometheus.DefaultRegisterer)
+	rateLimitTracker := ratelimit.New(prometheus.DefaultRegisterer)
+	sloTracker := slo.New(prometheus.DefaultRegisterer)
+
+	decisionLogger := decisionlog.New(log.With(logger, "component", "decisionlog"))
+	if *debugDecisionLogFile != "" {
+		if err := decisionLogger.SetFile(*debugDecisionLogFile); err != nil {
+			level.Error(logger).Log("msg", "unable to open decision log file", "err", err)
+			return 1
+		}
 	}
+	decisionLogger.SetURL(*debugDecisionLogURL)
 
-	marker := types.NewMarker(prometheus.DefaultRegisterer)
+	auditLog := audit.New(log.With(logger, "component", "audit"), 0, *auditLogMaxSizeBytes, *auditLogMaxBackups)
+	if *auditLogFile != "" {
+		if err := auditLog.SetFile(*auditLogFile); err != nil {
+			level.Error(logger).Log("msg", "unable to open audit log file", "err", err)
+			return 1
+		}
+	}
 
 	silenceOpts := silence.Options{
 		SnapshotFile: filepath.Join(*dataDir, "silences"),
 		Retention:    *retention,
+		Encryption:   encryptionKeyRing,
 		Logger:       log.With(logger, "component", "silences"),
 		Metrics:      prometheus.DefaultRegisterer,
 	}
@@ -285,6 +706,7 @@ func run() int {
 		c := peer.AddState("sil", silences, prometheus.DefaultRegisterer)
 		silences.SetBroadcast(c.Broadcast)
 	}
+	silences.SetQuotaTracker(quotaTracker)
 
 	// Start providers before router potentially sends updates.
 	wg.Add(1)
@@ -292,6 +714,28 @@ func run() int {
 		silences.Maintenance(15*time.Minute, filepath.Join(*dataDir, "silences"), stopc)
 		wg.Done()
 	}()
+	startup.complete("silences")
+
+	wg.Add(1)
+	notificationLogOpts := []nflog.Option{
+		nflog.WithRetention(*retention),
+		nflog.WithSnapshot(filepath.Join(*dataDir, "nflog")),
+		nflog.WithMaintenance(15*time.Minute, stopc, wg.Done),
+		nflog.WithMetrics(prometheus.DefaultRegisterer),
+		nflog.WithLogger(log.With(logger, "component", "nflog")),
+		nflog.WithEncryption(encryptionKeyRing),
+	}
+
+	notificationLog, err := nflog.New(notificationLogOpts...)
+	if err != nil {
+		level.Error(logger).Log("err", err)
+		return 1
+	}
+	if peer != nil {
+		c := peer.AddState("nfl", notificationLog, prometheus.DefaultRegisterer)
+		notificationLog.SetBroadcast(c.Broadcast)
+	}
+	startup.complete("nflog")
 
 	defer func() {
 		close(stopc)
@@ -314,7 +758,12 @@ func run() int {
 				level.Warn(logger).Log("msg", "unable to leave gossip mesh", "err", err)
 			}
 		}()
-		go peer.Settle(ctx, *gossipInterval*10)
+		go func() {
+			peer.Settle(ctx, *gossipInterval*10)
+			startup.complete("cluster")
+		}()
+	} else {
+		startup.complete("cluster")
 	}
 
 	alerts, err := mem.NewAlerts(context.Background(), marker, *alertGCInterval, logger)
@@ -323,6 +772,34 @@ func run() int {
 		return 1
 	}
 	defer alerts.Close()
+	alerts.SetEncryption(encryptionKeyRing)
+	alerts.SetLimits(*alertsMaxCount, int64(*alertsMaxBytes))
+	alerts.SetMetrics(prometheus.DefaultRegisterer)
+
+	alertsSnapshotFile := filepath.Join(*dataDir, "alerts")
+	if f, err := os.Open(alertsSnapshotFile); err == nil {
+		err := alerts.LoadSnapshot(f)
+		f.Close()
+		if err != nil {
+			level.Warn(logger).Log("msg", "unable to load alerts snapshot", "err", err)
+		}
+	} else if !os.IsNotExist(err) {
+		level.Warn(logger).Log("msg", "unable to open alerts snapshot", "err", err)
+	}
+
+	wg.Add(1)
+	go func() {
+		alerts.Maintenance(15*time.Minute, alertsSnapshotFile, stopc)
+		wg.Done()
+	}()
+
+	historyLog := history.New(0)
+	alerts.SetHistory(historyLog)
+	receiverMuteTracker := receivermute.New()
+	shadowTracker := shadow.New(0)
+	groupNotesTracker := groupnotes.New()
+	escalationTracker := escalation.New()
+	startup.complete("alerts")
 
 	var disp *dispatch.Dispatcher
 	defer disp.Stop()
@@ -332,15 +809,24 @@ func run() int {
 	}
 
 	api, err := api.New(api.Options{
-		Alerts:      alerts,
-		Silences:    silences,
-		StatusFunc:  marker.Status,
-		Peer:        peer,
-		Timeout:     *httpTimeout,
-		Concurrency: *getConcurrency,
-		Logger:      log.With(logger, "component", "api"),
-		Registry:    prometheus.DefaultRegisterer,
-		GroupFunc:   groupFn,
+		Alerts:              alerts,
+		Silences:            silences,
+		StatusFunc:          marker.Status,
+		Peer:                peer,
+		Timeout:             *httpTimeout,
+		Concurrency:         *getConcurrency,
+		Logger:              log.With(logger, "component", "api"),
+		Registry:            prometheus.DefaultRegisterer,
+		GroupFunc:           groupFn,
+		History:             historyLog,
+		ReceiverMuteTracker: receiverMuteTracker,
+		GroupNotesTracker:   groupNotesTracker,
+		EscalationTracker:   escalationTracker,
+		QuotaTracker:        quotaTracker,
+		StandbyTracker:      standbyTracker,
+		MaintenanceTracker:  maintenanceTracker,
+		ShadowTracker:       shadowTracker,
+		Audit:               auditLog,
 	})
 
 	if err != nil {
@@ -367,11 +853,57 @@ func run() int {
 	}
 
 	var (
-		inhibitor *inhibit.Inhibitor
-		tmpl      *template.Template
+		inhibitor    *inhibit.Inhibitor
+		tmpl         *template.Template
+		priorityGate *priority.Gate
 	)
 
+	pinger := heartbeat.New(prometheus.DefaultRegisterer, log.With(logger, "component", "heartbeat"))
+	go pinger.Run(context.Background(), stopc)
+
+	watchdogWatcher := watchdog.New(alerts, log.With(logger, "component", "watchdog"))
+	go watchdogWatcher.Run(context.Background())
+
+	if len(*federatePeers) > 0 {
+		puller := federate.New(*federateSourceLabel, prometheus.DefaultRegisterer, log.With(logger, "component", "federate"))
+		go puller.Run(context.Background(), *federatePeers, *federateInterval, alerts)
+	}
+
+	if *silenceSyncUpstream != "" {
+		syncer := silencesync.New(prometheus.DefaultRegisterer, log.With(logger, "component", "silencesync"))
+		go syncer.Run(context.Background(), *silenceSyncUpstream, *silenceSyncInterval, silences)
+	}
+
+	bounceTracker := bounce.NewTracker(prometheus.DefaultRegisterer, log.With(logger, "component", "bounce"))
+	go bounceTracker.Run(context.Background(), bounce.DefaultGCInterval, bounce.DefaultMaxAge)
+
+	timelineSink := timeline.New(log.With(logger, "component", "timeline"))
+	sqlReportExporter := sqlreport.New(prometheus.DefaultRegisterer, log.With(logger, "component", "sqlreport"))
+	archiver := archive.New(log.With(logger, "component", "archive"))
+	receiptSink := receipt.New(log.With(logger, "component", "receipt"))
+	incidentTracker := incident.New(0)
+	correlationTracker := correlate.New(nil, 0)
+	digestTracker := report.NewTracker()
+	reporter := report.New(log.With(logger, "component", "report"))
+	go reporter.Run(context.Background(), digestTracker, silences, stopc)
 	pipelineBuilder := notify.NewPipelineBuilder(prometheus.DefaultRegisterer)
+	pipelineBuilder.SetTimelineSink(timelineSink)
+	pipelineBuilder.SetSQLReportExporter(sqlReportExporter)
+	pipelineBuilder.SetArchiver(archiver)
+	pipelineBuilder.SetReceiptSink(receiptSink)
+	pipelineBuilder.SetIncidentTracker(incidentTracker)
+	pipelineBuilder.SetCorrelationTracker(correlationTracker)
+	pipelineBuilder.SetStartupGracePeriod(time.Now(), *startupNotificationDelay)
+	pipelineBuilder.SetDigestTracker(digestTracker)
+	pipelineBuilder.SetQuotaTracker(quotaTracker)
+	pipelineBuilder.SetStandbyTracker(standbyTracker)
+	pipelineBuilder.SetCircuitBreaker(breakerTracker)
+	pipelineBuilder.SetRateLimiter(rateLimitTracker)
+	pipelineBuilder.SetDecisionLog(decisionLogger)
+	pipelineBuilder.SetHistory(historyLog)
+	pipelineBuilder.SetReceiverMuteTracker(receiverMuteTracker)
+	pipelineBuilder.SetShadowTracker(shadowTracker)
+	prevReceiverConfigs := map[string][]byte{}
 	configCoordinator := config.NewCoordinator(
 		*configFile,
 		prometheus.DefaultRegisterer,
@@ -384,15 +916,142 @@ func run() int {
 		}
 		tmpl.ExternalURL = amURL
 
+		if conf.Global.RunbookURL != nil {
+			tmpl.RunbookURL = conf.Global.RunbookURL.URL
+		}
+		if conf.Global.DashboardURL != nil {
+			tmpl.DashboardURL = conf.Global.DashboardURL.URL
+		}
+		if conf.Global.SnoozeSecret != "" {
+			tmpl.SnoozeSecret = string(conf.Global.SnoozeSecret)
+			tmpl.SnoozeTTL = time.Duration(conf.Global.SnoozeTTL)
+		}
+
+		incidentTracker.SetWindow(time.Duration(conf.Global.IncidentWindow))
+
+		correlationLabels := make([]model.LabelName, len(conf.Global.CorrelationLabels))
+		for i, ln := range conf.Global.CorrelationLabels {
+			correlationLabels[i] = model.LabelName(ln)
+		}
+		correlationTracker.SetLabels(correlationLabels)
+		correlationTracker.SetWindow(time.Duration(conf.Global.CorrelationWindow))
+
+		if conf.Global.HeartbeatURL != nil {
+			pinger.Set(conf.Global.HeartbeatURL.String(), time.Duration(conf.Global.HeartbeatInterval))
+		} else {
+			pinger.Set("", time.Duration(conf.Global.HeartbeatInterval))
+		}
+
+		if conf.Global.TimelineURL != nil {
+			timelineSink.Set(conf.Global.TimelineURL.String())
+		} else {
+			timelineSink.Set("")
+		}
+
+		if conf.Global.SQLReport != nil {
+			if err := sqlReportExporter.Set(conf.Global.SQLReport.Driver, string(conf.Global.SQLReport.DSN)); err != nil {
+				level.Warn(logger).Log("msg", "failed to configure SQL reporting database", "err", err)
+			}
+		} else if err := sqlReportExporter.Set("", ""); err != nil {
+			level.Warn(logger).Log("msg", "failed to disable SQL reporting database", "err", err)
+		}
+
+		if conf.Global.ArchiveURL != nil {
+			archiver.Set(conf.Global.ArchiveURL.String())
+		} else {
+			archiver.Set("")
+		}
+
+		if conf.Global.ReceiptURL != nil {
+			receiptSink.Set(conf.Global.ReceiptURL.String())
+		} else {
+			receiptSink.Set("")
+		}
+
+		if conf.Global.ReportWebhookURL != nil {
+			reporter.Set(conf.Global.ReportWebhookURL.String(), time.Duration(conf.Global.ReportInterval), conf.Global.ReportTeamLabel)
+		} else {
+			reporter.Set("", time.Duration(conf.Global.ReportInterval), conf.Global.ReportTeamLabel)
+		}
+
+		quotaLimits := make(map[string]quota.Limits, len(conf.Global.TenantQuotas))
+		for tenant, q := range conf.Global.TenantQuotas {
+			quotaLimits[tenant] = quota.Limits{MaxAlerts: q.MaxAlerts, MaxSilences: q.MaxSilences}
+		}
+		quotaTracker.Configure(model.LabelName(conf.Global.TenantLabel), quotaLimits)
+
+		breakerSettings := make(map[string]breaker.Settings, len(conf.Receivers))
+		for _, rcv := range conf.Receivers {
+			if rcv.CircuitBreaker == nil {
+				continue
+			}
+			breakerSettings[rcv.Name] = breaker.Settings{
+				FailureThreshold: rcv.CircuitBreaker.FailureThreshold,
+				CooldownPeriod:   time.Duration(rcv.CircuitBreaker.CooldownPeriod),
+				FallbackReceiver: rcv.CircuitBreaker.FallbackReceiver,
+			}
+		}
+		breakerTracker.Configure(breakerSettings)
+
+		rateLimitSettings := make(map[string]ratelimit.Settings, len(conf.Receivers))
+		for _, rcv := range conf.Receivers {
+			if rcv.RateLimit == nil {
+				continue
+			}
+			rateLimitSettings[rcv.Name] = ratelimit.Settings{
+				PerMinute: rcv.RateLimit.PerMinute,
+				Burst:     rcv.RateLimit.Burst,
+				Overflow:  ratelimit.Overflow(rcv.RateLimit.Overflow),
+			}
+		}
+		rateLimitTracker.Configure(rateLimitSettings)
+
+		if err := configureEncryption(encryptionKeyRing, conf.Global.AtRestEncryption); err != nil {
+			return err
+		}
+
 		// Build the map of receiver to integrations.
 		receivers := make(map[string][]notify.Integration, len(conf.Receivers))
+		concurrencyLimits := make(map[string]int, len(conf.Receivers))
+		dryRun := make(map[string]bool, len(conf.Receivers))
+		maxRetryDurations := make(map[string]time.Duration, len(conf.Receivers))
+		notificationTimeouts := make(map[string]time.Duration, len(conf.Receivers))
+		redactions := make(map[string][]*config.RedactionRule, len(conf.Receivers))
+		changedReceivers := make(map[string][]notify.Integration)
+		newReceiverConfigs := make(map[string][]byte, len(conf.Receivers))
 		for _, rcv := range conf.Receivers {
-			integrations, err := buildReceiverIntegrations(rcv, tmpl, logger)
+			integrations, err := buildReceiverIntegrations(rcv, tmpl, logger, bounceTracker, silences)
 			if err != nil {
 				return err
 			}
 			// rcv.Name is guaranteed to be unique across all receivers.
 			receivers[rcv.Name] = integrations
+			concurrencyLimits[rcv.Name] = rcv.MaxConcurrency
+			maxRetryDurations[rcv.Name] = time.Duration(rcv.MaxRetryDuration)
+			notificationTimeouts[rcv.Name] = time.Duration(rcv.NotificationTimeout)
+			redactions[rcv.Name] = rcv.Redactions
+			if rcv.DryRun != nil {
+				dryRun[rcv.Name] = *rcv.DryRun
+			} else {
+				dryRun[rcv.Name] = conf.Global.DryRun
+			}
+
+			if *canaryOnReload {
+				raw, err := yaml.Marshal(rcv)
+				if err != nil {
+					return errors.Wrap(err, "failed to marshal receiver config")
+				}
+				newReceiverConfigs[rcv.Name] = raw
+				if prev, ok := prevReceiverConfigs[rcv.Name]; !ok || !bytes.Equal(prev, raw) {
+					changedReceivers[rcv.Name] = integrations
+				}
+			}
+		}
+		if *canaryOnReload {
+			prevReceiverConfigs = newReceiverConfigs
+			for name, integrations := range changedReceivers {
+				sendCanaryNotifications(log.With(logger, "component", "canary"), name, integrations)
+			}
 		}
 
 		inhibitor.Stop()
@@ -400,6 +1059,53 @@ func run() int {
 
 		inhibitor = inhibit.NewInhibitor(alerts, conf.InhibitRules, marker, logger)
 		silencer := silence.NewSilencer(silences, marker, logger)
+
+		if conf.Global.OutboundConcurrency > 0 {
+			priorityGate = priority.NewGate(conf.Global.OutboundConcurrency, priority.DefaultAgingInterval)
+		} else {
+			priorityGate = nil
+		}
+		pipelineBuilder.SetPriorityGate(priorityGate, model.LabelName(conf.Global.PriorityLabel), conf.Global.PriorityValues)
+
+		muteTimeIntervals := make(map[string][]timeinterval.TimeInterval, len(conf.MuteTimeIntervals))
+		for _, mt := range conf.MuteTimeIntervals {
+			muteTimeIntervals[mt.Name] = mt.TimeIntervals
+		}
+		pipelineBuilder.SetMuteTimeIntervals(muteTimeIntervals)
+
+		sloObjectives := make(map[string]slo.Settings, len(conf.Receivers))
+		for _, rcv := range conf.Receivers {
+			sloObjectives[rcv.Name] = slo.Settings{Objective: time.Duration(rcv.PagingLatencyObjective)}
+		}
+		sloTracker.Configure(sloObjectives)
+
+		sloAlertReceiver := conf.Global.SLOAlertReceiver
+		onSLOBreach := func(receiver string) {
+			if sloAlertReceiver == "" {
+				return
+			}
+			integrations, ok := receivers[sloAlertReceiver]
+			if !ok {
+				level.Warn(logger).Log("msg", "global.slo_alert_receiver is not a configured receiver", "receiver", sloAlertReceiver)
+				return
+			}
+			sendSLOAlert(log.With(logger, "component", "slo"), sloAlertReceiver, receiver, integrations)
+		}
+		pipelineBuilder.SetSLOTracker(sloTracker, onSLOBreach)
+
+		watchdogReceiver := conf.Global.WatchdogReceiver
+		watchdogWatcher.Set(conf.Global.WatchdogAlertName, time.Duration(conf.Global.WatchdogTimeout), func(alertname string, timeout time.Duration) {
+			if watchdogReceiver == "" {
+				return
+			}
+			integrations, ok := receivers[watchdogReceiver]
+			if !ok {
+				level.Warn(logger).Log("msg", "global.watchdog_receiver is not a configured receiver", "receiver", watchdogReceiver)
+				return
+			}
+			sendWatchdogAlert(log.With(logger, "component", "watchdog"), watchdogReceiver, alertname, timeout, integrations)
+		})
+
 		pipeline := pipelineBuilder.New(
 			receivers,
 			waitFunc,
@@ -407,15 +1113,54 @@ func run() int {
 			silencer,
 			notificationLog,
 			peer,
+			tmpl,
+			concurrencyLimits,
+			dryRun,
+			maxRetryDurations,
+			notificationTimeouts,
+			redactions,
 		)
 
-		api.Update(conf, func(labels model.LabelSet) {
-			inhibitor.Mutes(labels)
-			silencer.Mutes(labels)
-		})
+		api.Update(conf, func(labels, annotations model.LabelSet) {
+			inhibitor.Mutes(labels, annotations)
+			silencer.Mutes(labels, annotations)
+		}, tmpl)
+
+		budgetAlertReceiver := conf.Global.BudgetAlertReceiver
+		onBudgetExceeded := func(route *dispatch.Route) {
+			if budgetAlertReceiver == "" {
+				return
+			}
+			integrations, ok := receivers[budgetAlertReceiver]
+			if !ok {
+				level.Warn(logger).Log("msg", "global.budget_alert_receiver is not a configured receiver", "receiver", budgetAlertReceiver)
+				return
+			}
+			sendBudgetAlert(log.With(logger, "component", "budget"), budgetAlertReceiver, route, integrations)
+		}
+
+		for _, dup := range conf.DuplicateReceivers {
+			level.Warn(log.With(logger, "component", "configuration")).Log(
+				"msg",
+				"receiver configures the same integrations as another receiver; consider copy_of or a YAML anchor instead of duplicating them",
+				"receiver",
+				dup[1],
+				"duplicate_of",
+				dup[0],
+			)
+		}
 
-		routes := dispatch.NewRoute(conf.Route, nil)
-		disp = dispatch.NewDispatcher(alerts, routes, pipeline, marker, timeoutFunc, logger)
+		routes := dispatch.NewRoute(conf.Route, nil, config.ReceiversByName(conf.Receivers))
+		disp = dispatch.NewDispatcher(alerts, routes, pipeline, marker, timeoutFunc, prometheus.DefaultRegisterer, onBudgetExceeded, logger)
+		disp.SetHistory(historyLog)
+		disp.SetGroupNotes(groupNotesTracker)
+		disp.SetEscalation(escalationTracker)
+		disp.SetDecisionLog(decisionLogger)
+		disp.SetAudit(auditLog)
+		disp.SetNotifyWorkers(*notifyWorkers)
+		if shardRing != nil {
+			disp.SetHashRing(shardRing, peer, model.LabelName(*clusterHashringShardBy))
+		}
 		walkRoute(routes, func(r *dispatch.Route) {
 			if r.RouteOpts.RepeatInterval > *retention {
 				level.Warn(log.With(logger, "component", "configuration")).Log(
@@ -434,6 +1179,8 @@ func run() int {
 		go disp.Run()
 		go inhibitor.Run()
 
+		auditLog.Record(audit.Event{Type: audit.EventConfigReloaded})
+
 		return nil
 	})
 
@@ -455,16 +1202,85 @@ func run() int {
 
 	webReload := make(chan chan error)
 
-	ui.Register(router, webReload, logger)
+	var runbookLinks []ui.RunbookLink
+	for _, rl := range *webRunbookLinks {
+		parts := strings.SplitN(rl, "=", 2)
+		if len(parts) != 2 {
+			level.Warn(logger).Log("msg", "ignoring malformed --web.runbook-link, want name=url", "value", rl)
+			continue
+		}
+		runbookLinks = append(runbookLinks, ui.RunbookLink{Name: parts[0], URL: parts[1]})
+	}
+	branding := ui.BrandingOptions{
+		Title:         *webTitle,
+		LogoPath:      *webLogoPath,
+		CustomCSSPath: *webCustomCSSPath,
+		RunbookLinks:  runbookLinks,
+	}
+
+	ui.Register(router, webReload, startup.isReady, maintenanceTracker.ReadOnly, branding, logger)
 
 	mux := api.Register(router, *routePrefix)
+	mux.HandleFunc("/-/email-bounces", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		bounceTracker.Ingest(r.Body)
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/-/tenants", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(quotaTracker.Snapshot()); err != nil {
+			level.Error(logger).Log("msg", "failed to encode tenant usage", "err", err)
+		}
+	})
+	mux.HandleFunc("/-/receiver-circuit-breakers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(breakerTracker.Snapshot()); err != nil {
+			level.Error(logger).Log("msg", "failed to encode receiver circuit breaker status", "err", err)
+		}
+	})
+
+	chatopsHandler := &chatops.Handler{
+		Silences:            silences,
+		Alerts:              alerts,
+		Logger:              log.With(logger, "component", "chatops"),
+		EscalateLabel:       model.LabelName(*chatopsEscalateLabel),
+		AckSilenceDuration:  *chatopsAckSilenceDuration,
+		SlackSigningSecret:  *chatopsSlackSigningSecret,
+		TelegramSecretToken: *chatopsTelegramSecretToken,
+	}
+	mux.Handle("/-/chatops/slack", chatopsHandler)
+	mux.Handle("/-/chatops/telegram", chatopsHandler)
 
-	srv := http.Server{Addr: *listenAddress, Handler: mux}
+	srv := http.Server{Addr: *listenAddress, Handler: webCfg.Middleware(mux)}
 	srvc := make(chan struct{})
 
 	go func() {
 		level.Info(logger).Log("msg", "Listening", "address", *listenAddress)
-		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		var err error
+		if webCfg.TLSEnabled() {
+			tlsCfg, tlsErr := webCfg.NewTLSConfig()
+			if tlsErr != nil {
+				level.Error(logger).Log("msg", "Invalid TLS configuration", "err", tlsErr)
+				close(srvc)
+				return
+			}
+			srv.TLSConfig = tlsCfg
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != http.ErrServerClosed {
 			level.Error(logger).Log("msg", "Listen error", "err", err)
 			close(srvc)
 		}
@@ -483,6 +1299,8 @@ func run() int {
 	signal.Notify(hup, syscall.SIGHUP)
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 
+	// Both a SIGHUP and a POST to /-/reload trigger the same hot reload of
+	// the on-disk configuration, without requiring a restart.
 	go func() {
 		<-hupReady
 		for {
@@ -503,6 +1321,17 @@ func run() int {
 		select {
 		case <-term:
 			level.Info(logger).Log("msg", "Received SIGTERM, exiting gracefully...")
+
+			// Stop accepting new alerts over the API before draining the
+			// dispatcher, so the notification groups we're about to flush
+			// don't keep growing out from under us.
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), *drainTimeout)
+			if err := srv.Shutdown(shutdownCtx); err != nil {
+				level.Error(logger).Log("msg", "Error shutting down HTTP server", "err", err)
+			}
+			cancel()
+
+			disp.Drain(*drainTimeout)
 			return 0
 		case <-srvc:
 			return 1
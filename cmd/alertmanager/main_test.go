@@ -14,15 +14,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/go-kit/kit/log"
+	"github.com/prometheus/client_golang/prometheus"
 	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
 	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/types"
 )
 
 type sendResolved bool
@@ -40,10 +46,10 @@ func TestBuildReceiverIntegrations(t *testing.T) {
 				Name: "foo",
 				WebhookConfigs: []*config.WebhookConfig{
 					&config.WebhookConfig{
-						HTTPConfig: &commoncfg.HTTPClientConfig{},
+						HTTPConfig: &config.HTTPClientConfig{},
 					},
 					&config.WebhookConfig{
-						HTTPConfig: &commoncfg.HTTPClientConfig{},
+						HTTPConfig: &config.HTTPClientConfig{},
 						NotifierConfig: config.NotifierConfig{
 							VSendResolved: true,
 						},
@@ -60,9 +66,11 @@ func TestBuildReceiverIntegrations(t *testing.T) {
 				Name: "foo",
 				WebhookConfigs: []*config.WebhookConfig{
 					&config.WebhookConfig{
-						HTTPConfig: &commoncfg.HTTPClientConfig{
-							TLSConfig: commoncfg.TLSConfig{
-								CAFile: "not_existing",
+						HTTPConfig: &config.HTTPClientConfig{
+							HTTPClientConfig: commoncfg.HTTPClientConfig{
+								TLSConfig: commoncfg.TLSConfig{
+									CAFile: "not_existing",
+								},
 							},
 						},
 					},
@@ -73,7 +81,7 @@ func TestBuildReceiverIntegrations(t *testing.T) {
 	} {
 		tc := tc
 		t.Run("", func(t *testing.T) {
-			integrations, err := buildReceiverIntegrations(tc.receiver, nil, nil)
+			integrations, err := buildReceiverIntegrations(tc.receiver, nil, nil, nil, nil)
 			if tc.err {
 				require.Error(t, err)
 				return
@@ -89,6 +97,89 @@ func TestBuildReceiverIntegrations(t *testing.T) {
 	}
 }
 
+func TestCanaryAlert(t *testing.T) {
+	alert := canaryAlert("team-x")
+	require.Equal(t, model.LabelValue("AlertmanagerCanaryTest"), alert.Labels["alertname"])
+	require.Equal(t, model.LabelValue("team-x"), alert.Labels["receiver"])
+	require.True(t, alert.EndsAt.After(alert.StartsAt))
+}
+
+func TestSendCanaryNotifications(t *testing.T) {
+	notifier := &recordingNotifier{}
+	integrations := []notify.Integration{
+		notify.NewIntegration(notifier, sendResolved(false), "webhook", 0),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	notifier.done = wg.Done
+
+	sendCanaryNotifications(log.NewNopLogger(), "team-x", integrations)
+	wg.Wait()
+
+	require.Len(t, notifier.alerts, 1)
+	require.Equal(t, model.LabelValue("AlertmanagerCanaryTest"), notifier.alerts[0].Labels["alertname"])
+}
+
+func TestBudgetAlert(t *testing.T) {
+	route := dispatch.NewRoute(&config.Route{
+		Receiver:           "team-x",
+		NotificationBudget: 50,
+	}, nil, nil)
+
+	alert := budgetAlert(route)
+	require.Equal(t, model.LabelValue("NotificationBudgetExceeded"), alert.Labels["alertname"])
+	require.True(t, alert.EndsAt.After(alert.StartsAt))
+}
+
+func TestSendBudgetAlert(t *testing.T) {
+	route := dispatch.NewRoute(&config.Route{
+		Receiver:           "team-x",
+		NotificationBudget: 50,
+	}, nil, nil)
+
+	notifier := &recordingNotifier{}
+	integrations := []notify.Integration{
+		notify.NewIntegration(notifier, sendResolved(false), "webhook", 0),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	notifier.done = wg.Done
+
+	sendBudgetAlert(log.NewNopLogger(), "platform-team", route, integrations)
+	wg.Wait()
+
+	require.Len(t, notifier.alerts, 1)
+	require.Equal(t, model.LabelValue("NotificationBudgetExceeded"), notifier.alerts[0].Labels["alertname"])
+}
+
+func TestStartupTrackerBecomesReadyOnlyOnceAllStagesComplete(t *testing.T) {
+	tracker := newStartupTracker(prometheus.NewRegistry(), log.NewNopLogger())
+
+	for i, stage := range startupStages[:len(startupStages)-1] {
+		require.False(t, tracker.isReady(), "should not be ready after stage %d (%q)", i, stage)
+		tracker.complete(stage)
+	}
+	require.False(t, tracker.isReady())
+
+	tracker.complete(startupStages[len(startupStages)-1])
+	require.True(t, tracker.isReady())
+}
+
+type recordingNotifier struct {
+	alerts []*types.Alert
+	done   func()
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+	n.alerts = append(n.alerts, alerts...)
+	if n.done != nil {
+		n.done()
+	}
+	return false, nil
+}
+
 func TestExternalURL(t *testing.T) {
 	hostname := "foo"
 	for _, tc := range []struct {
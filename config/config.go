@@ -14,22 +14,49 @@
 package config
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"io/ioutil"
 	"net"
 	"net/url"
+	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strings"
+	texttemplate "text/template"
 	"time"
 
 	"github.com/pkg/errors"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
 	"gopkg.in/yaml.v2"
+
+	"github.com/prometheus/alertmanager/heartbeat"
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/pkg/timeinterval"
+	"github.com/prometheus/alertmanager/watchdog"
 )
 
+// GroupByTemplateFuncs are the functions made available, in addition to the
+// text/template builtins, to a Route's GroupByTemplate.
+var GroupByTemplateFuncs = texttemplate.FuncMap{
+	"hash": func(s string) string {
+		h := fnv.New32a()
+		// hash.Hash.Write never returns an error.
+		//nolint: errcheck
+		h.Write([]byte(s))
+		return fmt.Sprintf("%x", h.Sum32())
+	},
+	"truncate": func(n int, s string) string {
+		if len(s) <= n {
+			return s
+		}
+		return s[:n]
+	},
+}
+
 const secretToken = "<secret>"
 
 var secretTokenJSON string
@@ -53,10 +80,28 @@ func (s Secret) MarshalYAML() (interface{}, error) {
 	return nil, nil
 }
 
-// UnmarshalYAML implements the yaml.Unmarshaler interface for Secret.
+// envVarRefRe matches a value that consists entirely of an
+// "${ENV_VAR_NAME}" reference, so that a Secret can be sourced from the
+// environment instead of embedded in the config file, e.g. for a secret
+// injected into the Alertmanager container by an orchestrator.
+var envVarRefRe = regexp.MustCompile(`^\$\{(\w+)\}$`)
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Secret. A
+// value of the form "${ENV_VAR_NAME}" is replaced with the contents of that
+// environment variable; any other value is used verbatim.
 func (s *Secret) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	type plain Secret
-	return unmarshal((*plain)(s))
+	if err := unmarshal((*plain)(s)); err != nil {
+		return err
+	}
+	if m := envVarRefRe.FindStringSubmatch(string(*s)); m != nil {
+		v, ok := os.LookupEnv(m[1])
+		if !ok {
+			return fmt.Errorf("environment variable %q referenced in config is not set", m[1])
+		}
+		*s = Secret(v)
+	}
+	return nil
 }
 
 // MarshalJSON implements the json.Marshaler interface for Secret.
@@ -172,6 +217,13 @@ func LoadFile(filename string) (*Config, error) {
 	}
 
 	resolveFilepaths(filepath.Dir(filename), cfg)
+
+	if cfg.RouteFragmentsDir != "" {
+		if err := mergeRouteFragments(cfg.RouteFragmentsDir, cfg); err != nil {
+			return nil, err
+		}
+	}
+
 	return cfg, nil
 }
 
@@ -188,6 +240,10 @@ func resolveFilepaths(baseDir string, cfg *Config) {
 	for i, tf := range cfg.Templates {
 		cfg.Templates[i] = join(tf)
 	}
+
+	if cfg.RouteFragmentsDir != "" {
+		cfg.RouteFragmentsDir = join(cfg.RouteFragmentsDir)
+	}
 }
 
 // Config is the top-level configuration for Alertmanager's config files.
@@ -196,7 +252,32 @@ type Config struct {
 	Route        *Route         `yaml:"route,omitempty" json:"route,omitempty"`
 	InhibitRules []*InhibitRule `yaml:"inhibit_rules,omitempty" json:"inhibit_rules,omitempty"`
 	Receivers    []*Receiver    `yaml:"receivers,omitempty" json:"receivers,omitempty"`
-	Templates    []string       `yaml:"templates" json:"templates"`
+	// Templates lists glob patterns of additional template files loaded on
+	// top of the built-in default template library (template.FromGlobs),
+	// letting receiver notification formats (subject lines, Slack text,
+	// webhook fields, ...) be customized per organization.
+	Templates []string `yaml:"templates" json:"templates"`
+
+	// RouteFragmentsDir, if set, is a directory of per-team RouteFragment
+	// files merged into Route and Receivers on load, so that teams can
+	// self-service their own routing without a central platform team
+	// reviewing every change to the main configuration file.
+	RouteFragmentsDir string `yaml:"route_fragments_dir,omitempty" json:"route_fragments_dir,omitempty"`
+
+	// MuteTimeIntervals are named calendar windows that routes can
+	// reference by name via mute_time_intervals/active_time_intervals, so
+	// e.g. a low-severity route can be defined as "page during business
+	// hours, otherwise just notify a low-priority channel" without
+	// duplicating the route tree for day and night.
+	MuteTimeIntervals []MuteTimeInterval `yaml:"mute_time_intervals,omitempty" json:"mute_time_intervals,omitempty"`
+
+	// DuplicateReceivers is filled in by validate with a (earlier, later)
+	// name pair for every receiver whose integrations are byte-for-byte
+	// identical to one defined earlier in the file, other than through
+	// copy_of. It does not fail config load; callers that want to warn
+	// about it (suggesting copy_of or a YAML anchor instead) can do so
+	// after loading.
+	DuplicateReceivers [][2]string `yaml:"-" json:"-"`
 
 	// original is the input from which the config was parsed.
 	original string
@@ -227,12 +308,35 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		*c.Global = DefaultGlobalConfig()
 	}
 
-	names := map[string]struct{}{}
+	return c.validate()
+}
+
+// validate fills in receiver integration defaults from the global config and
+// checks that the configuration is internally consistent: receiver names are
+// unique and every receiver referenced from the routing tree is defined. It
+// is called once by UnmarshalYAML for the statically configured receivers
+// and routing tree, and again by mergeRouteFragments after merging in
+// per-team route fragments, since those add receivers and routing tree
+// nodes of their own that need the same treatment.
+func (c *Config) validate() error {
+	names := map[string]*Receiver{}
+	c.DuplicateReceivers = nil
 
 	for _, rcv := range c.Receivers {
 		if _, ok := names[rcv.Name]; ok {
 			return fmt.Errorf("notification config name %q is not unique", rcv.Name)
 		}
+
+		if rcv.CopyOf != "" {
+			src, ok := names[rcv.CopyOf]
+			if !ok {
+				return fmt.Errorf("receiver %q has copy_of %q, which must name a receiver defined earlier in the file", rcv.Name, rcv.CopyOf)
+			}
+			if err := rcv.copyIntegrationsFrom(src); err != nil {
+				return err
+			}
+		}
+
 		for _, wh := range rcv.WebhookConfigs {
 			if wh.HTTPConfig == nil {
 				wh.HTTPConfig = c.Global.HTTPConfig
@@ -257,8 +361,9 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 			if ec.AuthUsername == "" {
 				ec.AuthUsername = c.Global.SMTPAuthUsername
 			}
-			if ec.AuthPassword == "" {
+			if ec.AuthPassword == "" && ec.AuthPasswordFile == "" {
 				ec.AuthPassword = c.Global.SMTPAuthPassword
+				ec.AuthPasswordFile = c.Global.SMTPAuthPasswordFile
 			}
 			if ec.AuthSecret == "" {
 				ec.AuthSecret = c.Global.SMTPAuthSecret
@@ -307,6 +412,51 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 				poc.HTTPConfig = c.Global.HTTPConfig
 			}
 		}
+		for _, zc := range rcv.ZulipConfigs {
+			if zc.HTTPConfig == nil {
+				zc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, lnc := range rcv.LineNotifyConfigs {
+			if lnc.HTTPConfig == nil {
+				lnc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, ktc := range rcv.KakaoTalkConfigs {
+			if ktc.HTTPConfig == nil {
+				ktc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, tgc := range rcv.TelegramConfigs {
+			if tgc.HTTPConfig == nil {
+				tgc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, mtc := range rcv.MSTeamsConfigs {
+			if mtc.HTTPConfig == nil {
+				mtc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, snc := range rcv.SNSConfigs {
+			if snc.HTTPConfig == nil {
+				snc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, psc := range rcv.PubsubConfigs {
+			if psc.HTTPConfig == nil {
+				psc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, amc := range rcv.AlertmanagerConfigs {
+			if amc.HTTPConfig == nil {
+				amc.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
+		for _, ac := range rcv.AlertaConfigs {
+			if ac.HTTPConfig == nil {
+				ac.HTTPConfig = c.Global.HTTPConfig
+			}
+		}
 		for _, pdc := range rcv.PagerdutyConfigs {
 			if pdc.HTTPConfig == nil {
 				pdc.HTTPConfig = c.Global.HTTPConfig
@@ -331,11 +481,12 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 			if !strings.HasSuffix(ogc.APIURL.Path, "/") {
 				ogc.APIURL.Path += "/"
 			}
-			if ogc.APIKey == "" {
-				if c.Global.OpsGenieAPIKey == "" {
+			if ogc.APIKey == "" && ogc.APIKeyFile == "" {
+				if c.Global.OpsGenieAPIKey == "" && c.Global.OpsGenieAPIKeyFile == "" {
 					return fmt.Errorf("no global OpsGenie API Key set")
 				}
 				ogc.APIKey = c.Global.OpsGenieAPIKey
+				ogc.APIKeyFile = c.Global.OpsGenieAPIKeyFile
 			}
 		}
 		for _, wcc := range rcv.WechatConfigs {
@@ -381,14 +532,22 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 			if !strings.HasSuffix(voc.APIURL.Path, "/") {
 				voc.APIURL.Path += "/"
 			}
-			if voc.APIKey == "" {
-				if c.Global.VictorOpsAPIKey == "" {
+			if voc.APIKey == "" && voc.APIKeyFile == "" {
+				if c.Global.VictorOpsAPIKey == "" && c.Global.VictorOpsAPIKeyFile == "" {
 					return fmt.Errorf("no global VictorOps API Key set")
 				}
 				voc.APIKey = c.Global.VictorOpsAPIKey
+				voc.APIKeyFile = c.Global.VictorOpsAPIKeyFile
+			}
+		}
+		if rcv.CopyOf == "" {
+			for _, other := range names {
+				if other.CopyOf == "" && rcv.equalIntegrations(other) {
+					c.DuplicateReceivers = append(c.DuplicateReceivers, [2]string{other.Name, rcv.Name})
+				}
 			}
 		}
-		names[rcv.Name] = struct{}{}
+		names[rcv.Name] = rcv
 	}
 
 	// The root route must not have any matchers as it is the fallback node
@@ -399,12 +558,94 @@ func (c *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if len(c.Route.Receiver) == 0 {
 		return fmt.Errorf("root route must specify a default receiver")
 	}
-	if len(c.Route.Match) > 0 || len(c.Route.MatchRE) > 0 {
+	if len(c.Route.Match) > 0 || len(c.Route.MatchRE) > 0 || len(c.Route.MatchNot) > 0 || len(c.Route.MatchNotRE) > 0 {
 		return fmt.Errorf("root route must not have any matchers")
 	}
 
+	if c.Global.BudgetAlertReceiver != "" {
+		if _, ok := names[c.Global.BudgetAlertReceiver]; !ok {
+			return fmt.Errorf("undefined receiver %q used in global.budget_alert_receiver", c.Global.BudgetAlertReceiver)
+		}
+	}
+
+	if c.Global.SLOAlertReceiver != "" {
+		if _, ok := names[c.Global.SLOAlertReceiver]; !ok {
+			return fmt.Errorf("undefined receiver %q used in global.slo_alert_receiver", c.Global.SLOAlertReceiver)
+		}
+	}
+
+	for _, rcv := range names {
+		if rcv.CircuitBreaker == nil || rcv.CircuitBreaker.FallbackReceiver == "" {
+			continue
+		}
+		if _, ok := names[rcv.CircuitBreaker.FallbackReceiver]; !ok {
+			return fmt.Errorf("undefined receiver %q used in circuit_breaker.fallback_receiver for receiver %q", rcv.CircuitBreaker.FallbackReceiver, rcv.Name)
+		}
+	}
+
+	if c.Global.OutboundConcurrency < 0 {
+		return fmt.Errorf("global.outbound_concurrency must not be negative")
+	}
+
+	if c.Global.PriorityLabel != "" && !model.LabelName(c.Global.PriorityLabel).IsValid() {
+		return fmt.Errorf("invalid label name %q in global.priority_label", c.Global.PriorityLabel)
+	}
+
+	if c.Global.TenantLabel != "" && !model.LabelName(c.Global.TenantLabel).IsValid() {
+		return fmt.Errorf("invalid label name %q in global.tenant_label", c.Global.TenantLabel)
+	}
+	if len(c.Global.TenantQuotas) > 0 && c.Global.TenantLabel == "" {
+		return fmt.Errorf("global.tenant_quotas has no effect without global.tenant_label")
+	}
+
+	timeIntervalNames := make(map[string]struct{}, len(c.MuteTimeIntervals))
+	for _, mt := range c.MuteTimeIntervals {
+		if mt.Name == "" {
+			return fmt.Errorf("missing name in mute_time_intervals")
+		}
+		if _, ok := timeIntervalNames[mt.Name]; ok {
+			return fmt.Errorf("mute_time_intervals name %q is not unique", mt.Name)
+		}
+		timeIntervalNames[mt.Name] = struct{}{}
+	}
+
 	// Validate that all receivers used in the routing tree are defined.
-	return checkReceiver(c.Route, names)
+	receiverNames := make(map[string]struct{}, len(names))
+	for name := range names {
+		receiverNames[name] = struct{}{}
+	}
+	if err := checkReceiver(c.Route, receiverNames); err != nil {
+		return err
+	}
+
+	if c.Global.WatchdogAlertName != "" {
+		if _, ok := receiverNames[c.Global.WatchdogReceiver]; !ok {
+			return fmt.Errorf("global.watchdog_receiver %q is not defined", c.Global.WatchdogReceiver)
+		}
+	}
+
+	return checkTimeIntervals(c.Route, timeIntervalNames)
+}
+
+// checkTimeIntervals returns an error if a node in the routing tree
+// references a mute/active time interval not in the given set.
+func checkTimeIntervals(r *Route, timeIntervals map[string]struct{}) error {
+	for _, sr := range r.Routes {
+		if err := checkTimeIntervals(sr, timeIntervals); err != nil {
+			return err
+		}
+	}
+	for _, name := range r.MuteTimeIntervals {
+		if _, ok := timeIntervals[name]; !ok {
+			return fmt.Errorf("undefined time interval %q used in mute_time_intervals", name)
+		}
+	}
+	for _, name := range r.ActiveTimeIntervals {
+		if _, ok := timeIntervals[name]; !ok {
+			return fmt.Errorf("undefined time interval %q used in active_time_intervals", name)
+		}
+	}
+	return nil
 }
 
 // checkReceiver returns an error if a node in the routing tree
@@ -415,9 +656,20 @@ func checkReceiver(r *Route, receivers map[string]struct{}) error {
 			return err
 		}
 	}
+	if r.EscalationReceiver != "" {
+		if _, ok := receivers[r.EscalationReceiver]; !ok {
+			return fmt.Errorf("undefined receiver %q used in route escalation_receiver", r.EscalationReceiver)
+		}
+	}
 	if r.Receiver == "" {
 		return nil
 	}
+	if IsReceiverTemplate(r.Receiver) {
+		if _, ok := receivers[r.ReceiverFallback]; !ok {
+			return fmt.Errorf("undefined receiver %q used in route receiver_fallback", r.ReceiverFallback)
+		}
+		return nil
+	}
 	if _, ok := receivers[r.Receiver]; !ok {
 		return fmt.Errorf("undefined receiver %q used in route", r.Receiver)
 	}
@@ -428,7 +680,7 @@ func checkReceiver(r *Route, receivers map[string]struct{}) error {
 func DefaultGlobalConfig() GlobalConfig {
 	return GlobalConfig{
 		ResolveTimeout: model.Duration(5 * time.Minute),
-		HTTPConfig:     &commoncfg.HTTPClientConfig{},
+		HTTPConfig:     &HTTPClientConfig{},
 
 		SMTPHello:       "localhost",
 		SMTPRequireTLS:  true,
@@ -437,6 +689,9 @@ func DefaultGlobalConfig() GlobalConfig {
 		OpsGenieAPIURL:  mustParseURL("https://api.opsgenie.com/"),
 		WeChatAPIURL:    mustParseURL("https://qyapi.weixin.qq.com/cgi-bin/"),
 		VictorOpsAPIURL: mustParseURL("https://alert.victorops.com/integrations/generic/20131114/alert/"),
+
+		HeartbeatInterval: model.Duration(heartbeat.DefaultInterval),
+		WatchdogTimeout:   model.Duration(watchdog.DefaultTimeout),
 	}
 }
 
@@ -514,27 +769,249 @@ type GlobalConfig struct {
 	// if it has not been updated.
 	ResolveTimeout model.Duration `yaml:"resolve_timeout" json:"resolve_timeout"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
-
-	SMTPFrom         string     `yaml:"smtp_from,omitempty" json:"smtp_from,omitempty"`
-	SMTPHello        string     `yaml:"smtp_hello,omitempty" json:"smtp_hello,omitempty"`
-	SMTPSmarthost    HostPort   `yaml:"smtp_smarthost,omitempty" json:"smtp_smarthost,omitempty"`
-	SMTPAuthUsername string     `yaml:"smtp_auth_username,omitempty" json:"smtp_auth_username,omitempty"`
-	SMTPAuthPassword Secret     `yaml:"smtp_auth_password,omitempty" json:"smtp_auth_password,omitempty"`
-	SMTPAuthSecret   Secret     `yaml:"smtp_auth_secret,omitempty" json:"smtp_auth_secret,omitempty"`
-	SMTPAuthIdentity string     `yaml:"smtp_auth_identity,omitempty" json:"smtp_auth_identity,omitempty"`
-	SMTPRequireTLS   bool       `yaml:"smtp_require_tls,omitempty" json:"smtp_require_tls,omitempty"`
-	SlackAPIURL      *SecretURL `yaml:"slack_api_url,omitempty" json:"slack_api_url,omitempty"`
-	PagerdutyURL     *URL       `yaml:"pagerduty_url,omitempty" json:"pagerduty_url,omitempty"`
-	HipchatAPIURL    *URL       `yaml:"hipchat_api_url,omitempty" json:"hipchat_api_url,omitempty"`
-	HipchatAuthToken Secret     `yaml:"hipchat_auth_token,omitempty" json:"hipchat_auth_token,omitempty"`
-	OpsGenieAPIURL   *URL       `yaml:"opsgenie_api_url,omitempty" json:"opsgenie_api_url,omitempty"`
-	OpsGenieAPIKey   Secret     `yaml:"opsgenie_api_key,omitempty" json:"opsgenie_api_key,omitempty"`
-	WeChatAPIURL     *URL       `yaml:"wechat_api_url,omitempty" json:"wechat_api_url,omitempty"`
-	WeChatAPISecret  Secret     `yaml:"wechat_api_secret,omitempty" json:"wechat_api_secret,omitempty"`
-	WeChatAPICorpID  string     `yaml:"wechat_api_corp_id,omitempty" json:"wechat_api_corp_id,omitempty"`
-	VictorOpsAPIURL  *URL       `yaml:"victorops_api_url,omitempty" json:"victorops_api_url,omitempty"`
-	VictorOpsAPIKey  Secret     `yaml:"victorops_api_key,omitempty" json:"victorops_api_key,omitempty"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	SMTPFrom         string   `yaml:"smtp_from,omitempty" json:"smtp_from,omitempty"`
+	SMTPHello        string   `yaml:"smtp_hello,omitempty" json:"smtp_hello,omitempty"`
+	SMTPSmarthost    HostPort `yaml:"smtp_smarthost,omitempty" json:"smtp_smarthost,omitempty"`
+	SMTPAuthUsername string   `yaml:"smtp_auth_username,omitempty" json:"smtp_auth_username,omitempty"`
+	SMTPAuthPassword Secret   `yaml:"smtp_auth_password,omitempty" json:"smtp_auth_password,omitempty"`
+	// SMTPAuthPasswordFile, if set, is read for the SMTP auth password
+	// instead of SMTPAuthPassword. At most one of the two may be set.
+	SMTPAuthPasswordFile string     `yaml:"smtp_auth_password_file,omitempty" json:"smtp_auth_password_file,omitempty"`
+	SMTPAuthSecret       Secret     `yaml:"smtp_auth_secret,omitempty" json:"smtp_auth_secret,omitempty"`
+	SMTPAuthIdentity     string     `yaml:"smtp_auth_identity,omitempty" json:"smtp_auth_identity,omitempty"`
+	SMTPRequireTLS       bool       `yaml:"smtp_require_tls,omitempty" json:"smtp_require_tls,omitempty"`
+	SlackAPIURL          *SecretURL `yaml:"slack_api_url,omitempty" json:"slack_api_url,omitempty"`
+	PagerdutyURL         *URL       `yaml:"pagerduty_url,omitempty" json:"pagerduty_url,omitempty"`
+	HipchatAPIURL        *URL       `yaml:"hipchat_api_url,omitempty" json:"hipchat_api_url,omitempty"`
+	HipchatAuthToken     Secret     `yaml:"hipchat_auth_token,omitempty" json:"hipchat_auth_token,omitempty"`
+	OpsGenieAPIURL       *URL       `yaml:"opsgenie_api_url,omitempty" json:"opsgenie_api_url,omitempty"`
+	OpsGenieAPIKey       Secret     `yaml:"opsgenie_api_key,omitempty" json:"opsgenie_api_key,omitempty"`
+	// OpsGenieAPIKeyFile, if set, is read for the OpsGenie API key instead of
+	// OpsGenieAPIKey. At most one of the two may be set.
+	OpsGenieAPIKeyFile string `yaml:"opsgenie_api_key_file,omitempty" json:"opsgenie_api_key_file,omitempty"`
+	WeChatAPIURL       *URL   `yaml:"wechat_api_url,omitempty" json:"wechat_api_url,omitempty"`
+	WeChatAPISecret    Secret `yaml:"wechat_api_secret,omitempty" json:"wechat_api_secret,omitempty"`
+	WeChatAPICorpID    string `yaml:"wechat_api_corp_id,omitempty" json:"wechat_api_corp_id,omitempty"`
+	VictorOpsAPIURL    *URL   `yaml:"victorops_api_url,omitempty" json:"victorops_api_url,omitempty"`
+	VictorOpsAPIKey    Secret `yaml:"victorops_api_key,omitempty" json:"victorops_api_key,omitempty"`
+	// VictorOpsAPIKeyFile, if set, is read for the VictorOps API key instead
+	// of VictorOpsAPIKey. At most one of the two may be set.
+	VictorOpsAPIKeyFile string `yaml:"victorops_api_key_file,omitempty" json:"victorops_api_key_file,omitempty"`
+
+	// HeartbeatURL, when set, is pinged on HeartbeatInterval so that an
+	// external dead-man's-switch (e.g. an Opsgenie or BetterStack heartbeat
+	// check) can alert if the Alertmanager process itself stops running.
+	HeartbeatURL      *URL           `yaml:"heartbeat_url,omitempty" json:"heartbeat_url,omitempty"`
+	HeartbeatInterval model.Duration `yaml:"heartbeat_interval,omitempty" json:"heartbeat_interval,omitempty"`
+
+	// WatchdogAlertName, when set, names a liveness alert (e.g. "Watchdog",
+	// fired continuously by a Prometheus rule such as `vector(1)`) that
+	// Alertmanager expects to keep receiving. If no alert with this
+	// alertname is received within WatchdogTimeout, Alertmanager
+	// synthesizes an alert of its own to WatchdogReceiver, so that the
+	// monitoring pipeline being down doesn't silently mean nobody gets
+	// paged.
+	WatchdogAlertName string         `yaml:"watchdog_alertname,omitempty" json:"watchdog_alertname,omitempty"`
+	WatchdogTimeout   model.Duration `yaml:"watchdog_timeout,omitempty" json:"watchdog_timeout,omitempty"`
+	WatchdogReceiver  string         `yaml:"watchdog_receiver,omitempty" json:"watchdog_receiver,omitempty"`
+
+	// TimelineURL, when set, receives a JSON event for every group lifecycle
+	// transition (first notified, repeat notified, resolved), giving an
+	// incident-management system an automatic chronology of a group's life.
+	TimelineURL *URL `yaml:"timeline_url,omitempty" json:"timeline_url,omitempty"`
+
+	// SQLReport, when set, exports notification and alert lifecycle records
+	// to a SQL database for reporting (e.g. pages per team, MTTA) queries.
+	SQLReport *SQLReportConfig `yaml:"sql_report,omitempty" json:"sql_report,omitempty"`
+
+	// ArchiveURL, when set, receives a copy of every rendered notification
+	// and its delivery result, partitioned by date, for compliance retention
+	// and later auditing of exactly what was sent.
+	ArchiveURL *URL `yaml:"archive_url,omitempty" json:"archive_url,omitempty"`
+
+	// ReceiptURL, when set, receives a small JSON delivery receipt after
+	// every notification attempt (receiver, group key, outcome, latency), so
+	// an external SLO system can track time from alert firing to delivery.
+	ReceiptURL *URL `yaml:"receipt_url,omitempty" json:"receipt_url,omitempty"`
+
+	// DryRun suppresses delivery for every receiver that does not set its
+	// own dry_run explicitly. The rest of the pipeline (grouping, inhibition,
+	// silencing, deduplication) still runs as normal, so new routing or
+	// receiver configs can be staged against production alert traffic.
+	DryRun bool `yaml:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// RunbookURL, when set, is combined with an alert's alertname label to
+	// build a per-alert runbook link, so notification templates don't have
+	// to hand-build one from scratch.
+	RunbookURL *URL `yaml:"runbook_url,omitempty" json:"runbook_url,omitempty"`
+
+	// DashboardURL, when set, is combined with an alert's cluster label to
+	// build a per-alert dashboard link, so notification templates don't have
+	// to hand-build one from scratch.
+	DashboardURL *URL `yaml:"dashboard_url,omitempty" json:"dashboard_url,omitempty"`
+
+	// IncidentWindow is how long after an aggregation group fully resolves
+	// it still counts as the same incident if it refires. A zero value (the
+	// default) disables continuation: every refire starts a new incident.
+	IncidentWindow model.Duration `yaml:"incident_window,omitempty" json:"incident_window,omitempty"`
+
+	// CorrelationLabels, when non-empty, groups aggregation groups sharing
+	// the same values for these labels (e.g. "cluster") together: each
+	// notification is annotated with how many of the others are also
+	// currently firing within CorrelationWindow, to help responders gauge
+	// blast radius.
+	CorrelationLabels []string `yaml:"correlation_labels,omitempty" json:"correlation_labels,omitempty"`
+
+	// CorrelationWindow is how recently another correlated group must have
+	// last fired to still be counted. It has no effect unless
+	// CorrelationLabels is also set.
+	CorrelationWindow model.Duration `yaml:"correlation_window,omitempty" json:"correlation_window,omitempty"`
+
+	// ReportWebhookURL, when set, receives a periodic JSON summary of
+	// alerting activity (notification volume and failures, the noisiest
+	// alertnames, and silence usage per team).
+	ReportWebhookURL *URL `yaml:"report_webhook_url,omitempty" json:"report_webhook_url,omitempty"`
+
+	// ReportInterval is how often the alerting activity report is sent. It
+	// has no effect unless ReportWebhookURL is also set. Defaults to 24h.
+	ReportInterval model.Duration `yaml:"report_interval,omitempty" json:"report_interval,omitempty"`
+
+	// ReportTeamLabel, when set, is the matcher label used to break down
+	// silence usage by team in the alerting activity report. If unset,
+	// the report omits the silence usage breakdown.
+	ReportTeamLabel string `yaml:"report_team_label,omitempty" json:"report_team_label,omitempty"`
+
+	// BudgetAlertReceiver, when set, is the name of the receiver notified
+	// with a synthetic meta-alert whenever a route exceeds its configured
+	// notification_budget. It must name one of the top-level receivers.
+	BudgetAlertReceiver string `yaml:"budget_alert_receiver,omitempty" json:"budget_alert_receiver,omitempty"`
+
+	// SLOAlertReceiver, when set, is the name of the receiver notified with
+	// a synthetic meta-alert whenever a receiver's tracked p99 paging
+	// latency (see Receiver.PagingLatencyObjective) exceeds its objective.
+	// It must name one of the top-level receivers.
+	SLOAlertReceiver string `yaml:"slo_alert_receiver,omitempty" json:"slo_alert_receiver,omitempty"`
+
+	// MaxLabelValueLength, when non-zero, bounds the length in bytes of any
+	// label value accepted at alert ingestion.
+	MaxLabelValueLength int `yaml:"max_label_value_length,omitempty" json:"max_label_value_length,omitempty"`
+
+	// MaxAnnotationValueLength, when non-zero, bounds the length in bytes
+	// of any annotation value accepted at alert ingestion.
+	MaxAnnotationValueLength int `yaml:"max_annotation_value_length,omitempty" json:"max_annotation_value_length,omitempty"`
+
+	// TruncateOversizedValues controls what happens when a label or
+	// annotation value exceeds MaxLabelValueLength or
+	// MaxAnnotationValueLength: if true, the value is shortened and the
+	// alert gains a "truncated" annotation; if false (the default), the
+	// alert is rejected.
+	TruncateOversizedValues bool `yaml:"truncate_oversized_values,omitempty" json:"truncate_oversized_values,omitempty"`
+
+	// OutboundConcurrency, if set, caps how many notification deliveries
+	// run at once across all receivers combined, so a burst that would
+	// otherwise fan out unboundedly in parallel is throttled instead. If
+	// PriorityLabel is also set, queued deliveries are admitted in
+	// priority order rather than first-come-first-served.
+	OutboundConcurrency int `yaml:"outbound_concurrency,omitempty" json:"outbound_concurrency,omitempty"`
+
+	// PriorityLabel, if set, is the alert label whose value is looked up
+	// in PriorityValues to decide delivery order once OutboundConcurrency
+	// is saturated. Alerts missing the label, or with a value not present
+	// in PriorityValues, get the lowest priority. Has no effect unless
+	// OutboundConcurrency is also set.
+	PriorityLabel string `yaml:"priority_label,omitempty" json:"priority_label,omitempty"`
+
+	// PriorityValues maps PriorityLabel values to priority; lower numbers
+	// are delivered first, e.g. {"critical": 0, "warning": 5}.
+	PriorityValues map[string]int `yaml:"priority_values,omitempty" json:"priority_values,omitempty"`
+
+	// TenantLabel, when set, attributes ingested alerts, sent notifications,
+	// and created silences to the tenant named by that label's value, for
+	// the per-tenant metrics and quotas described by TenantQuotas. An empty
+	// value disables both tracking and quota enforcement.
+	TenantLabel string `yaml:"tenant_label,omitempty" json:"tenant_label,omitempty"`
+
+	// TenantQuotas caps how many alerts and silences a single tenant may
+	// create, keyed by tenant name. It has no effect unless TenantLabel is
+	// also set. A tenant with no entry here is unlimited.
+	TenantQuotas map[string]TenantQuota `yaml:"tenant_quotas,omitempty" json:"tenant_quotas,omitempty"`
+
+	// AtRestEncryption, if set, encrypts the alert, silence, and
+	// notification log snapshots written to disk, for deployments where
+	// alert labels carry customer identifiers subject to data-protection
+	// requirements.
+	AtRestEncryption *AtRestEncryptionConfig `yaml:"at_rest_encryption,omitempty" json:"at_rest_encryption,omitempty"`
+
+	// SnoozeSecret, when set, signs the callback URL rendered by the
+	// "Alert.Links.Snooze" template field and is required to verify one
+	// presented to the snooze API endpoint, so a "snooze this alert" button
+	// in a chat tool can call back into Alertmanager without exposing an
+	// open, unauthenticated write endpoint. Snoozing is disabled unless
+	// this is set.
+	SnoozeSecret Secret `yaml:"snooze_secret,omitempty" json:"snooze_secret,omitempty"`
+
+	// SnoozeLabels, if non-empty, lists the label names pinned into the
+	// matchers of a silence created via a snooze callback; an alert label
+	// not in this list is ignored even if present. If empty, every one of
+	// the alert's labels is pinned, which is exact but means the silence
+	// only ever matches that precise label set again.
+	SnoozeLabels []string `yaml:"snooze_labels,omitempty" json:"snooze_labels,omitempty"`
+
+	// SnoozeTTL is how long a silence created via a snooze callback lasts.
+	// Defaults to 1h.
+	SnoozeTTL model.Duration `yaml:"snooze_ttl,omitempty" json:"snooze_ttl,omitempty"`
+}
+
+// AtRestEncryptionConfig configures encryption of persisted snapshot state
+// (see GlobalConfig.AtRestEncryption). Keys are ordinarily populated from a
+// KMS-managed secrets file via environment or file-based templating rather
+// than written out literally; Keys itself only holds whatever the operator
+// has resolved them to by the time Alertmanager reads its config.
+type AtRestEncryptionConfig struct {
+	// Keys maps a key ID -- an operator-chosen label, not secret itself --
+	// to a base64-encoded 32-byte AES-256 key.
+	Keys map[string]Secret `yaml:"keys" json:"keys"`
+
+	// CurrentKeyID names the entry in Keys that new snapshots are
+	// encrypted with. To rotate, add a new key to Keys and point
+	// CurrentKeyID at it; keep the old key in Keys until every snapshot
+	// written under it has been rewritten, or it will become unreadable.
+	CurrentKeyID string `yaml:"current_key_id" json:"current_key_id"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for
+// AtRestEncryptionConfig.
+func (c *AtRestEncryptionConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain AtRestEncryptionConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.CurrentKeyID == "" {
+		return fmt.Errorf("current_key_id must be set in at_rest_encryption")
+	}
+	if _, ok := c.Keys[c.CurrentKeyID]; !ok {
+		return fmt.Errorf("current_key_id %q is not present in at_rest_encryption keys", c.CurrentKeyID)
+	}
+	for id, k := range c.Keys {
+		if _, err := base64.StdEncoding.DecodeString(string(k)); err != nil {
+			return fmt.Errorf("key %q in at_rest_encryption is not valid base64: %s", id, err)
+		}
+	}
+	return nil
+}
+
+// TenantQuota caps a single tenant's alert and silence activity. A zero
+// field disables the corresponding limit.
+type TenantQuota struct {
+	// MaxAlerts caps how many distinct alerts the tenant may have ingested
+	// within the alert data's retention period.
+	MaxAlerts int `yaml:"max_alerts,omitempty" json:"max_alerts,omitempty"`
+
+	// MaxSilences caps how many silences the tenant may have created within
+	// the silence data's retention period.
+	MaxSilences int `yaml:"max_silences,omitempty" json:"max_silences,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for GlobalConfig.
@@ -544,22 +1021,310 @@ func (c *GlobalConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return unmarshal((*plain)(c))
 }
 
+// validateMatcherExprs parses each of exprs using the same matcher syntax as
+// the alerts API and silences, rejecting negative matchers (!=, !~), which
+// routes and inhibition rules have no way to represent. The sole exception
+// is a negative matcher against an empty value (e.g. `team!=""`), which is
+// a label presence check rather than a negation of an existing selection.
+func validateMatcherExprs(exprs []string) error {
+	for _, expr := range exprs {
+		m, err := labels.ParseMatcher(expr)
+		if err != nil {
+			return fmt.Errorf("invalid matcher %q: %s", expr, err)
+		}
+		if (m.Type == labels.MatchNotEqual || m.Type == labels.MatchNotRegexp) && m.Value != "" {
+			return fmt.Errorf("negative matcher %q is not supported here", expr)
+		}
+	}
+	return nil
+}
+
+// IsReceiverTemplate reports whether receiver is a text/template, detected
+// by the presence of "{{", rather than a literal receiver name. See
+// Route.Receiver.
+func IsReceiverTemplate(receiver string) bool {
+	return strings.Contains(receiver, "{{")
+}
+
 // A Route is a node that contains definitions of how to handle alerts.
 type Route struct {
+	// Receiver is either a literal receiver name, or a text/template (the
+	// same dialect and funcs as GroupByTemplate, detected by IsReceiverTemplate)
+	// executed against the alert's labels to compute the receiver name
+	// dynamically, e.g. `team-{{ .team }}-slack`. This lets a routing tree
+	// with many near-identical per-team routes collapse into one. A
+	// templated receiver can't be checked against the configured receiver
+	// list at load time, so ReceiverFallback is required alongside it.
 	Receiver string `yaml:"receiver,omitempty" json:"receiver,omitempty"`
 
+	// ReceiverFallback names the receiver to notify instead, if Receiver is
+	// a template (see Receiver) and its rendered result does not name a
+	// defined receiver, e.g. because an alert is missing a label the
+	// template expects. Only valid when Receiver is a template.
+	ReceiverFallback string `yaml:"receiver_fallback,omitempty" json:"receiver_fallback,omitempty"`
+
+	// GroupByStr lists the labels to group alerts by. The special value
+	// "..." makes every distinct label set its own group instead of
+	// projecting onto GroupBy; GroupByAll is set in that case and GroupBy
+	// is left empty.
 	GroupByStr []string          `yaml:"group_by,omitempty" json:"group_by,omitempty"`
 	GroupBy    []model.LabelName `yaml:"-" json:"-"`
 	GroupByAll bool              `yaml:"-" json:"-"`
 
-	Match    map[string]string `yaml:"match,omitempty" json:"match,omitempty"`
-	MatchRE  MatchRegexps      `yaml:"match_re,omitempty" json:"match_re,omitempty"`
-	Continue bool              `yaml:"continue,omitempty" json:"continue,omitempty"`
-	Routes   []*Route          `yaml:"routes,omitempty" json:"routes,omitempty"`
+	// GroupByExcludeStr lists labels that are excluded from grouping while
+	// grouping by everything else, which is easier to maintain than
+	// GroupByStr for high-churn labels (e.g. "pod", "instance").
+	GroupByExcludeStr []string          `yaml:"group_by_exclude,omitempty" json:"group_by_exclude,omitempty"`
+	GroupByExclude    []model.LabelName `yaml:"-" json:"-"`
+
+	Match   map[string]string `yaml:"match,omitempty" json:"match,omitempty"`
+	MatchRE MatchRegexps      `yaml:"match_re,omitempty" json:"match_re,omitempty"`
+
+	// MatchNot and MatchNotRE mirror Match and MatchRE, but a route only
+	// matches an alert when the label does *not* equal (or does not match,
+	// for MatchNotRE) the given value. They let a route be defined as
+	// "everything except X" without enumerating every other value.
+	MatchNot   map[string]string `yaml:"match_not,omitempty" json:"match_not,omitempty"`
+	MatchNotRE MatchRegexps      `yaml:"match_not_re,omitempty" json:"match_not_re,omitempty"`
+
+	// Matchers lists label matchers using the same expression syntax as
+	// the alerts API and silences (e.g. `foo="bar"`, `baz=~"qux.*"`),
+	// ANDed together with any Match/MatchRE entries on the same route.
+	// Negative matchers (!=, !~) are not supported here, since a route
+	// selects a concrete class of alerts to handle rather than filtering
+	// an existing list, except against an empty value (e.g. `team!=""`),
+	// which checks for label presence rather than negating a selection.
+	Matchers []string `yaml:"matchers,omitempty" json:"matchers,omitempty"`
+
+	// MatchAnnotations uses the same expression syntax as Matchers, but
+	// matches against the alert's annotations instead of its labels, e.g.
+	// `runbook_url=~".*legacy.*"`. ANDed together with Matchers and any
+	// Match/MatchRE entries on the same route. Negative matchers (!=, !~)
+	// are not supported here, for the same reason as Matchers.
+	MatchAnnotations []string `yaml:"match_annotations,omitempty" json:"match_annotations,omitempty"`
+
+	Continue bool     `yaml:"continue,omitempty" json:"continue,omitempty"`
+	Routes   []*Route `yaml:"routes,omitempty" json:"routes,omitempty"`
 
 	GroupWait      *model.Duration `yaml:"group_wait,omitempty" json:"group_wait,omitempty"`
 	GroupInterval  *model.Duration `yaml:"group_interval,omitempty" json:"group_interval,omitempty"`
 	RepeatInterval *model.Duration `yaml:"repeat_interval,omitempty" json:"repeat_interval,omitempty"`
+
+	// RepeatIntervals, if set, replaces RepeatInterval with an escalating
+	// schedule: the first repeat notification for a still-firing group
+	// waits RepeatIntervals[0], the next RepeatIntervals[1], and so on,
+	// holding at the last entry once the schedule is exhausted. This keeps
+	// a long-running low-priority alert from re-paging on a fixed cadence
+	// forever, which trains people to ignore it. Mutually exclusive with
+	// RepeatInterval.
+	RepeatIntervals []model.Duration `yaml:"repeat_intervals,omitempty" json:"repeat_intervals,omitempty"`
+
+	// GroupJitter, if set, adds a random delay in [0, GroupJitter) to every
+	// group_wait and group_interval flush, so hundreds of groups created in
+	// the same instant (e.g. a mass outage) don't all flush in lockstep and
+	// hammer the receiver at once.
+	GroupJitter *model.Duration `yaml:"group_jitter,omitempty" json:"group_jitter,omitempty"`
+
+	// MinAlertAge, if set, holds an alert back from every notification
+	// (the initial one and any repeat) until it has existed for at least
+	// this long, independently of group_wait. Unlike group_wait, which
+	// only delays a group's first notification, this keeps re-checking on
+	// every flush, so it also absorbs producer-side omissions of a `for:`
+	// clause for specific noisy alert sources without having to tune
+	// group_wait for the whole subtree.
+	MinAlertAge *model.Duration `yaml:"min_alert_age,omitempty" json:"min_alert_age,omitempty"`
+
+	// ForwardToURL, if set, re-posts alerts matched by this route (not
+	// rendered notifications) to another alertmanager-compatible endpoint,
+	// so that this subtree can be delegated to a team-owned instance.
+	ForwardToURL *URL `yaml:"forward_to_url,omitempty" json:"forward_to_url,omitempty"`
+
+	// ExternalURL overrides the global --web.external-url for notifications
+	// sent from this subtree, so that links generated for a team routed
+	// through a different ingress hostname point back at the right one.
+	ExternalURL *URL `yaml:"external_url,omitempty" json:"external_url,omitempty"`
+
+	// GroupWaitOverrides fast-path the initial notification for alerts
+	// matching Match, skipping the route's configured group_wait, so that
+	// e.g. the first critical alert in a new group need not wait out a
+	// group_wait tuned for less urgent alerts.
+	GroupWaitOverrides []*GroupWaitOverride `yaml:"group_wait_override,omitempty" json:"group_wait_override,omitempty"`
+
+	// AbortOnResolve, if true, drops the initial firing notification for a
+	// group if every alert in it resolves before group_wait expires, so a
+	// blip that self-resolves in seconds never pages anyone.
+	AbortOnResolve bool `yaml:"abort_on_resolve,omitempty" json:"abort_on_resolve,omitempty"`
+
+	// FlushOnResolve, if true, flushes a group immediately once every
+	// alert in it has resolved instead of waiting for the next
+	// group_interval tick, so responders aren't left with a stale firing
+	// notification for minutes after the incident is already over.
+	FlushOnResolve bool `yaml:"flush_on_resolve,omitempty" json:"flush_on_resolve,omitempty"`
+
+	// MaxAggregationGroups, if set, caps how many distinct aggregation
+	// groups this route may have active at once. An alert that would
+	// otherwise start a new group beyond the cap is dropped instead, so a
+	// single alert source with a runaway label cardinality cannot create
+	// unbounded groups and exhaust memory.
+	MaxAggregationGroups int `yaml:"max_aggregation_groups,omitempty" json:"max_aggregation_groups,omitempty"`
+
+	// MaxAlertsPerGroup, if set, caps how many alerts a single
+	// aggregation group under this route holds at once. Alerts beyond
+	// the cap are dropped from the group and counted towards a
+	// truncation marker appended to the group's rendered notifications.
+	MaxAlertsPerGroup int `yaml:"max_alerts_per_group,omitempty" json:"max_alerts_per_group,omitempty"`
+
+	// AlertSortLabel, if set alongside AlertSortOrder, orders the alerts
+	// within a notification body by this label's value instead of leaving
+	// them in arrival order, so e.g. a critical alert doesn't end up buried
+	// in the middle of a large group's message.
+	AlertSortLabel string `yaml:"alert_sort_label,omitempty" json:"alert_sort_label,omitempty"`
+
+	// AlertSortOrder lists AlertSortLabel's values from highest to lowest
+	// priority, e.g. ["critical", "warning", "info"]. Alerts whose
+	// AlertSortLabel value isn't listed sort after every alert that is,
+	// keeping their relative arrival order.
+	AlertSortOrder []string `yaml:"alert_sort_order,omitempty" json:"alert_sort_order,omitempty"`
+
+	// MaxAlertsRendered, if set, caps how many alerts a single notification
+	// renders in its body, after AlertSortLabel/AlertSortOrder have been
+	// applied. Unlike MaxAlertsPerGroup, alerts beyond the cap are not
+	// dropped from the group, only omitted from the message and counted
+	// towards a "+N more" style summary available to templates.
+	MaxAlertsRendered int `yaml:"max_alerts_rendered,omitempty" json:"max_alerts_rendered,omitempty"`
+
+	// DedupKeyTemplate, if set, computes the deduplication key integrations
+	// such as PagerDuty and the webhook payload use to correlate a group's
+	// notifications (PagerDuty's dedup_key/incident_key, webhook's
+	// dedupKey) by executing this text/template (the same dialect and
+	// funcs as GroupByTemplate) against the group's labels, instead of the
+	// default opaque key derived from the route's position in the tree and
+	// the group's label set. A key templated from stable label values
+	// keeps correlating with the same incident across Alertmanager
+	// restarts and routing tree edits, which the default key does not
+	// survive.
+	DedupKeyTemplate string `yaml:"dedup_key_template,omitempty" json:"dedup_key_template,omitempty"`
+
+	// StormThreshold, if set, enables alert storm detection for this
+	// route's subtree: once the rate of alerts arriving for it reaches
+	// StormThreshold times its rolling baseline rate, the subtree is
+	// switched to summarized notifications (every matching alert folded
+	// into a single group, regardless of group_by/group_by_all) until the
+	// rate falls back under the threshold. Must be greater than 1 if set.
+	StormThreshold float64 `yaml:"storm_threshold,omitempty" json:"storm_threshold,omitempty"`
+
+	// NotificationBudget, if set, caps how many notifications this route's
+	// subtree may send over a rolling 24h window. Once the budget is
+	// exceeded, global.budget_alert_receiver (if configured) is notified
+	// with a synthetic meta-alert identifying the offending route, so a
+	// noisy alert source gets fixed instead of just paging on. Notification
+	// delivery for the route itself is unaffected.
+	NotificationBudget int `yaml:"notification_budget,omitempty" json:"notification_budget,omitempty"`
+
+	// FloodThreshold, if set, caps how many distinct alert groups under
+	// this route's subtree may send their own notification within a
+	// short rolling window. Once the threshold is exceeded, further
+	// group notifications in that window are collapsed into a single
+	// summary notification naming how many groups are firing and
+	// linking to the alerts view, so responders facing a cascading
+	// failure get one page instead of one per group.
+	FloodThreshold int `yaml:"flood_threshold,omitempty" json:"flood_threshold,omitempty"`
+
+	// NotificationTimeout, if set, overrides the receiver's
+	// notification_timeout for alerts matched by this route, bounding how
+	// long a single notifier call may block before being cancelled. See
+	// Receiver.NotificationTimeout.
+	NotificationTimeout model.Duration `yaml:"notification_timeout,omitempty" json:"notification_timeout,omitempty"`
+
+	// GroupByTemplate, if set, computes the grouping key for an alert by
+	// executing this text/template against its labels instead of grouping
+	// by group_by/group_by_exclude label equality, so groups can be shaped
+	// around structure a flat label list can't express, e.g. hashing one
+	// label and combining it with a truncated prefix of another. It is
+	// evaluated against the alert's labels (a map[string]string) and has
+	// the "hash" and "truncate" functions available in addition to the
+	// usual text/template builtins. It is mutually exclusive with
+	// group_by and group_by_exclude.
+	GroupByTemplate string `yaml:"group_by_template,omitempty" json:"group_by_template,omitempty"`
+
+	// Timezone, if set, is an IANA Time Zone Database name (e.g.
+	// "America/New_York" or "Europe/Berlin") that notification templates
+	// for this subtree can render alert timestamps in, so a team spread
+	// across timezones sees its own local time instead of the server's
+	// (UTC or otherwise), which is what "business hours" style messaging
+	// actually needs. It is inherited by child routes like the other
+	// RouteOpts fields, and does not itself change when notifications are
+	// sent.
+	Timezone string `yaml:"timezone,omitempty" json:"timezone,omitempty"`
+
+	// RepeatOnlyOnChange, if true, suppresses the repeat notification sent
+	// every RepeatInterval for a group whose alert membership and status
+	// have not changed since the last notification, so an incident that
+	// just sits there firing doesn't keep re-paging a team that only
+	// wants to hear about it again when something actually changes.
+	RepeatOnlyOnChange bool `yaml:"repeat_only_on_change,omitempty" json:"repeat_only_on_change,omitempty"`
+
+	// AnnotationTemplates computes additional annotations for alerts
+	// matching this route, keyed by annotation name, each a
+	// text/template (the same dialect and funcs as GroupByTemplate)
+	// executed against the alert's labels. This lets annotation logic
+	// that would otherwise have to be duplicated across every upstream
+	// alerting rule (e.g. a dashboard URL derived from a label) live
+	// once in the alertmanager config instead. Computed annotations are
+	// merged into the alert's existing annotations, overwriting any
+	// annotation of the same name already set upstream.
+	AnnotationTemplates map[string]string `yaml:"annotation_templates,omitempty" json:"annotation_templates,omitempty"`
+
+	// MuteTimeIntervals names entries in the top-level mute_time_intervals
+	// list. Notifications for this route's subtree are suppressed while
+	// the current time falls inside any of them. Inherited by child
+	// routes like the other RouteOpts fields.
+	MuteTimeIntervals []string `yaml:"mute_time_intervals,omitempty" json:"mute_time_intervals,omitempty"`
+
+	// ActiveTimeIntervals names entries in the top-level
+	// mute_time_intervals list. If set, notifications for this route's
+	// subtree are suppressed unless the current time falls inside one of
+	// them. Inherited by child routes like the other RouteOpts fields.
+	ActiveTimeIntervals []string `yaml:"active_time_intervals,omitempty" json:"active_time_intervals,omitempty"`
+
+	// EscalationReceiver, if set alongside EscalationTimeout, names the
+	// receiver a group's still-active alerts are re-sent to if the
+	// group's first notification goes unacknowledged (via
+	// POST /api/v1/groups/:key/ack) within EscalationTimeout. This models
+	// on-call escalation directly instead of relying on a downstream
+	// paging tool for it.
+	EscalationReceiver string `yaml:"escalation_receiver,omitempty" json:"escalation_receiver,omitempty"`
+
+	// EscalationTimeout is the grace period, after a group's first
+	// notification, during which it may be acknowledged before being
+	// escalated to EscalationReceiver. Required, and must be positive,
+	// when EscalationReceiver is set.
+	EscalationTimeout *model.Duration `yaml:"escalation_timeout,omitempty" json:"escalation_timeout,omitempty"`
+}
+
+// GroupWaitOverride fast-paths the initial notification for alerts whose
+// labels match Match, using GroupWait in place of the route's configured
+// group_wait.
+type GroupWaitOverride struct {
+	Match     map[string]string `yaml:"match,omitempty" json:"match,omitempty"`
+	GroupWait model.Duration    `yaml:"group_wait" json:"group_wait"`
+}
+
+// MuteTimeInterval is a named set of calendar windows that a Route can
+// reference by Name via MuteTimeIntervals/ActiveTimeIntervals.
+type MuteTimeInterval struct {
+	Name          string                      `yaml:"name" json:"name"`
+	TimeIntervals []timeinterval.TimeInterval `yaml:"time_intervals" json:"time_intervals"`
+}
+
+// SQLReportConfig configures the optional SQL reporting exporter.
+type SQLReportConfig struct {
+	// Driver is the name of a database/sql driver registered by the binary
+	// embedding Alertmanager, e.g. "postgres" or "mysql".
+	Driver string `yaml:"driver,omitempty" json:"driver,omitempty"`
+	// DSN is the driver-specific data source name. It may contain
+	// credentials, so it is never logged or rendered back in the API.
+	DSN Secret `yaml:"dsn,omitempty" json:"-"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for Route.
@@ -575,6 +1340,20 @@ func (r *Route) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		}
 	}
 
+	for k := range r.MatchNot {
+		if !model.LabelNameRE.MatchString(k) {
+			return fmt.Errorf("invalid label name %q", k)
+		}
+	}
+
+	if err := validateMatcherExprs(r.Matchers); err != nil {
+		return err
+	}
+
+	if err := validateMatcherExprs(r.MatchAnnotations); err != nil {
+		return err
+	}
+
 	for _, l := range r.GroupByStr {
 		if l == "..." {
 			r.GroupByAll = true
@@ -600,6 +1379,30 @@ func (r *Route) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		groupBy[ln] = struct{}{}
 	}
 
+	for _, l := range r.GroupByExcludeStr {
+		labelName := model.LabelName(l)
+		if !labelName.IsValid() {
+			return fmt.Errorf("invalid label name %q in group_by_exclude list", l)
+		}
+		r.GroupByExclude = append(r.GroupByExclude, labelName)
+	}
+
+	if len(r.GroupByExclude) > 0 {
+		if len(r.GroupBy) > 0 {
+			return fmt.Errorf("cannot have group_by_exclude and group_by at the same time")
+		}
+		r.GroupByAll = true
+	}
+
+	groupByExclude := map[model.LabelName]struct{}{}
+
+	for _, ln := range r.GroupByExclude {
+		if _, ok := groupByExclude[ln]; ok {
+			return fmt.Errorf("duplicated label %q in group_by_exclude", ln)
+		}
+		groupByExclude[ln] = struct{}{}
+	}
+
 	if r.GroupInterval != nil && time.Duration(*r.GroupInterval) == time.Duration(0) {
 		return fmt.Errorf("group_interval cannot be zero")
 	}
@@ -607,6 +1410,116 @@ func (r *Route) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return fmt.Errorf("repeat_interval cannot be zero")
 	}
 
+	if len(r.RepeatIntervals) > 0 {
+		if r.RepeatInterval != nil {
+			return fmt.Errorf("cannot have repeat_intervals and repeat_interval at the same time")
+		}
+		for _, ri := range r.RepeatIntervals {
+			if time.Duration(ri) == time.Duration(0) {
+				return fmt.Errorf("repeat_intervals entries cannot be zero")
+			}
+		}
+	}
+
+	if r.StormThreshold != 0 && r.StormThreshold <= 1 {
+		return fmt.Errorf("storm_threshold must be greater than 1")
+	}
+
+	if r.NotificationBudget < 0 {
+		return fmt.Errorf("notification_budget must not be negative")
+	}
+
+	if r.FloodThreshold < 0 {
+		return fmt.Errorf("flood_threshold must not be negative")
+	}
+
+	if r.NotificationTimeout < 0 {
+		return fmt.Errorf("notification_timeout must not be negative")
+	}
+
+	if r.MaxAggregationGroups < 0 {
+		return fmt.Errorf("max_aggregation_groups must not be negative")
+	}
+
+	if r.MaxAlertsPerGroup < 0 {
+		return fmt.Errorf("max_alerts_per_group must not be negative")
+	}
+
+	if r.MaxAlertsRendered < 0 {
+		return fmt.Errorf("max_alerts_rendered must not be negative")
+	}
+
+	if len(r.AlertSortOrder) > 0 && r.AlertSortLabel == "" {
+		return fmt.Errorf("alert_sort_label is required when alert_sort_order is set")
+	}
+	if r.AlertSortLabel != "" {
+		if len(r.AlertSortOrder) == 0 {
+			return fmt.Errorf("alert_sort_order is required when alert_sort_label is set")
+		}
+		if !model.LabelName(r.AlertSortLabel).IsValid() {
+			return fmt.Errorf("invalid label name %q in alert_sort_label", r.AlertSortLabel)
+		}
+	}
+
+	if r.GroupByTemplate != "" {
+		if len(r.GroupBy) > 0 || r.GroupByAll {
+			return fmt.Errorf("cannot have group_by_template and group_by at the same time")
+		}
+		if _, err := texttemplate.New("group_by_template").Funcs(GroupByTemplateFuncs).Parse(r.GroupByTemplate); err != nil {
+			return fmt.Errorf("invalid group_by_template: %s", err)
+		}
+	}
+
+	if r.DedupKeyTemplate != "" {
+		if _, err := texttemplate.New("dedup_key_template").Funcs(GroupByTemplateFuncs).Parse(r.DedupKeyTemplate); err != nil {
+			return fmt.Errorf("invalid dedup_key_template: %s", err)
+		}
+	}
+
+	if IsReceiverTemplate(r.Receiver) {
+		if _, err := texttemplate.New("receiver").Funcs(GroupByTemplateFuncs).Parse(r.Receiver); err != nil {
+			return fmt.Errorf("invalid receiver template: %s", err)
+		}
+		if r.ReceiverFallback == "" {
+			return fmt.Errorf("receiver_fallback is required when receiver is a template")
+		}
+	} else if r.ReceiverFallback != "" {
+		return fmt.Errorf("receiver_fallback is only valid when receiver is a template")
+	}
+
+	for _, o := range r.GroupWaitOverrides {
+		if len(o.Match) == 0 {
+			return fmt.Errorf("group_wait_override requires a non-empty match")
+		}
+		for k := range o.Match {
+			if !model.LabelNameRE.MatchString(k) {
+				return fmt.Errorf("invalid label name %q in group_wait_override match", k)
+			}
+		}
+	}
+
+	if r.Timezone != "" {
+		if _, err := time.LoadLocation(r.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q: %s", r.Timezone, err)
+		}
+	}
+
+	for name, tmpl := range r.AnnotationTemplates {
+		if _, err := texttemplate.New("annotation_template").Funcs(GroupByTemplateFuncs).Parse(tmpl); err != nil {
+			return fmt.Errorf("invalid annotation_templates entry %q: %s", name, err)
+		}
+	}
+
+	if r.EscalationReceiver != "" && r.EscalationTimeout == nil {
+		return fmt.Errorf("escalation_timeout is required when escalation_receiver is set")
+	}
+	if r.EscalationReceiver == "" && r.EscalationTimeout != nil {
+		return fmt.Errorf("escalation_receiver is required when escalation_timeout is set")
+	}
+	if r.EscalationTimeout != nil && time.Duration(*r.EscalationTimeout) <= 0 {
+		return fmt.Errorf("escalation_timeout must be positive")
+	}
+
 	return nil
 }
 
@@ -626,6 +1539,15 @@ type InhibitRule struct {
 	// TargetMatchRE defines pairs like TargetMatch but does regular expression
 	// matching.
 	TargetMatchRE MatchRegexps `yaml:"target_match_re,omitempty" json:"target_match_re,omitempty"`
+	// SourceMatchers and TargetMatchers list label matchers using the same
+	// expression syntax as the alerts API and silences (e.g. `foo="bar"`,
+	// `baz=~"qux.*"`), ANDed together with SourceMatch/SourceMatchRE and
+	// TargetMatch/TargetMatchRE respectively. Negative matchers (!=, !~)
+	// are not supported here, except against an empty value (e.g.
+	// `team!=""`), which checks for label presence rather than negating a
+	// selection.
+	SourceMatchers []string `yaml:"source_matchers,omitempty" json:"source_matchers,omitempty"`
+	TargetMatchers []string `yaml:"target_matchers,omitempty" json:"target_matchers,omitempty"`
 	// A set of labels that must be equal between the source and target alert
 	// for them to be a match.
 	Equal model.LabelNames `yaml:"equal,omitempty" json:"equal,omitempty"`
@@ -650,6 +1572,13 @@ func (r *InhibitRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		}
 	}
 
+	if err := validateMatcherExprs(r.SourceMatchers); err != nil {
+		return err
+	}
+	if err := validateMatcherExprs(r.TargetMatchers); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -658,15 +1587,113 @@ type Receiver struct {
 	// A unique identifier for this receiver.
 	Name string `yaml:"name" json:"name"`
 
-	EmailConfigs     []*EmailConfig     `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
-	PagerdutyConfigs []*PagerdutyConfig `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
-	HipchatConfigs   []*HipchatConfig   `yaml:"hipchat_configs,omitempty" json:"hipchat_configs,omitempty"`
-	SlackConfigs     []*SlackConfig     `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
-	WebhookConfigs   []*WebhookConfig   `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
-	OpsGenieConfigs  []*OpsGenieConfig  `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
-	WechatConfigs    []*WechatConfig    `yaml:"wechat_configs,omitempty" json:"wechat_configs,omitempty"`
-	PushoverConfigs  []*PushoverConfig  `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
-	VictorOpsConfigs []*VictorOpsConfig `yaml:"victorops_configs,omitempty" json:"victorops_configs,omitempty"`
+	// MaxConcurrency caps how many notifications this receiver delivers at
+	// once; excess deliveries queue until a slot frees up. Zero (the
+	// default) leaves delivery unbounded.
+	MaxConcurrency int `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty"`
+
+	// DryRun, if set, suppresses delivery for this receiver: the rest of the
+	// pipeline runs as normal, but what would have been sent is logged
+	// instead. It overrides the global dry_run flag for this receiver.
+	DryRun *bool `yaml:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// CircuitBreaker, if set, stops attempting delivery to this receiver
+	// after a run of consecutive failures for a cool-down period, instead
+	// of retrying against a provider that is already down.
+	CircuitBreaker *CircuitBreakerConfig `yaml:"circuit_breaker,omitempty" json:"circuit_breaker,omitempty"`
+
+	// RateLimit, if set, caps how many notifications this receiver may be
+	// sent per minute, so a label explosion upstream can't run up
+	// thousands of calls against an external endpoint in under a minute
+	// and get it blacklisted.
+	RateLimit *RateLimitConfig `yaml:"rate_limit,omitempty" json:"rate_limit,omitempty"`
+
+	// MaxRetryDuration caps how long the retry stage keeps retrying a
+	// failed notification for this receiver before giving up. Zero (the
+	// default) retries for as long as the enclosing group's context
+	// allows, as before this field existed.
+	MaxRetryDuration model.Duration `yaml:"max_retry_duration,omitempty" json:"max_retry_duration,omitempty"`
+
+	// NotificationTimeout bounds how long a single notifier call for this
+	// receiver may block before being cancelled via its context, instead
+	// of relying solely on the overall group flush deadline. Zero (the
+	// default) applies no per-call timeout of its own. A route may
+	// override this value for alerts it routes; see Route.NotificationTimeout.
+	NotificationTimeout model.Duration `yaml:"notification_timeout,omitempty" json:"notification_timeout,omitempty"`
+
+	// PagingLatencyObjective, when set, is this receiver's target p99
+	// end-to-end latency from an alert's StartsAt to its successful
+	// delivery. Once the tracked p99 exceeds it, a meta-alert is sent to
+	// global.slo_alert_receiver (see package slo). Zero (the default)
+	// disables SLO tracking for this receiver.
+	PagingLatencyObjective model.Duration `yaml:"paging_latency_objective,omitempty" json:"paging_latency_objective,omitempty"`
+
+	// DefaultGroupBy, if set, is the group_by label list a route inherits
+	// when it names this receiver (Route.Receiver, which must be a literal
+	// name, not a template) and does not itself set group_by,
+	// group_by_all, group_by_exclude, or group_by_template, so routes that
+	// all target the same receiver and should group alerts the same way
+	// don't have to repeat group_by on every one of them. A route's own
+	// grouping configuration always takes precedence.
+	DefaultGroupBy []string `yaml:"default_group_by,omitempty" json:"default_group_by,omitempty"`
+
+	// DefaultGroupWait, DefaultGroupInterval, and DefaultRepeatInterval
+	// mirror DefaultGroupBy for Route.GroupWait, Route.GroupInterval, and
+	// Route.RepeatInterval respectively: a route naming this receiver
+	// inherits them unless it sets its own.
+	DefaultGroupWait      *model.Duration `yaml:"default_group_wait,omitempty" json:"default_group_wait,omitempty"`
+	DefaultGroupInterval  *model.Duration `yaml:"default_group_interval,omitempty" json:"default_group_interval,omitempty"`
+	DefaultRepeatInterval *model.Duration `yaml:"default_repeat_interval,omitempty" json:"default_repeat_interval,omitempty"`
+
+	// Redactions lists rules that replace matching label and annotation
+	// values before an alert reaches this receiver's integrations, so
+	// secrets or PII that leak into labels never reach external
+	// chat/paging providers. Redaction happens only at delivery: the
+	// unredacted alert remains visible everywhere else, including the
+	// internal API and history.
+	Redactions []*RedactionRule `yaml:"redactions,omitempty" json:"redactions,omitempty"`
+
+	// Preset names a built-in bundle of sensible integration settings (see
+	// receiverPresets) to fill in for any integration this receiver does
+	// not already configure explicitly, cutting the boilerplate needed for
+	// a run-of-the-mill receiver down to a name and the integration's
+	// required secret.
+	Preset string `yaml:"preset,omitempty" json:"preset,omitempty"`
+
+	// CopyOf names another receiver, defined earlier in the same file,
+	// whose integrations this receiver reuses verbatim under a name of
+	// its own. It exists for generated configs with many receivers that
+	// are really the same handful of integrations under different team
+	// names; a YAML anchor and alias (&foo / <<: *foo) achieves the same
+	// thing and works today without this field, but copy_of reads clearly
+	// in a config that is assembled by a tool rather than hand-written.
+	// A receiver may not set copy_of and also configure its own
+	// integrations.
+	CopyOf string `yaml:"copy_of,omitempty" json:"copy_of,omitempty"`
+
+	EmailConfigs        []*EmailConfig        `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
+	PagerdutyConfigs    []*PagerdutyConfig    `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
+	HipchatConfigs      []*HipchatConfig      `yaml:"hipchat_configs,omitempty" json:"hipchat_configs,omitempty"`
+	SlackConfigs        []*SlackConfig        `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
+	WebhookConfigs      []*WebhookConfig      `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
+	OpsGenieConfigs     []*OpsGenieConfig     `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
+	WechatConfigs       []*WechatConfig       `yaml:"wechat_configs,omitempty" json:"wechat_configs,omitempty"`
+	PushoverConfigs     []*PushoverConfig     `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
+	VictorOpsConfigs    []*VictorOpsConfig    `yaml:"victorops_configs,omitempty" json:"victorops_configs,omitempty"`
+	IRCConfigs          []*IRCConfig          `yaml:"irc_configs,omitempty" json:"irc_configs,omitempty"`
+	XMPPConfigs         []*XMPPConfig         `yaml:"xmpp_configs,omitempty" json:"xmpp_configs,omitempty"`
+	ZulipConfigs        []*ZulipConfig        `yaml:"zulip_configs,omitempty" json:"zulip_configs,omitempty"`
+	LineNotifyConfigs   []*LineNotifyConfig   `yaml:"linenotify_configs,omitempty" json:"linenotify_configs,omitempty"`
+	KakaoTalkConfigs    []*KakaoTalkConfig    `yaml:"kakaotalk_configs,omitempty" json:"kakaotalk_configs,omitempty"`
+	SMPPConfigs         []*SMPPConfig         `yaml:"smpp_configs,omitempty" json:"smpp_configs,omitempty"`
+	AlertaConfigs       []*AlertaConfig       `yaml:"alerta_configs,omitempty" json:"alerta_configs,omitempty"`
+	ZabbixConfigs       []*ZabbixConfig       `yaml:"zabbix_configs,omitempty" json:"zabbix_configs,omitempty"`
+	TelegramConfigs     []*TelegramConfig     `yaml:"telegram_configs,omitempty" json:"telegram_configs,omitempty"`
+	MSTeamsConfigs      []*MSTeamsConfig      `yaml:"msteams_configs,omitempty" json:"msteams_configs,omitempty"`
+	SNSConfigs          []*SNSConfig          `yaml:"sns_configs,omitempty" json:"sns_configs,omitempty"`
+	PubsubConfigs       []*PubsubConfig       `yaml:"pubsub_configs,omitempty" json:"pubsub_configs,omitempty"`
+	ExecConfigs         []*ExecConfig         `yaml:"exec_configs,omitempty" json:"exec_configs,omitempty"`
+	AlertmanagerConfigs []*AlertmanagerConfig `yaml:"alertmanager_configs,omitempty" json:"alertmanager_configs,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for Receiver.
@@ -678,9 +1705,280 @@ func (c *Receiver) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if c.Name == "" {
 		return fmt.Errorf("missing name in receiver")
 	}
+	if c.MaxConcurrency < 0 {
+		return fmt.Errorf("negative max_concurrency in receiver %q", c.Name)
+	}
+	if c.MaxRetryDuration < 0 {
+		return fmt.Errorf("negative max_retry_duration in receiver %q", c.Name)
+	}
+	if c.NotificationTimeout < 0 {
+		return fmt.Errorf("negative notification_timeout in receiver %q", c.Name)
+	}
+	if c.PagingLatencyObjective < 0 {
+		return fmt.Errorf("negative paging_latency_objective in receiver %q", c.Name)
+	}
+	if c.DefaultGroupInterval != nil && time.Duration(*c.DefaultGroupInterval) == time.Duration(0) {
+		return fmt.Errorf("default_group_interval cannot be zero in receiver %q", c.Name)
+	}
+	if c.DefaultRepeatInterval != nil && time.Duration(*c.DefaultRepeatInterval) == time.Duration(0) {
+		return fmt.Errorf("default_repeat_interval cannot be zero in receiver %q", c.Name)
+	}
+	if c.CircuitBreaker != nil {
+		if c.CircuitBreaker.FailureThreshold <= 0 {
+			return fmt.Errorf("failure_threshold must be positive in circuit_breaker for receiver %q", c.Name)
+		}
+		if c.CircuitBreaker.CooldownPeriod <= 0 {
+			return fmt.Errorf("cooldown_period must be positive in circuit_breaker for receiver %q", c.Name)
+		}
+	}
+	if c.RateLimit != nil {
+		if c.RateLimit.PerMinute <= 0 {
+			return fmt.Errorf("per_minute must be positive in rate_limit for receiver %q", c.Name)
+		}
+		if c.RateLimit.Burst < 0 {
+			return fmt.Errorf("burst must not be negative in rate_limit for receiver %q", c.Name)
+		}
+		switch c.RateLimit.Overflow {
+		case "", RateLimitOverflowDrop, RateLimitOverflowQueue, RateLimitOverflowCollapse:
+		default:
+			return fmt.Errorf("invalid overflow %q in rate_limit for receiver %q", c.RateLimit.Overflow, c.Name)
+		}
+	}
+	for _, r := range c.Redactions {
+		if err := r.init(); err != nil {
+			return fmt.Errorf("invalid redaction rule in receiver %q: %s", c.Name, err)
+		}
+	}
+	return c.applyPreset()
+}
+
+// ReceiversByName indexes receivers by name, for callers that need to look
+// one up by the name a route resolves to (e.g. dispatch.NewRoute, to apply
+// a receiver's Default* fields).
+func ReceiversByName(receivers []*Receiver) map[string]*Receiver {
+	m := make(map[string]*Receiver, len(receivers))
+	for _, r := range receivers {
+		m[r.Name] = r
+	}
+	return m
+}
+
+// CircuitBreakerConfig configures a per-receiver circuit breaker (see
+// Receiver.CircuitBreaker). Once FailureThreshold consecutive notification
+// attempts to the receiver fail, the breaker trips open and further alerts
+// are routed to FallbackReceiver (or dropped, if unset) without attempting
+// delivery, until CooldownPeriod has passed and a single probe notification
+// is allowed through to test whether the receiver has recovered.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive delivery failures that
+	// trip the breaker open. Must be positive.
+	FailureThreshold int `yaml:"failure_threshold" json:"failure_threshold"`
+
+	// CooldownPeriod is how long the breaker stays open, rejecting
+	// notifications outright, before allowing a single half-open probe
+	// notification through to test the receiver. Must be positive.
+	CooldownPeriod model.Duration `yaml:"cooldown_period" json:"cooldown_period"`
+
+	// FallbackReceiver, if set, receives notifications in place of this
+	// receiver while the breaker is open. It must name another receiver
+	// defined in the same config.
+	FallbackReceiver string `yaml:"fallback_receiver,omitempty" json:"fallback_receiver,omitempty"`
+}
+
+// RateLimitOverflow names what happens to a notification that arrives once
+// a receiver's RateLimitConfig has no tokens left.
+type RateLimitOverflow string
+
+const (
+	// RateLimitOverflowDrop silently discards the notification. It is the
+	// default if Overflow is unset.
+	RateLimitOverflowDrop RateLimitOverflow = "drop"
+	// RateLimitOverflowQueue holds the notification until a token becomes
+	// available.
+	RateLimitOverflowQueue RateLimitOverflow = "queue"
+	// RateLimitOverflowCollapse discards the notification but folds it
+	// into a single "N notifications suppressed" summary sent once a
+	// token is next available.
+	RateLimitOverflowCollapse RateLimitOverflow = "collapse"
+)
+
+// RateLimitConfig configures a per-receiver token bucket rate limit (see
+// Receiver.RateLimit). Notifications beyond PerMinute (with Burst allowed
+// back-to-back) are handled according to Overflow instead of being
+// delivered immediately.
+type RateLimitConfig struct {
+	// PerMinute is the steady-state number of notifications this receiver
+	// may be sent per minute. Must be positive.
+	PerMinute float64 `yaml:"per_minute" json:"per_minute"`
+
+	// Burst is how many notifications may be sent back-to-back before
+	// per_minute applies. Defaults to per_minute (rounded up) if unset.
+	Burst int `yaml:"burst,omitempty" json:"burst,omitempty"`
+
+	// Overflow selects what happens to a notification once the bucket is
+	// empty: drop (the default), queue, or collapse.
+	Overflow RateLimitOverflow `yaml:"overflow,omitempty" json:"overflow,omitempty"`
+}
+
+// defaultRedactionReplacement is substituted for a redacted match when a
+// RedactionRule does not set its own Replacement.
+const defaultRedactionReplacement = "<redacted>"
+
+// RedactionRule replaces matching label and annotation values with a fixed
+// replacement before an alert is rendered for delivery to a receiver (see
+// Receiver.Redactions).
+type RedactionRule struct {
+	// LabelPattern is a regular expression, anchored at both ends,
+	// matched against each label and annotation name. Matching pairs
+	// have their value redacted.
+	LabelPattern string `yaml:"label_pattern" json:"label_pattern"`
+
+	// ValuePattern, if set, further restricts redaction to the
+	// substrings of a matching value that it matches, e.g. to redact
+	// only a card number embedded in a longer annotation. Unset redacts
+	// the entire value.
+	ValuePattern string `yaml:"value_pattern,omitempty" json:"value_pattern,omitempty"`
+
+	// Replacement is substituted for each redacted match. Defaults to
+	// "<redacted>".
+	Replacement string `yaml:"replacement,omitempty" json:"replacement,omitempty"`
+
+	labelRE *regexp.Regexp
+	valueRE *regexp.Regexp
+}
+
+// init compiles r's patterns and fills in its default replacement. It must
+// run once after unmarshaling, before Redact is called.
+func (r *RedactionRule) init() error {
+	if r.LabelPattern == "" {
+		return fmt.Errorf("missing label_pattern")
+	}
+	labelRE, err := regexp.Compile("^(?:" + r.LabelPattern + ")$")
+	if err != nil {
+		return fmt.Errorf("invalid label_pattern: %s", err)
+	}
+	r.labelRE = labelRE
+
+	if r.ValuePattern != "" {
+		valueRE, err := regexp.Compile(r.ValuePattern)
+		if err != nil {
+			return fmt.Errorf("invalid value_pattern: %s", err)
+		}
+		r.valueRE = valueRE
+	}
+
+	if r.Replacement == "" {
+		r.Replacement = defaultRedactionReplacement
+	}
 	return nil
 }
 
+// Redact returns value with any part matched by r replaced, and whether name
+// and value matched at all. A nil receiver (or one never passed through
+// init) matches nothing.
+func (r *RedactionRule) Redact(name, value string) (string, bool) {
+	if r == nil || r.labelRE == nil || !r.labelRE.MatchString(name) {
+		return value, false
+	}
+	if r.valueRE == nil {
+		return r.Replacement, true
+	}
+	if !r.valueRE.MatchString(value) {
+		return value, false
+	}
+	return r.valueRE.ReplaceAllString(value, r.Replacement), true
+}
+
+// applyPreset looks up c.Preset in receiverPresets and fills in integration
+// configs for any integration type c does not already configure explicitly,
+// so a preset only ever supplies boilerplate and never overrides settings
+// the user actually wrote down.
+func (c *Receiver) applyPreset() error {
+	if c.Preset == "" {
+		return nil
+	}
+	preset, ok := receiverPresets[c.Preset]
+	if !ok {
+		return fmt.Errorf("unknown preset %q in receiver %q", c.Preset, c.Name)
+	}
+	exemplar := preset()
+	if len(c.PagerdutyConfigs) == 0 {
+		c.PagerdutyConfigs = exemplar.PagerdutyConfigs
+	}
+	if len(c.SlackConfigs) == 0 {
+		c.SlackConfigs = exemplar.SlackConfigs
+	}
+	return nil
+}
+
+// hasIntegrations reports whether c configures any integration of its own.
+func (c *Receiver) hasIntegrations() bool {
+	return len(c.EmailConfigs) > 0 ||
+		len(c.PagerdutyConfigs) > 0 ||
+		len(c.HipchatConfigs) > 0 ||
+		len(c.SlackConfigs) > 0 ||
+		len(c.WebhookConfigs) > 0 ||
+		len(c.OpsGenieConfigs) > 0 ||
+		len(c.WechatConfigs) > 0 ||
+		len(c.PushoverConfigs) > 0 ||
+		len(c.VictorOpsConfigs) > 0 ||
+		len(c.IRCConfigs) > 0 ||
+		len(c.XMPPConfigs) > 0 ||
+		len(c.ZulipConfigs) > 0 ||
+		len(c.LineNotifyConfigs) > 0 ||
+		len(c.KakaoTalkConfigs) > 0 ||
+		len(c.SMPPConfigs) > 0 ||
+		len(c.AlertaConfigs) > 0 ||
+		len(c.ZabbixConfigs) > 0 ||
+		len(c.TelegramConfigs) > 0 ||
+		len(c.MSTeamsConfigs) > 0 ||
+		len(c.SNSConfigs) > 0 ||
+		len(c.PubsubConfigs) > 0 ||
+		len(c.ExecConfigs) > 0 ||
+		len(c.AlertmanagerConfigs) > 0
+}
+
+// copyIntegrationsFrom reuses src's integrations for c, implementing
+// CopyOf. c must not already configure any integrations of its own.
+func (c *Receiver) copyIntegrationsFrom(src *Receiver) error {
+	if c.hasIntegrations() {
+		return fmt.Errorf("receiver %q cannot set copy_of and also configure its own integrations", c.Name)
+	}
+	c.EmailConfigs = src.EmailConfigs
+	c.PagerdutyConfigs = src.PagerdutyConfigs
+	c.HipchatConfigs = src.HipchatConfigs
+	c.SlackConfigs = src.SlackConfigs
+	c.WebhookConfigs = src.WebhookConfigs
+	c.OpsGenieConfigs = src.OpsGenieConfigs
+	c.WechatConfigs = src.WechatConfigs
+	c.PushoverConfigs = src.PushoverConfigs
+	c.VictorOpsConfigs = src.VictorOpsConfigs
+	c.IRCConfigs = src.IRCConfigs
+	c.XMPPConfigs = src.XMPPConfigs
+	c.ZulipConfigs = src.ZulipConfigs
+	c.LineNotifyConfigs = src.LineNotifyConfigs
+	c.KakaoTalkConfigs = src.KakaoTalkConfigs
+	c.SMPPConfigs = src.SMPPConfigs
+	c.AlertaConfigs = src.AlertaConfigs
+	c.ZabbixConfigs = src.ZabbixConfigs
+	c.TelegramConfigs = src.TelegramConfigs
+	c.MSTeamsConfigs = src.MSTeamsConfigs
+	c.SNSConfigs = src.SNSConfigs
+	c.PubsubConfigs = src.PubsubConfigs
+	c.ExecConfigs = src.ExecConfigs
+	c.AlertmanagerConfigs = src.AlertmanagerConfigs
+	return nil
+}
+
+// equalIntegrations reports whether c and other configure byte-for-byte
+// identical integrations, ignoring Name and CopyOf.
+func (c *Receiver) equalIntegrations(other *Receiver) bool {
+	cCopy, otherCopy := *c, *other
+	cCopy.Name, otherCopy.Name = "", ""
+	cCopy.CopyOf, otherCopy.CopyOf = "", ""
+	return reflect.DeepEqual(cCopy, otherCopy)
+}
+
 // MatchRegexps represents a map of Regexp.
 type MatchRegexps map[string]Regexp
 
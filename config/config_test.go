@@ -23,55 +23,1204 @@ import (
 	"testing"
 	"time"
 
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 	yaml "gopkg.in/yaml.v2"
+
+	"github.com/prometheus/alertmanager/heartbeat"
+	"github.com/prometheus/alertmanager/watchdog"
 )
 
 func TestLoadEmptyString(t *testing.T) {
 	var in string
 	_, err := Load(in)
 
-	expected := "no route provided in config"
+	expected := "no route provided in config"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%v", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+	}
+}
+
+func TestDefaultReceiverExists(t *testing.T) {
+	in := `
+route:
+   group_wait: 30s
+`
+	_, err := Load(in)
+
+	expected := "root route must specify a default receiver"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%v", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+	}
+}
+
+func TestReceiverNameIsUnique(t *testing.T) {
+	in := `
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-X'
+- name: 'team-X'
+`
+	_, err := Load(in)
+
+	expected := "notification config name \"team-X\" is not unique"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+
+}
+
+func TestReceiverExists(t *testing.T) {
+	in := `
+route:
+    receiver: team-X
+
+receivers:
+- name: 'team-Y'
+`
+	_, err := Load(in)
+
+	expected := "undefined receiver \"team-X\" used in route"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+
+}
+
+func TestReceiverExistsForDeepSubRoute(t *testing.T) {
+	in := `
+route:
+    receiver: team-X
+    routes:
+      - match:
+          foo: bar
+        routes:
+        - match:
+            foo: bar
+          receiver: nonexistent
+
+receivers:
+- name: 'team-X'
+`
+	_, err := Load(in)
+
+	expected := "undefined receiver \"nonexistent\" used in route"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+
+}
+
+func TestReceiverHasName(t *testing.T) {
+	in := `
+route:
+
+receivers:
+- name: ''
+`
+	_, err := Load(in)
+
+	expected := "missing name in receiver"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+
+}
+
+func TestReceiverCopyOf(t *testing.T) {
+	in := `
+route:
+  receiver: team-X
+receivers:
+- name: 'team-X'
+  slack_configs:
+  - api_url: http://example.com/
+    channel: '#alerts'
+- name: 'team-X-copy'
+  copy_of: 'team-X'
+`
+	cfg, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(cfg.Receivers[1].SlackConfigs) != 1 || cfg.Receivers[1].SlackConfigs[0].Channel != "#alerts" {
+		t.Fatalf("expected team-X-copy to reuse team-X's slack_configs, got: %+v", cfg.Receivers[1].SlackConfigs)
+	}
+}
+
+func TestReceiverCopyOfUndefined(t *testing.T) {
+	in := `
+route:
+  receiver: team-X
+receivers:
+- name: 'team-X'
+  copy_of: 'team-Y'
+`
+	_, err := Load(in)
+
+	expected := `receiver "team-X" has copy_of "team-Y", which must name a receiver defined earlier in the file`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestReceiverCopyOfWithOwnIntegrations(t *testing.T) {
+	in := `
+route:
+  receiver: team-X
+receivers:
+- name: 'team-X'
+  slack_configs:
+  - api_url: http://example.com/
+- name: 'team-X-copy'
+  copy_of: 'team-X'
+  slack_configs:
+  - api_url: http://example.com/
+`
+	_, err := Load(in)
+
+	expected := `receiver "team-X-copy" cannot set copy_of and also configure its own integrations`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestDuplicateReceiversWarning(t *testing.T) {
+	in := `
+route:
+  receiver: team-X
+receivers:
+- name: 'team-X'
+  slack_configs:
+  - api_url: http://example.com/
+    channel: '#alerts'
+- name: 'team-Y'
+  slack_configs:
+  - api_url: http://example.com/
+    channel: '#alerts'
+`
+	cfg, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := [][2]string{{"team-X", "team-Y"}}
+	if !reflect.DeepEqual(cfg.DuplicateReceivers, expected) {
+		t.Errorf("expected DuplicateReceivers %v, got %v", expected, cfg.DuplicateReceivers)
+	}
+}
+
+func TestDuplicateReceiversViaCopyOfNotWarned(t *testing.T) {
+	in := `
+route:
+  receiver: team-X
+receivers:
+- name: 'team-X'
+  slack_configs:
+  - api_url: http://example.com/
+    channel: '#alerts'
+- name: 'team-X-copy'
+  copy_of: 'team-X'
+`
+	cfg, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(cfg.DuplicateReceivers) != 0 {
+		t.Errorf("expected no duplicate receiver warnings, got %v", cfg.DuplicateReceivers)
+	}
+}
+
+func TestGroupByHasNoDuplicatedLabels(t *testing.T) {
+	in := `
+route:
+  group_by: ['alertname', 'cluster', 'service', 'cluster']
+
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "duplicated label \"cluster\" in group_by"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+
+}
+
+func TestWildcardGroupByWithOtherGroupByLabels(t *testing.T) {
+	in := `
+route:
+  group_by: ['alertname', 'cluster', '...']
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "cannot have wildcard group_by (`...`) and other other labels at the same time"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestGroupByTemplateWithGroupBy(t *testing.T) {
+	in := `
+route:
+  group_by: ['alertname']
+  group_by_template: '{{ .alertname }}'
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "cannot have group_by_template and group_by at the same time"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestGroupByTemplateInvalidSyntax(t *testing.T) {
+	in := `
+route:
+  group_by_template: '{{ .alertname'
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+	if err == nil {
+		t.Fatal("expected an error for an invalid group_by_template")
+	}
+}
+
+func TestDedupKeyTemplateInvalidSyntax(t *testing.T) {
+	in := `
+route:
+  dedup_key_template: '{{ .alertname'
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+	if err == nil {
+		t.Fatal("expected an error for an invalid dedup_key_template")
+	}
+}
+
+func TestReceiverTemplate(t *testing.T) {
+	in := `
+route:
+  receiver: 'team-{{ .team }}-slack'
+  receiver_fallback: fallback
+  routes:
+  - match:
+      team: 'x'
+    receiver: fallback
+receivers:
+- name: 'team-a-slack'
+- name: 'team-b-slack'
+- name: fallback
+`
+	conf, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if conf.Route.Receiver != "team-{{ .team }}-slack" {
+		t.Fatalf("unexpected receiver: %q", conf.Route.Receiver)
+	}
+	if conf.Route.ReceiverFallback != "fallback" {
+		t.Fatalf("unexpected receiver_fallback: %q", conf.Route.ReceiverFallback)
+	}
+}
+
+func TestReceiverTemplateRequiresFallback(t *testing.T) {
+	in := `
+route:
+  receiver: 'team-{{ .team }}-slack'
+receivers:
+- name: 'team-a-slack'
+`
+	_, err := Load(in)
+
+	expected := "receiver_fallback is required when receiver is a template"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestReceiverFallbackWithoutTemplateReceiver(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  receiver_fallback: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "receiver_fallback is only valid when receiver is a template"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestReceiverTemplateInvalidSyntax(t *testing.T) {
+	in := `
+route:
+  receiver: 'team-{{ .team'
+  receiver_fallback: fallback
+receivers:
+- name: fallback
+`
+	_, err := Load(in)
+	if err == nil {
+		t.Fatal("expected an error for an invalid receiver template")
+	}
+}
+
+func TestReceiverTemplateUndefinedFallback(t *testing.T) {
+	in := `
+route:
+  receiver: 'team-{{ .team }}-slack'
+  receiver_fallback: does-not-exist
+receivers:
+- name: 'team-a-slack'
+`
+	_, err := Load(in)
+	if err == nil {
+		t.Fatal("expected an error for an undefined receiver_fallback")
+	}
+}
+
+func TestAnnotationTemplates(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  annotation_templates:
+    dashboard: 'https://dashboards.example.com/d/{{ .service }}'
+receivers:
+- name: 'team-X-mails'
+`
+	conf, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := conf.Route.AnnotationTemplates
+	want := map[string]string{"dashboard": "https://dashboards.example.com/d/{{ .service }}"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected annotation_templates %v, expected %v", got, want)
+	}
+}
+
+func TestAnnotationTemplatesInvalidSyntax(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  annotation_templates:
+    dashboard: '{{ .service'
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+	if err == nil {
+		t.Fatal("expected an error for an invalid annotation_templates entry")
+	}
+}
+
+func TestRouteTimezoneValid(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  timezone: America/New_York
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestRouteTimezoneInvalid(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  timezone: Not/A_Zone
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := `invalid timezone "Not/A_Zone": unknown time zone Not/A_Zone`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestRouteMatchersValid(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  routes:
+  - matchers: ['owner="team-X"', 'severity=~"critical|page"']
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	if _, err := Load(in); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestRouteMatchersNegative(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  routes:
+  - matchers: ['owner!="team-X"']
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := `negative matcher "owner!=\"team-X\"" is not supported here`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestRouteMatchersPresence(t *testing.T) {
+	in := `
+route:
+  receiver: fallback
+  routes:
+  - matchers: ['team!=""']
+    receiver: team-mails
+  - matchers: ['team=""']
+    receiver: fallback
+receivers:
+- name: 'fallback'
+- name: 'team-mails'
+`
+	if _, err := Load(in); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestRouteMatchAnnotationsValid(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  routes:
+  - match_annotations: ['runbook_url=~".*legacy.*"']
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	if _, err := Load(in); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestRouteMatchAnnotationsNegative(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  routes:
+  - match_annotations: ['runbook_url!="http://example.com"']
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := `negative matcher "runbook_url!=\"http://example.com\"" is not supported here`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestRouteMatchNot(t *testing.T) {
+	in := `
+route:
+  receiver: fallback
+  routes:
+  - match_not:
+      team: infra
+    receiver: team-X-mails
+receivers:
+- name: 'fallback'
+- name: 'team-X-mails'
+`
+	conf, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := conf.Route.Routes[0].MatchNot
+	if want := map[string]string{"team": "infra"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected match_not %v, expected %v", got, want)
+	}
+}
+
+func TestRouteMatchNotRE(t *testing.T) {
+	in := `
+route:
+  receiver: fallback
+  routes:
+  - match_not_re:
+      team: ^infra.*
+    receiver: team-X-mails
+receivers:
+- name: 'fallback'
+- name: 'team-X-mails'
+`
+	conf, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	re, ok := conf.Route.Routes[0].MatchNotRE["team"]
+	if !ok {
+		t.Fatalf("expected match_not_re to contain a \"team\" entry")
+	}
+	if re.String() != "^(?:^infra.*)$" {
+		t.Errorf("unexpected match_not_re regexp %q", re.String())
+	}
+}
+
+func TestMuteTimeIntervals(t *testing.T) {
+	in := `
+route:
+  receiver: fallback
+  routes:
+  - receiver: low-priority
+    mute_time_intervals: ['nights']
+    active_time_intervals: ['business-hours']
+mute_time_intervals:
+- name: nights
+  time_intervals:
+  - times: ['20:00-24:00']
+- name: business-hours
+  time_intervals:
+  - weekdays: ['monday:friday']
+    times: ['09:00-17:00']
+receivers:
+- name: 'fallback'
+- name: 'low-priority'
+`
+	conf, err := Load(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(conf.MuteTimeIntervals) != 2 {
+		t.Fatalf("expected 2 mute_time_intervals, got %d", len(conf.MuteTimeIntervals))
+	}
+
+	r := conf.Route.Routes[0]
+	if want := []string{"nights"}; !reflect.DeepEqual(r.MuteTimeIntervals, want) {
+		t.Errorf("unexpected mute_time_intervals %v, expected %v", r.MuteTimeIntervals, want)
+	}
+	if want := []string{"business-hours"}; !reflect.DeepEqual(r.ActiveTimeIntervals, want) {
+		t.Errorf("unexpected active_time_intervals %v, expected %v", r.ActiveTimeIntervals, want)
+	}
+}
+
+func TestMuteTimeIntervalsUndefinedName(t *testing.T) {
+	in := `
+route:
+  receiver: fallback
+  routes:
+  - receiver: fallback
+    mute_time_intervals: ['does-not-exist']
+receivers:
+- name: 'fallback'
+`
+	_, err := Load(in)
+	if err == nil {
+		t.Fatalf("expected an error for an undefined mute_time_intervals name")
+	}
+}
+
+func TestMuteTimeIntervalsDuplicateName(t *testing.T) {
+	in := `
+route:
+  receiver: fallback
+mute_time_intervals:
+- name: nights
+  time_intervals:
+  - times: ['20:00-24:00']
+- name: nights
+  time_intervals:
+  - times: ['00:00-06:00']
+receivers:
+- name: 'fallback'
+`
+	_, err := Load(in)
+	if err == nil {
+		t.Fatalf("expected an error for a duplicate mute_time_intervals name")
+	}
+}
+
+func TestRouteMatchersInvalidSyntax(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  routes:
+  - matchers: ['not a matcher']
+    receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	if _, err := Load(in); err == nil {
+		t.Fatal("expected an error for an invalid matcher expression")
+	}
+}
+
+func TestInhibitRuleMatchersNegative(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+inhibit_rules:
+- source_matchers: ['severity="critical"']
+  target_matchers: ['severity!~"critical|page"']
+  equal: ['alertname']
+`
+	_, err := Load(in)
+
+	expected := `negative matcher "severity!~\"critical|page\"" is not supported here`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestStormThresholdTooLow(t *testing.T) {
+	in := `
+route:
+  storm_threshold: 1
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "storm_threshold must be greater than 1"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestStormThresholdValid(t *testing.T) {
+	in := `
+route:
+  storm_threshold: 5
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	if _, err := Load(in); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestNotificationBudgetNegative(t *testing.T) {
+	in := `
+route:
+  notification_budget: -1
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "notification_budget must not be negative"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestNotificationBudgetValid(t *testing.T) {
+	in := `
+route:
+  notification_budget: 50
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	if _, err := Load(in); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMaxAggregationGroupsNegative(t *testing.T) {
+	in := `
+route:
+  max_aggregation_groups: -1
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "max_aggregation_groups must not be negative"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestMaxAlertsPerGroupNegative(t *testing.T) {
+	in := `
+route:
+  max_alerts_per_group: -1
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "max_alerts_per_group must not be negative"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestMaxAggregationGroupsAndMaxAlertsPerGroupValid(t *testing.T) {
+	in := `
+route:
+  max_aggregation_groups: 100
+  max_alerts_per_group: 50
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+	require.Equal(t, 100, cfg.Route.MaxAggregationGroups)
+	require.Equal(t, 50, cfg.Route.MaxAlertsPerGroup)
+}
+
+func TestMaxAlertsRenderedNegative(t *testing.T) {
+	in := `
+route:
+  max_alerts_rendered: -1
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "max_alerts_rendered must not be negative"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestAlertSortOrderWithoutLabel(t *testing.T) {
+	in := `
+route:
+  alert_sort_order: ['critical', 'warning', 'info']
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "alert_sort_label is required when alert_sort_order is set"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestAlertSortLabelWithoutOrder(t *testing.T) {
+	in := `
+route:
+  alert_sort_label: severity
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "alert_sort_order is required when alert_sort_label is set"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestAlertSortAndMaxAlertsRenderedValid(t *testing.T) {
+	in := `
+route:
+  alert_sort_label: severity
+  alert_sort_order: ['critical', 'warning', 'info']
+  max_alerts_rendered: 10
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+	require.Equal(t, "severity", cfg.Route.AlertSortLabel)
+	require.Equal(t, []string{"critical", "warning", "info"}, cfg.Route.AlertSortOrder)
+	require.Equal(t, 10, cfg.Route.MaxAlertsRendered)
+}
+
+func TestEscalationReceiverWithoutTimeout(t *testing.T) {
+	in := `
+route:
+  escalation_receiver: team-Y-mails
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+- name: 'team-Y-mails'
+`
+	_, err := Load(in)
+
+	expected := "escalation_timeout is required when escalation_receiver is set"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestEscalationTimeoutWithoutReceiver(t *testing.T) {
+	in := `
+route:
+  escalation_timeout: 5m
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "escalation_receiver is required when escalation_timeout is set"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestEscalationTimeoutNotPositive(t *testing.T) {
+	in := `
+route:
+  escalation_receiver: team-Y-mails
+  escalation_timeout: 0s
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+- name: 'team-Y-mails'
+`
+	_, err := Load(in)
+
+	expected := "escalation_timeout must be positive"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestEscalationReceiverUndefined(t *testing.T) {
+	in := `
+route:
+  escalation_receiver: team-Y-mails
+  escalation_timeout: 5m
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := `undefined receiver "team-Y-mails" used in route escalation_receiver`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestEscalationReceiverValid(t *testing.T) {
+	in := `
+route:
+  escalation_receiver: team-Y-mails
+  escalation_timeout: 5m
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+- name: 'team-Y-mails'
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+	require.Equal(t, "team-Y-mails", cfg.Route.EscalationReceiver)
+	require.NotNil(t, cfg.Route.EscalationTimeout)
+	require.Equal(t, 5*time.Minute, time.Duration(*cfg.Route.EscalationTimeout))
+}
+
+func TestBudgetAlertReceiverUndefined(t *testing.T) {
+	in := `
+global:
+  budget_alert_receiver: platform-team
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := `undefined receiver "platform-team" used in global.budget_alert_receiver`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestBudgetAlertReceiverValid(t *testing.T) {
+	in := `
+global:
+  budget_alert_receiver: platform-team
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+- name: 'platform-team'
+`
+	if _, err := Load(in); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestCircuitBreakerFallbackReceiverUndefined(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  circuit_breaker:
+    failure_threshold: 3
+    cooldown_period: 5m
+    fallback_receiver: platform-team
+`
+	_, err := Load(in)
+
+	expected := `undefined receiver "platform-team" used in circuit_breaker.fallback_receiver for receiver "team-X-mails"`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestCircuitBreakerFallbackReceiverValid(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  circuit_breaker:
+    failure_threshold: 3
+    cooldown_period: 5m
+- name: 'platform-team'
+`
+	if _, err := Load(in); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestCircuitBreakerInvalidThreshold(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  circuit_breaker:
+    failure_threshold: 0
+    cooldown_period: 5m
+`
+	_, err := Load(in)
+
+	expected := `failure_threshold must be positive in circuit_breaker for receiver "team-X-mails"`
 
 	if err == nil {
-		t.Fatalf("no error returned, expected:\n%v", expected)
+		t.Fatalf("no error returned, expected:\n%q", expected)
 	}
 	if err.Error() != expected {
-		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
 	}
 }
 
-func TestDefaultReceiverExists(t *testing.T) {
+func TestRateLimitValid(t *testing.T) {
 	in := `
 route:
-   group_wait: 30s
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  rate_limit:
+    per_minute: 10
+    burst: 5
+    overflow: collapse
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+	require.Equal(t, float64(10), cfg.Receivers[0].RateLimit.PerMinute)
+	require.Equal(t, 5, cfg.Receivers[0].RateLimit.Burst)
+	require.Equal(t, RateLimitOverflowCollapse, cfg.Receivers[0].RateLimit.Overflow)
+}
+
+func TestRateLimitInvalidPerMinute(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  rate_limit:
+    per_minute: 0
 `
 	_, err := Load(in)
 
-	expected := "root route must specify a default receiver"
+	expected := `per_minute must be positive in rate_limit for receiver "team-X-mails"`
 
 	if err == nil {
-		t.Fatalf("no error returned, expected:\n%v", expected)
+		t.Fatalf("no error returned, expected:\n%q", expected)
 	}
 	if err.Error() != expected {
-		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
 	}
 }
 
-func TestReceiverNameIsUnique(t *testing.T) {
+func TestRateLimitInvalidOverflow(t *testing.T) {
 	in := `
 route:
-    receiver: team-X
-
+  receiver: team-X-mails
 receivers:
-- name: 'team-X'
-- name: 'team-X'
+- name: 'team-X-mails'
+  rate_limit:
+    per_minute: 10
+    overflow: explode
 `
 	_, err := Load(in)
 
-	expected := "notification config name \"team-X\" is not unique"
+	expected := `invalid overflow "explode" in rate_limit for receiver "team-X-mails"`
 
 	if err == nil {
 		t.Fatalf("no error returned, expected:\n%q", expected)
@@ -79,20 +1228,165 @@ receivers:
 	if err.Error() != expected {
 		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
 	}
+}
 
+func TestReceiverMaxRetryDurationValid(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  max_retry_duration: 10m
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+	require.Equal(t, model.Duration(10*time.Minute), cfg.Receivers[0].MaxRetryDuration)
 }
 
-func TestReceiverExists(t *testing.T) {
+func TestReceiverDefaultGroupingValid(t *testing.T) {
 	in := `
 route:
-    receiver: team-X
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  default_group_by: ['cluster', 'alertname']
+  default_group_wait: 15s
+  default_group_interval: 3m
+  default_repeat_interval: 2h
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+	require.Equal(t, []string{"cluster", "alertname"}, cfg.Receivers[0].DefaultGroupBy)
+	require.Equal(t, model.Duration(15*time.Second), *cfg.Receivers[0].DefaultGroupWait)
+	require.Equal(t, model.Duration(3*time.Minute), *cfg.Receivers[0].DefaultGroupInterval)
+	require.Equal(t, model.Duration(2*time.Hour), *cfg.Receivers[0].DefaultRepeatInterval)
+}
 
+func TestReceiverDefaultGroupIntervalZero(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
 receivers:
-- name: 'team-Y'
+- name: 'team-X-mails'
+  default_group_interval: 0s
 `
 	_, err := Load(in)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "default_group_interval cannot be zero")
+}
 
-	expected := "undefined receiver \"team-X\" used in route"
+func TestReceiverDefaultRepeatIntervalZero(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  default_repeat_interval: 0s
+`
+	_, err := Load(in)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "default_repeat_interval cannot be zero")
+}
+
+func TestReceiverRedactionRuleValid(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  redactions:
+  - label_pattern: ssn
+    value_pattern: '\d{3}-\d{2}-\d{4}'
+    replacement: 'XXX-XX-XXXX'
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+	require.Len(t, cfg.Receivers[0].Redactions, 1)
+
+	rule := cfg.Receivers[0].Redactions[0]
+	redacted, matched := rule.Redact("ssn", "my ssn is 123-45-6789")
+	require.True(t, matched)
+	require.Equal(t, "my ssn is XXX-XX-XXXX", redacted)
+
+	_, matched = rule.Redact("account", "123-45-6789")
+	require.False(t, matched, "rule must not match an unrelated label name")
+}
+
+func TestReceiverRedactionRuleInvalidPattern(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+  redactions:
+  - label_pattern: '['
+`
+	_, err := Load(in)
+	require.Error(t, err)
+}
+
+func TestAtRestEncryptionValid(t *testing.T) {
+	in := `
+global:
+  at_rest_encryption:
+    current_key_id: k1
+    keys:
+      k1: MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTIzNDU2Nzg5MDE=
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	cfg, err := Load(in)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Global.AtRestEncryption)
+	require.Equal(t, "k1", cfg.Global.AtRestEncryption.CurrentKeyID)
+}
+
+func TestAtRestEncryptionMissingCurrentKey(t *testing.T) {
+	in := `
+global:
+  at_rest_encryption:
+    current_key_id: k2
+    keys:
+      k1: MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTIzNDU2Nzg5MDE=
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+	require.EqualError(t, err, `current_key_id "k2" is not present in at_rest_encryption keys`)
+}
+
+func TestAtRestEncryptionInvalidBase64(t *testing.T) {
+	in := `
+global:
+  at_rest_encryption:
+    current_key_id: k1
+    keys:
+      k1: "not-valid-base64!!"
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+	require.Error(t, err)
+}
+
+func TestOutboundConcurrencyNegative(t *testing.T) {
+	in := `
+global:
+  outbound_concurrency: -1
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "global.outbound_concurrency must not be negative"
 
 	if err == nil {
 		t.Fatalf("no error returned, expected:\n%q", expected)
@@ -100,27 +1394,59 @@ receivers:
 	if err.Error() != expected {
 		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
 	}
+}
+
+func TestPriorityLabelInvalid(t *testing.T) {
+	in := `
+global:
+  outbound_concurrency: 4
+  priority_label: '-invalid-'
+route:
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
 
+	expected := `invalid label name "-invalid-" in global.priority_label`
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
 }
 
-func TestReceiverExistsForDeepSubRoute(t *testing.T) {
+func TestPriorityValuesValid(t *testing.T) {
 	in := `
+global:
+  outbound_concurrency: 4
+  priority_label: severity
+  priority_values:
+    critical: 0
+    warning: 5
 route:
-    receiver: team-X
-    routes:
-      - match:
-          foo: bar
-        routes:
-        - match:
-            foo: bar
-          receiver: nonexistent
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	if _, err := Load(in); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
 
+func TestGroupByInvalidLabel(t *testing.T) {
+	in := `
+route:
+  group_by: ['-invalid-']
+  receiver: team-X-mails
 receivers:
-- name: 'team-X'
+- name: 'team-X-mails'
 `
 	_, err := Load(in)
 
-	expected := "undefined receiver \"nonexistent\" used in route"
+	expected := "invalid label name \"-invalid-\" in group_by list"
 
 	if err == nil {
 		t.Fatalf("no error returned, expected:\n%q", expected)
@@ -131,16 +1457,39 @@ receivers:
 
 }
 
-func TestReceiverHasName(t *testing.T) {
+func TestGroupByExcludeSetsGroupByAll(t *testing.T) {
 	in := `
 route:
+  group_by_exclude: ['pod', 'instance']
+  receiver: team-X-mails
+receivers:
+- name: 'team-X-mails'
+`
+	conf, err := Load(in)
+	if err != nil {
+		t.Fatalf("error parsing config: %s", err)
+	}
+
+	if !conf.Route.GroupByAll {
+		t.Errorf("expected group_by_exclude to imply group_by_all")
+	}
+	if got := conf.Route.GroupByExclude; len(got) != 2 || got[0] != "pod" || got[1] != "instance" {
+		t.Errorf("unexpected GroupByExclude: %v", got)
+	}
+}
 
+func TestGroupByExcludeWithGroupByLabels(t *testing.T) {
+	in := `
+route:
+  group_by: ['alertname']
+  group_by_exclude: ['pod']
+  receiver: team-X-mails
 receivers:
-- name: ''
+- name: 'team-X-mails'
 `
 	_, err := Load(in)
 
-	expected := "missing name in receiver"
+	expected := "cannot have group_by_exclude and group_by at the same time"
 
 	if err == nil {
 		t.Fatalf("no error returned, expected:\n%q", expected)
@@ -148,20 +1497,19 @@ receivers:
 	if err.Error() != expected {
 		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
 	}
-
 }
 
-func TestGroupByHasNoDuplicatedLabels(t *testing.T) {
+func TestGroupByExcludeHasNoDuplicatedLabels(t *testing.T) {
 	in := `
 route:
-  group_by: ['alertname', 'cluster', 'service', 'cluster']
-
+  group_by_exclude: ['pod', 'instance', 'pod']
+  receiver: team-X-mails
 receivers:
 - name: 'team-X-mails'
 `
 	_, err := Load(in)
 
-	expected := "duplicated label \"cluster\" in group_by"
+	expected := "duplicated label \"pod\" in group_by_exclude"
 
 	if err == nil {
 		t.Fatalf("no error returned, expected:\n%q", expected)
@@ -169,20 +1517,48 @@ receivers:
 	if err.Error() != expected {
 		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
 	}
+}
+
+func TestGroupWaitOverrideParses(t *testing.T) {
+	in := `
+route:
+  receiver: team-X-mails
+  group_wait_override:
+  - match:
+      severity: critical
+    group_wait: 0s
+receivers:
+- name: 'team-X-mails'
+`
+	conf, err := Load(in)
+	if err != nil {
+		t.Fatalf("error parsing config: %s", err)
+	}
 
+	overrides := conf.Route.GroupWaitOverrides
+	if len(overrides) != 1 {
+		t.Fatalf("expected 1 group_wait_override, got %d", len(overrides))
+	}
+	if overrides[0].Match["severity"] != "critical" {
+		t.Errorf("unexpected match: %v", overrides[0].Match)
+	}
+	if time.Duration(overrides[0].GroupWait) != 0 {
+		t.Errorf("expected group_wait of 0, got %s", time.Duration(overrides[0].GroupWait))
+	}
 }
 
-func TestWildcardGroupByWithOtherGroupByLabels(t *testing.T) {
+func TestGroupWaitOverrideRequiresMatch(t *testing.T) {
 	in := `
 route:
-  group_by: ['alertname', 'cluster', '...']
   receiver: team-X-mails
+  group_wait_override:
+  - group_wait: 0s
 receivers:
 - name: 'team-X-mails'
 `
 	_, err := Load(in)
 
-	expected := "cannot have wildcard group_by (`...`) and other other labels at the same time"
+	expected := "group_wait_override requires a non-empty match"
 
 	if err == nil {
 		t.Fatalf("no error returned, expected:\n%q", expected)
@@ -192,17 +1568,38 @@ receivers:
 	}
 }
 
-func TestGroupByInvalidLabel(t *testing.T) {
+func TestGroupJitterParses(t *testing.T) {
 	in := `
 route:
-  group_by: ['-invalid-']
+  receiver: team-X-mails
+  group_jitter: 30s
+receivers:
+- name: 'team-X-mails'
+`
+	conf, err := Load(in)
+	if err != nil {
+		t.Fatalf("error parsing config: %s", err)
+	}
+
+	if conf.Route.GroupJitter == nil {
+		t.Fatal("expected group_jitter to be set")
+	}
+	if time.Duration(*conf.Route.GroupJitter) != 30*time.Second {
+		t.Errorf("expected group_jitter of 30s, got %s", time.Duration(*conf.Route.GroupJitter))
+	}
+}
+
+func TestGroupByExcludeInvalidLabel(t *testing.T) {
+	in := `
+route:
+  group_by_exclude: ['-invalid-']
   receiver: team-X-mails
 receivers:
 - name: 'team-X-mails'
 `
 	_, err := Load(in)
 
-	expected := "invalid label name \"-invalid-\" in group_by list"
+	expected := "invalid label name \"-invalid-\" in group_by_exclude list"
 
 	if err == nil {
 		t.Fatalf("no error returned, expected:\n%q", expected)
@@ -210,7 +1607,6 @@ receivers:
 	if err.Error() != expected {
 		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
 	}
-
 }
 
 func TestRootRouteExists(t *testing.T) {
@@ -318,6 +1714,49 @@ receivers:
 	}
 }
 
+func TestRepeatIntervalsConflictsWithRepeatInterval(t *testing.T) {
+	in := `
+route:
+    receiver: team-X-mails
+    repeat_interval: 1h
+    repeat_intervals: ['1h', '4h', '24h']
+
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "cannot have repeat_intervals and repeat_interval at the same time"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
+func TestRepeatIntervalsEntryIsGreaterThanZero(t *testing.T) {
+	in := `
+route:
+    receiver: team-X-mails
+    repeat_intervals: ['1h', '0s']
+
+receivers:
+- name: 'team-X-mails'
+`
+	_, err := Load(in)
+
+	expected := "repeat_intervals entries cannot be zero"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%q", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%q\ngot:\n%q", expected, err.Error())
+	}
+}
+
 func TestHideConfigSecrets(t *testing.T) {
 	c, err := LoadFile("testdata/conf.good.yml")
 	if err != nil {
@@ -360,6 +1799,22 @@ func TestJSONMarshalSecret(t *testing.T) {
 	require.Equal(t, "{\"S\":\"\\u003csecret\\u003e\"}", string(c), "Secret not properly elided.")
 }
 
+func TestUnmarshalSecretFromEnv(t *testing.T) {
+	os.Setenv("ALERTMANAGER_TEST_SECRET", "from-env")
+	defer os.Unsetenv("ALERTMANAGER_TEST_SECRET")
+
+	var s Secret
+	err := yaml.Unmarshal([]byte(`"${ALERTMANAGER_TEST_SECRET}"`), &s)
+	require.NoError(t, err)
+	require.Equal(t, Secret("from-env"), s)
+}
+
+func TestUnmarshalSecretFromEnvUnset(t *testing.T) {
+	var s Secret
+	err := yaml.Unmarshal([]byte(`"${ALERTMANAGER_TEST_SECRET_UNSET}"`), &s)
+	require.Error(t, err)
+}
+
 func TestMarshalSecretURL(t *testing.T) {
 	urlp, err := url.Parse("http://example.com/")
 	if err != nil {
@@ -500,18 +1955,20 @@ func TestEmptyFieldsAndRegex(t *testing.T) {
 	var expectedConf = Config{
 
 		Global: &GlobalConfig{
-			HTTPConfig:       &commoncfg.HTTPClientConfig{},
-			ResolveTimeout:   model.Duration(5 * time.Minute),
-			SMTPSmarthost:    HostPort{Host: "localhost", Port: "25"},
-			SMTPFrom:         "alertmanager@example.org",
-			HipchatAuthToken: "mysecret",
-			HipchatAPIURL:    mustParseURL("https://hipchat.foobar.org/"),
-			SlackAPIURL:      (*SecretURL)(mustParseURL("http://slack.example.com/")),
-			SMTPRequireTLS:   true,
-			PagerdutyURL:     mustParseURL("https://events.pagerduty.com/v2/enqueue"),
-			OpsGenieAPIURL:   mustParseURL("https://api.opsgenie.com/"),
-			WeChatAPIURL:     mustParseURL("https://qyapi.weixin.qq.com/cgi-bin/"),
-			VictorOpsAPIURL:  mustParseURL("https://alert.victorops.com/integrations/generic/20131114/alert/"),
+			HTTPConfig:        &HTTPClientConfig{},
+			ResolveTimeout:    model.Duration(5 * time.Minute),
+			SMTPSmarthost:     HostPort{Host: "localhost", Port: "25"},
+			SMTPFrom:          "alertmanager@example.org",
+			HipchatAuthToken:  "mysecret",
+			HipchatAPIURL:     mustParseURL("https://hipchat.foobar.org/"),
+			SlackAPIURL:       (*SecretURL)(mustParseURL("http://slack.example.com/")),
+			SMTPRequireTLS:    true,
+			PagerdutyURL:      mustParseURL("https://events.pagerduty.com/v2/enqueue"),
+			OpsGenieAPIURL:    mustParseURL("https://api.opsgenie.com/"),
+			WeChatAPIURL:      mustParseURL("https://qyapi.weixin.qq.com/cgi-bin/"),
+			VictorOpsAPIURL:   mustParseURL("https://alert.victorops.com/integrations/generic/20131114/alert/"),
+			HeartbeatInterval: model.Duration(heartbeat.DefaultInterval),
+			WatchdogTimeout:   model.Duration(watchdog.DefaultTimeout),
 		},
 
 		Templates: []string{
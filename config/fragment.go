@@ -0,0 +1,137 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// TeamLabel is the label a team-owned RouteFragment's top-level route must
+// match on, so that a fragment can only ever narrow alerts already labeled
+// as belonging to that team.
+const TeamLabel = "team"
+
+// RouteFragment is a team-owned slice of the routing tree: a subtree rooted
+// at Route, plus the Receivers it references. Fragments are written by
+// teams to files under Config.RouteFragmentsDir and merged into the main
+// routing tree on every load, so a central platform team does not have to
+// review and merge every team's routing change by hand.
+//
+// Route is optional: a fragment may define only Receivers, for a team that
+// wants to self-service a receiver definition -- e.g. one referenced from a
+// route already present in the main configuration file -- without also
+// owning a route subtree.
+type RouteFragment struct {
+	Team      string      `yaml:"team" json:"team"`
+	Route     *Route      `yaml:"route,omitempty" json:"route,omitempty"`
+	Receivers []*Receiver `yaml:"receivers,omitempty" json:"receivers,omitempty"`
+}
+
+// ParseRouteFragment parses and validates a RouteFragment for team out of s.
+func ParseRouteFragment(team, s string) (*RouteFragment, error) {
+	frag := &RouteFragment{}
+	if err := yaml.UnmarshalStrict([]byte(s), frag); err != nil {
+		return nil, err
+	}
+	if err := frag.Validate(team); err != nil {
+		return nil, err
+	}
+	return frag, nil
+}
+
+// Validate checks that frag is well-formed and properly scoped to team:
+// every receiver it defines is name-spaced under the team, and its
+// top-level route only ever matches alerts that already carry a matching
+// team label, so a team cannot accidentally -- or deliberately -- start
+// routing another team's alerts.
+func (frag *RouteFragment) Validate(team string) error {
+	if team == "" {
+		return fmt.Errorf("route fragment requires a non-empty team")
+	}
+	if frag.Team != team {
+		return fmt.Errorf("route fragment is for team %q, expected %q", frag.Team, team)
+	}
+	if frag.Route == nil && len(frag.Receivers) == 0 {
+		return fmt.Errorf("route fragment requires a route, receivers, or both")
+	}
+	if frag.Route != nil {
+		if frag.Route.Continue {
+			return fmt.Errorf("route fragment's top-level route cannot set continue")
+		}
+		if v, ok := frag.Route.Match[TeamLabel]; !ok || v != team {
+			return fmt.Errorf("route fragment's top-level route must match %s=%q", TeamLabel, team)
+		}
+	}
+
+	prefix := team + "-"
+	names := map[string]struct{}{}
+	for _, rcv := range frag.Receivers {
+		if !strings.HasPrefix(rcv.Name, prefix) {
+			return fmt.Errorf("receiver %q must be prefixed with %q to avoid colliding with another team's receivers", rcv.Name, prefix)
+		}
+		if _, ok := names[rcv.Name]; ok {
+			return fmt.Errorf("duplicate receiver name %q in route fragment", rcv.Name)
+		}
+		names[rcv.Name] = struct{}{}
+	}
+
+	if frag.Route == nil {
+		return nil
+	}
+	return checkReceiver(frag.Route, names)
+}
+
+// mergeRouteFragments reads every *.yml and *.yaml file in dir as a
+// RouteFragment -- named after the team it belongs to, e.g. "payments.yml"
+// for team "payments" -- validates it, and merges its route (if any) and
+// receivers into cfg. The merged configuration is re-validated as a whole
+// afterwards, so a fragment cannot introduce a receiver name collision with
+// the static configuration or another team's fragment.
+func mergeRouteFragments(dir string, cfg *Config) error {
+	var matches []string
+	for _, pattern := range []string{"*.yml", "*.yaml"} {
+		m, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return err
+		}
+		matches = append(matches, m...)
+	}
+	sort.Strings(matches)
+
+	for _, fn := range matches {
+		team := strings.TrimSuffix(filepath.Base(fn), filepath.Ext(fn))
+
+		content, err := ioutil.ReadFile(fn)
+		if err != nil {
+			return err
+		}
+		frag, err := ParseRouteFragment(team, string(content))
+		if err != nil {
+			return fmt.Errorf("%s: %s", fn, err)
+		}
+
+		if frag.Route != nil {
+			cfg.Route.Routes = append(cfg.Route.Routes, frag.Route)
+		}
+		cfg.Receivers = append(cfg.Receivers, frag.Receivers...)
+	}
+
+	return cfg.validate()
+}
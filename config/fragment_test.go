@@ -0,0 +1,204 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRouteFragmentValid(t *testing.T) {
+	frag, err := ParseRouteFragment("payments", `
+team: payments
+route:
+  match:
+    team: payments
+  receiver: payments-default
+receivers:
+- name: payments-default
+`)
+	require.NoError(t, err)
+	require.Equal(t, "payments", frag.Team)
+	require.Equal(t, "payments-default", frag.Route.Receiver)
+}
+
+func TestParseRouteFragmentWrongTeam(t *testing.T) {
+	_, err := ParseRouteFragment("payments", `
+team: checkout
+route:
+  match:
+    team: payments
+  receiver: payments-default
+`)
+	require.Error(t, err)
+}
+
+func TestParseRouteFragmentMissingTeamMatcher(t *testing.T) {
+	_, err := ParseRouteFragment("payments", `
+team: payments
+route:
+  receiver: payments-default
+receivers:
+- name: payments-default
+`)
+	require.Error(t, err)
+}
+
+func TestParseRouteFragmentReceiverNotPrefixed(t *testing.T) {
+	_, err := ParseRouteFragment("payments", `
+team: payments
+route:
+  match:
+    team: payments
+  receiver: default
+receivers:
+- name: default
+`)
+	require.Error(t, err)
+}
+
+func TestParseRouteFragmentUndefinedReceiver(t *testing.T) {
+	_, err := ParseRouteFragment("payments", `
+team: payments
+route:
+  match:
+    team: payments
+  receiver: payments-missing
+receivers:
+- name: payments-default
+`)
+	require.Error(t, err)
+}
+
+func TestParseRouteFragmentReceiversOnly(t *testing.T) {
+	frag, err := ParseRouteFragment("payments", `
+team: payments
+receivers:
+- name: payments-default
+`)
+	require.NoError(t, err)
+	require.Nil(t, frag.Route)
+	require.Len(t, frag.Receivers, 1)
+}
+
+func TestParseRouteFragmentEmpty(t *testing.T) {
+	_, err := ParseRouteFragment("payments", `
+team: payments
+`)
+	require.Error(t, err)
+}
+
+func TestLoadFileMergesRouteFragments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fragments")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "payments.yml"), []byte(`
+team: payments
+route:
+  match:
+    team: payments
+  receiver: payments-default
+receivers:
+- name: payments-default
+`), 0666))
+
+	confFile, err := ioutil.TempFile("", "alertmanager.yml")
+	require.NoError(t, err)
+	defer os.Remove(confFile.Name())
+
+	_, err = confFile.WriteString(`
+route:
+  receiver: default
+route_fragments_dir: ` + dir + `
+receivers:
+- name: default
+`)
+	require.NoError(t, err)
+	require.NoError(t, confFile.Close())
+
+	cfg, err := LoadFile(confFile.Name())
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Route.Routes, 1)
+	require.Equal(t, "payments-default", cfg.Route.Routes[0].Receiver)
+	require.Len(t, cfg.Receivers, 2)
+}
+
+func TestLoadFileMergesReceiverOnlyFragment(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fragments")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "payments.yml"), []byte(`
+team: payments
+receivers:
+- name: payments-default
+`), 0666))
+
+	confFile, err := ioutil.TempFile("", "alertmanager.yml")
+	require.NoError(t, err)
+	defer os.Remove(confFile.Name())
+
+	_, err = confFile.WriteString(`
+route:
+  receiver: default
+route_fragments_dir: ` + dir + `
+receivers:
+- name: default
+`)
+	require.NoError(t, err)
+	require.NoError(t, confFile.Close())
+
+	cfg, err := LoadFile(confFile.Name())
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Route.Routes, 0)
+	require.Len(t, cfg.Receivers, 2)
+}
+
+func TestLoadFileRejectsInvalidRouteFragment(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fragments")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "payments.yml"), []byte(`
+team: payments
+route:
+  receiver: default
+receivers:
+- name: default
+`), 0666))
+
+	confFile, err := ioutil.TempFile("", "alertmanager.yml")
+	require.NoError(t, err)
+	defer os.Remove(confFile.Name())
+
+	_, err = confFile.WriteString(`
+route:
+  receiver: default
+route_fragments_dir: ` + dir + `
+receivers:
+- name: default
+`)
+	require.NoError(t, err)
+	require.NoError(t, confFile.Close())
+
+	_, err = LoadFile(confFile.Name())
+	require.Error(t, err)
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	commoncfg "github.com/prometheus/common/config"
+)
+
+// OAuth2Config configures the OAuth2 client-credentials flow used to
+// authenticate outbound notifier requests against a gateway that mints its
+// own bearer tokens, rather than accepting a static bearer_token or
+// basic_auth credential.
+type OAuth2Config struct {
+	ClientID         string            `yaml:"client_id"`
+	ClientSecret     Secret            `yaml:"client_secret,omitempty"`
+	ClientSecretFile string            `yaml:"client_secret_file,omitempty"`
+	TokenURL         string            `yaml:"token_url"`
+	Scopes           []string          `yaml:"scopes,omitempty"`
+	EndpointParams   map[string]string `yaml:"endpoint_params,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *OAuth2Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain OAuth2Config
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.ClientID == "" {
+		return fmt.Errorf("missing client_id in oauth2 config")
+	}
+	if c.ClientSecret == "" && c.ClientSecretFile == "" {
+		return fmt.Errorf("missing client_secret or client_secret_file in oauth2 config")
+	}
+	if c.ClientSecret != "" && c.ClientSecretFile != "" {
+		return fmt.Errorf("at most one of client_secret and client_secret_file must be configured")
+	}
+	if c.TokenURL == "" {
+		return fmt.Errorf("missing token_url in oauth2 config")
+	}
+	return nil
+}
+
+func (c *OAuth2Config) clientSecret() (string, error) {
+	if c.ClientSecretFile == "" {
+		return string(c.ClientSecret), nil
+	}
+	b, err := ioutil.ReadFile(c.ClientSecretFile)
+	if err != nil {
+		return "", fmt.Errorf("reading client_secret_file: %s", err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// HTTPClientConfig configures an outbound HTTP client shared by notifier
+// integrations. It wraps commoncfg.HTTPClientConfig -- proxy URL, TLS,
+// basic auth and a static bearer token -- with an optional OAuth2
+// client-credentials flow layered on top, for receivers that sit behind a
+// gateway requiring its own minted bearer tokens.
+type HTTPClientConfig struct {
+	commoncfg.HTTPClientConfig `yaml:",inline"`
+	OAuth2                     *OAuth2Config `yaml:"oauth2,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *HTTPClientConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain HTTPClientConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.OAuth2 != nil && (c.BasicAuth != nil || len(c.BearerToken) > 0 || len(c.BearerTokenFile) > 0) {
+		return fmt.Errorf("at most one of oauth2, basic_auth, bearer_token and bearer_token_file must be configured")
+	}
+	return c.HTTPClientConfig.Validate()
+}
+
+// NewClient returns an *http.Client configured per cfg: commoncfg's proxy,
+// TLS and static-credential support, plus this package's OAuth2
+// client-credentials flow if configured. A nil cfg returns an unconfigured
+// default client. name identifies the client the way
+// commoncfg.NewClientFromConfig's name parameter does.
+func NewClient(cfg *HTTPClientConfig, name string) (*http.Client, error) {
+	if cfg == nil {
+		cfg = &HTTPClientConfig{}
+	}
+	client, err := commoncfg.NewClientFromConfig(cfg.HTTPClientConfig, name, false)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.OAuth2 != nil {
+		secret, err := cfg.OAuth2.clientSecret()
+		if err != nil {
+			return nil, err
+		}
+		client.Transport = &oauth2RoundTripper{
+			next:   client.Transport,
+			conf:   cfg.OAuth2,
+			secret: secret,
+		}
+	}
+	return client, nil
+}
+
+// tokenExpiryMargin is subtracted from a token's reported lifetime so that a
+// request in flight never races the token expiring underneath it.
+const tokenExpiryMargin = time.Minute
+
+// oauth2RoundTripper authenticates requests with a bearer token obtained via
+// the OAuth2 client-credentials flow, fetching a new one once the cached
+// token is unknown to still be valid.
+type oauth2RoundTripper struct {
+	next   http.RoundTripper
+	conf   *OAuth2Config
+	secret string
+
+	mtx         sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func (rt *oauth2RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := rt.token()
+	if err != nil {
+		return nil, fmt.Errorf("fetching oauth2 token: %s", err)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	next := rt.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return next.RoundTrip(req)
+}
+
+func (rt *oauth2RoundTripper) token() (string, error) {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	if rt.accessToken != "" && time.Now().Before(rt.expiresAt) {
+		return rt.accessToken, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", rt.conf.ClientID)
+	form.Set("client_secret", rt.secret)
+	if len(rt.conf.Scopes) > 0 {
+		form.Set("scope", strings.Join(rt.conf.Scopes, " "))
+	}
+	for k, v := range rt.conf.EndpointParams {
+		form.Set(k, v)
+	}
+
+	resp, err := http.Post(rt.conf.TokenURL, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode/100 != 2 {
+		return "", fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, body)
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", fmt.Errorf("decoding token response: %s", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("token response did not include an access_token")
+	}
+
+	rt.accessToken = tokenResp.AccessToken
+	if tokenResp.ExpiresIn > 0 {
+		rt.expiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn)*time.Second - tokenExpiryMargin)
+	} else {
+		// Unknown lifetime: treat the token as immediately stale so the next
+		// request fetches a fresh one rather than risk reusing an expired one.
+		rt.expiresAt = time.Time{}
+	}
+	return rt.accessToken, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestHTTPClientConfigOAuth2RejectsOtherAuth(t *testing.T) {
+	var c HTTPClientConfig
+	err := yaml.UnmarshalStrict([]byte(`
+oauth2:
+  client_id: id
+  client_secret: secret
+  token_url: http://example.com/token
+bearer_token: abc
+`), &c)
+	require.Error(t, err)
+}
+
+func TestOAuth2ConfigRequiresFields(t *testing.T) {
+	for _, s := range []string{
+		`token_url: http://example.com/token`,
+		`client_id: id`,
+		`client_id: id
+token_url: http://example.com/token`,
+	} {
+		var c OAuth2Config
+		require.Error(t, yaml.UnmarshalStrict([]byte(s), &c), "input: %s", s)
+	}
+}
+
+func TestNewClientFetchesAndCachesOAuth2Token(t *testing.T) {
+	var tokenRequests int
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		require.Equal(t, "id", r.Form.Get("client_id"))
+		require.Equal(t, "secret", r.Form.Get("client_secret"))
+		fmt.Fprint(w, `{"access_token": "tok-123", "expires_in": 3600}`)
+	}))
+	defer tokenSrv.Close()
+
+	var gotAuth string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer upstream.Close()
+
+	cfg := &HTTPClientConfig{
+		OAuth2: &OAuth2Config{
+			ClientID:     "id",
+			ClientSecret: "secret",
+			TokenURL:     tokenSrv.URL,
+		},
+	}
+	client, err := NewClient(cfg, "test")
+	require.NoError(t, err)
+
+	_, err = client.Get(upstream.URL)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer tok-123", gotAuth)
+
+	_, err = client.Get(upstream.URL)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer tok-123", gotAuth)
+	require.Equal(t, 1, tokenRequests, "cached token should not be refetched before it expires")
+}
+
+func TestNewClientNilConfig(t *testing.T) {
+	client, err := NewClient(nil, "test")
+	require.NoError(t, err)
+	require.NotNil(t, client)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 
 	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
 )
 
 var (
@@ -30,6 +31,147 @@ var (
 		NotifierConfig: NotifierConfig{
 			VSendResolved: true,
 		},
+		PayloadVersion: "4",
+	}
+
+	// DefaultWebhookSDConfig defines default values for webhook service
+	// discovery configurations.
+	DefaultWebhookSDConfig = WebhookSDConfig{
+		Scheme:          "http",
+		RefreshInterval: model.Duration(30 * time.Second),
+	}
+
+	// DefaultAlertmanagerConfig defines default values for Alertmanager
+	// forwarding configurations.
+	DefaultAlertmanagerConfig = AlertmanagerConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+	}
+
+	// DefaultExecConfig defines default values for Exec configurations.
+	DefaultExecConfig = ExecConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Timeout:       model.Duration(30 * time.Second),
+		MaxConcurrent: 1,
+	}
+
+	// DefaultIRCConfig defines default values for IRC configurations.
+	DefaultIRCConfig = IRCConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Port:          6667,
+		FloodInterval: model.Duration(2 * time.Second),
+		Message:       `{{ template "irc.default.message" . }}`,
+	}
+
+	// DefaultXMPPConfig defines default values for XMPP configurations.
+	DefaultXMPPConfig = XMPPConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Port:    5222,
+		Message: `{{ template "xmpp.default.message" . }}`,
+	}
+
+	// DefaultZulipConfig defines default values for Zulip configurations.
+	DefaultZulipConfig = ZulipConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Topic:   `{{ template "zulip.default.topic" . }}`,
+		Message: `{{ template "zulip.default.message" . }}`,
+	}
+
+	// DefaultLineNotifyConfig defines default values for LINE Notify
+	// configurations.
+	DefaultLineNotifyConfig = LineNotifyConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Message: `{{ template "linenotify.default.message" . }}`,
+	}
+
+	// DefaultKakaoTalkConfig defines default values for KakaoTalk
+	// configurations.
+	DefaultKakaoTalkConfig = KakaoTalkConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Message: `{{ template "kakaotalk.default.message" . }}`,
+	}
+
+	// DefaultSMPPConfig defines default values for SMPP configurations.
+	DefaultSMPPConfig = SMPPConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Port:       2775,
+		SystemType: "",
+		Message:    `{{ template "smpp.default.message" . }}`,
+	}
+
+	// DefaultAlertaConfig defines default values for Alerta configurations.
+	DefaultAlertaConfig = AlertaConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Environment: `{{ template "alerta.default.environment" . }}`,
+		Resource:    `{{ template "alerta.default.resource" . }}`,
+		Event:       `{{ template "alerta.default.event" . }}`,
+		Severity:    `{{ template "alerta.default.severity" . }}`,
+		Text:        `{{ template "alerta.default.text" . }}`,
+		Origin:      "alertmanager",
+	}
+
+	// DefaultZabbixConfig defines default values for Zabbix configurations.
+	DefaultZabbixConfig = ZabbixConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Port:  10051,
+		Key:   "alertmanager.trap",
+		Value: `{{ template "zabbix.default.value" . }}`,
+	}
+
+	// DefaultTelegramConfig defines default values for Telegram configurations.
+	DefaultTelegramConfig = TelegramConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		APIUrl:    "https://api.telegram.org",
+		ParseMode: "HTML",
+		Message:   `{{ template "telegram.default.message" . }}`,
+	}
+
+	// DefaultMSTeamsConfig defines default values for Microsoft Teams
+	// configurations.
+	DefaultMSTeamsConfig = MSTeamsConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Title: `{{ template "msteams.default.title" . }}`,
+		Text:  `{{ template "msteams.default.text" . }}`,
+	}
+
+	// DefaultSNSConfig defines default values for AWS SNS configurations.
+	DefaultSNSConfig = SNSConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Message: `{{ template "sns.default.message" . }}`,
+	}
+
+	// DefaultPubsubConfig defines default values for Google Pub/Sub
+	// configurations.
+	DefaultPubsubConfig = PubsubConfig{
+		NotifierConfig: NotifierConfig{
+			VSendResolved: true,
+		},
+		Message: `{{ template "pubsub.default.message" . }}`,
 	}
 
 	// DefaultEmailConfig defines default values for Email configurations.
@@ -155,19 +297,34 @@ type EmailConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
 	// Email address to notify.
-	To           string              `yaml:"to,omitempty" json:"to,omitempty"`
-	From         string              `yaml:"from,omitempty" json:"from,omitempty"`
-	Hello        string              `yaml:"hello,omitempty" json:"hello,omitempty"`
-	Smarthost    HostPort            `yaml:"smarthost,omitempty" json:"smarthost,omitempty"`
-	AuthUsername string              `yaml:"auth_username,omitempty" json:"auth_username,omitempty"`
-	AuthPassword Secret              `yaml:"auth_password,omitempty" json:"auth_password,omitempty"`
-	AuthSecret   Secret              `yaml:"auth_secret,omitempty" json:"auth_secret,omitempty"`
-	AuthIdentity string              `yaml:"auth_identity,omitempty" json:"auth_identity,omitempty"`
-	Headers      map[string]string   `yaml:"headers,omitempty" json:"headers,omitempty"`
-	HTML         string              `yaml:"html,omitempty" json:"html,omitempty"`
-	Text         string              `yaml:"text,omitempty" json:"text,omitempty"`
-	RequireTLS   *bool               `yaml:"require_tls,omitempty" json:"require_tls,omitempty"`
-	TLSConfig    commoncfg.TLSConfig `yaml:"tls_config,omitempty" json:"tls_config,omitempty"`
+	To        string   `yaml:"to,omitempty" json:"to,omitempty"`
+	From      string   `yaml:"from,omitempty" json:"from,omitempty"`
+	Hello     string   `yaml:"hello,omitempty" json:"hello,omitempty"`
+	Smarthost HostPort `yaml:"smarthost,omitempty" json:"smarthost,omitempty"`
+	// AuthUsername selects the SMTP auth mechanism: PLAIN or LOGIN need
+	// AuthPassword, CRAM-MD5 needs AuthSecret instead. Auth is skipped if
+	// AuthUsername is empty.
+	AuthUsername string `yaml:"auth_username,omitempty" json:"auth_username,omitempty"`
+	AuthPassword Secret `yaml:"auth_password,omitempty" json:"auth_password,omitempty"`
+	// AuthPasswordFile, if set, is read for the SMTP auth password instead of
+	// AuthPassword, and re-read on every email sent so that a mounted secret
+	// can be rotated without an Alertmanager reload. At most one of
+	// AuthPassword and AuthPasswordFile may be set.
+	AuthPasswordFile string            `yaml:"auth_password_file,omitempty" json:"auth_password_file,omitempty"`
+	AuthSecret       Secret            `yaml:"auth_secret,omitempty" json:"auth_secret,omitempty"`
+	AuthIdentity     string            `yaml:"auth_identity,omitempty" json:"auth_identity,omitempty"`
+	Headers          map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`
+	HTML             string            `yaml:"html,omitempty" json:"html,omitempty"`
+	Text             string            `yaml:"text,omitempty" json:"text,omitempty"`
+	// RequireTLS, true by default, rejects the smarthost if it does not
+	// advertise STARTTLS; implicit TLS is instead used automatically for
+	// a Smarthost port of 465, regardless of this setting.
+	RequireTLS *bool               `yaml:"require_tls,omitempty" json:"require_tls,omitempty"`
+	TLSConfig  commoncfg.TLSConfig `yaml:"tls_config,omitempty" json:"tls_config,omitempty"`
+	// VERP tags the envelope sender of outgoing messages so that an
+	// asynchronous bounce can be correlated back to the message that
+	// caused it, even if the bounce is not a well-formed DSN.
+	VERP bool `yaml:"verp,omitempty" json:"verp,omitempty"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
@@ -180,6 +337,9 @@ func (c *EmailConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if c.To == "" {
 		return fmt.Errorf("missing to address in email config")
 	}
+	if c.AuthPassword != "" && c.AuthPasswordFile != "" {
+		return fmt.Errorf("at most one of auth_password & auth_password_file must be configured")
+	}
 	// Header names are case-insensitive, check for collisions.
 	normalizedHeaders := map[string]string{}
 	for h, v := range c.Headers {
@@ -198,21 +358,26 @@ func (c *EmailConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 type PagerdutyConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
-
-	ServiceKey  Secret            `yaml:"service_key,omitempty" json:"service_key,omitempty"`
-	RoutingKey  Secret            `yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
-	URL         *URL              `yaml:"url,omitempty" json:"url,omitempty"`
-	Client      string            `yaml:"client,omitempty" json:"client,omitempty"`
-	ClientURL   string            `yaml:"client_url,omitempty" json:"client_url,omitempty"`
-	Description string            `yaml:"description,omitempty" json:"description,omitempty"`
-	Details     map[string]string `yaml:"details,omitempty" json:"details,omitempty"`
-	Images      []PagerdutyImage  `yaml:"images,omitempty" json:"images,omitempty"`
-	Links       []PagerdutyLink   `yaml:"links,omitempty" json:"links,omitempty"`
-	Severity    string            `yaml:"severity,omitempty" json:"severity,omitempty"`
-	Class       string            `yaml:"class,omitempty" json:"class,omitempty"`
-	Component   string            `yaml:"component,omitempty" json:"component,omitempty"`
-	Group       string            `yaml:"group,omitempty" json:"group,omitempty"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	ServiceKey     Secret `yaml:"service_key,omitempty" json:"service_key,omitempty"`
+	ServiceKeyFile string `yaml:"service_key_file,omitempty" json:"service_key_file,omitempty"`
+	RoutingKey     Secret `yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
+	RoutingKeyFile string `yaml:"routing_key_file,omitempty" json:"routing_key_file,omitempty"`
+
+	URL         *URL   `yaml:"url,omitempty" json:"url,omitempty"`
+	Client      string `yaml:"client,omitempty" json:"client,omitempty"`
+	ClientURL   string `yaml:"client_url,omitempty" json:"client_url,omitempty"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// Details is templated per notification and populates the event's
+	// custom_details, typically from alert labels/annotations.
+	Details   map[string]string `yaml:"details,omitempty" json:"details,omitempty"`
+	Images    []PagerdutyImage  `yaml:"images,omitempty" json:"images,omitempty"`
+	Links     []PagerdutyLink   `yaml:"links,omitempty" json:"links,omitempty"`
+	Severity  string            `yaml:"severity,omitempty" json:"severity,omitempty"`
+	Class     string            `yaml:"class,omitempty" json:"class,omitempty"`
+	Component string            `yaml:"component,omitempty" json:"component,omitempty"`
+	Group     string            `yaml:"group,omitempty" json:"group,omitempty"`
 }
 
 // PagerdutyLink is a link
@@ -235,9 +400,15 @@ func (c *PagerdutyConfig) UnmarshalYAML(unmarshal func(interface{}) error) error
 	if err := unmarshal((*plain)(c)); err != nil {
 		return err
 	}
-	if c.RoutingKey == "" && c.ServiceKey == "" {
+	if c.RoutingKey == "" && c.RoutingKeyFile == "" && c.ServiceKey == "" && c.ServiceKeyFile == "" {
 		return fmt.Errorf("missing service or routing key in PagerDuty config")
 	}
+	if c.RoutingKey != "" && c.RoutingKeyFile != "" {
+		return fmt.Errorf("at most one of routing_key & routing_key_file must be configured")
+	}
+	if c.ServiceKey != "" && c.ServiceKeyFile != "" {
+		return fmt.Errorf("at most one of service_key & service_key_file must be configured")
+	}
 	if c.Details == nil {
 		c.Details = make(map[string]string)
 	}
@@ -338,14 +509,18 @@ func (c *SlackField) UnmarshalYAML(unmarshal func(interface{}) error) error {
 type SlackConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 
+	// APIURL is the incoming webhook URL Slack generates for the target
+	// channel. Required unless set globally via global.slack_api_url.
 	APIURL *SecretURL `yaml:"api_url,omitempty" json:"api_url,omitempty"`
 
 	// Slack channel override, (like #other-channel or @username).
 	Channel  string `yaml:"channel,omitempty" json:"channel,omitempty"`
 	Username string `yaml:"username,omitempty" json:"username,omitempty"`
-	Color    string `yaml:"color,omitempty" json:"color,omitempty"`
+	// Color is templated per notification and defaults to "danger" for a
+	// firing alert and "good" for a resolved one.
+	Color string `yaml:"color,omitempty" json:"color,omitempty"`
 
 	Title       string         `yaml:"title,omitempty" json:"title,omitempty"`
 	TitleLink   string         `yaml:"title_link,omitempty" json:"title_link,omitempty"`
@@ -376,7 +551,7 @@ func (c *SlackConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 type HipchatConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 
 	APIURL        *URL   `yaml:"api_url,omitempty" json:"api_url,omitempty"`
 	AuthToken     Secret `yaml:"auth_token,omitempty" json:"auth_token,omitempty"`
@@ -405,12 +580,57 @@ func (c *HipchatConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 type WebhookConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 
 	// URL to send POST request to.
 	URL *URL `yaml:"url" json:"url"`
+
+	// URLFile, if set, is read for the target URL instead of URL, and
+	// re-read on every notification so that a mounted secret can be rotated
+	// without an Alertmanager reload. Exactly one of URL, URLFile and
+	// ServiceDiscovery must be set.
+	URLFile string `yaml:"url_file,omitempty" json:"url_file,omitempty"`
+
+	// PayloadVersion is the schema version of the JSON body posted to URL.
+	// Consumers that still expect the older schema can pin this to keep
+	// working across Alertmanager upgrades.
+	PayloadVersion string `yaml:"payload_version,omitempty" json:"payload_version,omitempty"`
+
+	// ServiceDiscovery, if set, resolves the webhook target dynamically
+	// instead of using a static URL. Exactly one of URL and ServiceDiscovery
+	// must be set.
+	ServiceDiscovery *WebhookSDConfig `yaml:"service_discovery,omitempty" json:"service_discovery,omitempty"`
+
+	// HMACSecret, if set, signs the request body with HMAC-SHA256 and sends
+	// the hex-encoded result in the X-Alertmanager-Signature header, so the
+	// receiver can verify the request came from this Alertmanager.
+	HMACSecret Secret `yaml:"hmac_secret,omitempty" json:"hmac_secret,omitempty"`
+}
+
+// WebhookSDConfig discovers webhook targets via DNS, as exposed by Consul's
+// DNS interface or a Kubernetes headless service, instead of a fixed URL. A
+// target is only used if it passes a TCP health check, and targets are
+// rotated between on every notification so that a failing target is not
+// retried until its peers have been given a chance.
+type WebhookSDConfig struct {
+	// DNSName is resolved as a SRV record to obtain the set of candidate
+	// targets, e.g. "_http._tcp.alerts.service.consul" or the equivalent
+	// name for a Kubernetes headless service.
+	DNSName string `yaml:"dns_name" json:"dns_name"`
+
+	// Scheme and Path are combined with each resolved host:port to build the
+	// webhook URL.
+	Scheme string `yaml:"scheme,omitempty" json:"scheme,omitempty"`
+	Path   string `yaml:"path,omitempty" json:"path,omitempty"`
+
+	// RefreshInterval controls how often DNSName is re-resolved.
+	RefreshInterval model.Duration `yaml:"refresh_interval,omitempty" json:"refresh_interval,omitempty"`
 }
 
+// WebhookPayloadVersions are the JSON payload schema versions understood by
+// the webhook notifier, in the order they were introduced.
+var WebhookPayloadVersions = []string{"3", "4"}
+
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
 func (c *WebhookConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	*c = DefaultWebhookConfig
@@ -418,12 +638,388 @@ func (c *WebhookConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	if err := unmarshal((*plain)(c)); err != nil {
 		return err
 	}
-	if c.URL == nil {
-		return fmt.Errorf("missing URL in webhook config")
+	set := 0
+	for _, isSet := range []bool{c.URL != nil, c.URLFile != "", c.ServiceDiscovery != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set == 0 {
+		return fmt.Errorf("missing url, url_file or service_discovery in webhook config")
+	}
+	if set > 1 {
+		return fmt.Errorf("at most one of url, url_file and service_discovery must be configured")
 	}
-	if c.URL.Scheme != "https" && c.URL.Scheme != "http" {
+	if c.URL != nil && c.URL.Scheme != "https" && c.URL.Scheme != "http" {
 		return fmt.Errorf("scheme required for webhook url")
 	}
+	var known bool
+	for _, v := range WebhookPayloadVersions {
+		if c.PayloadVersion == v {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return fmt.Errorf("unsupported webhook payload_version %q, must be one of %v", c.PayloadVersion, WebhookPayloadVersions)
+	}
+	return nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *WebhookSDConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultWebhookSDConfig
+	type plain WebhookSDConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.DNSName == "" {
+		return fmt.Errorf("missing dns_name in webhook service_discovery config")
+	}
+	if c.Scheme != "https" && c.Scheme != "http" {
+		return fmt.Errorf("scheme required for webhook service_discovery")
+	}
+	return nil
+}
+
+// IRCConfig configures notifications posted to an IRC channel.
+type IRCConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	// Server and Port identify the IRC network to connect to.
+	Server string `yaml:"server" json:"server"`
+	Port   int    `yaml:"port,omitempty" json:"port,omitempty"`
+	// TLS enables a TLS connection to Server.
+	TLS       bool                `yaml:"tls,omitempty" json:"tls,omitempty"`
+	TLSConfig commoncfg.TLSConfig `yaml:"tls_config,omitempty" json:"tls_config,omitempty"`
+
+	// Nick is the nickname the notifier connects as.
+	Nick string `yaml:"nick" json:"nick"`
+	// SASLUser and SASLPassword, if set, are used to authenticate via SASL
+	// PLAIN before joining Channel.
+	SASLUser     string `yaml:"sasl_user,omitempty" json:"sasl_user,omitempty"`
+	SASLPassword Secret `yaml:"sasl_password,omitempty" json:"sasl_password,omitempty"`
+
+	// Channel to join and send notifications to.
+	Channel string `yaml:"channel" json:"channel"`
+
+	// Message is the text template sent as a single PRIVMSG per alert group.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+
+	// FloodInterval is the minimum time between two PRIVMSGs, so that the
+	// notifier does not trip the network's flood protection.
+	FloodInterval model.Duration `yaml:"flood_interval,omitempty" json:"flood_interval,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *IRCConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultIRCConfig
+	type plain IRCConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.Server == "" {
+		return fmt.Errorf("missing server in IRC config")
+	}
+	if c.Nick == "" {
+		return fmt.Errorf("missing nick in IRC config")
+	}
+	if c.Channel == "" {
+		return fmt.Errorf("missing channel in IRC config")
+	}
+	return nil
+}
+
+// XMPPConfig configures notifications posted to an XMPP MUC room.
+type XMPPConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	// Server and Port identify the XMPP server to connect to.
+	Server string `yaml:"server" json:"server"`
+	Port   int    `yaml:"port,omitempty" json:"port,omitempty"`
+	// TLS enables a TLS connection to Server.
+	TLS       bool                `yaml:"tls,omitempty" json:"tls,omitempty"`
+	TLSConfig commoncfg.TLSConfig `yaml:"tls_config,omitempty" json:"tls_config,omitempty"`
+
+	// Username is the bare JID (e.g. "alertmanager@example.com") the notifier
+	// authenticates as via SASL PLAIN. Password is its SASL PLAIN password.
+	Username string `yaml:"username" json:"username"`
+	Password Secret `yaml:"password" json:"password"`
+
+	// Room is the bare JID of the MUC room to join (e.g.
+	// "alerts@conference.example.com"). Nick is the nickname used inside it.
+	Room string `yaml:"room" json:"room"`
+	Nick string `yaml:"nick" json:"nick"`
+
+	// Message is the text template sent as a single groupchat message per
+	// alert group.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *XMPPConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultXMPPConfig
+	type plain XMPPConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.Server == "" {
+		return fmt.Errorf("missing server in XMPP config")
+	}
+	if c.Username == "" {
+		return fmt.Errorf("missing username in XMPP config")
+	}
+	if c.Room == "" {
+		return fmt.Errorf("missing room in XMPP config")
+	}
+	if c.Nick == "" {
+		return fmt.Errorf("missing nick in XMPP config")
+	}
+	return nil
+}
+
+// ZulipConfig configures notifications posted to a Zulip stream.
+type ZulipConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// APIURL is the base URL of the Zulip server (e.g.
+	// https://yourorg.zulipchat.com/api/v1).
+	APIURL *URL `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+
+	// BotEmail and APIKey authenticate as a Zulip bot.
+	BotEmail string `yaml:"bot_email" json:"bot_email"`
+	APIKey   Secret `yaml:"api_key" json:"api_key"`
+
+	// Stream is the channel the notifier posts to.
+	Stream string `yaml:"stream" json:"stream"`
+
+	// Topic groups messages for the same incident together. It defaults to
+	// a topic derived from the alert group key, so that all notifications
+	// for an ongoing incident thread in one place.
+	Topic string `yaml:"topic,omitempty" json:"topic,omitempty"`
+
+	// Message is the text template sent as the content of the Zulip message.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *ZulipConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultZulipConfig
+	type plain ZulipConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.APIURL == nil {
+		return fmt.Errorf("missing api_url in Zulip config")
+	}
+	if c.BotEmail == "" {
+		return fmt.Errorf("missing bot_email in Zulip config")
+	}
+	if c.APIKey == "" {
+		return fmt.Errorf("missing api_key in Zulip config")
+	}
+	if c.Stream == "" {
+		return fmt.Errorf("missing stream in Zulip config")
+	}
+	return nil
+}
+
+// LineNotifyConfig configures notifications sent via the LINE Notify API.
+type LineNotifyConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// Token is the personal or group access token issued by LINE Notify.
+	Token Secret `yaml:"token" json:"token"`
+
+	// Message is the text sent as the notification.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *LineNotifyConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultLineNotifyConfig
+	type plain LineNotifyConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.Token == "" {
+		return fmt.Errorf("missing token in LINE Notify config")
+	}
+	return nil
+}
+
+// KakaoTalkConfig configures notifications sent as KakaoTalk business
+// "memo to me" messages via the Kakao API.
+type KakaoTalkConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// AccessToken is an OAuth2 access token issued for the Kakao app, scoped
+	// to the talk_message permission.
+	AccessToken Secret `yaml:"access_token" json:"access_token"`
+
+	// Message is the text sent as the notification.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *KakaoTalkConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultKakaoTalkConfig
+	type plain KakaoTalkConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.AccessToken == "" {
+		return fmt.Errorf("missing access_token in KakaoTalk config")
+	}
+	return nil
+}
+
+// SMPPConfig configures notifications sent as SMS through an SMPP gateway.
+type SMPPConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	// Server and Port identify the SMPP gateway to bind to.
+	Server string `yaml:"server" json:"server"`
+	Port   int    `yaml:"port,omitempty" json:"port,omitempty"`
+	// TLS enables a TLS connection to Server.
+	TLS       bool                `yaml:"tls,omitempty" json:"tls,omitempty"`
+	TLSConfig commoncfg.TLSConfig `yaml:"tls_config,omitempty" json:"tls_config,omitempty"`
+
+	// SystemID and Password authenticate the bind_transmitter session.
+	// SystemType identifies the type of ESME binding, as assigned by the
+	// SMSC operator; most gateways accept an empty string.
+	SystemID   string `yaml:"system_id" json:"system_id"`
+	Password   Secret `yaml:"password" json:"password"`
+	SystemType string `yaml:"system_type,omitempty" json:"system_type,omitempty"`
+
+	// SourceAddr is the sender address submitted with every message.
+	SourceAddr string `yaml:"source_addr" json:"source_addr"`
+
+	// DestinationAddr is the phone number the notification is sent to.
+	DestinationAddr string `yaml:"destination_addr" json:"destination_addr"`
+
+	// Message is the text sent as the SMS body.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *SMPPConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultSMPPConfig
+	type plain SMPPConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.Server == "" {
+		return fmt.Errorf("missing server in SMPP config")
+	}
+	if c.SystemID == "" {
+		return fmt.Errorf("missing system_id in SMPP config")
+	}
+	if c.SourceAddr == "" {
+		return fmt.Errorf("missing source_addr in SMPP config")
+	}
+	if c.DestinationAddr == "" {
+		return fmt.Errorf("missing destination_addr in SMPP config")
+	}
+	return nil
+}
+
+// AlertaConfig configures notifications forwarded to an Alerta API.
+type AlertaConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// APIURL is the base URL of the Alerta API (e.g. https://alerta.example.com/api).
+	APIURL *URL `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+
+	// APIKey authenticates against the Alerta API.
+	APIKey Secret `yaml:"api_key" json:"api_key"`
+
+	// Environment, Resource and Event identify the alert within Alerta.
+	// Alerta deduplicates alerts sharing the same environment, resource and
+	// event, so these are derived from the alert's group labels by default.
+	Environment string `yaml:"environment,omitempty" json:"environment,omitempty"`
+	Resource    string `yaml:"resource,omitempty" json:"resource,omitempty"`
+	Event       string `yaml:"event,omitempty" json:"event,omitempty"`
+
+	// Severity maps the alert's severity label to one of Alerta's accepted
+	// severities (critical, major, minor, warning, informational, normal).
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty"`
+
+	// Group further classifies the alert within Alerta, e.g. by service.
+	Group string `yaml:"group,omitempty" json:"group,omitempty"`
+
+	// Text is the human-readable description sent to Alerta.
+	Text string `yaml:"text,omitempty" json:"text,omitempty"`
+
+	// Service lists the services the alert applies to.
+	Service []string `yaml:"service,omitempty" json:"service,omitempty"`
+
+	// Tags are attached to the Alerta alert as free-form labels.
+	Tags []string `yaml:"tags,omitempty" json:"tags,omitempty"`
+
+	// Origin identifies the monitoring tool that raised the alert.
+	Origin string `yaml:"origin,omitempty" json:"origin,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *AlertaConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultAlertaConfig
+	type plain AlertaConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.APIURL == nil {
+		return fmt.Errorf("missing api_url in Alerta config")
+	}
+	if c.APIKey == "" {
+		return fmt.Errorf("missing api_key in Alerta config")
+	}
+	return nil
+}
+
+// ZabbixConfig configures notifications forwarded to a Zabbix server's
+// trapper interface.
+type ZabbixConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	// Server and Port identify the Zabbix trapper to send data to.
+	Server string `yaml:"server" json:"server"`
+	Port   int    `yaml:"port,omitempty" json:"port,omitempty"`
+
+	// Host is the name of the host, as configured in Zabbix, that the item
+	// below belongs to.
+	Host string `yaml:"host" json:"host"`
+
+	// Key is the key of the Zabbix trapper item that receives the value.
+	// Alerts for the same host and key are deduplicated by Zabbix itself, so
+	// it doubles as the dedup key for a given alert group.
+	Key string `yaml:"key,omitempty" json:"key,omitempty"`
+
+	// Value is the text sent as the trapper item's value.
+	Value string `yaml:"value,omitempty" json:"value,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *ZabbixConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultZabbixConfig
+	type plain ZabbixConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.Server == "" {
+		return fmt.Errorf("missing server in Zabbix config")
+	}
+	if c.Host == "" {
+		return fmt.Errorf("missing host in Zabbix config")
+	}
 	return nil
 }
 
@@ -431,7 +1027,7 @@ func (c *WebhookConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 type WechatConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 
 	APISecret Secret `yaml:"api_secret,omitempty" json:"api_secret,omitempty"`
 	CorpID    string `yaml:"corp_id,omitempty" json:"corp_id,omitempty"`
@@ -454,9 +1050,14 @@ func (c *WechatConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 type OpsGenieConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 
-	APIKey      Secret                    `yaml:"api_key,omitempty" json:"api_key,omitempty"`
+	APIKey Secret `yaml:"api_key,omitempty" json:"api_key,omitempty"`
+	// APIKeyFile, if set, is read for the API key instead of APIKey, and
+	// re-read on every notification so that a mounted secret can be rotated
+	// without an Alertmanager reload. At most one of APIKey and APIKeyFile
+	// may be set.
+	APIKeyFile  string                    `yaml:"api_key_file,omitempty" json:"api_key_file,omitempty"`
 	APIURL      *URL                      `yaml:"api_url,omitempty" json:"api_url,omitempty"`
 	Message     string                    `yaml:"message,omitempty" json:"message,omitempty"`
 	Description string                    `yaml:"description,omitempty" json:"description,omitempty"`
@@ -465,7 +1066,10 @@ type OpsGenieConfig struct {
 	Responders  []OpsGenieConfigResponder `yaml:"responders,omitempty" json:"responders,omitempty"`
 	Tags        string                    `yaml:"tags,omitempty" json:"tags,omitempty"`
 	Note        string                    `yaml:"note,omitempty" json:"note,omitempty"`
-	Priority    string                    `yaml:"priority,omitempty" json:"priority,omitempty"`
+	// Priority is templated per notification, so it can be mapped from an
+	// alert label (e.g. `{{ .CommonLabels.priority }}`) instead of being
+	// fixed per receiver.
+	Priority string `yaml:"priority,omitempty" json:"priority,omitempty"`
 }
 
 const opsgenieValidTypesRe = `^(team|user|escalation|schedule)$`
@@ -479,6 +1083,9 @@ func (c *OpsGenieConfig) UnmarshalYAML(unmarshal func(interface{}) error) error
 	if err := unmarshal((*plain)(c)); err != nil {
 		return err
 	}
+	if c.APIKey != "" && c.APIKeyFile != "" {
+		return fmt.Errorf("at most one of api_key & api_key_file must be configured")
+	}
 
 	for _, r := range c.Responders {
 		if r.ID == "" && r.Username == "" && r.Name == "" {
@@ -508,10 +1115,17 @@ type OpsGenieConfigResponder struct {
 type VictorOpsConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
-
-	APIKey            Secret            `yaml:"api_key" json:"api_key"`
-	APIURL            *URL              `yaml:"api_url" json:"api_url"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	APIKey Secret `yaml:"api_key" json:"api_key"`
+	// APIKeyFile, if set, is read for the API key instead of APIKey, and
+	// re-read on every notification so that a mounted secret can be rotated
+	// without an Alertmanager reload. At most one of APIKey and APIKeyFile
+	// may be set.
+	APIKeyFile string `yaml:"api_key_file,omitempty" json:"api_key_file,omitempty"`
+	APIURL     *URL   `yaml:"api_url" json:"api_url"`
+	// RoutingKey is templated per notification and is appended to APIURL to
+	// select the VictorOps routing key/team that should receive the alert.
 	RoutingKey        string            `yaml:"routing_key" json:"routing_key"`
 	MessageType       string            `yaml:"message_type" json:"message_type"`
 	StateMessage      string            `yaml:"state_message" json:"state_message"`
@@ -530,6 +1144,9 @@ func (c *VictorOpsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error
 	if c.RoutingKey == "" {
 		return fmt.Errorf("missing Routing key in VictorOps config")
 	}
+	if c.APIKey != "" && c.APIKeyFile != "" {
+		return fmt.Errorf("at most one of api_key & api_key_file must be configured")
+	}
 
 	reservedFields := []string{"routing_key", "message_type", "state_message", "entity_display_name", "monitoring_tool", "entity_id", "entity_state"}
 
@@ -559,7 +1176,7 @@ func (d duration) MarshalText() ([]byte, error) {
 type PushoverConfig struct {
 	NotifierConfig `yaml:",inline" json:",inline"`
 
-	HTTPConfig *commoncfg.HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
 
 	UserKey  Secret   `yaml:"user_key,omitempty" json:"user_key,omitempty"`
 	Token    Secret   `yaml:"token,omitempty" json:"token,omitempty"`
@@ -589,3 +1206,262 @@ func (c *PushoverConfig) UnmarshalYAML(unmarshal func(interface{}) error) error
 	}
 	return nil
 }
+
+// AlertmanagerConfig configures a receiver that re-posts the grouped alerts
+// to another Alertmanager instance's v2 alert API, so that a regional
+// instance can pre-aggregate and forward only the alerts it decides matter to
+// a central instance.
+type AlertmanagerConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// URL is the base URL of the upstream Alertmanager, e.g.
+	// http://central-alertmanager:9093.
+	URL *URL `yaml:"url" json:"url"`
+
+	// ExternalLabels are merged into every forwarded alert's labels,
+	// overwriting any label of the same name the alert already carries, so
+	// the upstream instance can tell which regional instance an alert came
+	// from.
+	ExternalLabels map[string]string `yaml:"external_labels,omitempty" json:"external_labels,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *AlertmanagerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultAlertmanagerConfig
+	type plain AlertmanagerConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.URL == nil {
+		return fmt.Errorf("missing url in Alertmanager config")
+	}
+	return nil
+}
+
+// ExecConfig configures a receiver that runs a local command (or plugin
+// binary) with the rendered alert group, as an escape hatch for exotic
+// integrations that don't warrant their own notifier package.
+type ExecConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	// Command is the path to the executable to run. Args are passed to it
+	// as additional arguments. The rendered alert group is written to its
+	// stdin as JSON; Alertmanager does not interpret stdout, only the exit
+	// code (zero for success, non-zero for a retryable failure).
+	Command string   `yaml:"command" json:"command"`
+	Args    []string `yaml:"args,omitempty" json:"args,omitempty"`
+
+	// Timeout bounds how long a single invocation of Command may run
+	// before it is killed and treated as a failed, retryable notification.
+	Timeout model.Duration `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+
+	// MaxConcurrent bounds how many invocations of Command may run at
+	// once, so a slow or hanging plugin cannot exhaust the notify
+	// pipeline's worker pool.
+	MaxConcurrent int `yaml:"max_concurrent,omitempty" json:"max_concurrent,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *ExecConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultExecConfig
+	type plain ExecConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.Command == "" {
+		return fmt.Errorf("missing command in Exec config")
+	}
+	if c.MaxConcurrent <= 0 {
+		return fmt.Errorf("max_concurrent must be positive in Exec config")
+	}
+	return nil
+}
+
+// TelegramConfig configures notifications sent via a Telegram bot.
+type TelegramConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// APIUrl is the base URL of the Telegram Bot API.
+	APIUrl string `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+
+	// BotToken authenticates as a Telegram bot.
+	BotToken Secret `yaml:"bot_token" json:"bot_token"`
+
+	// ChatID is the chat or channel to post to. It is signed because
+	// Telegram identifies group and channel chats with negative IDs.
+	ChatID int64 `yaml:"chat_id" json:"chat_id"`
+
+	// Message is the text template sent as the content of the Telegram
+	// message.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+
+	// ParseMode selects how Telegram renders Message. One of "MarkdownV2",
+	// "Markdown" or "HTML".
+	ParseMode string `yaml:"parse_mode,omitempty" json:"parse_mode,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *TelegramConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultTelegramConfig
+	type plain TelegramConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.BotToken == "" {
+		return fmt.Errorf("missing bot_token in Telegram config")
+	}
+	if c.ChatID == 0 {
+		return fmt.Errorf("missing chat_id in Telegram config")
+	}
+	switch c.ParseMode {
+	case "MarkdownV2", "Markdown", "HTML":
+	default:
+		return fmt.Errorf("unknown parse_mode in Telegram config: %q", c.ParseMode)
+	}
+	return nil
+}
+
+// MSTeamsConfig configures notifications sent via a Microsoft Teams
+// incoming webhook connector.
+type MSTeamsConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// WebhookURL is the connector webhook to post the card to. It is a
+	// SecretURL because the URL itself authenticates the request.
+	WebhookURL *SecretURL `yaml:"webhook_url,omitempty" json:"webhook_url,omitempty"`
+
+	// Title and Text are rendered into the summary and body of the card.
+	Title string `yaml:"title,omitempty" json:"title,omitempty"`
+	Text  string `yaml:"text,omitempty" json:"text,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *MSTeamsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultMSTeamsConfig
+	type plain MSTeamsConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.WebhookURL == nil {
+		return fmt.Errorf("missing webhook_url in MSTeams config")
+	}
+	return nil
+}
+
+// snsTopicARNRe extracts the region from a topic ARN of the form
+// arn:aws:sns:REGION:ACCOUNT:NAME (or the arn:aws-cn/arn:aws-us-gov
+// partitions).
+var snsTopicARNRe = regexp.MustCompile(`^arn:aws[a-z0-9-]*:sns:([a-z0-9-]+):\d+:[^:]+$`)
+
+// SNSConfig configures notifications published to an AWS SNS topic.
+type SNSConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// TopicARN is the topic to publish to, e.g.
+	// arn:aws:sns:us-east-1:123456789012:my-topic. Region is derived from
+	// it unless Region is set explicitly.
+	TopicARN string `yaml:"topic_arn" json:"topic_arn"`
+
+	// Region overrides the region derived from TopicARN, e.g. to reach a
+	// VPC endpoint or a non-standard partition.
+	Region string `yaml:"region,omitempty" json:"region,omitempty"`
+
+	// AccessKey and SecretKey authenticate the request with static IAM
+	// user credentials. If unset, credentials are instead read from the
+	// AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY/AWS_SESSION_TOKEN environment
+	// variables, falling back to the EC2/ECS instance IAM role.
+	AccessKey    Secret `yaml:"access_key,omitempty" json:"access_key,omitempty"`
+	SecretKey    Secret `yaml:"secret_key,omitempty" json:"secret_key,omitempty"`
+	SessionToken Secret `yaml:"session_token,omitempty" json:"session_token,omitempty"`
+
+	// Message is the text template published as the SNS message body.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+
+	// Subject is an optional text template published as the SNS Subject
+	// attribute, used by e.g. email subscribers of the topic.
+	Subject string `yaml:"subject,omitempty" json:"subject,omitempty"`
+
+	// AttachLabels, if true (the default), publishes every label of the
+	// group's common labels as an SNS message attribute of type String, so
+	// that subscribers can filter deliveries without parsing the message
+	// body.
+	AttachLabels *bool `yaml:"attach_labels,omitempty" json:"attach_labels,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *SNSConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultSNSConfig
+	type plain SNSConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.TopicARN == "" {
+		return fmt.Errorf("missing topic_arn in SNS config")
+	}
+	m := snsTopicARNRe.FindStringSubmatch(c.TopicARN)
+	if m == nil {
+		return fmt.Errorf("invalid topic_arn in SNS config: %q", c.TopicARN)
+	}
+	if c.Region == "" {
+		c.Region = m[1]
+	}
+	if c.AttachLabels == nil {
+		c.AttachLabels = new(bool)
+		*c.AttachLabels = true
+	}
+	return nil
+}
+
+// PubsubConfig configures notifications published to a Google Cloud
+// Pub/Sub topic.
+type PubsubConfig struct {
+	NotifierConfig `yaml:",inline" json:",inline"`
+
+	HTTPConfig *HTTPClientConfig `yaml:"http_config,omitempty" json:"http_config,omitempty"`
+
+	// ProjectID and Topic identify the Pub/Sub topic to publish to.
+	ProjectID string `yaml:"project_id" json:"project_id"`
+	Topic     string `yaml:"topic" json:"topic"`
+
+	// CredentialsFile, if set, is the path to a GCP service account JSON
+	// key used to obtain an access token. If unset, an access token is
+	// instead requested from the GCE/GKE metadata server's default service
+	// account, analogous to an AWS IAM instance role.
+	CredentialsFile string `yaml:"credentials_file,omitempty" json:"credentials_file,omitempty"`
+
+	// Message is the text template published as the Pub/Sub message data.
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+
+	// AttachLabels, if true (the default), publishes every label of the
+	// group's common labels as a Pub/Sub message attribute, so that
+	// subscribers can filter deliveries without parsing the message data.
+	AttachLabels *bool `yaml:"attach_labels,omitempty" json:"attach_labels,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *PubsubConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	*c = DefaultPubsubConfig
+	type plain PubsubConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+	if c.ProjectID == "" {
+		return fmt.Errorf("missing project_id in Pubsub config")
+	}
+	if c.Topic == "" {
+		return fmt.Errorf("missing topic in Pubsub config")
+	}
+	if c.AttachLabels == nil {
+		c.AttachLabels = new(bool)
+		*c.AttachLabels = true
+	}
+	return nil
+}
@@ -57,6 +57,25 @@ headers:
 	}
 }
 
+func TestEmailAuthPasswordAndFileMutuallyExclusive(t *testing.T) {
+	in := `
+to: 'to@email.com'
+auth_password: 'xyz'
+auth_password_file: '/tmp/auth_password'
+`
+	var cfg EmailConfig
+	err := yaml.UnmarshalStrict([]byte(in), &cfg)
+
+	expected := "at most one of auth_password & auth_password_file must be configured"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%v", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+	}
+}
+
 func TestPagerdutyRoutingKeyIsPresent(t *testing.T) {
 	in := `
 routing_key: ''
@@ -91,6 +110,42 @@ service_key: ''
 	}
 }
 
+func TestPagerdutyRoutingKeyAndFileMutuallyExclusive(t *testing.T) {
+	in := `
+routing_key: 'xyz'
+routing_key_file: '/tmp/routing_key'
+`
+	var cfg PagerdutyConfig
+	err := yaml.UnmarshalStrict([]byte(in), &cfg)
+
+	expected := "at most one of routing_key & routing_key_file must be configured"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%v", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+	}
+}
+
+func TestPagerdutyServiceKeyAndFileMutuallyExclusive(t *testing.T) {
+	in := `
+service_key: 'xyz'
+service_key_file: '/tmp/service_key'
+`
+	var cfg PagerdutyConfig
+	err := yaml.UnmarshalStrict([]byte(in), &cfg)
+
+	expected := "at most one of service_key & service_key_file must be configured"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%v", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+	}
+}
+
 func TestPagerdutyDetails(t *testing.T) {
 
 	var tests = []struct {
@@ -170,7 +225,25 @@ func TestWebhookURLIsPresent(t *testing.T) {
 	var cfg WebhookConfig
 	err := yaml.UnmarshalStrict([]byte(in), &cfg)
 
-	expected := "missing URL in webhook config"
+	expected := "missing url, url_file or service_discovery in webhook config"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%v", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+	}
+}
+
+func TestWebhookURLAndFileMutuallyExclusive(t *testing.T) {
+	in := `
+url: 'http://example.com'
+url_file: '/tmp/url'
+`
+	var cfg WebhookConfig
+	err := yaml.UnmarshalStrict([]byte(in), &cfg)
+
+	expected := "at most one of url, url_file and service_discovery must be configured"
 
 	if err == nil {
 		t.Fatalf("no error returned, expected:\n%v", expected)
@@ -236,6 +309,24 @@ http_config:
 	}
 }
 
+func TestOpsGenieAPIKeyAndFileMutuallyExclusive(t *testing.T) {
+	in := `
+api_key: 'xyz'
+api_key_file: '/tmp/api_key'
+`
+	var cfg OpsGenieConfig
+	err := yaml.UnmarshalStrict([]byte(in), &cfg)
+
+	expected := "at most one of api_key & api_key_file must be configured"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%v", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+	}
+}
+
 func TestVictorOpsRoutingKeyIsPresent(t *testing.T) {
 	in := `
 routing_key: ''
@@ -253,6 +344,25 @@ routing_key: ''
 	}
 }
 
+func TestVictorOpsAPIKeyAndFileMutuallyExclusive(t *testing.T) {
+	in := `
+routing_key: 'test'
+api_key: 'xyz'
+api_key_file: '/tmp/api_key'
+`
+	var cfg VictorOpsConfig
+	err := yaml.UnmarshalStrict([]byte(in), &cfg)
+
+	expected := "at most one of api_key & api_key_file must be configured"
+
+	if err == nil {
+		t.Fatalf("no error returned, expected:\n%v", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("\nexpected:\n%v\ngot:\n%v", expected, err.Error())
+	}
+}
+
 func TestVictorOpsCustomFieldsValidation(t *testing.T) {
 	in := `
 routing_key: 'test'
@@ -0,0 +1,38 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// receiverPresets maps a preset name to a constructor for an exemplar
+// Receiver carrying the integration configs that preset fills in. Each
+// constructor returns a fresh Receiver so callers are always free to mutate
+// the result without affecting other receivers using the same preset.
+var receiverPresets = map[string]func() *Receiver{
+	"pagerduty-standard": func() *Receiver {
+		cfg := DefaultPagerdutyConfig
+		cfg.Severity = "error"
+		cfg.Class = "alertmanager"
+		cfg.Details = DefaultPagerdutyDetails
+		return &Receiver{
+			PagerdutyConfigs: []*PagerdutyConfig{&cfg},
+		}
+	},
+	"slack-compact": func() *Receiver {
+		cfg := DefaultSlackConfig
+		cfg.Text = " "
+		cfg.ShortFields = true
+		return &Receiver{
+			SlackConfigs: []*SlackConfig{&cfg},
+		}
+	},
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestReceiverPresetExpansion(t *testing.T) {
+	var rcv Receiver
+	err := yaml.UnmarshalStrict([]byte(`
+name: oncall
+preset: pagerduty-standard
+`), &rcv)
+	require.NoError(t, err)
+	require.Len(t, rcv.PagerdutyConfigs, 1)
+	require.Equal(t, "error", rcv.PagerdutyConfigs[0].Severity)
+	require.Equal(t, "alertmanager", rcv.PagerdutyConfigs[0].Class)
+	require.Empty(t, rcv.SlackConfigs)
+}
+
+func TestReceiverPresetOverridable(t *testing.T) {
+	var rcv Receiver
+	err := yaml.UnmarshalStrict([]byte(`
+name: oncall
+preset: pagerduty-standard
+pagerduty_configs:
+- service_key: abc123
+  severity: critical
+`), &rcv)
+	require.NoError(t, err)
+	require.Len(t, rcv.PagerdutyConfigs, 1)
+	require.Equal(t, "critical", rcv.PagerdutyConfigs[0].Severity)
+}
+
+func TestReceiverPresetUnknown(t *testing.T) {
+	var rcv Receiver
+	err := yaml.UnmarshalStrict([]byte(`
+name: oncall
+preset: does-not-exist
+`), &rcv)
+	require.Error(t, err)
+}
+
+func TestReceiverSlackCompactPreset(t *testing.T) {
+	var rcv Receiver
+	err := yaml.UnmarshalStrict([]byte(`
+name: chat
+preset: slack-compact
+`), &rcv)
+	require.NoError(t, err)
+	require.Len(t, rcv.SlackConfigs, 1)
+	require.True(t, rcv.SlackConfigs[0].ShortFields)
+}
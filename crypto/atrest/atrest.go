@@ -0,0 +1,177 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package atrest encrypts persisted snapshot state (alerts, silences, and
+// the notification log) so that a deployment whose alert labels carry
+// customer identifiers subject to data-protection requirements does not
+// write them to disk in the clear. Keys are supplied by configuration or,
+// indirectly, by whatever KMS the operator uses to populate that
+// configuration; a KeyRing holds every key still needed to decrypt older
+// snapshots, so a key can be rotated by adding a new current key without
+// losing the ability to read state written under the previous one.
+package atrest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// KeySize is the required length, in bytes, of every key in a KeyRing
+// (AES-256).
+const KeySize = 32
+
+// KeyRing holds the set of keys a deployment currently accepts, plus which
+// one new data should be encrypted with. Its zero value has no keys
+// configured, and Encrypt/Decrypt treat that as "encryption disabled" --
+// Encrypt returns the plaintext unchanged, and Decrypt assumes its input is
+// already plaintext.
+type KeyRing struct {
+	mtx     sync.RWMutex
+	keys    map[string][]byte
+	current string
+}
+
+// NewKeyRing returns an empty, disabled KeyRing. Call Configure to supply
+// keys.
+func NewKeyRing() *KeyRing {
+	return &KeyRing{}
+}
+
+// Configure (re-)sets the keys known to the ring and which one is current.
+// keys maps a key ID -- an operator-chosen label, not secret itself -- to a
+// KeySize-byte key. currentID must name an entry in keys, or be empty to
+// disable encryption. Previously configured keys that are absent from keys
+// are forgotten; keep every key an existing snapshot might have been
+// encrypted with until it has been rewritten (e.g. after the next
+// maintenance cycle) to avoid losing access to it.
+func (kr *KeyRing) Configure(keys map[string][]byte, currentID string) error {
+	if currentID != "" {
+		k, ok := keys[currentID]
+		if !ok {
+			return fmt.Errorf("current key %q is not present in keys", currentID)
+		}
+		if len(k) != KeySize {
+			return fmt.Errorf("key %q must be %d bytes, got %d", currentID, KeySize, len(k))
+		}
+	}
+	for id, k := range keys {
+		if len(k) != KeySize {
+			return fmt.Errorf("key %q must be %d bytes, got %d", id, KeySize, len(k))
+		}
+	}
+
+	cp := make(map[string][]byte, len(keys))
+	for id, k := range keys {
+		cp[id] = k
+	}
+
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+	kr.keys = cp
+	kr.current = currentID
+	return nil
+}
+
+// Enabled reports whether a current key is configured, i.e. whether Encrypt
+// actually encrypts rather than passing data through.
+func (kr *KeyRing) Enabled() bool {
+	kr.mtx.RLock()
+	defer kr.mtx.RUnlock()
+	return kr.current != ""
+}
+
+// Encrypt seals plaintext under the current key, framing the result as
+// [1-byte key ID length][key ID][nonce][ciphertext]. If no current key is
+// configured, plaintext is returned unchanged.
+func (kr *KeyRing) Encrypt(plaintext []byte) ([]byte, error) {
+	kr.mtx.RLock()
+	id, key := kr.current, kr.keys[kr.current]
+	kr.mtx.RUnlock()
+
+	if id == "" {
+		return plaintext, nil
+	}
+	if len(id) > 255 {
+		return nil, fmt.Errorf("key id %q is too long to frame", id)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+
+	out := make([]byte, 0, 1+len(id)+len(nonce)+len(plaintext)+gcm.Overhead())
+	out = append(out, byte(len(id)))
+	out = append(out, id...)
+	out = append(out, nonce...)
+	out = gcm.Seal(out, nonce, plaintext, nil)
+	return out, nil
+}
+
+// Decrypt opens data previously produced by Encrypt, looking up the key by
+// the ID framed into it -- which may be an older, non-current key, to
+// support rotation. If no keys are configured at all, data is returned
+// unchanged, on the assumption that it was written before encryption was
+// enabled.
+func (kr *KeyRing) Decrypt(data []byte) ([]byte, error) {
+	kr.mtx.RLock()
+	noKeys := len(kr.keys) == 0
+	kr.mtx.RUnlock()
+	if noKeys {
+		return data, nil
+	}
+
+	if len(data) < 1 {
+		return nil, fmt.Errorf("encrypted data too short")
+	}
+	idLen := int(data[0])
+	if len(data) < 1+idLen {
+		return nil, fmt.Errorf("encrypted data too short for framed key id")
+	}
+	id := string(data[1 : 1+idLen])
+	rest := data[1+idLen:]
+
+	kr.mtx.RLock()
+	key, ok := kr.keys[id]
+	kr.mtx.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", id)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted data too short for nonce")
+	}
+	nonce, ciphertext := rest[:gcm.NonceSize()], rest[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atrest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func key(b byte) []byte {
+	k := make([]byte, KeySize)
+	for i := range k {
+		k[i] = b
+	}
+	return k
+}
+
+func TestDisabledKeyRingIsPassthrough(t *testing.T) {
+	kr := NewKeyRing()
+	require.False(t, kr.Enabled())
+
+	out, err := kr.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), out)
+
+	back, err := kr.Decrypt(out)
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), back)
+}
+
+func TestEncryptDecryptRoundTrip(t *testing.T) {
+	kr := NewKeyRing()
+	require.NoError(t, kr.Configure(map[string][]byte{"k1": key(1)}, "k1"))
+	require.True(t, kr.Enabled())
+
+	ciphertext, err := kr.Encrypt([]byte("secret state"))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(ciphertext, []byte("secret state")))
+
+	plaintext, err := kr.Decrypt(ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret state"), plaintext)
+}
+
+func TestRotationKeepsOldCiphertextReadable(t *testing.T) {
+	kr := NewKeyRing()
+	require.NoError(t, kr.Configure(map[string][]byte{"k1": key(1)}, "k1"))
+
+	old, err := kr.Encrypt([]byte("data under k1"))
+	require.NoError(t, err)
+
+	// Rotate: k2 becomes current, but k1 is kept for old snapshots.
+	require.NoError(t, kr.Configure(map[string][]byte{"k1": key(1), "k2": key(2)}, "k2"))
+
+	plaintext, err := kr.Decrypt(old)
+	require.NoError(t, err)
+	require.Equal(t, []byte("data under k1"), plaintext)
+
+	fresh, err := kr.Encrypt([]byte("data under k2"))
+	require.NoError(t, err)
+	plaintext, err = kr.Decrypt(fresh)
+	require.NoError(t, err)
+	require.Equal(t, []byte("data under k2"), plaintext)
+}
+
+func TestConfigureRejectsWrongKeySize(t *testing.T) {
+	kr := NewKeyRing()
+	err := kr.Configure(map[string][]byte{"k1": []byte("too-short")}, "k1")
+	require.Error(t, err)
+}
+
+func TestConfigureRejectsUnknownCurrent(t *testing.T) {
+	kr := NewKeyRing()
+	err := kr.Configure(map[string][]byte{"k1": key(1)}, "k2")
+	require.Error(t, err)
+}
+
+func TestDecryptUnknownKeyID(t *testing.T) {
+	kr := NewKeyRing()
+	require.NoError(t, kr.Configure(map[string][]byte{"k1": key(1)}, "k1"))
+	ciphertext, err := kr.Encrypt([]byte("data"))
+	require.NoError(t, err)
+
+	kr2 := NewKeyRing()
+	require.NoError(t, kr2.Configure(map[string][]byte{"k2": key(2)}, "k2"))
+	_, err = kr2.Decrypt(ciphertext)
+	require.Error(t, err)
+}
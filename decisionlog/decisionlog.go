@@ -0,0 +1,146 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package decisionlog records every dispatcher routing decision and notify
+// pipeline delivery outcome as a JSON line, written to a configurable file
+// and/or HTTP endpoint, so that the effect of a config change can be
+// analyzed offline or replayed as a regression test instead of only
+// observed live.
+package decisionlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/common/model"
+)
+
+// Decision is a single JSON-line record of a routing or notification
+// decision.
+type Decision struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Fingerprint identifies the alert the decision was made for.
+	Fingerprint string `json:"fingerprint"`
+	// Labels holds the alert's label set at the time of the decision. Only
+	// populated for routing decisions, so that the decision can later be
+	// replayed against a candidate configuration (see package replay).
+	Labels model.LabelSet `json:"labels,omitempty"`
+	// RoutePath identifies what the decision applies to: the matched
+	// route's Route.Key() for a routing decision, or "receiver/integration"
+	// for a notification decision.
+	RoutePath string `json:"routePath"`
+	// Action names what was decided, e.g. "routed", "forwarded",
+	// "delivered", "failed".
+	Action string `json:"action"`
+	// Reason gives additional context, e.g. a delivery error. May be empty.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Logger writes Decisions as JSON lines to a file and/or POSTs them
+// individually to an HTTP endpoint. It is safe to reconfigure via SetFile/
+// SetURL while in use, e.g. across configuration reloads. Its zero value is
+// not usable; construct with New.
+type Logger struct {
+	logger log.Logger
+	client *http.Client
+
+	mtx  sync.Mutex
+	file *os.File
+	url  string
+}
+
+// New returns a Logger that is initially disabled. Call SetFile and/or
+// SetURL to start recording decisions.
+func New(l log.Logger) *Logger {
+	return &Logger{
+		logger: l,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SetFile (re-)configures the local file decisions are appended to as JSON
+// lines. An empty path closes any previously open file and disables file
+// output.
+func (dl *Logger) SetFile(path string) error {
+	dl.mtx.Lock()
+	defer dl.mtx.Unlock()
+
+	if dl.file != nil {
+		dl.file.Close()
+		dl.file = nil
+	}
+	if path == "" {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	dl.file = f
+	return nil
+}
+
+// SetURL (re-)configures the HTTP endpoint each Decision is POSTed to
+// individually. An empty url disables endpoint output.
+func (dl *Logger) SetURL(url string) {
+	dl.mtx.Lock()
+	defer dl.mtx.Unlock()
+	dl.url = url
+}
+
+// Enabled reports whether a destination file or endpoint is currently
+// configured.
+func (dl *Logger) Enabled() bool {
+	dl.mtx.Lock()
+	defer dl.mtx.Unlock()
+	return dl.file != nil || dl.url != ""
+}
+
+// Log records d, stamping its Timestamp with the current time if unset. It
+// is a best-effort operation: errors are logged but never propagated, so a
+// decision logging outage never affects actual dispatch or notification.
+func (dl *Logger) Log(d Decision) {
+	if d.Timestamp.IsZero() {
+		d.Timestamp = time.Now()
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		level.Error(dl.logger).Log("msg", "failed to marshal decision", "err", err)
+		return
+	}
+
+	dl.mtx.Lock()
+	file := dl.file
+	url := dl.url
+	dl.mtx.Unlock()
+
+	if file != nil {
+		if _, err := file.Write(append(b, '\n')); err != nil {
+			level.Error(dl.logger).Log("msg", "failed to write decision to file", "err", err)
+		}
+	}
+	if url != "" {
+		resp, err := dl.client.Post(url, "application/json", bytes.NewReader(b))
+		if err != nil {
+			level.Error(dl.logger).Log("msg", "failed to post decision", "err", err)
+			return
+		}
+		resp.Body.Close()
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decisionlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisabledByDefault(t *testing.T) {
+	dl := New(log.NewNopLogger())
+	require.False(t, dl.Enabled())
+}
+
+func TestSetFileWritesJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "decisions.jsonl")
+	dl := New(log.NewNopLogger())
+	require.NoError(t, dl.SetFile(path))
+	require.True(t, dl.Enabled())
+
+	dl.Log(Decision{Fingerprint: "abc", RoutePath: "team-a/slack", Action: "delivered"})
+	dl.Log(Decision{Fingerprint: "def", RoutePath: "team-a/slack", Action: "failed", Reason: "timeout"})
+
+	require.NoError(t, dl.SetFile(""))
+	require.False(t, dl.Enabled())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var decisions []Decision
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var d Decision
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &d))
+		decisions = append(decisions, d)
+	}
+	require.NoError(t, sc.Err())
+	require.Len(t, decisions, 2)
+	require.Equal(t, "abc", decisions[0].Fingerprint)
+	require.Equal(t, "delivered", decisions[0].Action)
+	require.Equal(t, "def", decisions[1].Fingerprint)
+	require.Equal(t, "failed", decisions[1].Action)
+	require.Equal(t, "timeout", decisions[1].Reason)
+}
+
+func TestSetURLPostsDecisions(t *testing.T) {
+	var received []Decision
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var d Decision
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&d))
+		received = append(received, d)
+	}))
+	defer srv.Close()
+
+	dl := New(log.NewNopLogger())
+	dl.SetURL(srv.URL)
+	require.True(t, dl.Enabled())
+
+	dl.Log(Decision{Fingerprint: "abc", RoutePath: "{}", Action: "routed"})
+
+	require.Len(t, received, 1)
+	require.Equal(t, "abc", received[0].Fingerprint)
+	require.Equal(t, "routed", received[0].Action)
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"sync"
+	"time"
+)
+
+// budgetWindow is the rolling period a route's notification budget applies
+// over.
+const budgetWindow = 24 * time.Hour
+
+// budgetTracker counts how many notifications a route has sent within a
+// rolling window, flagging when a configured budget is exceeded.
+type budgetTracker struct {
+	mtx sync.Mutex
+
+	sent   []time.Time // timestamps within the last budgetWindow, oldest first.
+	active bool
+}
+
+// record registers a notification sent at now and returns whether the
+// route's budget is exceeded afterwards, and whether that is a change from
+// before the call. A budget of zero or less disables tracking.
+func (b *budgetTracker) record(now time.Time, budget int) (exceeded, changed bool) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.sent = append(b.sent, now)
+	cutoff := now.Add(-budgetWindow)
+	i := 0
+	for i < len(b.sent) && b.sent[i].Before(cutoff) {
+		i++
+	}
+	b.sent = b.sent[i:]
+
+	wasActive := b.active
+	b.active = budget > 0 && len(b.sent) > budget
+	return b.active, b.active != wasActive
+}
+
+// current reports whether the route's budget is currently exceeded.
+func (b *budgetTracker) current() bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.active
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBudgetTrackerTriggersAndRecovers(t *testing.T) {
+	var b budgetTracker
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		exceeded, changed := b.record(now, 3)
+		if exceeded {
+			t.Fatalf("did not expect budget to be exceeded yet (notification %d)", i+1)
+		}
+		if changed {
+			t.Fatalf("did not expect a change while under budget (notification %d)", i+1)
+		}
+		now = now.Add(time.Minute)
+	}
+
+	exceeded, changed := b.record(now, 3)
+	if !exceeded {
+		t.Fatal("expected the budget to be exceeded on the fourth notification")
+	}
+	if !changed {
+		t.Fatal("expected the transition to be reported as a change")
+	}
+
+	if _, changed = b.record(now, 3); changed {
+		t.Fatal("did not expect a repeated change while still over budget")
+	}
+
+	// Once the oldest notifications fall out of the rolling window, the
+	// budget should no longer be exceeded.
+	now = now.Add(budgetWindow)
+	exceeded, changed = b.record(now, 3)
+	if exceeded {
+		t.Fatal("expected the budget to no longer be exceeded once old notifications expired")
+	}
+	if !changed {
+		t.Fatal("expected the recovery to be reported as a change")
+	}
+}
+
+func TestBudgetTrackerDisabledByThreshold(t *testing.T) {
+	var b budgetTracker
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		exceeded, changed := b.record(now, 0)
+		if exceeded || changed {
+			t.Fatal("a zero budget should never be reported as exceeded")
+		}
+	}
+}
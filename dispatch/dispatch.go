@@ -14,17 +14,31 @@
 package dispatch
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math/rand"
 	"sort"
+	"strings"
 	"sync"
+	texttemplate "text/template"
 	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 
+	"github.com/prometheus/alertmanager/audit"
+	"github.com/prometheus/alertmanager/cluster"
+	"github.com/prometheus/alertmanager/cluster/hashring"
+	"github.com/prometheus/alertmanager/decisionlog"
+	"github.com/prometheus/alertmanager/history"
 	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/escalation"
+	"github.com/prometheus/alertmanager/notify/forward"
+	"github.com/prometheus/alertmanager/notify/groupnotes"
+	"github.com/prometheus/alertmanager/pkg/tracing"
 	"github.com/prometheus/alertmanager/provider"
 	"github.com/prometheus/alertmanager/store"
 	"github.com/prometheus/alertmanager/types"
@@ -37,39 +51,282 @@ type Dispatcher struct {
 	alerts provider.Alerts
 	stage  notify.Stage
 
+	forwarder *forward.Forwarder
+
 	marker  types.Marker
 	timeout func(time.Duration) time.Duration
 
 	aggrGroups map[*Route]map[model.Fingerprint]*aggrGroup
 	mtx        sync.RWMutex
 
+	draining bool
+
 	done   chan struct{}
 	ctx    context.Context
 	cancel func()
 
-	logger log.Logger
+	onBudgetExceeded func(route *Route)
+
+	history    *history.Log
+	groupNotes *groupnotes.Tracker
+	escalation *escalation.Tracker
+	decisions  *decisionlog.Logger
+	audit      *audit.Log
+
+	ring       hashring.Ring
+	peer       *cluster.Peer
+	shardLabel model.LabelName
+
+	notifyPool *notifyPool
+
+	logger  log.Logger
+	metrics *dispatcherMetrics
+}
+
+type dispatcherMetrics struct {
+	reg prometheus.Registerer
+
+	stormActive        *prometheus.GaugeVec
+	stormTransitions   *prometheus.CounterVec
+	budgetExceeded     *prometheus.GaugeVec
+	budgetTransitions  *prometheus.CounterVec
+	floodCollapsed     *prometheus.CounterVec
+	aggrGroups         prometheus.GaugeFunc
+	routeMatchDuration prometheus.Histogram
+	groupsTruncated    *prometheus.CounterVec
+	alertsTruncated    *prometheus.CounterVec
+	notifyQueueDepth   prometheus.GaugeFunc
+	notifyWorkersBusy  prometheus.GaugeFunc
 }
 
-// NewDispatcher returns a new Dispatcher.
+// unregister removes all of m's collectors from the registerer it was
+// created with, so a later dispatcher using the same registerer (e.g. after
+// a config reload recreates the dispatcher) doesn't fail to register its own
+// copies of these same metrics.
+func (m *dispatcherMetrics) unregister() {
+	m.reg.Unregister(m.stormActive)
+	m.reg.Unregister(m.stormTransitions)
+	m.reg.Unregister(m.budgetExceeded)
+	m.reg.Unregister(m.budgetTransitions)
+	m.reg.Unregister(m.floodCollapsed)
+	m.reg.Unregister(m.aggrGroups)
+	m.reg.Unregister(m.routeMatchDuration)
+	m.reg.Unregister(m.groupsTruncated)
+	m.reg.Unregister(m.alertsTruncated)
+	m.reg.Unregister(m.notifyQueueDepth)
+	m.reg.Unregister(m.notifyWorkersBusy)
+}
+
+func newDispatcherMetrics(r prometheus.Registerer, d *Dispatcher) *dispatcherMetrics {
+	m := &dispatcherMetrics{
+		reg: r,
+		stormActive: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "alertmanager",
+			Name:      "dispatcher_alert_storm_active",
+			Help:      "Whether a route is currently in alert storm mode (1) or not (0).",
+		}, []string{"route"}),
+		stormTransitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "alertmanager",
+			Name:      "dispatcher_alert_storm_transitions_total",
+			Help:      "Total number of times a route has entered or left alert storm mode.",
+		}, []string{"route", "state"}),
+		budgetExceeded: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "alertmanager",
+			Name:      "dispatcher_notification_budget_exceeded",
+			Help:      "Whether a route currently exceeds its configured notification budget (1) or not (0).",
+		}, []string{"route"}),
+		budgetTransitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "alertmanager",
+			Name:      "dispatcher_notification_budget_transitions_total",
+			Help:      "Total number of times a route has started or stopped exceeding its notification budget.",
+		}, []string{"route", "state"}),
+		floodCollapsed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "alertmanager",
+			Name:      "dispatcher_notifications_collapsed_total",
+			Help:      "Total number of group notifications collapsed into a flood summary notification instead of being sent individually.",
+		}, []string{"route"}),
+		routeMatchDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "alertmanager",
+			Name:      "dispatcher_route_match_duration_seconds",
+			Help:      "Duration of matching a received alert against the routing tree.",
+		}),
+		groupsTruncated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "alertmanager",
+			Name:      "dispatcher_aggregation_groups_truncated_total",
+			Help:      "Total number of alerts dropped because their route had already reached its max_aggregation_groups limit.",
+		}, []string{"route"}),
+		alertsTruncated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "alertmanager",
+			Name:      "dispatcher_alerts_truncated_total",
+			Help:      "Total number of alerts dropped because their aggregation group had already reached its max_alerts_per_group limit.",
+		}, []string{"route"}),
+	}
+	m.aggrGroups = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "alertmanager",
+		Name:      "dispatcher_aggregation_groups",
+		Help:      "Number of active aggregation groups.",
+	}, func() float64 {
+		d.mtx.RLock()
+		defer d.mtx.RUnlock()
+		var n int
+		for _, groups := range d.aggrGroups {
+			n += len(groups)
+		}
+		return float64(n)
+	})
+	m.notifyQueueDepth = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "alertmanager",
+		Name:      "dispatcher_notify_queue_depth",
+		Help:      "Number of group notification flushes waiting for a free worker in the notify pool. Always 0 unless SetNotifyWorkers was called.",
+	}, func() float64 {
+		if d.notifyPool == nil {
+			return 0
+		}
+		return float64(d.notifyPool.queued())
+	})
+	m.notifyWorkersBusy = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "alertmanager",
+		Name:      "dispatcher_notify_workers_busy",
+		Help:      "Number of notify pool workers currently executing a group notification flush. Always 0 unless SetNotifyWorkers was called.",
+	}, func() float64 {
+		if d.notifyPool == nil {
+			return 0
+		}
+		return float64(d.notifyPool.busyWorkers())
+	})
+	r.MustRegister(m.stormActive, m.stormTransitions, m.budgetExceeded, m.budgetTransitions, m.floodCollapsed, m.aggrGroups, m.routeMatchDuration, m.groupsTruncated, m.alertsTruncated, m.notifyQueueDepth, m.notifyWorkersBusy)
+	return m
+}
+
+// NewDispatcher returns a new Dispatcher. onBudgetExceeded, if non-nil, is
+// invoked whenever a route newly exceeds its configured notification
+// budget (see RouteOpts.NotificationBudget).
 func NewDispatcher(
 	ap provider.Alerts,
 	r *Route,
 	s notify.Stage,
 	mk types.Marker,
 	to func(time.Duration) time.Duration,
+	reg prometheus.Registerer,
+	onBudgetExceeded func(route *Route),
 	l log.Logger,
 ) *Dispatcher {
 	disp := &Dispatcher{
-		alerts:  ap,
-		stage:   s,
-		route:   r,
-		marker:  mk,
-		timeout: to,
-		logger:  log.With(l, "component", "dispatcher"),
+		alerts:           ap,
+		stage:            s,
+		route:            r,
+		marker:           mk,
+		timeout:          to,
+		forwarder:        forward.New(log.With(l, "component", "forward")),
+		onBudgetExceeded: onBudgetExceeded,
+		logger:           log.With(l, "component", "dispatcher"),
 	}
+	disp.metrics = newDispatcherMetrics(reg, disp)
 	return disp
 }
 
+// SetHistory attaches h as the destination for per-alert "grouped" lifecycle
+// events. It is a no-op if never called.
+func (d *Dispatcher) SetHistory(h *history.Log) {
+	d.history = h
+}
+
+// SetGroupNotes attaches t as the source of free-form notes consulted when
+// notifying for a group, so notes set via the API are included in
+// subsequent notifications. It is a no-op if never called.
+func (d *Dispatcher) SetGroupNotes(t *groupnotes.Tracker) {
+	d.groupNotes = t
+}
+
+// SetEscalation attaches t as the source of acknowledgements consulted
+// before escalating a group's unanswered notification to its route's
+// escalation_receiver. It is a no-op if never called, in which case
+// escalation_receiver routes never escalate.
+func (d *Dispatcher) SetEscalation(t *escalation.Tracker) {
+	d.escalation = t
+}
+
+// SetDecisionLog attaches l as the destination for routing decisions made
+// on alert ingestion. It is a no-op if never called.
+func (d *Dispatcher) SetDecisionLog(l *decisionlog.Logger) {
+	d.decisions = l
+}
+
+// SetAudit attaches l as the destination for sent-notification audit
+// records. It is a no-op if never called.
+func (d *Dispatcher) SetAudit(l *audit.Log) {
+	d.audit = l
+}
+
+// SetHashRing configures ring to shard matched routes across the cluster's
+// members, so that only the instance owning a route's shard key processes
+// it; every other instance drops the alert for that route instead of
+// grouping and notifying on it. shardLabel, if non-empty, selects the
+// label whose value is hashed (e.g. a team label), letting an operator pin
+// a team's alert groups to a specific instance; otherwise the matched
+// route's key is hashed, sharding whole routes. Passing a nil ring
+// disables sharding, the default, in which every instance processes every
+// alert.
+func (d *Dispatcher) SetHashRing(ring hashring.Ring, peer *cluster.Peer, shardLabel model.LabelName) {
+	d.ring = ring
+	d.peer = peer
+	d.shardLabel = shardLabel
+}
+
+// SetNotifyWorkers bounds the number of group flushes that may execute
+// their notification pipeline concurrently to workers, queuing any further
+// flushes instead of running them inline. This keeps a large number of
+// simultaneously-flushing aggregation groups from each blocking on a
+// stalled integration at once; it does not affect how many aggregation
+// groups may exist or tick independently, only how many of their flushes
+// may be in flight together. It is a no-op if never called, or if workers
+// is not greater than 0, in which case every flush runs inline as before.
+func (d *Dispatcher) SetNotifyWorkers(workers int) {
+	if workers <= 0 {
+		return
+	}
+	d.notifyPool = newNotifyPool(workers)
+}
+
+// execNotify runs fn, routing it through the bounded worker pool set via
+// SetNotifyWorkers if one is configured, and blocking until fn returns.
+func (d *Dispatcher) execNotify(fn func() bool) bool {
+	if d.notifyPool == nil {
+		return fn()
+	}
+	done := make(chan bool, 1)
+	d.notifyPool.submit(func() {
+		done <- fn()
+	})
+	return <-done
+}
+
+// owns reports whether this instance is responsible for processing alert
+// for route r, according to the configured hash ring. It always returns
+// true if no ring is configured.
+func (d *Dispatcher) owns(r *Route, alert *types.Alert) bool {
+	if d.ring == nil || d.peer == nil {
+		return true
+	}
+
+	key := r.Key()
+	if d.shardLabel != "" {
+		if v, ok := alert.Labels[d.shardLabel]; ok {
+			key = string(v)
+		}
+	}
+
+	var members []string
+	for _, n := range d.peer.Peers() {
+		members = append(members, n.Name)
+	}
+	if len(members) == 0 {
+		return true
+	}
+
+	return d.ring.Owner(members, key) == d.peer.Name()
+}
+
 // Run starts dispatching alerts incoming via the updates channel.
 func (d *Dispatcher) Run() {
 	d.done = make(chan struct{})
@@ -109,8 +366,50 @@ func (d *Dispatcher) run(it provider.AlertIterator) {
 				continue
 			}
 
-			for _, r := range d.route.Match(alert.Labels) {
-				d.processAlert(alert, r)
+			d.mtx.RLock()
+			draining := d.draining
+			d.mtx.RUnlock()
+			if draining {
+				level.Debug(d.logger).Log("msg", "Dropping alert received while draining", "alert", alert)
+				continue
+			}
+
+			matchStart := time.Now()
+			matched := d.route.Match(alert.Labels, alert.Annotations)
+			d.metrics.routeMatchDuration.Observe(time.Since(matchStart).Seconds())
+
+			for _, r := range matched {
+				if !d.owns(r, alert) {
+					level.Debug(d.logger).Log("msg", "Dropping alert not owned by this instance's shard", "alert", alert, "route", r.Key())
+					continue
+				}
+				d.recordStorm(r)
+				receiver, err := r.RouteOpts.ResolveReceiver(alert.Labels)
+				if err != nil {
+					level.Error(d.logger).Log("msg", "Failed to render templated receiver, using fallback", "route", r.Key(), "err", err)
+					receiver = r.RouteOpts.ReceiverFallback
+				}
+				d.processAlert(alert, r, receiver)
+				if d.decisions != nil {
+					d.decisions.Log(decisionlog.Decision{
+						Fingerprint: alert.Fingerprint().String(),
+						Labels:      alert.Labels,
+						RoutePath:   r.Key(),
+						Action:      "routed",
+						Reason:      receiver,
+					})
+				}
+				if url := r.RouteOpts.ForwardToURL; url != "" {
+					go d.forwarder.Send(context.Background(), url, alert)
+					if d.decisions != nil {
+						d.decisions.Log(decisionlog.Decision{
+							Fingerprint: alert.Fingerprint().String(),
+							RoutePath:   r.Key(),
+							Action:      "forwarded",
+							Reason:      url,
+						})
+					}
+				}
 			}
 
 		case <-cleanup.C:
@@ -135,9 +434,15 @@ func (d *Dispatcher) run(it provider.AlertIterator) {
 
 // AlertGroup represents how alerts exist within an aggrGroup.
 type AlertGroup struct {
+	Key      string
 	Alerts   types.AlertSlice
 	Labels   model.LabelSet
 	Receiver string
+
+	// NextFlush is when this group's next scheduled notification will
+	// fire, so a caller rendering the current groups can show when each
+	// one will actually page, not just what would be included if it did.
+	NextFlush time.Time
 }
 
 type AlertGroups []*AlertGroup
@@ -170,10 +475,12 @@ func (d *Dispatcher) Groups(routeFilter func(*Route) bool, alertFilter func(*typ
 		}
 
 		for _, ag := range ags {
-			receiver := route.RouteOpts.Receiver
+			receiver := ag.receiver
 			alertGroup := &AlertGroup{
-				Labels:   ag.labels,
-				Receiver: receiver,
+				Key:       ag.GroupKey(),
+				Labels:    ag.labels,
+				Receiver:  receiver,
+				NextFlush: ag.NextFlush(),
 			}
 
 			alerts := ag.alerts.List()
@@ -224,6 +531,157 @@ func (d *Dispatcher) Stop() {
 	d.cancel = nil
 
 	<-d.done
+
+	if d.metrics != nil {
+		d.metrics.unregister()
+	}
+}
+
+// Drain stops the dispatcher from accepting new alerts and gives aggregation
+// groups that are already past their group_wait up to timeout to flush their
+// pending notifications before the dispatcher is torn down. Groups that
+// haven't reached group_wait yet are left alone, since forcing them out
+// early would just mean sending under-grouped notifications on the way out.
+func (d *Dispatcher) Drain(timeout time.Duration) {
+	d.mtx.Lock()
+	d.draining = true
+
+	now := time.Now()
+	var due []*aggrGroup
+	for _, groups := range d.aggrGroups {
+		for _, ag := range groups {
+			if !ag.empty() && ag.readyToFlush(now) {
+				due = append(due, ag)
+			}
+		}
+	}
+	for _, ag := range due {
+		ag.triggerFlush()
+	}
+	d.mtx.Unlock()
+
+	deadline := time.Now().Add(timeout)
+	for _, ag := range due {
+		for !ag.empty() {
+			if time.Now().After(deadline) {
+				level.Warn(d.logger).Log("msg", "Drain timeout exceeded with pending notifications", "aggrGroup", ag)
+				d.Stop()
+				return
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+
+	d.Stop()
+}
+
+// recordStorm records an alert arrival towards route's storm detector (a
+// no-op if route doesn't have storm detection enabled), logging and
+// metering any change in storm state.
+func (d *Dispatcher) recordStorm(route *Route) {
+	active, changed := route.recordStorm(time.Now())
+	if !changed {
+		return
+	}
+
+	key := route.Key()
+	msg := "Alert storm ended, reverting to normal notifications"
+	state := "ended"
+	var activeValue float64
+	if active {
+		msg = "Alert storm detected, switching to summarized notifications"
+		state = "started"
+		activeValue = 1
+	}
+	level.Info(d.logger).Log("msg", msg, "route", key)
+	d.metrics.stormTransitions.WithLabelValues(key, state).Inc()
+	d.metrics.stormActive.WithLabelValues(key).Set(activeValue)
+}
+
+// alertFingerprints returns the fingerprint of each alert, in order, for log
+// lines that need to name exactly which alerts were involved.
+func alertFingerprints(alerts []*types.Alert) []string {
+	fps := make([]string, len(alerts))
+	for i, a := range alerts {
+		fps[i] = a.Fingerprint().String()
+	}
+	return fps
+}
+
+// recordBudget records a notification sent towards route's notification
+// budget tracker (a no-op if route doesn't have a budget configured),
+// logging and metering any change, and invoking onBudgetExceeded when the
+// route newly exceeds its budget.
+func (d *Dispatcher) recordBudget(route *Route) {
+	exceeded, changed := route.recordBudget(time.Now())
+	if !changed {
+		return
+	}
+
+	key := route.Key()
+	state := "ok"
+	var exceededValue float64
+	if exceeded {
+		state = "exceeded"
+		exceededValue = 1
+		level.Warn(d.logger).Log("msg", "route exceeded its notification budget", "route", key, "budget", route.RouteOpts.NotificationBudget)
+		if d.onBudgetExceeded != nil {
+			d.onBudgetExceeded(route)
+		}
+	} else {
+		level.Info(d.logger).Log("msg", "route back under its notification budget", "route", key)
+	}
+	d.metrics.budgetTransitions.WithLabelValues(key, state).Inc()
+	d.metrics.budgetExceeded.WithLabelValues(key).Set(exceededValue)
+}
+
+// collapseFlood records a group notification attempt towards route's flood
+// tracker (a no-op if route doesn't have flood_threshold configured). It
+// returns the alerts the caller should actually send: unchanged if the
+// route is under its threshold, a single synthetic summary alert the one
+// time the threshold is crossed, or nil to suppress the notification
+// entirely for the rest of the window.
+func (d *Dispatcher) collapseFlood(route *Route, receiver string, alerts []*types.Alert) []*types.Alert {
+	forward, collapsed := route.recordFlood(time.Now())
+	if forward {
+		return alerts
+	}
+	if collapsed == 0 {
+		d.metrics.floodCollapsed.WithLabelValues(route.Key()).Inc()
+		return nil
+	}
+
+	level.Warn(d.logger).Log("msg", "route exceeded its flood threshold, collapsing further notifications into a summary", "route", route.Key(), "flood_threshold", route.RouteOpts.FloodThreshold, "collapsed", collapsed)
+	d.metrics.floodCollapsed.WithLabelValues(route.Key()).Inc()
+	return []*types.Alert{floodSummaryAlert(route, receiver, collapsed)}
+}
+
+// floodSummaryAlert returns a synthetic alert summarizing collapsed group
+// notifications for route, clearly marked as synthetic so it cannot be
+// mistaken for a real incident. It links to the alerts view filtered to
+// receiver so a responder can still reach every individual group.
+func floodSummaryAlert(route *Route, receiver string, collapsed int) *types.Alert {
+	now := time.Now()
+	alertsURL := route.RouteOpts.ExternalURL
+	if alertsURL != "" {
+		alertsURL = fmt.Sprintf("%s/#/alerts?receiver=%s", strings.TrimSuffix(alertsURL, "/"), receiver)
+	}
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"alertname": "NotificationsCollapsed",
+				"receiver":  model.LabelValue(receiver),
+			},
+			Annotations: model.LabelSet{
+				"summary": model.LabelValue(fmt.Sprintf(
+					"%d distinct alert groups are now firing for receiver %q. Individual notifications have been collapsed into this summary to avoid paging once per group; see %s for the full list.",
+					collapsed, receiver, alertsURL,
+				)),
+			},
+			StartsAt: now,
+		},
+		UpdatedAt: now,
+	}
 }
 
 // notifyFunc is a function that performs notification for the alert
@@ -233,8 +691,19 @@ type notifyFunc func(context.Context, ...*types.Alert) bool
 
 // processAlert determines in which aggregation group the alert falls
 // and inserts it.
-func (d *Dispatcher) processAlert(alert *types.Alert, route *Route) {
-	groupLabels := getGroupLabels(alert, route)
+func (d *Dispatcher) processAlert(alert *types.Alert, route *Route, receiver string) {
+	// Alerts are consumed off the provider's iterator rather than handled
+	// inline with the HTTP request that created them, so this span starts
+	// fresh here instead of continuing the ingest handler's trace; the two
+	// are correlated by group_key/receiver in the logs instead.
+	_, span := tracing.Start(d.ctx, "dispatch.process_alert")
+	defer span.End()
+	span.SetAttr("route", route.Key())
+	span.SetAttr("receiver", receiver)
+
+	alert = applyAnnotationTemplates(alert, route.RouteOpts.AnnotationTemplates)
+
+	groupLabels := GroupLabels(alert, route)
 
 	fp := groupLabels.Fingerprint()
 
@@ -250,32 +719,104 @@ func (d *Dispatcher) processAlert(alert *types.Alert, route *Route) {
 	// If the group does not exist, create it.
 	ag, ok := group[fp]
 	if !ok {
-		ag = newAggrGroup(d.ctx, groupLabels, route, d.timeout, d.logger)
+		if route.RouteOpts.MaxAggregationGroups > 0 && len(group) >= route.RouteOpts.MaxAggregationGroups {
+			level.Warn(d.logger).Log("msg", "Dropping alert, route has reached its max_aggregation_groups limit", "route", route.Key(), "max_aggregation_groups", route.RouteOpts.MaxAggregationGroups)
+			d.metrics.groupsTruncated.WithLabelValues(route.Key()).Inc()
+			return
+		}
+
+		ag = newAggrGroup(d.ctx, groupLabels, route, receiver, d.timeout, d.groupNotes, d.escalation, d.logger, d.metrics.alertsTruncated)
 		group[fp] = ag
 
 		go ag.run(func(ctx context.Context, alerts ...*types.Alert) bool {
-			_, _, err := d.stage.Exec(ctx, d.logger, alerts...)
-			if err != nil {
-				lvl := level.Error(d.logger)
-				if ctx.Err() == context.Canceled {
-					// It is expected for the context to be canceled on
-					// configuration reload or shutdown. In this case, the
-					// message should only be logged at the debug level.
-					lvl = level.Debug(d.logger)
+			return d.execNotify(func() bool {
+				// Annotated once here, logger carries group_key and
+				// receiver through every stage of the notification
+				// pipeline below, so a failure deep in e.g. RetryStage
+				// can be correlated back to the group and route that
+				// produced it without each stage repeating those fields.
+				logger := log.With(d.logger, "group_key", ag.GroupKey(), "receiver", receiver)
+
+				ctx, span := tracing.Start(ctx, "notify.group_flush")
+				defer span.End()
+				span.SetAttr("group_key", ag.GroupKey())
+				span.SetAttr("receiver", receiver)
+
+				sendAlerts := d.collapseFlood(route, receiver, alerts)
+				if sendAlerts == nil {
+					return true
 				}
-				lvl.Log("msg", "Notify for alerts failed", "num_alerts", len(alerts), "err", err)
-			}
-			return err == nil
+				_, _, err := d.stage.Exec(ctx, logger, sendAlerts...)
+				outcome := "delivered"
+				if err != nil {
+					outcome = "failed"
+					lvl := level.Error(logger)
+					if ctx.Err() == context.Canceled {
+						// It is expected for the context to be canceled on
+						// configuration reload or shutdown. In this case, the
+						// message should only be logged at the debug level.
+						lvl = level.Debug(logger)
+					}
+					lvl.Log("msg", "Notify for alerts failed", "num_alerts", len(alerts), "fingerprints", alertFingerprints(alerts), "err", err)
+					span.RecordError(err)
+				} else {
+					d.recordBudget(route)
+				}
+				if d.audit != nil {
+					detail := ""
+					if err != nil {
+						detail = err.Error()
+					}
+					d.audit.Record(audit.Event{
+						Type:         audit.EventNotification,
+						Receiver:     receiver,
+						GroupKey:     ag.GroupKey(),
+						Fingerprints: alertFingerprints(alerts),
+						Outcome:      outcome,
+						Detail:       detail,
+					})
+				}
+				return err == nil
+			})
 		})
 	}
 
 	ag.insert(alert)
+
+	if d.history != nil {
+		d.history.Add(alert.Fingerprint(), history.EventGrouped, route.Key())
+	}
 }
 
-func getGroupLabels(alert *types.Alert, route *Route) model.LabelSet {
+// groupByTemplateKeyLabel is the synthetic label under which the rendered
+// result of a route's GroupByTemplate is stored in its group's labels, so
+// that grouping machinery designed around label sets -- fingerprinting,
+// GroupLabels in notification templates -- keeps working unchanged.
+const groupByTemplateKeyLabel = model.LabelName("group_key")
+
+// GroupLabels returns the label set alert would be grouped by under route,
+// accounting for GroupByTemplate, storm mode, and GroupBy/GroupByAll, the
+// same way a running Dispatcher would when assigning alert to an
+// aggrGroup. It is exported so tools evaluating a routing config offline --
+// config linters, chatops bots, test harnesses -- can reproduce a grouping
+// decision without running a Dispatcher, the same way MatchingReceivers
+// reproduces a routing decision.
+func GroupLabels(alert *types.Alert, route *Route) model.LabelSet {
+	if tmpl := route.RouteOpts.GroupByTemplate; tmpl != nil {
+		return templateGroupLabels(alert, tmpl)
+	}
+
+	if route.stormActive() {
+		// Storm mode folds every alert matching this route into a single
+		// group, regardless of GroupBy/GroupByAll, so a spike of alerts
+		// produces one summarized notification instead of many.
+		return model.LabelSet{}
+	}
+
 	groupLabels := model.LabelSet{}
 	for ln, lv := range alert.Labels {
-		if _, ok := route.RouteOpts.GroupBy[ln]; ok || route.RouteOpts.GroupByAll {
+		_, excluded := route.RouteOpts.GroupByExclude[ln]
+		if _, ok := route.RouteOpts.GroupBy[ln]; ok || (route.RouteOpts.GroupByAll && !excluded) {
 			groupLabels[ln] = lv
 		}
 	}
@@ -283,38 +824,130 @@ func getGroupLabels(alert *types.Alert, route *Route) model.LabelSet {
 	return groupLabels
 }
 
+// applyAnnotationTemplates returns alert unchanged if tmpls is empty.
+// Otherwise it returns a copy of alert whose annotations have been merged
+// with tmpls, each executed against alert's labels, so a route's
+// annotation_templates never mutate an alert shared with other matched
+// routes.
+func applyAnnotationTemplates(alert *types.Alert, tmpls map[string]*texttemplate.Template) *types.Alert {
+	if len(tmpls) == 0 {
+		return alert
+	}
+
+	data := make(map[string]string, len(alert.Labels))
+	for ln, lv := range alert.Labels {
+		data[string(ln)] = string(lv)
+	}
+
+	annotations := make(model.LabelSet, len(alert.Annotations)+len(tmpls))
+	for an, av := range alert.Annotations {
+		annotations[an] = av
+	}
+	for name, tmpl := range tmpls {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			continue
+		}
+		annotations[model.LabelName(name)] = model.LabelValue(buf.String())
+	}
+
+	clone := *alert
+	clone.Annotations = annotations
+	return &clone
+}
+
+// templateGroupLabels executes tmpl against alert's labels and returns the
+// result as a single-entry label set under groupByTemplateKeyLabel, so an
+// arbitrary computed string (a hash, a truncated prefix, ...) can serve as
+// an alert's grouping key.
+func templateGroupLabels(alert *types.Alert, tmpl *texttemplate.Template) model.LabelSet {
+	data := make(map[string]string, len(alert.Labels))
+	for ln, lv := range alert.Labels {
+		data[string(ln)] = string(lv)
+	}
+
+	var buf bytes.Buffer
+	key := ""
+	if err := tmpl.Execute(&buf, data); err == nil {
+		key = buf.String()
+	}
+
+	return model.LabelSet{groupByTemplateKeyLabel: model.LabelValue(key)}
+}
+
+// templateDedupKey executes tmpl against labels and returns the rendered
+// result, or "" if execution fails, mirroring templateGroupLabels.
+func templateDedupKey(labels model.LabelSet, tmpl *texttemplate.Template) string {
+	data := make(map[string]string, len(labels))
+	for ln, lv := range labels {
+		data[string(ln)] = string(lv)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
 // aggrGroup aggregates alert fingerprints into groups to which a
 // common set of routing options applies.
 // It emits notifications in the specified intervals.
 type aggrGroup struct {
-	labels   model.LabelSet
-	opts     *RouteOpts
+	labels model.LabelSet
+	opts   *RouteOpts
+
+	// receiver is the receiver name resolved for this group, which, for a
+	// route with RouteOpts.ReceiverTemplate set, may differ from
+	// opts.Receiver (the raw, unrendered template). It is resolved once,
+	// from the alert that created this group, and reused for as long as
+	// the group exists.
+	receiver string
+
 	logger   log.Logger
 	routeKey string
 
-	alerts  *store.Alerts
-	ctx     context.Context
-	cancel  func()
-	done    chan struct{}
-	next    *time.Timer
-	timeout func(time.Duration) time.Duration
-
-	mtx        sync.RWMutex
-	hasFlushed bool
+	alerts     *store.Alerts
+	ctx        context.Context
+	cancel     func()
+	done       chan struct{}
+	next       *time.Timer
+	timeout    func(time.Duration) time.Duration
+	createdAt  time.Time
+	notes      *groupnotes.Tracker
+	escalation *escalation.Tracker
+
+	// alertsTruncated counts, via the "route" label, alerts dropped
+	// because this group had already reached its max_alerts_per_group
+	// limit.
+	alertsTruncated *prometheus.CounterVec
+
+	mtx             sync.RWMutex
+	hasFlushed      bool
+	waitOverridden  bool
+	nextFlush       time.Time
+	truncatedAlerts int
 }
 
-// newAggrGroup returns a new aggregation group.
-func newAggrGroup(ctx context.Context, labels model.LabelSet, r *Route, to func(time.Duration) time.Duration, logger log.Logger) *aggrGroup {
+// newAggrGroup returns a new aggregation group. alertsTruncated, if
+// non-nil, is incremented whenever insert drops an alert because the
+// group has reached r.RouteOpts.MaxAlertsPerGroup.
+func newAggrGroup(ctx context.Context, labels model.LabelSet, r *Route, receiver string, to func(time.Duration) time.Duration, notes *groupnotes.Tracker, esc *escalation.Tracker, logger log.Logger, alertsTruncated *prometheus.CounterVec) *aggrGroup {
 	if to == nil {
 		to = func(d time.Duration) time.Duration { return d }
 	}
 	ag := &aggrGroup{
-		labels:   labels,
-		routeKey: r.Key(),
-		opts:     &r.RouteOpts,
-		timeout:  to,
-		alerts:   store.NewAlerts(),
-		done:     make(chan struct{}),
+		labels:          labels,
+		routeKey:        r.Key(),
+		opts:            &r.RouteOpts,
+		receiver:        receiver,
+		timeout:         to,
+		alerts:          store.NewAlerts(),
+		done:            make(chan struct{}),
+		createdAt:       time.Now(),
+		notes:           notes,
+		escalation:      esc,
+		alertsTruncated: alertsTruncated,
 	}
 	ag.ctx, ag.cancel = context.WithCancel(ctx)
 
@@ -322,11 +955,30 @@ func newAggrGroup(ctx context.Context, labels model.LabelSet, r *Route, to func(
 
 	// Set an initial one-time wait before flushing
 	// the first batch of notifications.
-	ag.next = time.NewTimer(ag.opts.GroupWait)
+	wait := ag.opts.GroupWait + jitter(ag.opts.GroupJitter)
+	ag.next = time.NewTimer(wait)
+	ag.nextFlush = ag.createdAt.Add(wait)
 
 	return ag
 }
 
+// jitter returns a random duration in [0, max), or 0 if max is not
+// positive, so callers can stagger periodic work that would otherwise fire
+// in lockstep across many aggrGroups created at the same instant.
+func jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// NextFlush returns the time ag's next scheduled flush will fire.
+func (ag *aggrGroup) NextFlush() time.Time {
+	ag.mtx.RLock()
+	defer ag.mtx.RUnlock()
+	return ag.nextFlush
+}
+
 func (ag *aggrGroup) fingerprint() model.Fingerprint {
 	return ag.labels.Fingerprint()
 }
@@ -339,6 +991,88 @@ func (ag *aggrGroup) String() string {
 	return ag.GroupKey()
 }
 
+// repeatInterval returns how long must pass since this group's last
+// notification before it repeats. If RouteOpts.RepeatIntervals is set, it
+// walks that escalating schedule by total time elapsed since the group was
+// created, holding at the last entry once the schedule is exhausted, so a
+// long-running group backs off instead of repeating on a fixed cadence
+// forever. Otherwise it returns the fixed RepeatInterval.
+func (ag *aggrGroup) repeatInterval(now time.Time) time.Duration {
+	if len(ag.opts.RepeatIntervals) == 0 {
+		return ag.opts.RepeatInterval
+	}
+
+	elapsed := now.Sub(ag.createdAt)
+	var cumulative time.Duration
+	for _, ri := range ag.opts.RepeatIntervals {
+		cumulative += ri
+		if elapsed < cumulative {
+			return ri
+		}
+	}
+	return ag.opts.RepeatIntervals[len(ag.opts.RepeatIntervals)-1]
+}
+
+// notifyContext returns a context for a notification sent to receiver as
+// of now, populated the same way for every notification attempt this
+// group makes, whether a routine group_interval flush or an escalation
+// re-route.
+func (ag *aggrGroup) notifyContext(now time.Time, receiver string) (context.Context, func()) {
+	// Give the notifications time until the next flush to
+	// finish before terminating them.
+	ctx, cancel := context.WithTimeout(ag.ctx, ag.timeout(ag.opts.GroupInterval))
+
+	// The now time we retrieve from the ticker is the only reliable
+	// point of time reference for the subsequent notification pipeline.
+	// Calculating the current time directly is prone to flaky behavior,
+	// which usually only becomes apparent in tests.
+	ctx = notify.WithNow(ctx, now)
+
+	// Populate context with information needed along the pipeline.
+	ctx = notify.WithGroupKey(ctx, ag.GroupKey())
+	if ag.opts.DedupKeyTemplate != nil {
+		ctx = notify.WithDedupKey(ctx, templateDedupKey(ag.labels, ag.opts.DedupKeyTemplate))
+	}
+	ctx = notify.WithGroupLabels(ctx, ag.labels)
+	ctx = notify.WithReceiverName(ctx, receiver)
+	if ag.opts.ReceiverTemplate != nil {
+		ctx = notify.WithReceiverFallback(ctx, ag.opts.ReceiverFallback)
+	}
+	ctx = notify.WithRepeatInterval(ctx, ag.repeatInterval(now))
+	if ag.opts.RepeatOnlyOnChange {
+		ctx = notify.WithRepeatOnlyOnChange(ctx, ag.opts.RepeatOnlyOnChange)
+	}
+	if ag.opts.ExternalURL != "" {
+		ctx = notify.WithExternalURL(ctx, ag.opts.ExternalURL)
+	}
+	if ag.opts.NotificationTimeout > 0 {
+		ctx = notify.WithNotificationTimeout(ctx, ag.opts.NotificationTimeout)
+	}
+	if ag.opts.Timezone != nil {
+		ctx = notify.WithTimezone(ctx, ag.opts.Timezone)
+	}
+	if len(ag.opts.MuteTimeIntervals) > 0 {
+		ctx = notify.WithMuteTimeIntervalNames(ctx, ag.opts.MuteTimeIntervals)
+	}
+	if len(ag.opts.ActiveTimeIntervals) > 0 {
+		ctx = notify.WithActiveTimeIntervalNames(ctx, ag.opts.ActiveTimeIntervals)
+	}
+	if ag.opts.AlertSortLabel != "" || ag.opts.MaxAlertsRendered > 0 {
+		ctx = notify.WithAlertRenderOpts(ctx, notify.AlertRenderOpts{
+			SortLabel: ag.opts.AlertSortLabel,
+			SortOrder: ag.opts.AlertSortOrder,
+			MaxAlerts: ag.opts.MaxAlertsRendered,
+		})
+	}
+	if ag.notes != nil {
+		if notes, ok := ag.notes.Get(ag.GroupKey()); ok {
+			ctx = notify.WithGroupNotes(ctx, notes)
+		}
+	}
+
+	return ctx, cancel
+}
+
 func (ag *aggrGroup) run(nf notifyFunc) {
 	defer close(ag.done)
 	defer ag.next.Stop()
@@ -346,32 +1080,27 @@ func (ag *aggrGroup) run(nf notifyFunc) {
 	for {
 		select {
 		case now := <-ag.next.C:
-			// Give the notifications time until the next flush to
-			// finish before terminating them.
-			ctx, cancel := context.WithTimeout(ag.ctx, ag.timeout(ag.opts.GroupInterval))
-
-			// The now time we retrieve from the ticker is the only reliable
-			// point of time reference for the subsequent notification pipeline.
-			// Calculating the current time directly is prone to flaky behavior,
-			// which usually only becomes apparent in tests.
-			ctx = notify.WithNow(ctx, now)
-
-			// Populate context with information needed along the pipeline.
-			ctx = notify.WithGroupKey(ctx, ag.GroupKey())
-			ctx = notify.WithGroupLabels(ctx, ag.labels)
-			ctx = notify.WithReceiverName(ctx, ag.opts.Receiver)
-			ctx = notify.WithRepeatInterval(ctx, ag.opts.RepeatInterval)
+			ctx, cancel := ag.notifyContext(now, ag.receiver)
 
 			// Wait the configured interval before calling flush again.
 			ag.mtx.Lock()
-			ag.next.Reset(ag.opts.GroupInterval)
+			firstFlush := !ag.hasFlushed
+			interval := ag.opts.GroupInterval + jitter(ag.opts.GroupJitter)
+			ag.next.Reset(interval)
+			ag.nextFlush = now.Add(interval)
 			ag.hasFlushed = true
 			ag.mtx.Unlock()
 
-			ag.flush(func(alerts ...*types.Alert) bool {
-				return nf(ctx, alerts...)
+			var notified bool
+			ag.flush(firstFlush, func(alerts ...*types.Alert) bool {
+				notified = nf(ctx, alerts...)
+				return notified
 			})
 
+			if firstFlush && notified && ag.opts.EscalationReceiver != "" && ag.opts.EscalationTimeout > 0 {
+				go ag.watchEscalation(now, nf)
+			}
+
 			cancel()
 
 		case <-ag.ctx.Done():
@@ -380,6 +1109,34 @@ func (ag *aggrGroup) run(nf notifyFunc) {
 	}
 }
 
+// watchEscalation waits opts.EscalationTimeout for an acknowledgement of
+// the notification sent at notifiedAt; if none arrives before the group
+// stops, it re-sends the group's still-active alerts to
+// opts.EscalationReceiver, so an unanswered page is escalated to a
+// secondary receiver instead of being left to repeat on its own schedule.
+func (ag *aggrGroup) watchEscalation(notifiedAt time.Time, nf notifyFunc) {
+	select {
+	case <-time.After(ag.opts.EscalationTimeout):
+	case <-ag.ctx.Done():
+		return
+	}
+
+	if ag.escalation != nil && ag.escalation.AckedSince(ag.GroupKey(), notifiedAt) {
+		return
+	}
+
+	alerts := ag.alerts.List()
+	if len(alerts) == 0 {
+		return
+	}
+
+	level.Warn(ag.logger).Log("msg", "Notification not acknowledged in time, escalating", "escalation_receiver", ag.opts.EscalationReceiver)
+
+	ctx, cancel := ag.notifyContext(time.Now(), ag.opts.EscalationReceiver)
+	defer cancel()
+	nf(ctx, alerts...)
+}
+
 func (ag *aggrGroup) stop() {
 	// Calling cancel will terminate all in-process notifications
 	// and the run() loop.
@@ -387,8 +1144,26 @@ func (ag *aggrGroup) stop() {
 	<-ag.done
 }
 
-// insert inserts the alert into the aggregation group.
+// insert inserts the alert into the aggregation group, unless doing so
+// would exceed the group's configured max_alerts_per_group, in which case
+// the alert is dropped and counted towards a truncation marker appended
+// on the next flush.
 func (ag *aggrGroup) insert(alert *types.Alert) {
+	if ag.opts.MaxAlertsPerGroup > 0 {
+		_, err := ag.alerts.Get(alert.Fingerprint())
+		alreadyPresent := err == nil
+		if !alreadyPresent && len(ag.alerts.List()) >= ag.opts.MaxAlertsPerGroup {
+			level.Warn(ag.logger).Log("msg", "Dropping alert, group has reached its max_alerts_per_group limit", "max_alerts_per_group", ag.opts.MaxAlertsPerGroup)
+			if ag.alertsTruncated != nil {
+				ag.alertsTruncated.WithLabelValues(ag.routeKey).Inc()
+			}
+			ag.mtx.Lock()
+			ag.truncatedAlerts++
+			ag.mtx.Unlock()
+			return
+		}
+	}
+
 	if err := ag.alerts.Set(alert); err != nil {
 		level.Error(ag.logger).Log("msg", "error on set alert", "err", err)
 	}
@@ -399,6 +1174,31 @@ func (ag *aggrGroup) insert(alert *types.Alert) {
 	defer ag.mtx.Unlock()
 	if !ag.hasFlushed && alert.StartsAt.Add(ag.opts.GroupWait).Before(time.Now()) {
 		ag.next.Reset(0)
+		ag.nextFlush = time.Now()
+		return
+	}
+
+	// Fast-path the initial notification for alerts matching a configured
+	// group_wait_override, so e.g. the first critical alert in a new group
+	// need not wait out a group_wait tuned for less urgent alerts.
+	if !ag.hasFlushed && !ag.waitOverridden {
+		if wait, ok := ag.opts.matchGroupWait(alert.Labels, alert.Annotations); ok {
+			ag.waitOverridden = true
+			ag.next.Reset(wait)
+			ag.nextFlush = time.Now().Add(wait)
+		}
+	}
+
+	// Once the group has sent its first notification, flush immediately
+	// as soon as every alert in it has resolved rather than waiting out
+	// the rest of group_interval, so responders aren't left with a stale
+	// firing notification after the incident is already over.
+	if ag.opts.FlushOnResolve && ag.hasFlushed {
+		now := time.Now()
+		if allResolved(types.AlertSlice(ag.alerts.List()), now) {
+			ag.next.Reset(0)
+			ag.nextFlush = now
+		}
 	}
 }
 
@@ -406,8 +1206,52 @@ func (ag *aggrGroup) empty() bool {
 	return ag.alerts.Empty()
 }
 
+// readyToFlush reports whether the group has already sent its first
+// notification, or its initial group_wait has elapsed as of now, so it can
+// be flushed immediately instead of waiting out the remainder of group_wait
+// during a drain.
+func (ag *aggrGroup) readyToFlush(now time.Time) bool {
+	ag.mtx.RLock()
+	defer ag.mtx.RUnlock()
+	return ag.hasFlushed || now.After(ag.createdAt.Add(ag.opts.GroupWait))
+}
+
+// triggerFlush causes the group's run loop to flush immediately rather than
+// waiting for its timer to fire on its own.
+func (ag *aggrGroup) triggerFlush() {
+	ag.next.Reset(0)
+}
+
+// allResolved reports whether every alert in alerts has already resolved as
+// of now.
+func allResolved(alerts types.AlertSlice, now time.Time) bool {
+	for _, a := range alerts {
+		if !a.ResolvedAt(now) {
+			return false
+		}
+	}
+	return true
+}
+
+// truncationMarker builds a synthetic alert summarizing how many alerts a
+// group dropped because it reached its configured max_alerts_per_group, so
+// a notification still tells responders that alerts are missing instead of
+// silently under-reporting the incident.
+func truncationMarker(labels model.LabelSet, truncated int, now time.Time) *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: labels.Clone(),
+			Annotations: model.LabelSet{
+				"summary": model.LabelValue(fmt.Sprintf("%d alerts dropped by max_alerts_per_group", truncated)),
+			},
+			StartsAt: now,
+		},
+		UpdatedAt: now,
+	}
+}
+
 // flush sends notifications for all new alerts.
-func (ag *aggrGroup) flush(notify func(...*types.Alert) bool) {
+func (ag *aggrGroup) flush(firstFlush bool, notify func(...*types.Alert) bool) {
 	if ag.empty() {
 		return
 	}
@@ -423,13 +1267,42 @@ func (ag *aggrGroup) flush(notify func(...*types.Alert) bool) {
 		if !a.ResolvedAt(now) {
 			a.EndsAt = time.Time{}
 		}
+		// Hold back alerts that haven't reached min_alert_age yet; they
+		// stay in the store and are reconsidered on the next flush.
+		if ag.opts.MinAlertAge > 0 && now.Sub(a.StartsAt) < ag.opts.MinAlertAge {
+			continue
+		}
 		alertsSlice = append(alertsSlice, &a)
 	}
 	sort.Stable(alertsSlice)
 
+	if len(alertsSlice) == 0 {
+		level.Debug(ag.logger).Log("msg", "flush skipped, all alerts held back by min_alert_age")
+		return
+	}
+
+	if firstFlush && ag.opts.AbortOnResolve && allResolved(alertsSlice, now) {
+		level.Debug(ag.logger).Log("msg", "aborting notification, all alerts resolved during group_wait", "alerts", fmt.Sprintf("%v", alertsSlice))
+		for _, a := range alertsSlice {
+			if err := ag.alerts.Delete(a.Fingerprint()); err != nil {
+				level.Error(ag.logger).Log("msg", "error on delete alert", "err", err, "alert", a.String())
+			}
+		}
+		return
+	}
+
 	level.Debug(ag.logger).Log("msg", "flushing", "alerts", fmt.Sprintf("%v", alertsSlice))
 
-	if notify(alertsSlice...) {
+	ag.mtx.Lock()
+	truncated := ag.truncatedAlerts
+	ag.truncatedAlerts = 0
+	ag.mtx.Unlock()
+	notifySlice := alertsSlice
+	if truncated > 0 {
+		notifySlice = append(types.AlertSlice{truncationMarker(ag.labels, truncated, now)}, alertsSlice...)
+	}
+
+	if notify(notifySlice...) {
 		for _, a := range alertsSlice {
 			// Only delete if the fingerprint has not been inserted
 			// again since we notified about it.
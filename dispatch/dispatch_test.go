@@ -15,10 +15,14 @@ package dispatch
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"testing"
+	texttemplate "text/template"
 	"time"
 
 	"github.com/go-kit/kit/log"
@@ -27,7 +31,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/decisionlog"
+	"github.com/prometheus/alertmanager/history"
 	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/escalation"
+	"github.com/prometheus/alertmanager/notify/groupnotes"
 	"github.com/prometheus/alertmanager/provider/mem"
 	"github.com/prometheus/alertmanager/types"
 )
@@ -136,7 +144,7 @@ func TestAggrGroup(t *testing.T) {
 	}
 
 	// Test regular situation where we wait for group_wait to send out alerts.
-	ag := newAggrGroup(context.Background(), lset, route, nil, log.NewNopLogger())
+	ag := newAggrGroup(context.Background(), lset, route, opts.Receiver, nil, nil, nil, log.NewNopLogger(), nil)
 	go ag.run(ntfy)
 
 	ag.insert(a1)
@@ -190,7 +198,7 @@ func TestAggrGroup(t *testing.T) {
 	// immediate flushing.
 	// Finally, set all alerts to be resolved. After successful notify the aggregation group
 	// should empty itself.
-	ag = newAggrGroup(context.Background(), lset, route, nil, log.NewNopLogger())
+	ag = newAggrGroup(context.Background(), lset, route, opts.Receiver, nil, nil, nil, log.NewNopLogger(), nil)
 	go ag.run(ntfy)
 
 	ag.insert(a1)
@@ -267,6 +275,403 @@ func TestAggrGroup(t *testing.T) {
 	ag.stop()
 }
 
+func TestAggrGroupWaitOverride(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:      "n1",
+			GroupWait:     time.Hour,
+			GroupInterval: time.Hour,
+			GroupWaitOverrides: []GroupWaitOverride{
+				{
+					Matchers:  types.Matchers{types.NewMatcher("severity", "critical")},
+					GroupWait: 0,
+				},
+			},
+		},
+	}
+
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, nil, nil, log.NewNopLogger(), nil)
+	defer ag.cancel()
+
+	ag.insert(&types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"severity": "critical"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+		UpdatedAt: time.Now(),
+	})
+
+	select {
+	case <-ag.next.C:
+	case <-time.After(time.Second):
+		t.Fatalf("expected group_wait_override to fast-path the initial flush")
+	}
+}
+
+func TestAggrGroupJitter(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:      "n1",
+			GroupWait:     time.Hour,
+			GroupInterval: time.Hour,
+			GroupJitter:   time.Minute,
+		},
+	}
+
+	for i := 0; i < 20; i++ {
+		ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, nil, nil, log.NewNopLogger(), nil)
+		wait := ag.nextFlush.Sub(ag.createdAt)
+		ag.cancel()
+
+		if wait < r.RouteOpts.GroupWait || wait >= r.RouteOpts.GroupWait+r.RouteOpts.GroupJitter {
+			t.Fatalf("expected initial wait in [%s, %s), got %s", r.RouteOpts.GroupWait, r.RouteOpts.GroupWait+r.RouteOpts.GroupJitter, wait)
+		}
+	}
+}
+
+func TestAggrGroupAbortOnResolve(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:       "n1",
+			GroupWait:      100 * time.Millisecond,
+			GroupInterval:  100 * time.Millisecond,
+			AbortOnResolve: true,
+		},
+	}
+
+	var notified int32
+	ntfy := func(ctx context.Context, alerts ...*types.Alert) bool {
+		atomic.AddInt32(&notified, 1)
+		return true
+	}
+
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, nil, nil, log.NewNopLogger(), nil)
+	go ag.run(ntfy)
+	defer ag.stop()
+
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "Blip"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+		UpdatedAt: time.Now(),
+	}
+	ag.insert(a)
+
+	// The alert resolves before group_wait elapses.
+	resolved := *a
+	resolved.EndsAt = time.Now()
+	ag.insert(&resolved)
+
+	time.Sleep(2 * r.RouteOpts.GroupWait)
+
+	if n := atomic.LoadInt32(&notified); n != 0 {
+		t.Fatalf("expected no notification to be sent, got %d", n)
+	}
+	if !ag.empty() {
+		t.Fatalf("expected aggregation group to be empty after aborting, got %v", ag)
+	}
+}
+
+func TestAggrGroupFlushOnResolve(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:       "n1",
+			GroupWait:      30 * time.Millisecond,
+			GroupInterval:  time.Hour,
+			FlushOnResolve: true,
+		},
+	}
+
+	var notifications int32
+	ntfy := func(ctx context.Context, alerts ...*types.Alert) bool {
+		atomic.AddInt32(&notifications, 1)
+		return true
+	}
+
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, nil, nil, log.NewNopLogger(), nil)
+	go ag.run(ntfy)
+	defer ag.stop()
+
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "Blip"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+		UpdatedAt: time.Now(),
+	}
+	ag.insert(a)
+
+	// Wait for the initial firing notification to go out.
+	for i := 0; i < 100 && atomic.LoadInt32(&notifications) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := atomic.LoadInt32(&notifications); n != 1 {
+		t.Fatalf("expected one notification for the initial flush, got %d", n)
+	}
+
+	// The alert resolves; with group_interval set to an hour, only
+	// flush_on_resolve can cause the resolved notice to go out promptly.
+	resolved := *a
+	resolved.EndsAt = time.Now()
+	ag.insert(&resolved)
+
+	for i := 0; i < 100 && atomic.LoadInt32(&notifications) < 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := atomic.LoadInt32(&notifications); n != 2 {
+		t.Fatalf("expected a prompt resolved notification, got %d", n)
+	}
+}
+
+func TestAggrGroupMinAlertAge(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:      "n1",
+			GroupWait:     10 * time.Millisecond,
+			GroupInterval: 100 * time.Millisecond,
+			MinAlertAge:   300 * time.Millisecond,
+		},
+	}
+
+	var notified int32
+	var alertsAtNotify int
+	ntfy := func(ctx context.Context, alerts ...*types.Alert) bool {
+		atomic.AddInt32(&notified, 1)
+		alertsAtNotify = len(alerts)
+		return true
+	}
+
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, nil, nil, log.NewNopLogger(), nil)
+	go ag.run(ntfy)
+	defer ag.stop()
+
+	ag.insert(&types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "New"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+		UpdatedAt: time.Now(),
+	})
+
+	// group_wait and the first group_interval elapse while the alert is
+	// still younger than min_alert_age, so it must be held back.
+	time.Sleep(150 * time.Millisecond)
+	if n := atomic.LoadInt32(&notified); n != 0 {
+		t.Fatalf("expected no notification before min_alert_age elapses, got %d", n)
+	}
+
+	// Once min_alert_age elapses, the next flush must send it.
+	time.Sleep(300 * time.Millisecond)
+	if n := atomic.LoadInt32(&notified); n == 0 {
+		t.Fatalf("expected a notification once min_alert_age elapsed, got %d", n)
+	}
+	if alertsAtNotify != 1 {
+		t.Fatalf("expected the held alert to be included once ready, got %d alerts", alertsAtNotify)
+	}
+}
+
+func TestAggrGroupMaxAlertsPerGroup(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:          "n1",
+			GroupWait:         10 * time.Millisecond,
+			GroupInterval:     50 * time.Millisecond,
+			MaxAlertsPerGroup: 2,
+		},
+	}
+
+	var mtx sync.Mutex
+	var lastBatch types.AlertSlice
+	ntfy := func(ctx context.Context, alerts ...*types.Alert) bool {
+		mtx.Lock()
+		lastBatch = alerts
+		mtx.Unlock()
+		return true
+	}
+
+	reg := prometheus.NewRegistry()
+	truncated := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "truncated"}, []string{"route"})
+	reg.MustRegister(truncated)
+
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, nil, nil, log.NewNopLogger(), truncated)
+	go ag.run(ntfy)
+	defer ag.stop()
+
+	ag.insert(&types.Alert{Alert: model.Alert{Labels: model.LabelSet{"a": "1"}, StartsAt: time.Now()}, UpdatedAt: time.Now()})
+	ag.insert(&types.Alert{Alert: model.Alert{Labels: model.LabelSet{"a": "2"}, StartsAt: time.Now()}, UpdatedAt: time.Now()})
+	// Exceeds max_alerts_per_group and should be dropped, not stored.
+	ag.insert(&types.Alert{Alert: model.Alert{Labels: model.LabelSet{"a": "3"}, StartsAt: time.Now()}, UpdatedAt: time.Now()})
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		mtx.Lock()
+		n := len(lastBatch)
+		mtx.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mtx.Lock()
+	batch := lastBatch
+	mtx.Unlock()
+	require.Len(t, batch, 3, "expected the two stored alerts plus a truncation marker")
+
+	var foundMarker bool
+	for _, a := range batch {
+		if summary, ok := a.Annotations["summary"]; ok && summary == "1 alerts dropped by max_alerts_per_group" {
+			foundMarker = true
+		}
+	}
+	require.True(t, foundMarker, "expected a truncation marker alert in the flushed batch")
+}
+
+func TestAggrGroupNotes(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:      "n1",
+			GroupWait:     100 * time.Millisecond,
+			GroupInterval: 100 * time.Millisecond,
+		},
+	}
+
+	tracker := groupnotes.New()
+
+	var gotNotes string
+	var gotOK bool
+	notified := make(chan struct{}, 1)
+	ntfy := func(ctx context.Context, alerts ...*types.Alert) bool {
+		gotNotes, gotOK = notify.GroupNotes(ctx)
+		notified <- struct{}{}
+		return true
+	}
+
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, tracker, nil, log.NewNopLogger(), nil)
+	tracker.Set(ag.GroupKey(), "driver rollback in progress")
+	go ag.run(ntfy)
+	defer ag.stop()
+
+	ag.insert(&types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "Blip"},
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		},
+		UpdatedAt: time.Now(),
+	})
+
+	select {
+	case <-notified:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected a notification")
+	}
+
+	if !gotOK || gotNotes != "driver rollback in progress" {
+		t.Fatalf("expected group notes to be propagated, got %q (ok=%v)", gotNotes, gotOK)
+	}
+}
+
+func TestAggrGroupEscalation(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:           "n1",
+			GroupWait:          10 * time.Millisecond,
+			GroupInterval:      time.Hour,
+			EscalationReceiver: "n2",
+			EscalationTimeout:  50 * time.Millisecond,
+		},
+	}
+
+	tracker := escalation.New()
+
+	var mtx sync.Mutex
+	var receivers []string
+	notified := make(chan struct{}, 2)
+	ntfy := func(ctx context.Context, alerts ...*types.Alert) bool {
+		receiver, _ := notify.ReceiverName(ctx)
+		mtx.Lock()
+		receivers = append(receivers, receiver)
+		mtx.Unlock()
+		notified <- struct{}{}
+		return true
+	}
+
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "n1", nil, nil, tracker, log.NewNopLogger(), nil)
+	go ag.run(ntfy)
+	defer ag.stop()
+
+	ag.insert(&types.Alert{
+		Alert:     model.Alert{Labels: model.LabelSet{"alertname": "Blip"}, StartsAt: time.Now()},
+		UpdatedAt: time.Now(),
+	})
+
+	select {
+	case <-notified:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected the initial notification")
+	}
+
+	select {
+	case <-notified:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected an escalation notification")
+	}
+
+	mtx.Lock()
+	got := append([]string(nil), receivers...)
+	mtx.Unlock()
+	require.Equal(t, []string{"n1", "n2"}, got, "expected the unacknowledged group to escalate to n2")
+}
+
+func TestAggrGroupEscalationAcked(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:           "n1",
+			GroupWait:          10 * time.Millisecond,
+			GroupInterval:      time.Hour,
+			EscalationReceiver: "n2",
+			EscalationTimeout:  50 * time.Millisecond,
+		},
+	}
+
+	tracker := escalation.New()
+
+	notified := make(chan struct{}, 2)
+	ntfy := func(ctx context.Context, alerts ...*types.Alert) bool {
+		notified <- struct{}{}
+		return true
+	}
+
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "n1", nil, nil, tracker, log.NewNopLogger(), nil)
+	go ag.run(ntfy)
+	defer ag.stop()
+
+	ag.insert(&types.Alert{
+		Alert:     model.Alert{Labels: model.LabelSet{"alertname": "Blip"}, StartsAt: time.Now()},
+		UpdatedAt: time.Now(),
+	})
+
+	select {
+	case <-notified:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("expected the initial notification")
+	}
+
+	tracker.Ack(ag.GroupKey(), time.Now())
+
+	select {
+	case <-notified:
+		t.Fatalf("did not expect an escalation notification for an acknowledged group")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
 func TestGroupLabels(t *testing.T) {
 	var a = &types.Alert{
 		Alert: model.Alert{
@@ -293,7 +698,7 @@ func TestGroupLabels(t *testing.T) {
 		"b": "v2",
 	}
 
-	ls := getGroupLabels(a, route)
+	ls := GroupLabels(a, route)
 
 	if !reflect.DeepEqual(ls, expLs) {
 		t.Fatalf("expected labels are %v, but got %v", expLs, ls)
@@ -324,13 +729,157 @@ func TestGroupByAllLabels(t *testing.T) {
 		"c": "v3",
 	}
 
-	ls := getGroupLabels(a, route)
+	ls := GroupLabels(a, route)
+
+	if !reflect.DeepEqual(ls, expLs) {
+		t.Fatalf("expected labels are %v, but got %v", expLs, ls)
+	}
+}
+
+func TestGroupByAllExcludeLabels(t *testing.T) {
+	var a = &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"a": "v1",
+				"b": "v2",
+				"c": "v3",
+			},
+		},
+	}
+
+	route := &Route{
+		RouteOpts: RouteOpts{
+			GroupBy:    map[model.LabelName]struct{}{},
+			GroupByAll: true,
+			GroupByExclude: map[model.LabelName]struct{}{
+				"c": struct{}{},
+			},
+		},
+	}
+
+	expLs := model.LabelSet{
+		"a": "v1",
+		"b": "v2",
+	}
+
+	ls := GroupLabels(a, route)
+
+	if !reflect.DeepEqual(ls, expLs) {
+		t.Fatalf("expected labels are %v, but got %v", expLs, ls)
+	}
+}
+
+func TestGroupByTemplateLabels(t *testing.T) {
+	var a = &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"cluster":   "prod-1",
+				"alertname": "NodeDown",
+			},
+		},
+	}
+
+	tmpl, err := texttemplate.New("group_by_template").Funcs(config.GroupByTemplateFuncs).Parse(
+		`{{ .cluster }}-{{ truncate 4 .alertname }}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	route := &Route{
+		RouteOpts: RouteOpts{
+			GroupByTemplate: tmpl,
+		},
+	}
+
+	expLs := model.LabelSet{
+		groupByTemplateKeyLabel: "prod-1-Node",
+	}
+
+	ls := GroupLabels(a, route)
 
 	if !reflect.DeepEqual(ls, expLs) {
 		t.Fatalf("expected labels are %v, but got %v", expLs, ls)
 	}
 }
 
+func TestApplyAnnotationTemplates(t *testing.T) {
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:      model.LabelSet{"service": "api"},
+			Annotations: model.LabelSet{"summary": "already set"},
+		},
+	}
+
+	tmpl, err := texttemplate.New("annotation_template").Funcs(config.GroupByTemplateFuncs).Parse(
+		`https://dashboards.example.com/d/{{ .service }}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpls := map[string]*texttemplate.Template{"dashboard": tmpl}
+
+	got := applyAnnotationTemplates(a, tmpls)
+
+	want := model.LabelSet{
+		"summary":   "already set",
+		"dashboard": "https://dashboards.example.com/d/api",
+	}
+	if !reflect.DeepEqual(got.Annotations, want) {
+		t.Fatalf("expected annotations %v, got %v", want, got.Annotations)
+	}
+
+	// The original alert is left untouched, since it may be shared with
+	// other matched routes.
+	if _, ok := a.Annotations["dashboard"]; ok {
+		t.Fatal("expected the original alert not to be mutated")
+	}
+}
+
+func TestApplyAnnotationTemplatesNoop(t *testing.T) {
+	a := &types.Alert{
+		Alert: model.Alert{Labels: model.LabelSet{"service": "api"}},
+	}
+
+	got := applyAnnotationTemplates(a, nil)
+	if got != a {
+		t.Fatal("expected the same alert to be returned when no annotation_templates are configured")
+	}
+}
+
+func TestGetGroupLabelsStormMode(t *testing.T) {
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"alertname": "NodeDown", "cluster": "prod-1"},
+		},
+	}
+
+	route := &Route{
+		RouteOpts: RouteOpts{
+			GroupBy:        map[model.LabelName]struct{}{"alertname": {}},
+			StormThreshold: 3,
+		},
+	}
+
+	if ls := GroupLabels(a, route); !reflect.DeepEqual(ls, model.LabelSet{"alertname": "NodeDown"}) {
+		t.Fatalf("expected normal GroupBy grouping before a storm, got %v", ls)
+	}
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		route.recordStorm(now)
+		now = now.Add(stormWindow)
+	}
+	for i := 0; !route.stormActive() && i < 100; i++ {
+		route.recordStorm(now)
+	}
+	if !route.stormActive() {
+		t.Fatal("expected storm mode to activate")
+	}
+
+	if ls := GroupLabels(a, route); !reflect.DeepEqual(ls, model.LabelSet{}) {
+		t.Fatalf("expected storm mode to fold every alert into a single group, got %v", ls)
+	}
+}
+
 func TestGroups(t *testing.T) {
 	confData := `receivers:
 - name: 'kafka'
@@ -362,7 +911,7 @@ route:
 	}
 
 	logger := log.NewNopLogger()
-	route := NewRoute(conf.Route, nil)
+	route := NewRoute(conf.Route, nil, nil)
 	marker := types.NewMarker(prometheus.NewRegistry())
 	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, logger)
 	if err != nil {
@@ -372,7 +921,7 @@ route:
 
 	timeout := func(d time.Duration) time.Duration { return time.Duration(0) }
 	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
-	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, logger)
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, prometheus.NewRegistry(), nil, logger)
 	go dispatcher.Run()
 	defer dispatcher.Stop()
 
@@ -406,6 +955,14 @@ route:
 		},
 	)
 
+	// Key and NextFlush are asserted separately below; clear them here so
+	// the rest of the comparison doesn't have to hardcode their exact,
+	// route-key- and wall-clock-dependent values.
+	for _, g := range alertGroups {
+		g.Key = ""
+		g.NextFlush = time.Time{}
+	}
+
 	require.Equal(t, AlertGroups{
 		&AlertGroup{
 			Alerts: []*types.Alert{inputAlerts[0]},
@@ -459,6 +1016,16 @@ route:
 			Receiver: "prod",
 		},
 	}, alertGroups)
+
+	alertGroups, _ = dispatcher.Groups(
+		func(*Route) bool { return true },
+		func(*types.Alert, time.Time) bool { return true },
+	)
+	for _, g := range alertGroups {
+		require.NotEmpty(t, g.Key)
+		require.False(t, g.NextFlush.IsZero())
+	}
+
 	require.Equal(t, map[model.Fingerprint][]string{
 		inputAlerts[0].Fingerprint(): []string{"prod"},
 		inputAlerts[1].Fingerprint(): []string{"testing"},
@@ -469,6 +1036,331 @@ route:
 	}, receivers)
 }
 
+func TestDrain(t *testing.T) {
+	confData := `receivers:
+- name: 'prod'
+- name: 'slow'
+
+route:
+  group_by: ['alertname']
+  group_wait: 10ms
+  group_interval: 10ms
+  receiver: 'prod'
+  routes:
+  - match:
+      team: 'slow'
+    receiver: 'slow'
+    group_wait: 1h`
+	conf, err := config.Load(confData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := log.NewNopLogger()
+	route := NewRoute(conf.Route, nil, nil)
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	timeout := func(d time.Duration) time.Duration { return d }
+	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, prometheus.NewRegistry(), nil, logger)
+	go dispatcher.Run()
+	defer dispatcher.Stop()
+
+	// due matches the default group_wait and should be flushed by Drain.
+	// stuck sits behind the 1h group_wait override on the "slow" route and
+	// must not be forced out early.
+	due := newAlert(model.LabelSet{"alertname": "Due"})
+	stuck := newAlert(model.LabelSet{"alertname": "Stuck", "team": "slow"})
+	alerts.Put(due, stuck)
+
+	// Give the dispatcher a moment to sort both alerts into their groups
+	// before we start draining.
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		_, receivers := dispatcher.Groups(
+			func(*Route) bool { return true },
+			func(*types.Alert, time.Time) bool { return true },
+		)
+		if len(receivers) == 2 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	dispatcher.Drain(time.Second)
+
+	require.Len(t, recorder.Alerts(), 1)
+	require.Equal(t, "Due", string(recorder.Alerts()[0].Labels["alertname"]))
+
+	// A new alert arriving after Drain must be dropped, not grouped.
+	alerts.Put(newAlert(model.LabelSet{"alertname": "TooLate"}))
+	time.Sleep(50 * time.Millisecond)
+	require.Len(t, recorder.Alerts(), 1)
+}
+
+func TestDispatcherRecordsGroupedHistory(t *testing.T) {
+	confData := `receivers:
+- name: 'prod'
+
+route:
+  group_by: ['alertname']
+  group_wait: 1h
+  group_interval: 1h
+  receiver: 'prod'`
+	conf, err := config.Load(confData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := log.NewNopLogger()
+	route := NewRoute(conf.Route, nil, nil)
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	h := history.New(0)
+	timeout := func(d time.Duration) time.Duration { return d }
+	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, prometheus.NewRegistry(), nil, logger)
+	dispatcher.SetHistory(h)
+	go dispatcher.Run()
+	defer dispatcher.Stop()
+
+	alert := newAlert(model.LabelSet{"alertname": "Grouped"})
+	alerts.Put(alert)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(h.Get(alert.Fingerprint())) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	events := h.Get(alert.Fingerprint())
+	require.Len(t, events, 1)
+	require.Equal(t, history.EventGrouped, events[0].Type)
+}
+
+func TestDispatcherLogsRoutingDecisions(t *testing.T) {
+	confData := `receivers:
+- name: 'prod'
+
+route:
+  group_by: ['alertname']
+  group_wait: 1h
+  group_interval: 1h
+  receiver: 'prod'`
+	conf, err := config.Load(confData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := log.NewNopLogger()
+	route := NewRoute(conf.Route, nil, nil)
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	path := filepath.Join(t.TempDir(), "decisions.jsonl")
+	dl := decisionlog.New(logger)
+	require.NoError(t, dl.SetFile(path))
+
+	timeout := func(d time.Duration) time.Duration { return d }
+	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, prometheus.NewRegistry(), nil, logger)
+	dispatcher.SetDecisionLog(dl)
+	go dispatcher.Run()
+	defer dispatcher.Stop()
+
+	alert := newAlert(model.LabelSet{"alertname": "Routed"})
+	alerts.Put(alert)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(recorder.Alerts()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	deadline = time.Now().Add(time.Second)
+	var contents []byte
+	for time.Now().Before(deadline) {
+		contents, err = os.ReadFile(path)
+		require.NoError(t, err)
+		if len(contents) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Contains(t, string(contents), `"routed"`)
+	require.Contains(t, string(contents), alert.Fingerprint().String())
+}
+
+func TestDispatcherMaxAggregationGroups(t *testing.T) {
+	confData := `receivers:
+- name: 'prod'
+
+route:
+  group_by: ['alertname']
+  group_wait: 10ms
+  group_interval: 1h
+  max_aggregation_groups: 1
+  receiver: 'prod'`
+	conf, err := config.Load(confData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := log.NewNopLogger()
+	route := NewRoute(conf.Route, nil, nil)
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	timeout := func(d time.Duration) time.Duration { return d }
+	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, prometheus.NewRegistry(), nil, logger)
+	go dispatcher.Run()
+	defer dispatcher.Stop()
+
+	alerts.Put(newAlert(model.LabelSet{"alertname": "First"}))
+	alerts.Put(newAlert(model.LabelSet{"alertname": "Second"}))
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		dispatcher.mtx.RLock()
+		n := len(dispatcher.aggrGroups[route])
+		dispatcher.mtx.RUnlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Give the second alert a chance to (not) create a second group.
+	time.Sleep(100 * time.Millisecond)
+
+	dispatcher.mtx.RLock()
+	n := len(dispatcher.aggrGroups[route])
+	dispatcher.mtx.RUnlock()
+	require.Equal(t, 1, n, "expected the route's group count to stay capped at max_aggregation_groups")
+}
+
+func TestDispatcherCollapsesFloodedNotifications(t *testing.T) {
+	confData := `receivers:
+- name: 'prod'
+
+route:
+  group_by: ['alertname']
+  group_wait: 10ms
+  group_interval: 1h
+  flood_threshold: 2
+  receiver: 'prod'`
+	conf, err := config.Load(confData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := log.NewNopLogger()
+	route := NewRoute(conf.Route, nil, nil)
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	timeout := func(d time.Duration) time.Duration { return d }
+	recorder := &recordStage{alerts: make(map[string]map[model.Fingerprint]*types.Alert)}
+	dispatcher := NewDispatcher(alerts, route, recorder, marker, timeout, prometheus.NewRegistry(), nil, logger)
+	go dispatcher.Run()
+	defer dispatcher.Stop()
+
+	for _, name := range []string{"First", "Second", "Third", "Fourth"} {
+		alerts.Put(newAlert(model.LabelSet{"alertname": model.LabelValue(name)}))
+	}
+
+	var got []*types.Alert
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		got = recorder.Alerts()
+		if len(got) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Len(t, got, 3, "expected the third and later group notifications to collapse into a single summary")
+
+	var sawSummary bool
+	for _, a := range got {
+		if a.Labels["alertname"] == "NotificationsCollapsed" {
+			sawSummary = true
+		}
+	}
+	require.True(t, sawSummary, "expected a NotificationsCollapsed summary alert among the notified alerts")
+}
+
+func TestAggrGroupRepeatIntervalsEscalate(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:        "n1",
+			RepeatIntervals: []time.Duration{time.Hour, 4 * time.Hour, 24 * time.Hour},
+		},
+	}
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, nil, nil, log.NewNopLogger(), nil)
+
+	for _, tc := range []struct {
+		elapsed time.Duration
+		want    time.Duration
+	}{
+		{0, time.Hour},
+		{59 * time.Minute, time.Hour},
+		{90 * time.Minute, 4 * time.Hour},
+		{4 * time.Hour, 4 * time.Hour},
+		{6 * time.Hour, 24 * time.Hour},
+		{100 * time.Hour, 24 * time.Hour}, // holds at the last entry once exhausted
+	} {
+		now := ag.createdAt.Add(tc.elapsed)
+		if got := ag.repeatInterval(now); got != tc.want {
+			t.Errorf("elapsed %v: expected repeat interval %v, got %v", tc.elapsed, tc.want, got)
+		}
+	}
+}
+
+func TestAggrGroupRepeatIntervalFixed(t *testing.T) {
+	r := &Route{
+		RouteOpts: RouteOpts{
+			Receiver:       "n1",
+			RepeatInterval: 2 * time.Hour,
+		},
+	}
+	ag := newAggrGroup(context.Background(), model.LabelSet{}, r, "", nil, nil, nil, log.NewNopLogger(), nil)
+
+	if got := ag.repeatInterval(ag.createdAt.Add(100 * time.Hour)); got != 2*time.Hour {
+		t.Errorf("expected fixed repeat interval %v, got %v", 2*time.Hour, got)
+	}
+}
+
+func TestDispatcherOwnsEverythingWithoutHashRing(t *testing.T) {
+	d := &Dispatcher{}
+	r := &Route{RouteOpts: RouteOpts{Receiver: "prod"}}
+	alert := newAlert(model.LabelSet{"alertname": "Foo"})
+
+	require.True(t, d.owns(r, alert))
+}
+
 type recordStage struct {
 	mtx    sync.RWMutex
 	alerts map[string]map[model.Fingerprint]*types.Alert
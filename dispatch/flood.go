@@ -0,0 +1,70 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"sync"
+	"time"
+)
+
+// floodWindow is the tumbling bucket size distinct group notifications are
+// counted in towards a route's flood threshold.
+const floodWindow = 5 * time.Minute
+
+// floodTracker counts how many distinct alert groups under a route have
+// sent their own notification within the current floodWindow, flagging
+// when a configured threshold is exceeded so the caller can collapse
+// further notifications in that window into a single summary.
+type floodTracker struct {
+	mtx sync.Mutex
+
+	windowStart time.Time
+	count       int
+	summarySent bool
+}
+
+// record registers a group notification attempt at now. If the route's
+// threshold has not been reached yet this window, it returns
+// (true, 0): the caller should send its notification as usual. Once the
+// threshold is exceeded, it returns (false, 0) so the caller suppresses
+// its notification, except the one call that crosses the threshold,
+// which instead returns (false, n) with n set to the number of group
+// notification attempts recorded in this window so far, including the
+// ones already sent -- the caller's signal to send a single summary
+// notification instead. A threshold of zero or
+// less disables flood detection.
+func (f *floodTracker) record(now time.Time, threshold int) (forward bool, collapsed int) {
+	if threshold <= 0 {
+		return true, 0
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.windowStart.IsZero() || now.Sub(f.windowStart) >= floodWindow {
+		f.windowStart = now
+		f.count = 0
+		f.summarySent = false
+	}
+	f.count++
+
+	if f.count <= threshold {
+		return true, 0
+	}
+	if !f.summarySent {
+		f.summarySent = true
+		return false, f.count
+	}
+	return false, 0
+}
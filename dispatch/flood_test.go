@@ -0,0 +1,73 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFloodTrackerCollapsesAfterThreshold(t *testing.T) {
+	var f floodTracker
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		forward, collapsed := f.record(now, 3)
+		if !forward {
+			t.Fatalf("did not expect notification %d to be collapsed", i+1)
+		}
+		if collapsed != 0 {
+			t.Fatalf("did not expect a collapsed count while under threshold (notification %d)", i+1)
+		}
+	}
+
+	forward, collapsed := f.record(now, 3)
+	if forward {
+		t.Fatal("expected the fourth notification to be collapsed")
+	}
+	if collapsed != 4 {
+		t.Fatalf("expected the crossing notification to report a collapsed count of 4, got %d", collapsed)
+	}
+
+	forward, collapsed = f.record(now, 3)
+	if forward || collapsed != 0 {
+		t.Fatal("expected subsequent notifications in the same window to be silently suppressed")
+	}
+}
+
+func TestFloodTrackerResetsOnNewWindow(t *testing.T) {
+	var f floodTracker
+	now := time.Now()
+
+	for i := 0; i < 5; i++ {
+		f.record(now, 3)
+	}
+
+	now = now.Add(floodWindow)
+	forward, collapsed := f.record(now, 3)
+	if !forward || collapsed != 0 {
+		t.Fatal("expected the tracker to forward normally again once the window rolled over")
+	}
+}
+
+func TestFloodTrackerDisabledByThreshold(t *testing.T) {
+	var f floodTracker
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		forward, collapsed := f.record(now, 0)
+		if !forward || collapsed != 0 {
+			t.Fatal("a zero threshold should never collapse notifications")
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import "sync/atomic"
+
+// notifyPool is a bounded pool of workers that execute group notification
+// flushes, so a surge of simultaneously-flushing aggregation groups cannot
+// each hold their own goroutine blocked against a stalled integration.
+// Submissions queue once every worker is busy instead of running inline.
+type notifyPool struct {
+	tasks chan func()
+	busy  int64
+	size  int
+}
+
+// newNotifyPool starts a notifyPool with size workers. size must be
+// greater than 0.
+func newNotifyPool(size int) *notifyPool {
+	p := &notifyPool{
+		tasks: make(chan func(), size*4),
+		size:  size,
+	}
+	for i := 0; i < size; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+func (p *notifyPool) worker() {
+	for task := range p.tasks {
+		atomic.AddInt64(&p.busy, 1)
+		task()
+		atomic.AddInt64(&p.busy, -1)
+	}
+}
+
+// submit queues task to run on the pool, blocking if every worker is busy
+// and the queue is full.
+func (p *notifyPool) submit(task func()) {
+	p.tasks <- task
+}
+
+// queued returns the number of tasks currently waiting for a free worker.
+func (p *notifyPool) queued() int {
+	return len(p.tasks)
+}
+
+// busyWorkers returns the number of workers currently executing a task.
+func (p *notifyPool) busyWorkers() int {
+	return int(atomic.LoadInt64(&p.busy))
+}
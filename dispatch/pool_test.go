@@ -0,0 +1,77 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNotifyPoolBoundsConcurrency(t *testing.T) {
+	p := newNotifyPool(2)
+
+	var (
+		mtx     sync.Mutex
+		current int
+		maxSeen int
+	)
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		p.submit(func() {
+			defer wg.Done()
+			mtx.Lock()
+			current++
+			if current > maxSeen {
+				maxSeen = current
+			}
+			mtx.Unlock()
+
+			<-release
+
+			mtx.Lock()
+			current--
+			mtx.Unlock()
+		})
+	}
+
+	// Give the pool a moment to saturate its two workers before releasing
+	// them all at once.
+	time.Sleep(20 * time.Millisecond)
+	if got := p.busyWorkers(); got != 2 {
+		t.Fatalf("expected 2 busy workers, got %d", got)
+	}
+	if got := p.queued(); got != 3 {
+		t.Fatalf("expected 3 tasks still queued, got %d", got)
+	}
+
+	close(release)
+	wg.Wait()
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	if maxSeen > 2 {
+		t.Fatalf("expected at most 2 tasks to run concurrently, saw %d", maxSeen)
+	}
+}
+
+func TestDispatcherExecNotifyWithoutPoolRunsInline(t *testing.T) {
+	var d Dispatcher
+	if got := d.execNotify(func() bool { return true }); !got {
+		t.Fatal("expected execNotify to return the task's own result when no pool is configured")
+	}
+}
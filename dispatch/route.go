@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	texttemplate "text/template"
 	"time"
 
 	"github.com/prometheus/common/model"
@@ -33,6 +34,8 @@ var DefaultRouteOpts = RouteOpts{
 	RepeatInterval: 4 * time.Hour,
 	GroupBy:        map[model.LabelName]struct{}{},
 	GroupByAll:     false,
+	GroupByExclude: map[model.LabelName]struct{}{},
+	Timezone:       time.UTC,
 }
 
 // A Route is a node that contains definitions of how to handle alerts.
@@ -43,7 +46,9 @@ type Route struct {
 	RouteOpts RouteOpts
 
 	// Equality or regex matchers an alert has to fulfill to match
-	// this route.
+	// this route. Matchers built from config.Route's MatchAnnotations
+	// are included here with Matcher.Annotation set, matching against
+	// the alert's annotations instead of its labels.
 	Matchers types.Matchers
 
 	// If true, an alert matches further routes on the same level.
@@ -51,10 +56,49 @@ type Route struct {
 
 	// Children routes of this route.
 	Routes []*Route
+
+	// storm tracks this route's alert storm state, when RouteOpts.
+	// StormThreshold enables detection.
+	storm stormDetector
+
+	// budget tracks this route's rolling notification count, when
+	// RouteOpts.NotificationBudget enables tracking.
+	budget budgetTracker
+
+	// flood tracks how many distinct groups under this route have
+	// notified within the current window, when RouteOpts.FloodThreshold
+	// enables collapsing.
+	flood floodTracker
+
+	// routeIndex maps the label name of an indexable equality matcher to
+	// its values, and each value to the direct children keyed on it, so
+	// Match need not evaluate every direct child's full Matchers for
+	// routing trees with many siblings. Built once by buildIndex. Nil if
+	// no child qualified for indexing.
+	routeIndex map[model.LabelName]map[string][]routeIndexEntry
+
+	// indexFallback holds direct children that cannot be indexed --
+	// regex-only, negated-only, annotation-only, or empty matcher sets --
+	// and so must always be considered regardless of the label set being
+	// matched.
+	indexFallback []routeIndexEntry
+}
+
+// routeIndexEntry pairs an indexed or fallback child route with its
+// position among its parent's direct children, so index lookups in Match
+// can still be returned in the original routing order.
+type routeIndexEntry struct {
+	route *Route
+	pos   int
 }
 
-// NewRoute returns a new route.
-func NewRoute(cr *config.Route, parent *Route) *Route {
+// NewRoute returns a new route. receivers looks up a config.Receiver by
+// name, so this route's resolved receiver (if it names one literally,
+// rather than via a template resolved only at notification time) can
+// supply DefaultGroupBy/DefaultGroupWait/DefaultGroupInterval/
+// DefaultRepeatInterval in place of the usual parent-route inheritance.
+// It may be nil, in which case no such defaults apply.
+func NewRoute(cr *config.Route, parent *Route, receivers map[string]*config.Receiver) *Route {
 	// Create default and overwrite with configured settings.
 	opts := DefaultRouteOpts
 	if parent != nil {
@@ -63,24 +107,179 @@ func NewRoute(cr *config.Route, parent *Route) *Route {
 
 	if cr.Receiver != "" {
 		opts.Receiver = cr.Receiver
+		if config.IsReceiverTemplate(cr.Receiver) {
+			// Already validated by config.Route.UnmarshalYAML; a parse
+			// error here would mean that validation and this parse
+			// disagree.
+			tmpl, err := texttemplate.New("receiver").Funcs(config.GroupByTemplateFuncs).Parse(cr.Receiver)
+			if err != nil {
+				panic(fmt.Sprintf("receiver template failed to parse after validation: %s", err))
+			}
+			opts.ReceiverTemplate = tmpl
+			opts.ReceiverFallback = cr.ReceiverFallback
+		} else {
+			opts.ReceiverTemplate = nil
+			opts.ReceiverFallback = ""
+		}
 	}
+
+	// recv is nil unless opts.Receiver names a receiver literally (not a
+	// template, whose rendered name isn't known until notification time)
+	// that is actually defined, in which case it may supply defaults for
+	// fields this route leaves unconfigured.
+	var recv *config.Receiver
+	if opts.ReceiverTemplate == nil {
+		recv = receivers[opts.Receiver]
+	}
+
 	if cr.GroupBy != nil {
 		opts.GroupBy = map[model.LabelName]struct{}{}
 		for _, ln := range cr.GroupBy {
 			opts.GroupBy[ln] = struct{}{}
 		}
+	} else if recv != nil && recv.DefaultGroupBy != nil && !cr.GroupByAll && cr.GroupByExclude == nil && cr.GroupByTemplate == "" {
+		opts.GroupBy = map[model.LabelName]struct{}{}
+		for _, ln := range recv.DefaultGroupBy {
+			opts.GroupBy[model.LabelName(ln)] = struct{}{}
+		}
 	}
 
 	opts.GroupByAll = cr.GroupByAll
 
+	if cr.GroupByExclude != nil {
+		opts.GroupByExclude = map[model.LabelName]struct{}{}
+		for _, ln := range cr.GroupByExclude {
+			opts.GroupByExclude[ln] = struct{}{}
+		}
+	}
+
 	if cr.GroupWait != nil {
 		opts.GroupWait = time.Duration(*cr.GroupWait)
+	} else if recv != nil && recv.DefaultGroupWait != nil {
+		opts.GroupWait = time.Duration(*recv.DefaultGroupWait)
 	}
 	if cr.GroupInterval != nil {
 		opts.GroupInterval = time.Duration(*cr.GroupInterval)
+	} else if recv != nil && recv.DefaultGroupInterval != nil {
+		opts.GroupInterval = time.Duration(*recv.DefaultGroupInterval)
 	}
 	if cr.RepeatInterval != nil {
 		opts.RepeatInterval = time.Duration(*cr.RepeatInterval)
+	} else if recv != nil && recv.DefaultRepeatInterval != nil {
+		opts.RepeatInterval = time.Duration(*recv.DefaultRepeatInterval)
+	}
+	if cr.RepeatIntervals != nil {
+		opts.RepeatIntervals = make([]time.Duration, len(cr.RepeatIntervals))
+		for i, ri := range cr.RepeatIntervals {
+			opts.RepeatIntervals[i] = time.Duration(ri)
+		}
+	} else if cr.RepeatInterval != nil {
+		// An explicit repeat_interval on this route overrides any
+		// repeat_intervals schedule inherited from its parent.
+		opts.RepeatIntervals = nil
+	}
+	if cr.GroupJitter != nil {
+		opts.GroupJitter = time.Duration(*cr.GroupJitter)
+	}
+	if cr.MinAlertAge != nil {
+		opts.MinAlertAge = time.Duration(*cr.MinAlertAge)
+	}
+	if cr.ForwardToURL != nil {
+		opts.ForwardToURL = cr.ForwardToURL.String()
+	}
+	if cr.ExternalURL != nil {
+		opts.ExternalURL = cr.ExternalURL.String()
+	}
+
+	if cr.GroupWaitOverrides != nil {
+		overrides := make([]GroupWaitOverride, 0, len(cr.GroupWaitOverrides))
+		for _, o := range cr.GroupWaitOverrides {
+			var matchers types.Matchers
+			for ln, lv := range o.Match {
+				matchers = append(matchers, types.NewMatcher(model.LabelName(ln), lv))
+			}
+			sort.Sort(matchers)
+			overrides = append(overrides, GroupWaitOverride{
+				Matchers:  matchers,
+				GroupWait: time.Duration(o.GroupWait),
+			})
+		}
+		opts.GroupWaitOverrides = overrides
+	}
+
+	opts.AbortOnResolve = cr.AbortOnResolve
+	opts.FlushOnResolve = cr.FlushOnResolve
+	opts.StormThreshold = cr.StormThreshold
+	opts.NotificationBudget = cr.NotificationBudget
+	opts.FloodThreshold = cr.FloodThreshold
+	opts.NotificationTimeout = time.Duration(cr.NotificationTimeout)
+	opts.MaxAggregationGroups = cr.MaxAggregationGroups
+	opts.MaxAlertsPerGroup = cr.MaxAlertsPerGroup
+	opts.MaxAlertsRendered = cr.MaxAlertsRendered
+	if cr.AlertSortLabel != "" {
+		opts.AlertSortLabel = model.LabelName(cr.AlertSortLabel)
+		opts.AlertSortOrder = cr.AlertSortOrder
+	}
+	opts.RepeatOnlyOnChange = cr.RepeatOnlyOnChange
+	opts.EscalationReceiver = cr.EscalationReceiver
+
+	if cr.EscalationTimeout != nil {
+		opts.EscalationTimeout = time.Duration(*cr.EscalationTimeout)
+	}
+
+	if cr.MuteTimeIntervals != nil {
+		opts.MuteTimeIntervals = cr.MuteTimeIntervals
+	}
+	if cr.ActiveTimeIntervals != nil {
+		opts.ActiveTimeIntervals = cr.ActiveTimeIntervals
+	}
+
+	if cr.GroupByTemplate != "" {
+		// Already validated by config.Route.UnmarshalYAML; a parse error
+		// here would mean that validation and this parse disagree.
+		tmpl, err := texttemplate.New("group_by_template").Funcs(config.GroupByTemplateFuncs).Parse(cr.GroupByTemplate)
+		if err != nil {
+			panic(fmt.Sprintf("group_by_template failed to parse after validation: %s", err))
+		}
+		opts.GroupByTemplate = tmpl
+	} else {
+		opts.GroupByTemplate = nil
+	}
+
+	if cr.DedupKeyTemplate != "" {
+		// Already validated by config.Route.UnmarshalYAML; a parse error
+		// here would mean that validation and this parse disagree.
+		tmpl, err := texttemplate.New("dedup_key_template").Funcs(config.GroupByTemplateFuncs).Parse(cr.DedupKeyTemplate)
+		if err != nil {
+			panic(fmt.Sprintf("dedup_key_template failed to parse after validation: %s", err))
+		}
+		opts.DedupKeyTemplate = tmpl
+	} else {
+		opts.DedupKeyTemplate = nil
+	}
+
+	if cr.Timezone != "" {
+		// Already validated by config.Route.UnmarshalYAML; an error here
+		// would mean that validation and this parse disagree.
+		loc, err := time.LoadLocation(cr.Timezone)
+		if err != nil {
+			panic(fmt.Sprintf("timezone failed to load after validation: %s", err))
+		}
+		opts.Timezone = loc
+	}
+
+	if cr.AnnotationTemplates != nil {
+		tmpls := make(map[string]*texttemplate.Template, len(cr.AnnotationTemplates))
+		for name, tmpl := range cr.AnnotationTemplates {
+			// Already validated by config's validate(); an error here
+			// would mean that validation and this parse disagree.
+			t, err := texttemplate.New("annotation_template").Funcs(config.GroupByTemplateFuncs).Parse(tmpl)
+			if err != nil {
+				panic(fmt.Sprintf("annotation_templates entry %q failed to parse after validation: %s", name, err))
+			}
+			tmpls[name] = t
+		}
+		opts.AnnotationTemplates = tmpls
 	}
 
 	// Build matchers.
@@ -92,6 +291,31 @@ func NewRoute(cr *config.Route, parent *Route) *Route {
 	for ln, lv := range cr.MatchRE {
 		matchers = append(matchers, types.NewRegexMatcher(model.LabelName(ln), lv.Regexp))
 	}
+	for ln, lv := range cr.MatchNot {
+		matchers = append(matchers, types.NewNotMatcher(model.LabelName(ln), lv))
+	}
+	for ln, lv := range cr.MatchNotRE {
+		matchers = append(matchers, types.NewNotRegexMatcher(model.LabelName(ln), lv.Regexp))
+	}
+	for _, expr := range cr.Matchers {
+		// Already validated by config.Route.UnmarshalYAML; an error here
+		// would mean that validation and this parse disagree.
+		m, err := types.NewMatcherFromExpr(expr)
+		if err != nil {
+			panic(fmt.Sprintf("matchers failed to parse after validation: %s", err))
+		}
+		matchers = append(matchers, m)
+	}
+	for _, expr := range cr.MatchAnnotations {
+		// Already validated by config.Route.UnmarshalYAML; an error here
+		// would mean that validation and this parse disagree.
+		m, err := types.NewMatcherFromExpr(expr)
+		if err != nil {
+			panic(fmt.Sprintf("match_annotations failed to parse after validation: %s", err))
+		}
+		m.Annotation = true
+		matchers = append(matchers, m)
+	}
 	sort.Sort(matchers)
 
 	route := &Route{
@@ -101,31 +325,104 @@ func NewRoute(cr *config.Route, parent *Route) *Route {
 		Continue:  cr.Continue,
 	}
 
-	route.Routes = NewRoutes(cr.Routes, route)
+	route.Routes = NewRoutes(cr.Routes, route, receivers)
+	route.buildIndex()
 
 	return route
 }
 
+// buildIndex populates r.routeIndex and r.indexFallback from r.Routes, so
+// Match can skip evaluating a direct child's full Matchers when an earlier,
+// cheaper equality check already rules it out. A child qualifies for
+// indexing if it has at least one plain equality matcher -- not regex, not
+// negated, not against annotations -- and is indexed on the first such
+// matcher found in its Matchers (kept sorted by name, so the choice is
+// deterministic). Children without a qualifying matcher -- regex-only,
+// negated-only, annotation-only, or empty -- always fall back to a linear
+// scan, since no single label value can rule them out up front.
+func (r *Route) buildIndex() {
+	if len(r.Routes) == 0 {
+		return
+	}
+
+	index := make(map[model.LabelName]map[string][]routeIndexEntry)
+	var fallback []routeIndexEntry
+
+	for pos, cr := range r.Routes {
+		entry := routeIndexEntry{route: cr, pos: pos}
+		m := cr.indexMatcher()
+		if m == nil {
+			fallback = append(fallback, entry)
+			continue
+		}
+		name := model.LabelName(m.Name)
+		byValue, ok := index[name]
+		if !ok {
+			byValue = make(map[string][]routeIndexEntry)
+			index[name] = byValue
+		}
+		byValue[m.Value] = append(byValue[m.Value], entry)
+	}
+
+	r.routeIndex = index
+	r.indexFallback = fallback
+}
+
+// indexMatcher returns the first plain equality matcher -- not regex, not
+// negated, not against annotations -- in r's own Matchers, suitable for use
+// as an index key by the parent's buildIndex, or nil if r has none.
+func (r *Route) indexMatcher() *types.Matcher {
+	for _, m := range r.Matchers {
+		if !m.IsRegex && !m.Negate && !m.Annotation {
+			return m
+		}
+	}
+	return nil
+}
+
+// candidateRoutes returns the direct children of r that might match lset,
+// in the same relative order they appear in r.Routes, using r.routeIndex to
+// skip children an indexed equality matcher already rules out. It returns
+// r.Routes unchanged if r has no index, e.g. because every child lacked a
+// qualifying equality matcher.
+func (r *Route) candidateRoutes(lset model.LabelSet) []*Route {
+	if r.routeIndex == nil {
+		return r.Routes
+	}
+
+	entries := append([]routeIndexEntry{}, r.indexFallback...)
+	for name, byValue := range r.routeIndex {
+		entries = append(entries, byValue[string(lset[name])]...)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].pos < entries[j].pos })
+
+	out := make([]*Route, len(entries))
+	for i, e := range entries {
+		out[i] = e.route
+	}
+	return out
+}
+
 // NewRoutes returns a slice of routes.
-func NewRoutes(croutes []*config.Route, parent *Route) []*Route {
+func NewRoutes(croutes []*config.Route, parent *Route, receivers map[string]*config.Receiver) []*Route {
 	res := []*Route{}
 	for _, cr := range croutes {
-		res = append(res, NewRoute(cr, parent))
+		res = append(res, NewRoute(cr, parent, receivers))
 	}
 	return res
 }
 
 // Match does a depth-first left-to-right search through the route tree
 // and returns the matching routing nodes.
-func (r *Route) Match(lset model.LabelSet) []*Route {
-	if !r.Matchers.Match(lset) {
+func (r *Route) Match(lset, annotations model.LabelSet) []*Route {
+	if !r.Matchers.Match(lset, annotations) {
 		return nil
 	}
 
 	var all []*Route
 
-	for _, cr := range r.Routes {
-		matches := cr.Match(lset)
+	for _, cr := range r.candidateRoutes(lset) {
+		matches := cr.Match(lset, annotations)
 
 		all = append(all, matches...)
 
@@ -142,6 +439,153 @@ func (r *Route) Match(lset model.LabelSet) []*Route {
 	return all
 }
 
+// RouteMatch is one node of a match trace produced by MatchTrace: the
+// outcome of evaluating a label set against a single routing node, plus
+// the same trace for every child node that was considered.
+type RouteMatch struct {
+	// Route is the node this trace entry describes.
+	Route *Route
+
+	// Matched is true if Route's own Matchers matched the label set.
+	// It is meaningless, and always false, if Skipped is true.
+	Matched bool
+
+	// Skipped is true if Route's Matchers were never evaluated because an
+	// earlier sibling route already matched and did not set Continue.
+	Skipped bool
+
+	// Children holds the trace for each of Route's child routes, in the
+	// order they were considered.
+	Children []*RouteMatch
+}
+
+// MatchTrace behaves like Match, but additionally returns the full match
+// path through the routing tree: every node considered, whether it
+// matched, and which sibling nodes were never evaluated because an
+// earlier one matched without Continue. It is intended for diagnosing why
+// an alert landed on an unexpected receiver, e.g. via a debug API.
+func (r *Route) MatchTrace(lset, annotations model.LabelSet) (all []*Route, trace *RouteMatch) {
+	trace = &RouteMatch{Route: r, Matched: r.Matchers.Match(lset, annotations)}
+	if !trace.Matched {
+		return nil, trace
+	}
+
+	stopped := false
+	for _, cr := range r.Routes {
+		if stopped {
+			trace.Children = append(trace.Children, &RouteMatch{Route: cr, Skipped: true})
+			continue
+		}
+
+		matches, childTrace := cr.MatchTrace(lset, annotations)
+		trace.Children = append(trace.Children, childTrace)
+		all = append(all, matches...)
+
+		if matches != nil && !cr.Continue {
+			stopped = true
+		}
+	}
+
+	// If no child nodes were matches, the current node itself is a match.
+	if len(all) == 0 {
+		all = append(all, r)
+	}
+
+	return all, trace
+}
+
+// MatchingReceivers returns the names of the receivers that lset resolves
+// to under r, in the order the matching routes are reached by Match. It is
+// a convenience for tools that want to evaluate routing decisions offline,
+// e.g. config linters, test harnesses, or chatops bots, without running a
+// Dispatcher. A templated receiver (see RouteOpts.ReceiverTemplate) is
+// rendered against lset; its ReceiverFallback is used if rendering fails,
+// but, unlike a running Dispatcher, MatchingReceivers has no registered
+// receiver set to check the rendered name against.
+func (r *Route) MatchingReceivers(lset, annotations model.LabelSet) []string {
+	matches := r.Match(lset, annotations)
+	receivers := make([]string, 0, len(matches))
+	for _, m := range matches {
+		receiver, err := m.RouteOpts.ResolveReceiver(lset)
+		if err != nil {
+			receiver = m.RouteOpts.ReceiverFallback
+		}
+		receivers = append(receivers, receiver)
+	}
+	return receivers
+}
+
+// ResolveReceiver returns the receiver name that lset resolves to under
+// ro: the rendered result of ReceiverTemplate, executed against lset the
+// same way GroupByTemplate is, if set, or Receiver otherwise. It does not
+// know which receivers are actually configured; callers that need
+// fallback-if-unknown behavior also use ReceiverFallback, e.g. via
+// notify.WithReceiverFallback.
+func (ro *RouteOpts) ResolveReceiver(lset model.LabelSet) (string, error) {
+	if ro.ReceiverTemplate == nil {
+		return ro.Receiver, nil
+	}
+
+	data := make(map[string]string, len(lset))
+	for ln, lv := range lset {
+		data[string(ln)] = string(lv)
+	}
+
+	var buf strings.Builder
+	if err := ro.ReceiverTemplate.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// recordStorm registers an alert arrival for this route at now, returning
+// whether storm mode is active afterwards and whether that is a change.
+// It is a no-op, always returning (false, false), if StormThreshold is not
+// set on this route.
+func (r *Route) recordStorm(now time.Time) (active, changed bool) {
+	if r.RouteOpts.StormThreshold <= 0 {
+		return false, false
+	}
+	return r.storm.record(now, r.RouteOpts.StormThreshold)
+}
+
+// stormActive reports whether this route is currently in storm mode.
+func (r *Route) stormActive() bool {
+	return r.storm.current()
+}
+
+// recordBudget registers a notification sent for this route at now,
+// returning whether its configured notification budget is exceeded
+// afterwards and whether that is a change. It is a no-op, always returning
+// (false, false), if NotificationBudget is not set on this route.
+func (r *Route) recordBudget(now time.Time) (exceeded, changed bool) {
+	if r.RouteOpts.NotificationBudget <= 0 {
+		return false, false
+	}
+	return r.budget.record(now, r.RouteOpts.NotificationBudget)
+}
+
+// budgetExceeded reports whether this route has currently exceeded its
+// notification budget.
+func (r *Route) budgetExceeded() bool {
+	return r.budget.current()
+}
+
+// recordFlood registers a group notification attempt for this route at
+// now. It returns (true, 0) if the caller should send its notification as
+// usual. It returns (false, n) if the caller should instead collapse it:
+// n is non-zero on the single call that crosses FloodThreshold, signaling
+// the caller to send a summary notification, with n the number of group
+// notification attempts in the window so far (sent ones included), and
+// zero on every call after that for the rest of the window. It is always (true, 0), a no-op, if FloodThreshold is not set
+// on this route.
+func (r *Route) recordFlood(now time.Time) (forward bool, collapsed int) {
+	if r.RouteOpts.FloodThreshold <= 0 {
+		return true, 0
+	}
+	return r.flood.record(now, r.RouteOpts.FloodThreshold)
+}
+
 // Key returns a key for the route. It does not uniquely identify the route in general.
 func (r *Route) Key() string {
 	b := strings.Builder{}
@@ -160,17 +604,173 @@ type RouteOpts struct {
 	// The identifier of the associated notification configuration.
 	Receiver string
 
+	// ReceiverTemplate, if set, computes this route's receiver name by
+	// executing this template against the alert's labels instead of using
+	// Receiver directly. See config.Route's receiver field.
+	ReceiverTemplate *texttemplate.Template
+
+	// ReceiverFallback names the receiver to use if ReceiverTemplate's
+	// rendered result does not match a configured receiver.
+	ReceiverFallback string
+
 	// What labels to group alerts by for notifications.
 	GroupBy map[model.LabelName]struct{}
 
 	// Use all alert labels to group.
 	GroupByAll bool
 
+	// Labels excluded from grouping when GroupByAll is set.
+	GroupByExclude map[model.LabelName]struct{}
+
 	// How long to wait to group matching alerts before sending
 	// a notification.
 	GroupWait      time.Duration
 	GroupInterval  time.Duration
 	RepeatInterval time.Duration
+
+	// RepeatIntervals, if non-empty, replaces RepeatInterval with an
+	// escalating repeat schedule: RepeatIntervals[0] applies to the first
+	// repeat, RepeatIntervals[1] to the next, and so on, holding at the
+	// last entry once the schedule is exhausted. See
+	// config.Route.RepeatIntervals.
+	RepeatIntervals []time.Duration
+
+	// GroupJitter, if greater than 0, adds a random delay in [0,
+	// GroupJitter) to every group_wait and group_interval flush. See
+	// config.Route.GroupJitter.
+	GroupJitter time.Duration
+
+	// MinAlertAge, if greater than 0, holds an alert back from every
+	// flush until it has existed for at least this long. See
+	// config.Route.MinAlertAge.
+	MinAlertAge time.Duration
+
+	// ForwardToURL, if set, is the URL that alerts matching this route are
+	// re-posted to, in addition to being dispatched to Receiver.
+	ForwardToURL string
+
+	// ExternalURL, if set, overrides the alertmanager external URL used in
+	// links generated for notifications sent from this route.
+	ExternalURL string
+
+	// GroupWaitOverrides fast-path the initial notification for alerts
+	// matching one of their Matchers, in the order given.
+	GroupWaitOverrides []GroupWaitOverride
+
+	// AbortOnResolve, if true, drops the initial firing notification for a
+	// group if every alert in it resolves before GroupWait expires.
+	AbortOnResolve bool
+
+	// FlushOnResolve, if true, flushes a group immediately once every
+	// alert in it has resolved instead of waiting out GroupInterval. See
+	// config.Route.FlushOnResolve.
+	FlushOnResolve bool
+
+	// StormThreshold, if greater than 0, is the multiple of this route's
+	// rolling baseline alert rate that triggers storm mode. See Route.storm.
+	StormThreshold float64
+
+	// NotificationBudget, if greater than 0, is the maximum number of
+	// notifications this route may send over a rolling 24h window before
+	// it is considered to have exceeded its budget. See Route.budget.
+	NotificationBudget int
+
+	// FloodThreshold, if greater than 0, caps how many distinct groups
+	// under this route may notify within a rolling window before further
+	// group notifications in that window are collapsed into a single
+	// summary. See Route.flood.
+	FloodThreshold int
+
+	// MaxAggregationGroups, if greater than 0, caps how many distinct
+	// aggregation groups this route may have active at once. See
+	// config.Route.MaxAggregationGroups.
+	MaxAggregationGroups int
+
+	// MaxAlertsPerGroup, if greater than 0, caps how many alerts a single
+	// aggregation group under this route holds at once. See
+	// config.Route.MaxAlertsPerGroup.
+	MaxAlertsPerGroup int
+
+	// AlertSortLabel and AlertSortOrder, if AlertSortLabel is non-empty,
+	// order the alerts rendered in a notification body by this label's
+	// value instead of arrival order. See config.Route.AlertSortLabel.
+	AlertSortLabel model.LabelName
+	AlertSortOrder []string
+
+	// MaxAlertsRendered, if greater than 0, caps how many alerts a single
+	// notification renders in its body. See config.Route.MaxAlertsRendered.
+	MaxAlertsRendered int
+
+	// GroupByTemplate, if set, computes an alert's grouping key by
+	// executing this template against its labels instead of grouping by
+	// GroupBy/GroupByAll label equality.
+	GroupByTemplate *texttemplate.Template
+
+	// DedupKeyTemplate, if set, computes a group's deduplication key for
+	// integrations (PagerDuty, webhook) by executing this template against
+	// the group's labels instead of the default opaque key derived from
+	// the route's position in the tree and the group's label set. See
+	// config.Route.DedupKeyTemplate.
+	DedupKeyTemplate *texttemplate.Template
+
+	// Timezone is the IANA location that notification templates for this
+	// route render alert timestamps in. Defaults to UTC.
+	Timezone *time.Location
+
+	// RepeatOnlyOnChange, if true, suppresses a group's repeat
+	// notification when its alert membership and status have not changed
+	// since the last notification.
+	RepeatOnlyOnChange bool
+
+	// MuteTimeIntervals names the mute_time_intervals entries that
+	// suppress notifications for this route while active.
+	MuteTimeIntervals []string
+
+	// ActiveTimeIntervals, if non-empty, names the mute_time_intervals
+	// entries outside of which notifications for this route are
+	// suppressed.
+	ActiveTimeIntervals []string
+
+	// AnnotationTemplates computes additional annotations, keyed by
+	// annotation name, for alerts matching this route. See
+	// config.Route.AnnotationTemplates.
+	AnnotationTemplates map[string]*texttemplate.Template
+
+	// EscalationReceiver, if set alongside EscalationTimeout, names the
+	// receiver a group's still-active alerts are re-sent to once its
+	// first notification goes unacknowledged for EscalationTimeout. See
+	// config.Route.EscalationReceiver.
+	EscalationReceiver string
+
+	// EscalationTimeout is the grace period after a group's first
+	// notification during which it may be acknowledged before being
+	// escalated to EscalationReceiver. See config.Route.EscalationTimeout.
+	EscalationTimeout time.Duration
+
+	// NotificationTimeout, if greater than 0, overrides the receiver's
+	// notification_timeout for alerts matching this route, bounding how
+	// long a single notifier call may block before being cancelled. See
+	// config.Route.NotificationTimeout.
+	NotificationTimeout time.Duration
+}
+
+// GroupWaitOverride fast-paths the initial notification for alerts whose
+// labels match Matchers, using GroupWait in place of the route's configured
+// GroupWait.
+type GroupWaitOverride struct {
+	Matchers  types.Matchers
+	GroupWait time.Duration
+}
+
+// matchGroupWait returns the GroupWait of the first GroupWaitOverride whose
+// Matchers match lset, and whether one matched at all.
+func (ro *RouteOpts) matchGroupWait(lset, annotations model.LabelSet) (time.Duration, bool) {
+	for _, o := range ro.GroupWaitOverrides {
+		if o.Matchers.Match(lset, annotations) {
+			return o.GroupWait, true
+		}
+	}
+	return 0, false
 }
 
 func (ro *RouteOpts) String() string {
@@ -178,6 +778,10 @@ func (ro *RouteOpts) String() string {
 	for ln := range ro.GroupBy {
 		labels = append(labels, ln)
 	}
-	return fmt.Sprintf("<RouteOpts send_to:%q group_by:%q group_by_all:%t timers:%q|%q>",
-		ro.Receiver, labels, ro.GroupByAll, ro.GroupWait, ro.GroupInterval)
+	var excludeLabels []model.LabelName
+	for ln := range ro.GroupByExclude {
+		excludeLabels = append(excludeLabels, ln)
+	}
+	return fmt.Sprintf("<RouteOpts send_to:%q group_by:%q group_by_all:%t group_by_exclude:%q timers:%q|%q abort_on_resolve:%t flush_on_resolve:%t external_url:%q storm_threshold:%v notification_budget:%v flood_threshold:%v max_aggregation_groups:%v max_alerts_per_group:%v escalation_receiver:%q escalation_timeout:%v>",
+		ro.Receiver, labels, ro.GroupByAll, excludeLabels, ro.GroupWait, ro.GroupInterval, ro.AbortOnResolve, ro.FlushOnResolve, ro.ExternalURL, ro.StormThreshold, ro.NotificationBudget, ro.FloodThreshold, ro.MaxAggregationGroups, ro.MaxAlertsPerGroup, ro.EscalationReceiver, ro.EscalationTimeout)
 }
@@ -22,6 +22,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/types"
 )
 
 func TestRouteMatch(t *testing.T) {
@@ -87,7 +88,7 @@ routes:
 	}
 	var (
 		def  = DefaultRouteOpts
-		tree = NewRoute(&ctree, nil)
+		tree = NewRoute(&ctree, nil, nil)
 	)
 	lset := func(labels ...string) map[model.LabelName]struct{} {
 		s := map[model.LabelName]struct{}{}
@@ -111,9 +112,11 @@ routes:
 					Receiver:       "notify-A",
 					GroupBy:        def.GroupBy,
 					GroupByAll:     false,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      def.GroupWait,
 					GroupInterval:  def.GroupInterval,
 					RepeatInterval: def.RepeatInterval,
+					Timezone:       def.Timezone,
 				},
 			},
 			keys: []string{"{}/{owner=\"team-A\"}"},
@@ -128,9 +131,11 @@ routes:
 					Receiver:       "notify-A",
 					GroupBy:        def.GroupBy,
 					GroupByAll:     false,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      def.GroupWait,
 					GroupInterval:  def.GroupInterval,
 					RepeatInterval: def.RepeatInterval,
+					Timezone:       def.Timezone,
 				},
 			},
 			keys: []string{"{}/{owner=\"team-A\"}"},
@@ -144,9 +149,11 @@ routes:
 					Receiver:       "notify-BC",
 					GroupBy:        lset("foo", "bar"),
 					GroupByAll:     false,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      2 * time.Minute,
 					GroupInterval:  def.GroupInterval,
 					RepeatInterval: def.RepeatInterval,
+					Timezone:       def.Timezone,
 				},
 			},
 			keys: []string{"{}/{owner=~\"^(?:team-(B|C))$\"}"},
@@ -161,9 +168,11 @@ routes:
 					Receiver:       "notify-testing",
 					GroupBy:        lset(),
 					GroupByAll:     true,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      def.GroupWait,
 					GroupInterval:  def.GroupInterval,
 					RepeatInterval: def.RepeatInterval,
+					Timezone:       def.Timezone,
 				},
 			},
 			keys: []string{"{}/{owner=\"team-A\"}/{env=\"testing\"}"},
@@ -178,17 +187,21 @@ routes:
 					Receiver:       "notify-productionA",
 					GroupBy:        def.GroupBy,
 					GroupByAll:     false,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      1 * time.Minute,
 					GroupInterval:  def.GroupInterval,
 					RepeatInterval: def.RepeatInterval,
+					Timezone:       def.Timezone,
 				},
 				{
 					Receiver:       "notify-productionB",
 					GroupBy:        lset("job"),
 					GroupByAll:     false,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      30 * time.Second,
 					GroupInterval:  5 * time.Minute,
 					RepeatInterval: 1 * time.Hour,
+					Timezone:       def.Timezone,
 				},
 			},
 			keys: []string{
@@ -205,9 +218,11 @@ routes:
 					Receiver:       "notify-def",
 					GroupBy:        lset("role"),
 					GroupByAll:     false,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      def.GroupWait,
 					GroupInterval:  def.GroupInterval,
 					RepeatInterval: def.RepeatInterval,
+					Timezone:       def.Timezone,
 				},
 			},
 			keys: []string{"{}/{group_by=\"role\"}"},
@@ -222,9 +237,11 @@ routes:
 					Receiver:       "notify-testing",
 					GroupBy:        lset("role"),
 					GroupByAll:     false,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      def.GroupWait,
 					GroupInterval:  def.GroupInterval,
 					RepeatInterval: def.RepeatInterval,
+					Timezone:       def.Timezone,
 				},
 			},
 			keys: []string{"{}/{group_by=\"role\"}/{env=\"testing\"}"},
@@ -240,9 +257,11 @@ routes:
 					Receiver:       "notify-testing",
 					GroupBy:        lset("role"),
 					GroupByAll:     false,
+					GroupByExclude: def.GroupByExclude,
 					GroupWait:      2 * time.Minute,
 					GroupInterval:  def.GroupInterval,
 					RepeatInterval: def.RepeatInterval,
+					Timezone:       def.Timezone,
 				},
 			},
 			keys: []string{"{}/{group_by=\"role\"}/{env=\"testing\"}/{wait=\"long\"}"},
@@ -253,7 +272,7 @@ routes:
 		var matches []*RouteOpts
 		var keys []string
 
-		for _, r := range tree.Match(test.input) {
+		for _, r := range tree.Match(test.input, nil) {
 			matches = append(matches, &r.RouteOpts)
 			keys = append(keys, r.Key())
 		}
@@ -267,3 +286,691 @@ routes:
 		}
 	}
 }
+
+func TestRouteMatchIndexedAndFallbackSiblings(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+
+routes:
+- match:
+    team: 'a'
+  receiver: 'notify-a'
+- match:
+    team: 'b'
+  receiver: 'notify-b'
+  continue: true
+- match_re:
+    team: 'c|d'
+  receiver: 'notify-cd'
+- match:
+    team: 'b'
+    env: 'prod'
+  receiver: 'notify-b-prod'
+`
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	tests := []struct {
+		input     model.LabelSet
+		receivers []string
+	}{
+		{
+			input:     model.LabelSet{"team": "a"},
+			receivers: []string{"notify-a"},
+		},
+		{
+			input:     model.LabelSet{"team": "b"},
+			receivers: []string{"notify-b"},
+		},
+		{
+			input:     model.LabelSet{"team": "b", "env": "prod"},
+			receivers: []string{"notify-b", "notify-b-prod"},
+		},
+		{
+			input:     model.LabelSet{"team": "c"},
+			receivers: []string{"notify-cd"},
+		},
+		{
+			input:     model.LabelSet{"team": "d"},
+			receivers: []string{"notify-cd"},
+		},
+		{
+			input:     model.LabelSet{"team": "e"},
+			receivers: []string{"notify-def"},
+		},
+	}
+
+	for _, test := range tests {
+		var receivers []string
+		for _, r := range tree.Match(test.input, nil) {
+			receivers = append(receivers, r.RouteOpts.Receiver)
+		}
+		if !reflect.DeepEqual(receivers, test.receivers) {
+			t.Errorf("input %v: expected receivers %v, got %v", test.input, test.receivers, receivers)
+		}
+	}
+}
+
+func TestRouteForwardToURL(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+forward_to_url: 'http://parent.example.com'
+
+routes:
+- match:
+    owner: 'team-A'
+  receiver: 'notify-A'
+
+- match:
+    owner: 'team-B'
+  receiver: 'notify-B'
+  forward_to_url: 'http://team-b.example.com'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	matches := tree.Match(model.LabelSet{"owner": "team-A"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.ForwardToURL != "http://parent.example.com" {
+		t.Errorf("expected team-A to inherit the parent forward_to_url, got %v", matches)
+	}
+
+	matches = tree.Match(model.LabelSet{"owner": "team-B"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.ForwardToURL != "http://team-b.example.com" {
+		t.Errorf("expected team-B to override the parent forward_to_url, got %v", matches)
+	}
+}
+
+func TestRouteExternalURL(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+external_url: 'https://am.example.com'
+
+routes:
+- match:
+    owner: 'team-A'
+  receiver: 'notify-A'
+
+- match:
+    owner: 'team-B'
+  receiver: 'notify-B'
+  external_url: 'https://team-b.example.com'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	matches := tree.Match(model.LabelSet{"owner": "team-A"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.ExternalURL != "https://am.example.com" {
+		t.Errorf("expected team-A to inherit the parent external_url, got %v", matches)
+	}
+
+	matches = tree.Match(model.LabelSet{"owner": "team-B"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.ExternalURL != "https://team-b.example.com" {
+		t.Errorf("expected team-B to override the parent external_url, got %v", matches)
+	}
+}
+
+func TestRouteTimezone(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+timezone: 'America/New_York'
+
+routes:
+- match:
+    owner: 'team-A'
+  receiver: 'notify-A'
+
+- match:
+    owner: 'team-B'
+  receiver: 'notify-B'
+  timezone: 'Europe/Berlin'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	matches := tree.Match(model.LabelSet{"owner": "team-A"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.Timezone.String() != "America/New_York" {
+		t.Errorf("expected team-A to inherit the parent timezone, got %v", matches)
+	}
+
+	matches = tree.Match(model.LabelSet{"owner": "team-B"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.Timezone.String() != "Europe/Berlin" {
+		t.Errorf("expected team-B to override the parent timezone, got %v", matches)
+	}
+}
+
+func TestRouteTimezoneDefaultsToUTC(t *testing.T) {
+	in := `receiver: 'notify-def'`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	if tree.RouteOpts.Timezone != time.UTC {
+		t.Errorf("expected the root route to default to UTC, got %v", tree.RouteOpts.Timezone)
+	}
+}
+
+func TestRouteRepeatOnlyOnChange(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+repeat_only_on_change: true
+
+routes:
+- match:
+    owner: 'team-A'
+  receiver: 'notify-A'
+
+- match:
+    owner: 'team-B'
+  receiver: 'notify-B'
+  repeat_only_on_change: false
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	matches := tree.Match(model.LabelSet{"owner": "team-A"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.RepeatOnlyOnChange {
+		t.Errorf("expected team-A not to inherit repeat_only_on_change, got %v", matches)
+	}
+
+	matches = tree.Match(model.LabelSet{"owner": "team-B"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.RepeatOnlyOnChange {
+		t.Errorf("expected team-B to keep repeat_only_on_change false, got %v", matches)
+	}
+
+	if !tree.RouteOpts.RepeatOnlyOnChange {
+		t.Errorf("expected the root route to have repeat_only_on_change set")
+	}
+}
+
+func TestRouteMatchersField(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+
+routes:
+- matchers: ['owner="team-A"', 'env=~"produ.*"']
+  receiver: 'notify-A'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	matches := tree.Match(model.LabelSet{"owner": "team-A", "env": "production"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.Receiver != "notify-A" {
+		t.Errorf("expected the matchers route to match, got %v", matches)
+	}
+
+	matches = tree.Match(model.LabelSet{"owner": "team-A", "env": "staging"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.Receiver != "notify-def" {
+		t.Errorf("expected the matchers route not to match a different env, got %v", matches)
+	}
+}
+
+func TestRouteMatchNot(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+
+routes:
+- match_not:
+    team: 'infra'
+  receiver: 'notify-catchall'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	matches := tree.Match(model.LabelSet{"team": "infra"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.Receiver != "notify-def" {
+		t.Errorf("expected team=infra to fall through to notify-def, got %v", matches)
+	}
+
+	matches = tree.Match(model.LabelSet{"team": "payments"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.Receiver != "notify-catchall" {
+		t.Errorf("expected team=payments to match notify-catchall, got %v", matches)
+	}
+}
+
+func TestRouteMatchNotRE(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+
+routes:
+- match_not_re:
+    team: '^infra.*'
+  receiver: 'notify-catchall'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	matches := tree.Match(model.LabelSet{"team": "infra-oncall"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.Receiver != "notify-def" {
+		t.Errorf("expected team=infra-oncall to fall through to notify-def, got %v", matches)
+	}
+
+	matches = tree.Match(model.LabelSet{"team": "payments"}, nil)
+	if len(matches) != 1 || matches[0].RouteOpts.Receiver != "notify-catchall" {
+		t.Errorf("expected team=payments to match notify-catchall, got %v", matches)
+	}
+}
+
+func TestRouteMuteTimeIntervals(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+mute_time_intervals: ['nights']
+
+routes:
+- receiver: 'notify-business-hours'
+  active_time_intervals: ['business-hours']
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	if want := []string{"nights"}; !reflect.DeepEqual(tree.RouteOpts.MuteTimeIntervals, want) {
+		t.Errorf("unexpected root route MuteTimeIntervals %v, expected %v", tree.RouteOpts.MuteTimeIntervals, want)
+	}
+
+	child := tree.Routes[0]
+	if want := []string{"business-hours"}; !reflect.DeepEqual(child.RouteOpts.ActiveTimeIntervals, want) {
+		t.Errorf("unexpected child route ActiveTimeIntervals %v, expected %v", child.RouteOpts.ActiveTimeIntervals, want)
+	}
+	// MuteTimeIntervals is inherited from the parent since the child does
+	// not set its own.
+	if want := []string{"nights"}; !reflect.DeepEqual(child.RouteOpts.MuteTimeIntervals, want) {
+		t.Errorf("unexpected inherited child route MuteTimeIntervals %v, expected %v", child.RouteOpts.MuteTimeIntervals, want)
+	}
+}
+
+func TestRouteMatchersPresence(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+
+routes:
+- matchers: ['team!=""']
+  receiver: 'notify-team'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	receivers := tree.MatchingReceivers(model.LabelSet{"team": "infra"}, nil)
+	if !reflect.DeepEqual(receivers, []string{"notify-team"}) {
+		t.Errorf("expected [notify-team], got %v", receivers)
+	}
+
+	receivers = tree.MatchingReceivers(model.LabelSet{}, nil)
+	if !reflect.DeepEqual(receivers, []string{"notify-def"}) {
+		t.Errorf("expected [notify-def], got %v", receivers)
+	}
+}
+
+func TestRouteMatchAnnotations(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+
+routes:
+- match_annotations: ['runbook_url=~".*legacy.*"']
+  receiver: 'notify-legacy'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	lset := model.LabelSet{"runbook_url": "http://example.com/legacy"}
+
+	// The label set carries the matching value, but the route only matches
+	// against annotations, so it must fall through to the default route.
+	receivers := tree.MatchingReceivers(lset, nil)
+	if !reflect.DeepEqual(receivers, []string{"notify-def"}) {
+		t.Errorf("expected [notify-def], got %v", receivers)
+	}
+
+	annotations := model.LabelSet{"runbook_url": "http://example.com/legacy"}
+	receivers = tree.MatchingReceivers(nil, annotations)
+	if !reflect.DeepEqual(receivers, []string{"notify-legacy"}) {
+		t.Errorf("expected [notify-legacy], got %v", receivers)
+	}
+}
+
+func TestRouteMatchingReceivers(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+
+routes:
+- match:
+    owner: 'team-A'
+  receiver: 'notify-A'
+  continue: true
+- match:
+    owner: 'team-A'
+  receiver: 'notify-A-secondary'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	receivers := tree.MatchingReceivers(model.LabelSet{"owner": "team-A"}, nil)
+	if !reflect.DeepEqual(receivers, []string{"notify-A", "notify-A-secondary"}) {
+		t.Errorf("expected [notify-A notify-A-secondary], got %v", receivers)
+	}
+
+	receivers = tree.MatchingReceivers(model.LabelSet{"owner": "team-B"}, nil)
+	if !reflect.DeepEqual(receivers, []string{"notify-def"}) {
+		t.Errorf("expected [notify-def], got %v", receivers)
+	}
+}
+
+func TestRouteMatchTrace(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+
+routes:
+- match:
+    owner: 'team-A'
+  receiver: 'notify-A'
+  continue: true
+- match:
+    owner: 'team-A'
+  receiver: 'notify-A-secondary'
+- match:
+    owner: 'team-B'
+  receiver: 'notify-B'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	all, trace := tree.MatchTrace(model.LabelSet{"owner": "team-A"}, nil)
+	if !reflect.DeepEqual([]string{"notify-A", "notify-A-secondary"}, func() []string {
+		var rs []string
+		for _, r := range all {
+			rs = append(rs, r.RouteOpts.Receiver)
+		}
+		return rs
+	}()) {
+		t.Errorf("unexpected matches: %v", all)
+	}
+
+	if !trace.Matched {
+		t.Errorf("expected root to match")
+	}
+	if len(trace.Children) != 3 {
+		t.Fatalf("expected 3 children in trace, got %d", len(trace.Children))
+	}
+	if !trace.Children[0].Matched || trace.Children[0].Skipped {
+		t.Errorf("expected first child to have matched and not been skipped")
+	}
+	if !trace.Children[1].Matched || trace.Children[1].Skipped {
+		t.Errorf("expected second child to have matched and not been skipped")
+	}
+	if trace.Children[2].Matched || !trace.Children[2].Skipped {
+		t.Errorf("expected third child to have been skipped since the second matched without continue")
+	}
+}
+
+func TestRouteMatchingReceiversTemplate(t *testing.T) {
+	in := `
+receiver: 'team-{{ .team }}-slack'
+receiver_fallback: 'fallback'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	receivers := tree.MatchingReceivers(model.LabelSet{"team": "a"}, nil)
+	if !reflect.DeepEqual(receivers, []string{"team-a-slack"}) {
+		t.Errorf("expected [team-a-slack], got %v", receivers)
+	}
+
+	receivers = tree.MatchingReceivers(model.LabelSet{}, nil)
+	if !reflect.DeepEqual(receivers, []string{"team-<no value>-slack"}) {
+		t.Errorf("expected [team-<no value>-slack], got %v", receivers)
+	}
+}
+
+func TestRouteResolveReceiverWithoutTemplate(t *testing.T) {
+	in := `
+receiver: 'team-{{ .team }}-bogus'
+receiver_fallback: 'fallback'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	tree.RouteOpts.ReceiverTemplate = nil
+	receiver, err := tree.RouteOpts.ResolveReceiver(model.LabelSet{"team": "a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if receiver != "team-{{ .team }}-bogus" {
+		t.Errorf("expected receiver unchanged when no template set, got %q", receiver)
+	}
+}
+
+func TestRouteAlertSortAndMaxAlertsRendered(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+alert_sort_label: severity
+alert_sort_order: ['critical', 'warning', 'info']
+max_alerts_rendered: 10
+
+routes:
+- receiver: 'notify-child'
+  max_alerts_rendered: 3
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	if want := model.LabelName("severity"); tree.RouteOpts.AlertSortLabel != want {
+		t.Errorf("unexpected root route AlertSortLabel %v, expected %v", tree.RouteOpts.AlertSortLabel, want)
+	}
+	if want := []string{"critical", "warning", "info"}; !reflect.DeepEqual(tree.RouteOpts.AlertSortOrder, want) {
+		t.Errorf("unexpected root route AlertSortOrder %v, expected %v", tree.RouteOpts.AlertSortOrder, want)
+	}
+	if want := 10; tree.RouteOpts.MaxAlertsRendered != want {
+		t.Errorf("unexpected root route MaxAlertsRendered %v, expected %v", tree.RouteOpts.MaxAlertsRendered, want)
+	}
+
+	// AlertSortLabel/AlertSortOrder are inherited from the parent since the
+	// child does not set its own, while MaxAlertsRendered is overridden.
+	child := tree.Routes[0]
+	if want := model.LabelName("severity"); child.RouteOpts.AlertSortLabel != want {
+		t.Errorf("unexpected inherited child route AlertSortLabel %v, expected %v", child.RouteOpts.AlertSortLabel, want)
+	}
+	if want := 3; child.RouteOpts.MaxAlertsRendered != want {
+		t.Errorf("unexpected child route MaxAlertsRendered %v, expected %v", child.RouteOpts.MaxAlertsRendered, want)
+	}
+}
+
+func TestRouteGroupByTemplate(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+group_by_template: '{{ .cluster }}-{{ truncate 4 .alertname }}'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	if tree.RouteOpts.GroupByTemplate == nil {
+		t.Fatal("expected GroupByTemplate to be compiled")
+	}
+
+	groupLabels := GroupLabels(&types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"cluster": "prod-1", "alertname": "NodeDown"},
+		},
+	}, tree)
+	if got := groupLabels[groupByTemplateKeyLabel]; got != "prod-1-Node" {
+		t.Errorf("expected group key %q, got %q", "prod-1-Node", got)
+	}
+}
+
+func TestRouteDedupKeyTemplate(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+dedup_key_template: '{{ .cluster }}-{{ .alertname }}'
+`
+
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	if tree.RouteOpts.DedupKeyTemplate == nil {
+		t.Fatal("expected DedupKeyTemplate to be compiled")
+	}
+
+	labels := model.LabelSet{"cluster": "prod-1", "alertname": "NodeDown"}
+	if got := templateDedupKey(labels, tree.RouteOpts.DedupKeyTemplate); got != "prod-1-NodeDown" {
+		t.Errorf("expected dedup key %q, got %q", "prod-1-NodeDown", got)
+	}
+}
+
+func TestRouteReceiverDefaults(t *testing.T) {
+	groupWait := model.Duration(10 * time.Second)
+	groupInterval := model.Duration(2 * time.Minute)
+	repeatInterval := model.Duration(2 * time.Hour)
+	receivers := config.ReceiversByName([]*config.Receiver{
+		{
+			Name:                  "team-x",
+			DefaultGroupBy:        []string{"cluster"},
+			DefaultGroupWait:      &groupWait,
+			DefaultGroupInterval:  &groupInterval,
+			DefaultRepeatInterval: &repeatInterval,
+		},
+	})
+
+	in := `
+receiver: 'notify-def'
+
+routes:
+- match:
+    owner: 'team-x'
+  receiver: 'team-x'
+- match:
+    owner: 'team-x-override'
+  receiver: 'team-x'
+  group_by: ['alertname']
+  group_wait: 1m
+  group_interval: 10m
+  repeat_interval: 4h
+`
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, receivers)
+
+	defaulted := tree.Routes[0]
+	if want := (map[model.LabelName]struct{}{"cluster": {}}); !reflect.DeepEqual(defaulted.RouteOpts.GroupBy, want) {
+		t.Errorf("expected receiver default group_by %v, got %v", want, defaulted.RouteOpts.GroupBy)
+	}
+	if want := 10 * time.Second; defaulted.RouteOpts.GroupWait != want {
+		t.Errorf("expected receiver default group_wait %v, got %v", want, defaulted.RouteOpts.GroupWait)
+	}
+	if want := 2 * time.Minute; defaulted.RouteOpts.GroupInterval != want {
+		t.Errorf("expected receiver default group_interval %v, got %v", want, defaulted.RouteOpts.GroupInterval)
+	}
+	if want := 2 * time.Hour; defaulted.RouteOpts.RepeatInterval != want {
+		t.Errorf("expected receiver default repeat_interval %v, got %v", want, defaulted.RouteOpts.RepeatInterval)
+	}
+
+	overridden := tree.Routes[1]
+	if want := (map[model.LabelName]struct{}{"alertname": {}}); !reflect.DeepEqual(overridden.RouteOpts.GroupBy, want) {
+		t.Errorf("expected route's own group_by %v to win over receiver default, got %v", want, overridden.RouteOpts.GroupBy)
+	}
+	if want := time.Minute; overridden.RouteOpts.GroupWait != want {
+		t.Errorf("expected route's own group_wait %v to win over receiver default, got %v", want, overridden.RouteOpts.GroupWait)
+	}
+}
+
+func TestRouteRepeatIntervals(t *testing.T) {
+	in := `
+receiver: 'notify-def'
+repeat_intervals: ['1h', '4h', '24h']
+
+routes:
+- match:
+    owner: 'team-x'
+  receiver: 'notify-def'
+  repeat_interval: 2h
+`
+	var ctree config.Route
+	if err := yaml.UnmarshalStrict([]byte(in), &ctree); err != nil {
+		t.Fatal(err)
+	}
+	tree := NewRoute(&ctree, nil, nil)
+
+	want := []time.Duration{time.Hour, 4 * time.Hour, 24 * time.Hour}
+	if !reflect.DeepEqual(tree.RouteOpts.RepeatIntervals, want) {
+		t.Errorf("expected repeat_intervals %v, got %v", want, tree.RouteOpts.RepeatIntervals)
+	}
+
+	child := tree.Routes[0]
+	if child.RouteOpts.RepeatIntervals != nil {
+		t.Errorf("expected child's own repeat_interval to clear inherited repeat_intervals, got %v", child.RouteOpts.RepeatIntervals)
+	}
+	if want := 2 * time.Hour; child.RouteOpts.RepeatInterval != want {
+		t.Errorf("expected child repeat_interval %v, got %v", want, child.RouteOpts.RepeatInterval)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2018 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// stormWindow is the bucket size alert arrivals are counted in.
+	stormWindow = time.Minute
+	// stormBaselineEWMAAlpha weighs each closed window's rate into the
+	// rolling baseline; lower values make the baseline adapt more slowly.
+	stormBaselineEWMAAlpha = 0.2
+)
+
+// stormDetector tracks how fast alerts are arriving for a route's subtree
+// against a rolling baseline rate, flagging "storm mode" once the current
+// rate reaches a configured multiple of that baseline.
+type stormDetector struct {
+	mtx sync.Mutex
+
+	windowStart time.Time
+	windowCount int
+	baseline    float64 // alerts per stormWindow
+	active      bool
+}
+
+// record registers an alert arrival at now and returns whether storm mode
+// is active afterwards, and whether that is a change from before the call.
+// A threshold of zero or less disables detection.
+func (s *stormDetector) record(now time.Time, threshold float64) (active, changed bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.windowStart.IsZero() {
+		s.windowStart = now
+	}
+
+	if elapsed := now.Sub(s.windowStart); elapsed >= stormWindow {
+		rate := float64(s.windowCount) / (float64(elapsed) / float64(stormWindow))
+		if s.baseline == 0 {
+			s.baseline = rate
+		} else {
+			s.baseline = s.baseline*(1-stormBaselineEWMAAlpha) + rate*stormBaselineEWMAAlpha
+		}
+		s.windowCount = 0
+		s.windowStart = now
+	}
+
+	s.windowCount++
+
+	// Compare the count accumulated so far in the current (still open)
+	// window against the baseline, rather than waiting for the window to
+	// close, so a burst is flagged as it happens rather than a minute
+	// later.
+	wasActive := s.active
+	s.active = threshold > 0 && s.baseline > 0 && float64(s.windowCount) >= threshold*s.baseline
+	return s.active, s.active != wasActive
+}
+
+// current reports whether storm mode is currently active.
+func (s *stormDetector) current() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.active
+}
@@ -0,0 +1,85 @@
+// Copyright 2018 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStormDetectorTriggersAndRecovers(t *testing.T) {
+	var s stormDetector
+	now := time.Now()
+
+	// Establish a baseline of one alert per minute over a few windows.
+	for i := 0; i < 5; i++ {
+		active, _ := s.record(now, 3)
+		if active {
+			t.Fatalf("did not expect storm mode while establishing baseline")
+		}
+		now = now.Add(stormWindow)
+	}
+
+	// A sudden burst, well above 3x the baseline, should trigger storm mode
+	// immediately rather than waiting for the window to close.
+	var active, changed bool
+	for i := 0; i < 10; i++ {
+		active, changed = s.record(now, 3)
+		if active {
+			break
+		}
+	}
+	if !active {
+		t.Fatal("expected storm mode to activate during the burst")
+	}
+	if !changed {
+		t.Fatal("expected the activation to be reported as a change")
+	}
+
+	// A repeat call shouldn't claim another change.
+	if _, changed = s.record(now, 3); changed {
+		t.Fatal("did not expect a repeated change while still in storm mode")
+	}
+
+	// Quiet down for several windows; the baseline should catch up and
+	// storm mode should clear.
+	var endedChanged bool
+	for i := 0; i < 10; i++ {
+		now = now.Add(stormWindow)
+		active, changed = s.record(now, 3)
+		if changed {
+			endedChanged = true
+		}
+		if !active {
+			break
+		}
+	}
+	if active {
+		t.Fatal("expected storm mode to clear once the rate normalized")
+	}
+	if !endedChanged {
+		t.Fatal("expected the recovery to be reported as a change")
+	}
+}
+
+func TestStormDetectorDisabledByThreshold(t *testing.T) {
+	var s stormDetector
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		active, changed := s.record(now, 0)
+		if active || changed {
+			t.Fatal("a zero threshold should never activate storm mode")
+		}
+	}
+}
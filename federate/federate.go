@@ -0,0 +1,187 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package federate lets one Alertmanager mirror the active alert set of one
+// or more peer Alertmanagers, tagging every mirrored alert with a source
+// label. This enables hierarchical deployments (e.g. edge -> central) where
+// a central instance has global visibility without every edge instance
+// needing to know about every receiver. It reuses the existing v2 "GET
+// /api/v2/alerts" endpoint as its wire format, so any Alertmanager can act as
+// a federation source without further configuration.
+package federate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// DefaultSourceLabel is the label name added to every alert pulled from a
+// peer, set to the peer's URL.
+const DefaultSourceLabel model.LabelName = "am_source"
+
+// Puller periodically pulls the active alert set from a list of peer
+// Alertmanagers and inserts it into a local alert store.
+type Puller struct {
+	client      *http.Client
+	sourceLabel model.LabelName
+	logger      log.Logger
+
+	pullsTotal  *prometheus.CounterVec
+	errorsTotal *prometheus.CounterVec
+}
+
+// New returns a Puller that tags mirrored alerts with sourceLabel. If
+// sourceLabel is empty, DefaultSourceLabel is used.
+func New(sourceLabel string, r prometheus.Registerer, l log.Logger) *Puller {
+	if sourceLabel == "" {
+		sourceLabel = string(DefaultSourceLabel)
+	}
+	p := &Puller{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		sourceLabel: model.LabelName(sourceLabel),
+		logger:      l,
+		pullsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_federate_pulls_total",
+			Help: "Number of successful alert federation pulls per peer.",
+		}, []string{"peer"}),
+		errorsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_federate_pull_errors_total",
+			Help: "Number of failed alert federation pulls per peer.",
+		}, []string{"peer"}),
+	}
+	if r != nil {
+		r.MustRegister(p.pullsTotal, p.errorsTotal)
+	}
+	return p
+}
+
+// Run pulls the active alert set from every peer on every tick of interval,
+// inserting mirrored alerts into alerts, until ctx is canceled.
+func (p *Puller) Run(ctx context.Context, peers []string, interval time.Duration, alerts provider.Alerts) {
+	if len(peers) == 0 {
+		return
+	}
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		for _, peer := range peers {
+			p.pull(ctx, peer, alerts)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (p *Puller) pull(ctx context.Context, peer string, alerts provider.Alerts) {
+	u := fmt.Sprintf("%s/api/v2/alerts", peer)
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		p.errorsTotal.WithLabelValues(peer).Inc()
+		level.Error(p.logger).Log("msg", "failed to build federation request", "peer", peer, "err", err)
+		return
+	}
+
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		p.errorsTotal.WithLabelValues(peer).Inc()
+		level.Warn(p.logger).Log("msg", "failed to pull alerts from peer", "peer", peer, "err", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		p.errorsTotal.WithLabelValues(peer).Inc()
+		level.Warn(p.logger).Log("msg", "peer returned unexpected status", "peer", peer, "status", resp.StatusCode)
+		return
+	}
+
+	var gettable models.GettableAlerts
+	if err := json.NewDecoder(resp.Body).Decode(&gettable); err != nil {
+		p.errorsTotal.WithLabelValues(peer).Inc()
+		level.Error(p.logger).Log("msg", "failed to decode peer alerts", "peer", peer, "err", err)
+		return
+	}
+
+	converted := make([]*types.Alert, 0, len(gettable))
+	for _, ga := range gettable {
+		a, err := p.convert(peer, ga)
+		if err != nil {
+			level.Error(p.logger).Log("msg", "failed to convert peer alert", "peer", peer, "err", err)
+			continue
+		}
+		converted = append(converted, a)
+	}
+
+	if len(converted) > 0 {
+		if err := alerts.Put(converted...); err != nil {
+			p.errorsTotal.WithLabelValues(peer).Inc()
+			level.Error(p.logger).Log("msg", "failed to store alerts pulled from peer", "peer", peer, "err", err)
+			return
+		}
+	}
+
+	p.pullsTotal.WithLabelValues(peer).Inc()
+}
+
+func (p *Puller) convert(peer string, ga *models.GettableAlert) (*types.Alert, error) {
+	if ga == nil {
+		return nil, fmt.Errorf("nil alert")
+	}
+
+	labels := make(model.LabelSet, len(ga.Labels)+1)
+	for k, v := range ga.Labels {
+		labels[model.LabelName(k)] = model.LabelValue(v)
+	}
+	labels[p.sourceLabel] = model.LabelValue(peer)
+
+	annotations := make(model.LabelSet, len(ga.Annotations))
+	for k, v := range ga.Annotations {
+		annotations[model.LabelName(k)] = model.LabelValue(v)
+	}
+
+	var startsAt, endsAt time.Time
+	if ga.StartsAt != nil {
+		startsAt = time.Time(*ga.StartsAt)
+	}
+	if ga.EndsAt != nil {
+		endsAt = time.Time(*ga.EndsAt)
+	}
+
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:      labels,
+			Annotations: annotations,
+			StartsAt:    startsAt,
+			EndsAt:      endsAt,
+		},
+		UpdatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/provider/mem"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestPullerPullsAndTagsAlerts(t *testing.T) {
+	now := strfmt.DateTime(time.Now())
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{
+			"labels": {"alertname": "Edge"},
+			"annotations": {},
+			"startsAt": "` + now.String() + `",
+			"endsAt": "0001-01-01T00:00:00.000Z",
+			"fingerprint": "abc",
+			"receivers": [],
+			"status": {"state": "active"}
+		}]`))
+	}))
+	defer srv.Close()
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, log.NewNopLogger())
+	require.NoError(t, err)
+	defer alerts.Close()
+
+	p := New("", nil, log.NewNopLogger())
+	p.pull(context.Background(), srv.URL, alerts)
+
+	it := alerts.GetPending()
+	defer it.Close()
+	var got *types.Alert
+	for a := range it.Next() {
+		got = a
+	}
+	require.NoError(t, it.Err())
+	require.NotNil(t, got)
+	require.Equal(t, model.LabelValue(srv.URL), got.Labels[DefaultSourceLabel])
+	require.Equal(t, model.LabelValue("Edge"), got.Labels["alertname"])
+}
@@ -0,0 +1,135 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package heartbeat periodically pings an external dead-man's-switch URL
+// (for example an Opsgenie or BetterStack heartbeat check) so that the
+// paging provider can alert the on-call team if the Alertmanager process
+// itself stops running or loses connectivity.
+package heartbeat
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultInterval is the ping interval used when none is configured.
+const DefaultInterval = time.Minute
+
+// Pinger periodically sends an HTTP GET request to a configurable heartbeat
+// URL. It is safe to reconfigure via Set while Run is executing, e.g. across
+// configuration reloads.
+type Pinger struct {
+	client *http.Client
+	logger log.Logger
+
+	mtx      sync.Mutex
+	url      string
+	interval time.Duration
+
+	pingsTotal  prometheus.Counter
+	errorsTotal prometheus.Counter
+}
+
+// New returns a Pinger that is initially disabled. Call Set to configure it
+// with a heartbeat URL before or during Run.
+func New(r prometheus.Registerer, l log.Logger) *Pinger {
+	p := &Pinger{
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: l,
+		pingsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_heartbeat_pings_total",
+			Help: "Number of heartbeat pings sent.",
+		}),
+		errorsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_heartbeat_errors_total",
+			Help: "Number of heartbeat pings that failed or got a non-2xx response.",
+		}),
+	}
+	if r != nil {
+		r.MustRegister(p.pingsTotal, p.errorsTotal)
+	}
+	return p
+}
+
+// Set (re-)configures the heartbeat URL and interval. An empty url disables
+// pinging. It may be called concurrently with Run, e.g. on config reload.
+func (p *Pinger) Set(url string, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.url = url
+	p.interval = interval
+}
+
+func (p *Pinger) current() (string, time.Duration) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.url, p.interval
+}
+
+// Run pings the configured heartbeat URL until stopc is closed. It is a
+// no-op for as long as no URL has been configured via Set.
+func (p *Pinger) Run(ctx context.Context, stopc <-chan struct{}) {
+	t := time.NewTimer(p.waitDuration())
+	defer t.Stop()
+	for {
+		select {
+		case <-stopc:
+			return
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if url, _ := p.current(); url != "" {
+				p.ping(ctx, url)
+			}
+			t.Reset(p.waitDuration())
+		}
+	}
+}
+
+func (p *Pinger) waitDuration() time.Duration {
+	_, interval := p.current()
+	if interval <= 0 {
+		return DefaultInterval
+	}
+	return interval
+}
+
+func (p *Pinger) ping(ctx context.Context, url string) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		p.errorsTotal.Inc()
+		level.Error(p.logger).Log("msg", "failed to build heartbeat request", "err", err)
+		return
+	}
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		p.errorsTotal.Inc()
+		level.Warn(p.logger).Log("msg", "heartbeat ping failed", "err", err)
+		return
+	}
+	defer resp.Body.Close()
+	p.pingsTotal.Inc()
+	if resp.StatusCode/100 != 2 {
+		p.errorsTotal.Inc()
+		level.Warn(p.logger).Log("msg", "heartbeat ping returned unexpected status", "status", resp.StatusCode)
+	}
+}
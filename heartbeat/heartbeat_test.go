@@ -0,0 +1,70 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package heartbeat
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingerPing(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p := New(nil, log.NewNopLogger())
+	p.Set(srv.URL, 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopc := make(chan struct{})
+	go p.Run(ctx, stopc)
+
+	deadline := time.Now().Add(time.Second)
+	for hits < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	require.True(t, hits >= 2, "expected at least 2 pings, got %d", hits)
+
+	cancel()
+	close(stopc)
+}
+
+func TestPingerDisabledByDefault(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	p := New(nil, log.NewNopLogger())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopc := make(chan struct{})
+	defer close(stopc)
+
+	go p.Run(ctx, stopc)
+	time.Sleep(20 * time.Millisecond)
+
+	require.Equal(t, 0, hits)
+}
@@ -0,0 +1,115 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package history keeps a bounded, in-memory log of lifecycle events for
+// individual alerts -- received, grouped, notified, silenced, resolved --
+// so a question like "why did/didn't this alert page" can be answered by a
+// query instead of log spelunking.
+package history
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+// EventType identifies a point in an alert's lifecycle.
+type EventType string
+
+const (
+	// EventReceived is recorded when an alert is first accepted into the
+	// alert store.
+	EventReceived EventType = "received"
+	// EventGrouped is recorded when an alert is sorted into an aggregation
+	// group by the dispatcher.
+	EventGrouped EventType = "grouped"
+	// EventNotified is recorded when a notification for the alert has been
+	// successfully delivered through a receiver integration.
+	EventNotified EventType = "notified"
+	// EventSilenced is recorded when an active silence mutes the alert.
+	EventSilenced EventType = "silenced"
+	// EventInhibited is recorded when another firing alert inhibits the
+	// alert.
+	EventInhibited EventType = "inhibited"
+	// EventResolved is recorded when the alert is received in a resolved
+	// state.
+	EventResolved EventType = "resolved"
+)
+
+// Event is a single recorded lifecycle transition for an alert.
+type Event struct {
+	Type      EventType
+	Timestamp time.Time
+	// Detail carries event-specific context, e.g. the route key an alert
+	// was grouped under, the receiver/integration it was notified through,
+	// or the silence IDs that muted it.
+	Detail string
+}
+
+// DefaultMaxEventsPerAlert bounds per-alert history in the absence of an
+// explicit limit, keeping a noisy alert from growing its history without
+// bound.
+const DefaultMaxEventsPerAlert = 50
+
+// Log keeps a bounded, goroutine-safe history of lifecycle events per alert
+// fingerprint.
+type Log struct {
+	mtx       sync.Mutex
+	maxEvents int
+	events    map[model.Fingerprint][]Event
+}
+
+// New returns a Log that retains at most maxEventsPerAlert events per
+// fingerprint, discarding the oldest once the bound is reached. A
+// non-positive maxEventsPerAlert falls back to DefaultMaxEventsPerAlert.
+func New(maxEventsPerAlert int) *Log {
+	if maxEventsPerAlert <= 0 {
+		maxEventsPerAlert = DefaultMaxEventsPerAlert
+	}
+	return &Log{
+		maxEvents: maxEventsPerAlert,
+		events:    map[model.Fingerprint][]Event{},
+	}
+}
+
+// Add records an event of the given type for fp at the current time.
+func (l *Log) Add(fp model.Fingerprint, typ EventType, detail string) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	events := append(l.events[fp], Event{
+		Type:      typ,
+		Timestamp: time.Now(),
+		Detail:    detail,
+	})
+	if over := len(events) - l.maxEvents; over > 0 {
+		events = events[over:]
+	}
+	l.events[fp] = events
+}
+
+// Get returns the recorded events for fp, oldest first. It returns nil if
+// no events have been recorded for fp.
+func (l *Log) Get(fp model.Fingerprint) []Event {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	events := l.events[fp]
+	if len(events) == 0 {
+		return nil
+	}
+	out := make([]Event, len(events))
+	copy(out, events)
+	return out
+}
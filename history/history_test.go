@@ -0,0 +1,65 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogAddGet(t *testing.T) {
+	l := New(10)
+	fp := model.Fingerprint(1)
+
+	require.Nil(t, l.Get(fp))
+
+	l.Add(fp, EventReceived, "")
+	l.Add(fp, EventGrouped, "team-x")
+	l.Add(fp, EventNotified, "team-x/webhook")
+
+	events := l.Get(fp)
+	require.Len(t, events, 3)
+	require.Equal(t, EventReceived, events[0].Type)
+	require.Equal(t, EventGrouped, events[1].Type)
+	require.Equal(t, "team-x", events[1].Detail)
+	require.Equal(t, EventNotified, events[2].Type)
+	require.Equal(t, "team-x/webhook", events[2].Detail)
+}
+
+func TestLogBoundsPerAlert(t *testing.T) {
+	l := New(3)
+	fp := model.Fingerprint(1)
+
+	for i := 0; i < 5; i++ {
+		l.Add(fp, EventNotified, "")
+	}
+
+	require.Len(t, l.Get(fp), 3)
+}
+
+func TestLogDefaultsNonPositiveBound(t *testing.T) {
+	l := New(0)
+	require.Equal(t, DefaultMaxEventsPerAlert, l.maxEvents)
+}
+
+func TestLogIsolatesFingerprints(t *testing.T) {
+	l := New(10)
+	a, b := model.Fingerprint(1), model.Fingerprint(2)
+
+	l.Add(a, EventReceived, "")
+	require.Len(t, l.Get(a), 1)
+	require.Nil(t, l.Get(b))
+}
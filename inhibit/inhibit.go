@@ -15,6 +15,7 @@ package inhibit
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -56,6 +57,9 @@ func NewInhibitor(ap provider.Alerts, rs []*config.InhibitRule, mk types.Marker,
 	return ih
 }
 
+// run subscribes to the alert stream and keeps each rule's source-alert
+// cache up to date so that Mutes can answer without touching provider.Alerts
+// on the hot path.
 func (ih *Inhibitor) run(ctx context.Context) {
 	it := ih.alerts.Subscribe()
 	defer it.Close()
@@ -71,7 +75,7 @@ func (ih *Inhibitor) run(ctx context.Context) {
 			}
 			// Update the inhibition rules' cache.
 			for _, r := range ih.rules {
-				if r.SourceMatchers.Match(a.Labels) {
+				if r.SourceMatchers.Match(a.Labels, a.Annotations) {
 					if err := r.scache.Set(a); err != nil {
 						level.Error(ih.logger).Log("msg", "error on set alert", "err", err)
 					}
@@ -122,19 +126,20 @@ func (ih *Inhibitor) Stop() {
 	}
 }
 
-// Mutes returns true iff the given label set is muted. It implements the Muter
-// interface.
-func (ih *Inhibitor) Mutes(lset model.LabelSet) bool {
+// Mutes returns true iff the given label set is muted. It implements the
+// Muter interface. annotations is accepted for interface compliance, but
+// inhibition rules only ever match against labels.
+func (ih *Inhibitor) Mutes(lset, annotations model.LabelSet) bool {
 	fp := lset.Fingerprint()
 
 	for _, r := range ih.rules {
-		if !r.TargetMatchers.Match(lset) {
+		if !r.TargetMatchers.Match(lset, annotations) {
 			// If target side of rule doesn't match, we don't need to look any further.
 			continue
 		}
 		// If we are here, the target side matches. If the source side matches, too, we
 		// need to exclude inhibiting alerts for which the same is true.
-		if inhibitedByFP, eq := r.hasEqual(lset, r.SourceMatchers.Match(lset)); eq {
+		if inhibitedByFP, eq := r.hasEqual(lset, r.SourceMatchers.Match(lset, annotations)); eq {
 			ih.marker.SetInhibited(fp, inhibitedByFP.String())
 			return true
 		}
@@ -144,6 +149,14 @@ func (ih *Inhibitor) Mutes(lset model.LabelSet) bool {
 	return false
 }
 
+// InhibitedBy returns the fingerprints of the source alerts currently
+// inhibiting the alert with fingerprint fp, as last recorded by Mutes. It
+// returns an empty slice if the alert is not currently inhibited.
+func (ih *Inhibitor) InhibitedBy(fp model.Fingerprint) []string {
+	ids, _ := ih.marker.Inhibited(fp)
+	return ids
+}
+
 // An InhibitRule specifies that a class of (source) alerts should inhibit
 // notifications for another class of (target) alerts if all specified matching
 // labels are equal between the two alerts. This may be used to inhibit alerts
@@ -185,6 +198,23 @@ func NewInhibitRule(cr *config.InhibitRule) *InhibitRule {
 		targetm = append(targetm, types.NewRegexMatcher(model.LabelName(ln), lv.Regexp))
 	}
 
+	for _, expr := range cr.SourceMatchers {
+		// Already validated by config.InhibitRule.UnmarshalYAML; an error
+		// here would mean that validation and this parse disagree.
+		m, err := types.NewMatcherFromExpr(expr)
+		if err != nil {
+			panic(fmt.Sprintf("source_matchers failed to parse after validation: %s", err))
+		}
+		sourcem = append(sourcem, m)
+	}
+	for _, expr := range cr.TargetMatchers {
+		m, err := types.NewMatcherFromExpr(expr)
+		if err != nil {
+			panic(fmt.Sprintf("target_matchers failed to parse after validation: %s", err))
+		}
+		targetm = append(targetm, m)
+	}
+
 	equal := map[model.LabelName]struct{}{}
 	for _, ln := range cr.Equal {
 		equal[ln] = struct{}{}
@@ -214,7 +244,7 @@ Outer:
 				continue Outer
 			}
 		}
-		if excludeTwoSidedMatch && r.TargetMatchers.Match(a.Labels) {
+		if excludeTwoSidedMatch && r.TargetMatchers.Match(a.Labels, a.Annotations) {
 			continue Outer
 		}
 		return a.Fingerprint(), true
@@ -137,6 +137,29 @@ func TestInhibitRuleHasEqual(t *testing.T) {
 	}
 }
 
+func TestNewInhibitRuleMatchers(t *testing.T) {
+	t.Parallel()
+
+	cr := &config.InhibitRule{
+		SourceMatch:    map[string]string{"s1": "1"},
+		SourceMatchers: []string{"s2=~\"a|b\""},
+		TargetMatch:    map[string]string{"t1": "1"},
+		TargetMatchers: []string{"t2=~\"a|b\""},
+		Equal:          model.LabelNames{"e"},
+	}
+	r := NewInhibitRule(cr)
+
+	if !r.SourceMatchers.Match(model.LabelSet{"s1": "1", "s2": "a"}, nil) {
+		t.Error("expected SourceMatchers to match s1 and s2")
+	}
+	if r.SourceMatchers.Match(model.LabelSet{"s1": "1", "s2": "c"}, nil) {
+		t.Error("expected SourceMatchers not to match an s2 value outside the regexp")
+	}
+	if !r.TargetMatchers.Match(model.LabelSet{"t1": "1", "t2": "b"}, nil) {
+		t.Error("expected TargetMatchers to match t1 and t2")
+	}
+}
+
 func TestInhibitRuleMatches(t *testing.T) {
 	t.Parallel()
 
@@ -226,7 +249,7 @@ func TestInhibitRuleMatches(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		if actual := ih.Mutes(c.target); actual != c.expected {
+		if actual := ih.Mutes(c.target, nil); actual != c.expected {
 			t.Errorf("Expected (*Inhibitor).Mutes(%v) to return %t but got %t", c.target, c.expected, actual)
 		}
 	}
@@ -369,7 +392,7 @@ func TestInhibit(t *testing.T) {
 		inhibitor.Run()
 
 		for _, expected := range tc.expected {
-			if inhibitor.Mutes(expected.lbls) != expected.muted {
+			if inhibitor.Mutes(expected.lbls, nil) != expected.muted {
 				mute := "unmuted"
 				if expected.muted {
 					mute = "muted"
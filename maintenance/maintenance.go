@@ -0,0 +1,68 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package maintenance lets the whole instance be switched into read-only or
+// drain mode at runtime, for use around cluster migrations and restores
+// where an operator needs to stop a specific instance from being mutated or
+// from taking on new work without tearing it down.
+package maintenance
+
+import "sync"
+
+// Tracker reports whether the instance is currently in read-only mode,
+// drain mode, or both. The zero value is not usable; use New.
+type Tracker struct {
+	mtx      sync.Mutex
+	readOnly bool
+	draining bool
+}
+
+// New returns a Tracker with neither mode initially enabled.
+func New() *Tracker {
+	return &Tracker{}
+}
+
+// SetReadOnly toggles read-only mode: while enabled, silence mutations and
+// configuration reloads are rejected, but alert and silence queries still
+// work as normal.
+func (t *Tracker) SetReadOnly(readOnly bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.readOnly = readOnly
+}
+
+// ReadOnly reports whether read-only mode is currently enabled.
+func (t *Tracker) ReadOnly() bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.readOnly
+}
+
+// SetDraining toggles drain mode: while enabled, new alerts are rejected at
+// ingestion, but alert groups already being tracked keep flushing as usual.
+func (t *Tracker) SetDraining(draining bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.draining = draining
+}
+
+// Draining reports whether drain mode is currently enabled.
+func (t *Tracker) Draining() bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.draining
+}
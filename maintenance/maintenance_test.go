@@ -0,0 +1,46 @@
+// Copyright 2024 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDefaultsToDisabled(t *testing.T) {
+	tr := New()
+	require.False(t, tr.ReadOnly())
+	require.False(t, tr.Draining())
+}
+
+func TestSetReadOnlyToggles(t *testing.T) {
+	tr := New()
+	tr.SetReadOnly(true)
+	require.True(t, tr.ReadOnly())
+	require.False(t, tr.Draining())
+
+	tr.SetReadOnly(false)
+	require.False(t, tr.ReadOnly())
+}
+
+func TestSetDrainingToggles(t *testing.T) {
+	tr := New()
+	tr.SetDraining(true)
+	require.True(t, tr.Draining())
+	require.False(t, tr.ReadOnly())
+
+	tr.SetDraining(false)
+	require.False(t, tr.Draining())
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"sync"
@@ -31,6 +32,7 @@ import (
 	"github.com/go-kit/kit/log/level"
 	"github.com/matttproud/golang_protobuf_extensions/pbutil"
 	"github.com/prometheus/alertmanager/cluster"
+	"github.com/prometheus/alertmanager/crypto/atrest"
 	pb "github.com/prometheus/alertmanager/nflog/nflogpb"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -72,6 +74,13 @@ func QGroupKey(gk string) QueryParam {
 	}
 }
 
+// Log keeps a record, per (group key, receiver) pair, of which alerts
+// (identified by their firing/resolved hashes) were last notified and when.
+// It is snapshotted to disk and gossiped between peers, so the entries
+// survive process restarts and are shared across replicas. notify.DedupStage
+// and notify.DiffStage consult it to suppress a notification that a prior
+// process lifetime, or another replica, already sent, and to honor each
+// receiver's RepeatInterval across those lifetimes.
 type Log struct {
 	logger    log.Logger
 	metrics   *metrics
@@ -82,6 +91,7 @@ type Log struct {
 	snapf       string
 	stopc       chan struct{}
 	done        func()
+	keyring     *atrest.KeyRing
 
 	// For now we only store the most recently added log entry.
 	// The key is a serialized concatenation of group key and receiver.
@@ -212,6 +222,16 @@ func WithSnapshot(sf string) Option {
 	}
 }
 
+// WithEncryption configures the log to encrypt the snapshots it writes to
+// disk, and to decrypt the snapshot it loads at startup, using kr. A nil or
+// disabled kr leaves snapshots in the clear.
+func WithEncryption(kr *atrest.KeyRing) Option {
+	return func(l *Log) error {
+		l.keyring = kr
+		return nil
+	}
+}
+
 func utcNow() time.Time {
 	return time.Now().UTC()
 }
@@ -483,6 +503,18 @@ func (l *Log) Query(params ...QueryParam) ([]*pb.Entry, error) {
 
 // loadSnapshot loads a snapshot generated by Snapshot() into the state.
 func (l *Log) loadSnapshot(r io.Reader) error {
+	if l.keyring != nil {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		b, err = l.keyring.Decrypt(b)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+
 	st, err := decodeState(r)
 	if err != nil {
 		return err
@@ -501,13 +533,19 @@ func (l *Log) Snapshot(w io.Writer) (int64, error) {
 	defer func() { l.metrics.snapshotDuration.Observe(time.Since(start).Seconds()) }()
 
 	l.mtx.RLock()
-	defer l.mtx.RUnlock()
-
 	b, err := l.st.MarshalBinary()
+	l.mtx.RUnlock()
 	if err != nil {
 		return 0, err
 	}
 
+	if l.keyring != nil {
+		b, err = l.keyring.Encrypt(b)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	return io.Copy(w, bytes.NewReader(b))
 }
 
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/alertmanager/crypto/atrest"
 	pb "github.com/prometheus/alertmanager/nflog/nflogpb"
 	"github.com/stretchr/testify/require"
 )
@@ -123,6 +124,44 @@ func TestLogSnapshot(t *testing.T) {
 	}
 }
 
+func TestLogSnapshotEncrypted(t *testing.T) {
+	entry := &pb.MeshEntry{
+		Entry: &pb.Entry{
+			GroupKey:  []byte("d8e8fca2dc0f896fd7cb4cb0031ba249"),
+			Receiver:  &pb.Receiver{GroupName: "abc", Integration: "test1", Idx: 1},
+			GroupHash: []byte("126a8a51b9d1bbd07fddc65819a542c3"),
+			Resolved:  false,
+			Timestamp: utcNow(),
+		},
+		ExpiresAt: utcNow(),
+	}
+
+	kr := atrest.NewKeyRing()
+	key := make([]byte, atrest.KeySize)
+	require.NoError(t, kr.Configure(map[string][]byte{"k1": key}, "k1"))
+
+	l1 := &Log{st: state{}, metrics: newMetrics(nil), keyring: kr}
+	l1.st[stateKey(string(entry.Entry.GroupKey), entry.Entry.Receiver)] = entry
+
+	f, err := ioutil.TempFile("", "snapshot")
+	require.NoError(t, err)
+	_, err = l1.Snapshot(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	raw, err := ioutil.ReadFile(f.Name())
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "abc", "snapshot must not contain the plaintext receiver group name")
+
+	f, err = os.Open(f.Name())
+	require.NoError(t, err)
+	defer f.Close()
+
+	l2 := &Log{keyring: kr}
+	require.NoError(t, l2.loadSnapshot(f))
+	require.Equal(t, l1.st, l2.st)
+}
+
 func TestReplaceFile(t *testing.T) {
 	dir, err := ioutil.TempDir("", "replace_file")
 	require.NoError(t, err, "creating temp dir failed")
@@ -0,0 +1,135 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Notifier implements a Notifier for the Alerta API.
+type Notifier struct {
+	conf    *config.AlertaConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Alerta notifier.
+func New(c *config.AlertaConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	client, err := config.NewClient(c.HTTPConfig, "alerta")
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{},
+	}, nil
+}
+
+// alert mirrors the fields of an Alerta API alert that this notifier sets.
+// The groupKey attribute lets Alertmanager's own dedup/grouping key be
+// traced through to the Alerta console alongside Alerta's own
+// environment/resource/event based deduplication.
+type alert struct {
+	Resource    string            `json:"resource"`
+	Event       string            `json:"event"`
+	Environment string            `json:"environment"`
+	Severity    string            `json:"severity"`
+	Service     []string          `json:"service,omitempty"`
+	Group       string            `json:"group,omitempty"`
+	Value       string            `json:"value,omitempty"`
+	Text        string            `json:"text,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	Origin      string            `json:"origin,omitempty"`
+	Type        string            `json:"type"`
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, ok := notify.GroupKey(ctx)
+	if !ok {
+		return false, fmt.Errorf("group key missing")
+	}
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+
+	level.Debug(n.logger).Log("incident", key)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	a := alert{
+		Resource:    tmpl(n.conf.Resource),
+		Event:       tmpl(n.conf.Event),
+		Environment: tmpl(n.conf.Environment),
+		Severity:    tmpl(n.conf.Severity),
+		Group:       tmpl(n.conf.Group),
+		Text:        tmpl(n.conf.Text),
+		Origin:      n.conf.Origin,
+		Type:        "alertmanagerAlert",
+		Attributes:  map[string]string{"groupKey": key},
+	}
+	for _, s := range n.conf.Service {
+		a.Service = append(a.Service, tmpl(s))
+	}
+	for _, t := range n.conf.Tags {
+		a.Tags = append(a.Tags, tmpl(t))
+	}
+	if data.Status == "resolved" {
+		a.Severity = "normal"
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(a); err != nil {
+		return false, err
+	}
+
+	u := n.conf.APIURL.Copy()
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/alert"
+
+	req, err := http.NewRequest("POST", u.String(), &buf)
+	if err != nil {
+		return true, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", n.conf.APIKey))
+
+	resp, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alerta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestAlertaRetry(t *testing.T) {
+	notifier, err := New(
+		&config.AlertaConfig{
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	for statusCode, expected := range test.RetryTests(test.DefaultRetryCodes()) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("error on status %d", statusCode))
+	}
+}
+
+func TestAlertaSendsExpectedRequest(t *testing.T) {
+	var gotReq *http.Request
+	var gotBody alert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL + "/api")
+	require.NoError(t, err)
+
+	conf := &config.AlertaConfig{
+		APIURL:      &config.URL{URL: u},
+		APIKey:      "secret",
+		Environment: `{{ .CommonLabels.env }}`,
+		Resource:    `{{ .CommonLabels.instance }}`,
+		Event:       `{{ .CommonLabels.alertname }}`,
+		Severity:    `{{ .CommonLabels.severity }}`,
+		Text:        `{{ .CommonLabels.summary }}`,
+		Origin:      "alertmanager",
+		HTTPConfig:  &config.HTTPClientConfig{},
+	}
+	notifier, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{
+				"alertname": "high_cpu",
+				"instance":  "host1",
+				"env":       "prod",
+				"severity":  "critical",
+				"summary":   "CPU is too high",
+			},
+			StartsAt: time.Now(),
+		},
+	}
+	retry, err := notifier.Notify(ctx, a)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "/api/alert", gotReq.URL.Path)
+	require.Equal(t, "Key secret", gotReq.Header.Get("Authorization"))
+
+	require.Equal(t, "host1", gotBody.Resource)
+	require.Equal(t, "high_cpu", gotBody.Event)
+	require.Equal(t, "prod", gotBody.Environment)
+	require.Equal(t, "critical", gotBody.Severity)
+	require.Equal(t, "CPU is too high", gotBody.Text)
+	require.Equal(t, "1", gotBody.Attributes["groupKey"])
+}
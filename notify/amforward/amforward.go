@@ -0,0 +1,106 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package amforward implements a Notifier that re-posts the notified,
+// grouped alerts to another Alertmanager instance's v2 alert API, so a
+// regional instance can pre-aggregate and forward only the alerts it
+// decides matter to a central instance.
+package amforward
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Notifier implements a Notifier for forwarding to an upstream Alertmanager.
+type Notifier struct {
+	conf    *config.AlertmanagerConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Notifier.
+func New(c *config.AlertmanagerConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	client, err := config.NewClient(c.HTTPConfig, "alertmanager")
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{},
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	postable := make(models.PostableAlerts, 0, len(as))
+	for _, a := range as {
+		postable = append(postable, &models.PostableAlert{
+			Alert: models.Alert{
+				Labels:       labelSet(a.Labels, n.conf.ExternalLabels),
+				GeneratorURL: strfmt.URI(a.GeneratorURL),
+			},
+			Annotations: labelSet(a.Annotations, nil),
+			StartsAt:    strfmt.DateTime(a.StartsAt),
+			EndsAt:      strfmt.DateTime(a.EndsAt),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(postable); err != nil {
+		return false, err
+	}
+
+	u := fmt.Sprintf("%s/api/v2/alerts", strings.TrimSuffix(n.conf.URL.String(), "/"))
+
+	resp, err := notify.PostJSON(ctx, n.client, u, &buf)
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
+
+// labelSet converts ls to the wire LabelSet, overlaying extra on top so that
+// extra's keys always win (used to stamp ExternalLabels onto every alert's
+// labels).
+func labelSet(ls model.LabelSet, extra map[string]string) models.LabelSet {
+	out := make(models.LabelSet, len(ls)+len(extra))
+	for k, v := range ls {
+		out[string(k)] = string(v)
+	}
+	for k, v := range extra {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,127 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package archive writes every rendered notification, together with its
+// delivery result, to object storage for compliance retention and later
+// auditing of exactly what was sent and when.
+//
+// It speaks plain HTTP PUT rather than a cloud provider SDK, so it works
+// against an S3 pre-signed URL, the GCS XML API, an S3-compatible gateway
+// (e.g. minio), or any HTTP endpoint that accepts object writes -- without
+// vendoring a cloud SDK. Objects are partitioned by UTC date so that a
+// retention policy can be applied per day without scanning the whole bucket.
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/template"
+)
+
+// Record is the JSON document written for a single notification attempt.
+type Record struct {
+	Timestamp   time.Time      `json:"timestamp"`
+	Receiver    string         `json:"receiver"`
+	Integration string         `json:"integration"`
+	GroupKey    string         `json:"groupKey"`
+	Success     bool           `json:"success"`
+	Data        *template.Data `json:"data"`
+}
+
+// Archiver writes Records as objects under a configurable base URL. It is
+// safe to reconfigure via Set while in use, e.g. across configuration
+// reloads.
+type Archiver struct {
+	client *http.Client
+	logger log.Logger
+
+	mtx     sync.Mutex
+	baseURL string
+}
+
+// New returns an Archiver that is initially disabled. Call Set to point it
+// at an object storage endpoint.
+func New(l log.Logger) *Archiver {
+	return &Archiver{
+		client: &http.Client{Timeout: 30 * time.Second},
+		logger: l,
+	}
+}
+
+// Set (re-)configures the destination base URL. An empty baseURL disables
+// the archiver.
+func (a *Archiver) Set(baseURL string) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.baseURL = baseURL
+}
+
+// Enabled reports whether a destination base URL is currently configured.
+func (a *Archiver) Enabled() bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	return a.baseURL != ""
+}
+
+// Write archives rec as an object named after its timestamp and group key,
+// partitioned under baseURL by UTC date. It is a best-effort operation:
+// errors are logged but never propagated, so an archival outage never blocks
+// actual notification delivery.
+func (a *Archiver) Write(ctx context.Context, rec Record) {
+	a.mtx.Lock()
+	baseURL := a.baseURL
+	a.mtx.Unlock()
+	if baseURL == "" {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(rec); err != nil {
+		level.Error(a.logger).Log("msg", "failed to encode archive record", "err", err)
+		return
+	}
+
+	url := fmt.Sprintf("%s/%s/%s.json", baseURL, rec.Timestamp.UTC().Format("2006/01/02"), objectName(rec))
+
+	req, err := http.NewRequest(http.MethodPut, url, &buf)
+	if err != nil {
+		level.Error(a.logger).Log("msg", "failed to build archive request", "err", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req.WithContext(ctx))
+	if err != nil {
+		level.Warn(a.logger).Log("msg", "failed to archive notification", "err", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		level.Warn(a.logger).Log("msg", "archive endpoint returned unexpected status", "status", resp.StatusCode)
+	}
+}
+
+// objectName derives a unique-enough object key from rec so that repeated
+// notifications for the same group do not overwrite each other.
+func objectName(rec Record) string {
+	return fmt.Sprintf("%s-%s-%d", rec.Receiver, rec.Integration, rec.Timestamp.UTC().UnixNano())
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiverWrite(t *testing.T) {
+	var (
+		got    Record
+		method string
+		path   string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	a := New(log.NewNopLogger())
+	require.False(t, a.Enabled())
+
+	a.Set(srv.URL)
+	require.True(t, a.Enabled())
+
+	ts := time.Date(2026, 8, 8, 12, 0, 0, 0, time.UTC)
+	a.Write(context.Background(), Record{
+		Timestamp:   ts,
+		Receiver:    "team-X",
+		Integration: "webhook",
+		GroupKey:    "gk",
+		Success:     true,
+	})
+
+	require.Equal(t, http.MethodPut, method)
+	require.Equal(t, "/2026/08/08/team-X-webhook-1786190400000000000.json", path)
+	require.Equal(t, "team-X", got.Receiver)
+	require.Equal(t, "gk", got.GroupKey)
+	require.True(t, got.Success)
+}
+
+func TestArchiverWriteDisabled(t *testing.T) {
+	var hit bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer srv.Close()
+
+	a := New(log.NewNopLogger())
+	a.Write(context.Background(), Record{Timestamp: time.Now()})
+
+	require.False(t, hit)
+}
@@ -0,0 +1,127 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package correlate tracks which aggregation groups share the same values
+// for a configured set of labels (e.g. "cluster"), so that a notification
+// for one of them can be annotated with how many of the others are
+// currently, or very recently, also firing -- a cheap signal of blast
+// radius without requiring any topology knowledge of the alerts involved.
+package correlate
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+// Tracker groups aggregation groups by the values of a configured set of
+// labels and counts, for a given group, how many other groups in the same
+// bucket are firing within a trailing window. The zero value is not
+// usable; use New.
+type Tracker struct {
+	mtx    sync.Mutex
+	labels []model.LabelName
+	window time.Duration
+	groups map[string]map[string]time.Time // correlation key -> group key -> last seen firing.
+}
+
+// New returns a Tracker that correlates groups sharing the same values for
+// labels, counting another group as related if it last fired within
+// window. Either labels being empty or window being zero disables
+// correlation: Observe then always reports ok=false.
+func New(labels []model.LabelName, window time.Duration) *Tracker {
+	return &Tracker{
+		labels: labels,
+		window: window,
+		groups: map[string]map[string]time.Time{},
+	}
+}
+
+// SetLabels updates the labels used to bucket groups together.
+func (t *Tracker) SetLabels(labels []model.LabelName) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.labels = labels
+}
+
+// SetWindow updates the trailing window used to decide whether another
+// group in the same bucket still counts as firing.
+func (t *Tracker) SetWindow(window time.Duration) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.window = window
+}
+
+// key returns the correlation bucket for groupLabels, and whether
+// correlation applies at all: it doesn't if no labels are configured, or
+// groupLabels is missing a value for one of them.
+func (t *Tracker) key(groupLabels model.LabelSet) (string, bool) {
+	if len(t.labels) == 0 || t.window <= 0 {
+		return "", false
+	}
+	parts := make([]string, 0, len(t.labels))
+	for _, ln := range t.labels {
+		v, ok := groupLabels[ln]
+		if !ok {
+			return "", false
+		}
+		parts = append(parts, string(ln)+"="+string(v))
+	}
+	return strings.Join(parts, ","), true
+}
+
+// Observe records the firing status of groupKey, which carries
+// groupLabels, as of now, and returns how many other groups sharing the
+// same bucket are currently firing within the window. ok is false if
+// correlation does not apply to this group (see key).
+func (t *Tracker) Observe(groupLabels model.LabelSet, groupKey string, firing bool, now time.Time) (related int, ok bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	ckey, ok := t.key(groupLabels)
+	if !ok {
+		return 0, false
+	}
+
+	bucket, exists := t.groups[ckey]
+	if !exists {
+		bucket = map[string]time.Time{}
+		t.groups[ckey] = bucket
+	}
+
+	if firing {
+		bucket[groupKey] = now
+	} else {
+		delete(bucket, groupKey)
+	}
+
+	for gk, last := range bucket {
+		if gk != groupKey && now.Sub(last) > t.window {
+			delete(bucket, gk)
+		}
+	}
+
+	for gk := range bucket {
+		if gk != groupKey {
+			related++
+		}
+	}
+
+	if len(bucket) == 0 {
+		delete(t.groups, ckey)
+	}
+
+	return related, true
+}
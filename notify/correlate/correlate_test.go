@@ -0,0 +1,83 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveDisabledWithoutLabels(t *testing.T) {
+	tr := New(nil, time.Minute)
+	_, ok := tr.Observe(model.LabelSet{"cluster": "a"}, "group1", true, time.Now())
+	require.False(t, ok)
+}
+
+func TestObserveDisabledWithoutMatchingLabel(t *testing.T) {
+	tr := New([]model.LabelName{"cluster"}, time.Minute)
+	_, ok := tr.Observe(model.LabelSet{"job": "a"}, "group1", true, time.Now())
+	require.False(t, ok)
+}
+
+func TestObserveCountsOtherFiringGroups(t *testing.T) {
+	tr := New([]model.LabelName{"cluster"}, time.Minute)
+	now := time.Now()
+
+	related, ok := tr.Observe(model.LabelSet{"cluster": "a"}, "group1", true, now)
+	require.True(t, ok)
+	require.Equal(t, 0, related)
+
+	related, ok = tr.Observe(model.LabelSet{"cluster": "a"}, "group2", true, now)
+	require.True(t, ok)
+	require.Equal(t, 1, related)
+
+	related, ok = tr.Observe(model.LabelSet{"cluster": "a"}, "group1", true, now)
+	require.True(t, ok)
+	require.Equal(t, 1, related)
+}
+
+func TestObserveIgnoresDifferentBucket(t *testing.T) {
+	tr := New([]model.LabelName{"cluster"}, time.Minute)
+	now := time.Now()
+
+	_, _ = tr.Observe(model.LabelSet{"cluster": "a"}, "group1", true, now)
+	related, ok := tr.Observe(model.LabelSet{"cluster": "b"}, "group2", true, now)
+	require.True(t, ok)
+	require.Equal(t, 0, related)
+}
+
+func TestObserveExpiresOutsideWindow(t *testing.T) {
+	tr := New([]model.LabelName{"cluster"}, time.Minute)
+	now := time.Now()
+
+	_, _ = tr.Observe(model.LabelSet{"cluster": "a"}, "group1", true, now)
+	related, ok := tr.Observe(model.LabelSet{"cluster": "a"}, "group2", true, now.Add(2*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 0, related)
+}
+
+func TestObserveResolvedGroupStopsCounting(t *testing.T) {
+	tr := New([]model.LabelName{"cluster"}, time.Minute)
+	now := time.Now()
+
+	_, _ = tr.Observe(model.LabelSet{"cluster": "a"}, "group1", true, now)
+	_, _ = tr.Observe(model.LabelSet{"cluster": "a"}, "group1", false, now)
+
+	related, ok := tr.Observe(model.LabelSet{"cluster": "a"}, "group2", true, now)
+	require.True(t, ok)
+	require.Equal(t, 0, related)
+}
@@ -0,0 +1,363 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bounce surfaces asynchronous email delivery failures. A successful
+// SMTP "250 OK" only means the message was accepted by the next hop, not
+// that it reached a mailbox: the receiving side can still reject it later
+// with a bounce message, sent out-of-band to a delivery status notification
+// (DSN, RFC 3464) mailbox or to the VERP-tagged envelope sender of the
+// original message. Tracker correlates those bounces back to the message
+// that caused them and exposes them as metrics and per-message status.
+package bounce
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// DefaultGCInterval is how often Run sweeps for tracked messages older
+	// than DefaultMaxAge, if the caller doesn't pick its own interval.
+	DefaultGCInterval = 10 * time.Minute
+	// DefaultMaxAge is how long a tracked message is kept around waiting for
+	// a bounce before Run evicts it. Bounces typically arrive within minutes
+	// to a few days of the original send; an entry older than that can never
+	// be attributed anyway.
+	DefaultMaxAge = 7 * 24 * time.Hour
+)
+
+// Report describes a single bounced message, parsed out of a delivery
+// status notification.
+type Report struct {
+	// MessageID is the Message-Id of the original message, taken from the
+	// DSN's "Original-Envelope-Id" or VERP-decoded envelope sender.
+	MessageID string
+	// Recipient is the mailbox that could not be delivered to.
+	Recipient string
+	// Action is the DSN "Action" field (e.g. "failed", "delayed").
+	Action string
+	// DiagnosticCode is the raw DSN "Diagnostic-Code" field, if present.
+	DiagnosticCode string
+}
+
+// trackedMessage is what Tracker remembers about a single outgoing message
+// while it waits to find out whether it bounced.
+type trackedMessage struct {
+	receiver  string
+	bounced   bool
+	trackedAt time.Time
+}
+
+// Tracker records which receiver sent a given message-id, matches incoming
+// bounce reports against that record, and keeps metrics and per-message
+// status so it can be surfaced alongside the receiver it failed for. Run
+// must be started once to bound Tracker's memory use; otherwise every
+// tracked message accumulates for the life of the process.
+type Tracker struct {
+	logger log.Logger
+
+	mtx      sync.Mutex
+	messages map[string]*trackedMessage // message-id -> message
+	tokens   map[string]string          // VERP token -> message-id
+
+	bouncesTotal     *prometheus.CounterVec
+	parseErrorsTotal prometheus.Counter
+	evictionsTotal   prometheus.Counter
+}
+
+// NewTracker returns a Tracker. r may be nil, in which case metrics are not
+// registered.
+func NewTracker(r prometheus.Registerer, l log.Logger) *Tracker {
+	t := &Tracker{
+		logger:   l,
+		messages: map[string]*trackedMessage{},
+		tokens:   map[string]string{},
+		bouncesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_email_bounces_total",
+			Help: "Number of asynchronous bounce reports received for a receiver.",
+		}, []string{"receiver"}),
+		parseErrorsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_email_bounce_parse_errors_total",
+			Help: "Number of delivery status notifications that could not be parsed.",
+		}),
+		evictionsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_email_bounce_tracker_evictions_total",
+			Help: "Number of tracked messages evicted for exceeding the maximum tracking age.",
+		}),
+	}
+	if r != nil {
+		r.MustRegister(t.bouncesTotal, t.parseErrorsTotal, t.evictionsTotal)
+	}
+	return t
+}
+
+// Track records that messageID was sent on behalf of receiver, so that a
+// later bounce for messageID can be attributed to it. Call this once per
+// outgoing message.
+func (t *Tracker) Track(messageID, receiver string) {
+	if messageID == "" {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.messages[messageID] = &trackedMessage{receiver: receiver, trackedAt: time.Now()}
+	t.tokens[verpToken(messageID)] = messageID
+}
+
+// Bounced reports whether a bounce has been recorded for messageID.
+func (t *Tracker) Bounced(messageID string) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	msg, ok := t.messages[messageID]
+	return ok && msg.bounced
+}
+
+// Run starts the GC loop, evicting tracked messages older than maxAge on
+// every tick of interval, until ctx is done. The interval must be greater
+// than zero; if not, the function will panic.
+func (t *Tracker) Run(ctx context.Context, interval, maxAge time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.gc(maxAge)
+		}
+	}
+}
+
+// gc evicts tracked messages older than maxAge, bounding Tracker's memory
+// use for long-running processes.
+func (t *Tracker) gc(maxAge time.Duration) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	cutoff := time.Now().Add(-maxAge)
+	for id, msg := range t.messages {
+		if msg.trackedAt.Before(cutoff) {
+			delete(t.messages, id)
+			delete(t.tokens, verpToken(id))
+			t.evictionsTotal.Inc()
+		}
+	}
+}
+
+// Ingest parses a raw RFC 3464 delivery status notification (as delivered to
+// a bounce mailbox, or piped from an MTA alias) and records any failures it
+// reports. It is best-effort: a malformed email only increments
+// parseErrorsTotal and is otherwise ignored; an email that is not a
+// multipart message is ignored without being counted.
+func (t *Tracker) Ingest(r io.Reader) {
+	reports, bounceMailbox, err := Parse(r)
+	if err != nil {
+		t.parseErrorsTotal.Inc()
+		level.Warn(t.logger).Log("msg", "failed to parse delivery status notification", "err", err)
+		return
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	// The DSN did not echo back Original-Envelope-Id (not every MTA sets
+	// it), so fall back to the VERP token embedded in the address the
+	// bounce itself was delivered to.
+	if token, ok := verpTokenFromAddress(bounceMailbox); ok {
+		if messageID, ok := t.tokens[token]; ok {
+			for i := range reports {
+				if reports[i].MessageID == "" {
+					reports[i].MessageID = messageID
+				}
+			}
+		}
+	}
+
+	for _, rep := range reports {
+		if rep.Action != "failed" {
+			continue
+		}
+		msg, ok := t.messages[rep.MessageID]
+		if !ok {
+			level.Warn(t.logger).Log("msg", "bounce for unknown message-id", "message_id", rep.MessageID, "recipient", rep.Recipient)
+			continue
+		}
+		msg.bounced = true
+		t.bouncesTotal.WithLabelValues(msg.receiver).Inc()
+		level.Error(t.logger).Log("msg", "email delivery failed", "receiver", msg.receiver, "recipient", rep.Recipient, "diagnostic", rep.DiagnosticCode, "ts", time.Now())
+	}
+}
+
+// Parse extracts delivery failure Reports from a multipart/report DSN
+// message, along with the address the bounce was itself addressed to (the
+// bounce mailbox or VERP-tagged address). A message with no
+// message/delivery-status part yields no reports and no error, since not
+// every email reaching the bounce mailbox is necessarily a DSN.
+func Parse(r io.Reader) ([]Report, string, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bounceMailbox := ""
+	if addrs, err := mail.ParseAddressList(msg.Header.Get("To")); err == nil && len(addrs) > 0 {
+		bounceMailbox = addrs[0].Address
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, bounceMailbox, nil
+	}
+
+	originalEnvelopeID := ""
+	var reports []Report
+
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, bounceMailbox, err
+		}
+
+		partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if partType != "message/delivery-status" {
+			continue
+		}
+
+		body, err := ioutil.ReadAll(part)
+		if err != nil {
+			return nil, bounceMailbox, err
+		}
+
+		fields, perRecipient := parseDeliveryStatus(body)
+		originalEnvelopeID = fields["original-envelope-id"]
+
+		for _, rf := range perRecipient {
+			messageID := rf["original-envelope-id"]
+			if messageID == "" {
+				messageID = originalEnvelopeID
+			}
+			reports = append(reports, Report{
+				MessageID:      messageID,
+				Recipient:      stripAddressType(rf["final-recipient"]),
+				Action:         strings.ToLower(rf["action"]),
+				DiagnosticCode: rf["diagnostic-code"],
+			})
+		}
+	}
+
+	return reports, bounceMailbox, nil
+}
+
+// parseDeliveryStatus parses the per-message fields and each per-recipient
+// block of a message/delivery-status body (RFC 3464 section 2), which is a
+// sequence of header-like groups separated by blank lines.
+func parseDeliveryStatus(body []byte) (map[string]string, []map[string]string) {
+	groups := splitGroups(body)
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	perMessage := parseFields(groups[0])
+	var perRecipient []map[string]string
+	for _, g := range groups[1:] {
+		perRecipient = append(perRecipient, parseFields(g))
+	}
+	return perMessage, perRecipient
+}
+
+func splitGroups(body []byte) [][]byte {
+	normalized := strings.ReplaceAll(string(body), "\r\n", "\n")
+	var groups [][]byte
+	for _, g := range strings.Split(normalized, "\n\n") {
+		if strings.TrimSpace(g) != "" {
+			groups = append(groups, []byte(g))
+		}
+	}
+	return groups
+}
+
+func parseFields(group []byte) map[string]string {
+	fields := map[string]string{}
+	tp := textproto.NewReader(bufio.NewReader(bytes.NewReader(group)))
+	header, err := tp.ReadMIMEHeader()
+	if err != nil && len(header) == 0 {
+		return fields
+	}
+	for k, v := range header {
+		if len(v) > 0 {
+			fields[strings.ToLower(k)] = v[0]
+		}
+	}
+	return fields
+}
+
+// VERPAddress returns a variant of from whose local part encodes messageID,
+// so that a bounce for this specific message can be identified by its
+// envelope sender alone, without depending on the receiving side returning a
+// well-formed DSN. The returned address still routes to the same mailbox as
+// from, since only a sub-addressing tag is appended to the local part.
+func VERPAddress(from, messageID string) string {
+	at := strings.LastIndex(from, "@")
+	if at == -1 {
+		return from
+	}
+	return from[:at] + "+bounce-" + verpToken(messageID) + from[at:]
+}
+
+func verpToken(messageID string) string {
+	sum := sha256.Sum256([]byte(messageID))
+	return hex.EncodeToString(sum[:8])
+}
+
+// verpTokenFromAddress extracts the VERP token embedded by VERPAddress from
+// a "local+bounce-<token>@domain" address, if present.
+func verpTokenFromAddress(addr string) (string, bool) {
+	at := strings.LastIndex(addr, "@")
+	if at == -1 {
+		return "", false
+	}
+	i := strings.LastIndex(addr[:at], "+bounce-")
+	if i == -1 {
+		return "", false
+	}
+	return addr[i+len("+bounce-") : at], true
+}
+
+// stripAddressType strips a DSN address-type field's "type;" prefix, e.g.
+// "rfc822;user@example.com" becomes "user@example.com".
+func stripAddressType(addr string) string {
+	if i := strings.Index(addr, ";"); i != -1 {
+		return strings.TrimSpace(addr[i+1:])
+	}
+	return addr
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bounce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const dsnTemplate = "From: mailer-daemon@example.com\r\n" +
+	"To: %s\r\n" +
+	"Subject: Undelivered Mail Returned to Sender\r\n" +
+	"Content-Type: multipart/report; report-type=delivery-status; boundary=\"BOUNDARY\"\r\n" +
+	"\r\n" +
+	"--BOUNDARY\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"\r\n" +
+	"Delivery failed.\r\n" +
+	"--BOUNDARY\r\n" +
+	"Content-Type: message/delivery-status\r\n" +
+	"\r\n" +
+	"Reporting-MTA: dns; mx.example.com\r\n" +
+	"%s" +
+	"\r\n" +
+	"Final-Recipient: rfc822; user@example.org\r\n" +
+	"Action: failed\r\n" +
+	"Status: 5.1.1\r\n" +
+	"Diagnostic-Code: smtp; 550 5.1.1 no such user\r\n" +
+	"--BOUNDARY--\r\n"
+
+func TestTrackerIngestWithOriginalEnvelopeID(t *testing.T) {
+	tracker := NewTracker(prometheus.NewRegistry(), log.NewNopLogger())
+	tracker.Track("<abc@am>", "team-receiver")
+
+	dsn := fmt.Sprintf(dsnTemplate, "bounces@example.com", "Original-Envelope-Id: <abc@am>\r\n")
+	tracker.Ingest(strings.NewReader(dsn))
+
+	require.True(t, tracker.Bounced("<abc@am>"))
+
+	var m dto.Metric
+	require.NoError(t, tracker.bouncesTotal.WithLabelValues("team-receiver").Write(&m))
+	require.Equal(t, 1.0, m.GetCounter().GetValue())
+}
+
+func TestTrackerIngestResolvesByVERPToken(t *testing.T) {
+	tracker := NewTracker(prometheus.NewRegistry(), log.NewNopLogger())
+	tracker.Track("<xyz@am>", "team-receiver")
+
+	verpAddr := VERPAddress("bounces@example.com", "<xyz@am>")
+	dsn := fmt.Sprintf(dsnTemplate, verpAddr, "")
+	tracker.Ingest(strings.NewReader(dsn))
+
+	require.True(t, tracker.Bounced("<xyz@am>"))
+}
+
+func TestTrackerIngestUnknownMessageID(t *testing.T) {
+	tracker := NewTracker(prometheus.NewRegistry(), log.NewNopLogger())
+
+	dsn := fmt.Sprintf(dsnTemplate, "bounces@example.com", "Original-Envelope-Id: <unknown@am>\r\n")
+	tracker.Ingest(strings.NewReader(dsn))
+
+	require.False(t, tracker.Bounced("<unknown@am>"))
+}
+
+func TestTrackerGCEvictsOldMessages(t *testing.T) {
+	tracker := NewTracker(prometheus.NewRegistry(), log.NewNopLogger())
+	tracker.Track("<old@am>", "team-receiver")
+	tracker.messages["<old@am>"].trackedAt = time.Now().Add(-time.Hour)
+	tracker.Track("<new@am>", "team-receiver")
+
+	tracker.gc(time.Minute)
+
+	require.Len(t, tracker.messages, 1)
+	require.Len(t, tracker.tokens, 1)
+	if _, ok := tracker.messages["<new@am>"]; !ok {
+		t.Fatalf("expected recently tracked message to survive GC")
+	}
+
+	var m dto.Metric
+	require.NoError(t, tracker.evictionsTotal.Write(&m))
+	require.Equal(t, 1.0, m.GetCounter().GetValue())
+}
+
+func TestTrackerRunEvictsOnTick(t *testing.T) {
+	tracker := NewTracker(prometheus.NewRegistry(), log.NewNopLogger())
+	tracker.Track("<old@am>", "team-receiver")
+	tracker.messages["<old@am>"].trackedAt = time.Now().Add(-time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tracker.Run(ctx, 10*time.Millisecond, time.Minute)
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		tracker.mtx.Lock()
+		_, ok := tracker.messages["<old@am>"]
+		tracker.mtx.Unlock()
+		if !ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected Run to evict the aged-out message")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestVERPAddress(t *testing.T) {
+	addr := VERPAddress("bounces@example.com", "<abc@am>")
+	require.True(t, strings.HasPrefix(addr, "bounces+bounce-"))
+	require.True(t, strings.HasSuffix(addr, "@example.com"))
+
+	token, ok := verpTokenFromAddress(addr)
+	require.True(t, ok)
+	require.Equal(t, verpToken("<abc@am>"), token)
+}
@@ -37,6 +37,7 @@ import (
 
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/email/bounce"
 	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
@@ -47,10 +48,13 @@ type Email struct {
 	tmpl     *template.Template
 	logger   log.Logger
 	hostname string
+	tracker  *bounce.Tracker
+	receiver string
 }
 
-// New returns a new Email notifier.
-func New(c *config.EmailConfig, t *template.Template, l log.Logger) *Email {
+// New returns a new Email notifier. tracker may be nil, in which case
+// outgoing messages are not correlated against later bounce reports.
+func New(c *config.EmailConfig, t *template.Template, l log.Logger, tracker *bounce.Tracker, receiver string) *Email {
 	if _, ok := c.Headers["Subject"]; !ok {
 		c.Headers["Subject"] = config.DefaultEmailSubject
 	}
@@ -66,7 +70,7 @@ func New(c *config.EmailConfig, t *template.Template, l log.Logger) *Email {
 	if err != nil {
 		h = "localhost.localdomain"
 	}
-	return &Email{conf: c, tmpl: t, logger: l, hostname: h}
+	return &Email{conf: c, tmpl: t, logger: l, hostname: h, tracker: tracker, receiver: receiver}
 }
 
 // auth resolves a string of authentication mechanisms.
@@ -91,7 +95,11 @@ func (n *Email) auth(mechs string) (smtp.Auth, error) {
 			return smtp.CRAMMD5Auth(username, secret), nil
 
 		case "PLAIN":
-			password := string(n.conf.AuthPassword)
+			password, perr := notify.ResolveSecret(n.conf.AuthPassword, n.conf.AuthPasswordFile)
+			if perr != nil {
+				err.Add(perr)
+				continue
+			}
 			if password == "" {
 				err.Add(errors.New("missing password for PLAIN auth mechanism"))
 				continue
@@ -100,7 +108,11 @@ func (n *Email) auth(mechs string) (smtp.Auth, error) {
 
 			return smtp.PlainAuth(identity, username, password, n.conf.Smarthost.Host), nil
 		case "LOGIN":
-			password := string(n.conf.AuthPassword)
+			password, perr := notify.ResolveSecret(n.conf.AuthPassword, n.conf.AuthPasswordFile)
+			if perr != nil {
+				err.Add(perr)
+				continue
+			}
 			if password == "" {
 				err.Add(errors.New("missing password for LOGIN auth mechanism"))
 				continue
@@ -216,7 +228,20 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 	if len(addrs) != 1 {
 		return false, errors.Errorf("must be exactly one 'from' address (got: %d)", len(addrs))
 	}
-	if err = c.Mail(addrs[0].Address); err != nil {
+
+	messageID, ok := n.conf.Headers["Message-Id"]
+	if !ok {
+		messageID = fmt.Sprintf("<%d.%d@%s>", time.Now().UnixNano(), rand.Uint64(), n.hostname)
+	}
+	if n.tracker != nil {
+		n.tracker.Track(messageID, n.receiver)
+	}
+
+	envelopeFrom := addrs[0].Address
+	if n.conf.VERP {
+		envelopeFrom = bounce.VERPAddress(envelopeFrom, messageID)
+	}
+	if err = c.Mail(envelopeFrom); err != nil {
 		return true, errors.Wrap(err, "send MAIL command")
 	}
 	addrs, err = mail.ParseAddressList(to)
@@ -245,8 +270,8 @@ func (n *Email) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
 		fmt.Fprintf(buffer, "%s: %s\r\n", header, mime.QEncoding.Encode("utf-8", value))
 	}
 
-	if _, ok := n.conf.Headers["Message-Id"]; !ok {
-		fmt.Fprintf(buffer, "Message-Id: %s\r\n", fmt.Sprintf("<%d.%d@%s>", time.Now().UnixNano(), rand.Uint64(), n.hostname))
+	if !ok {
+		fmt.Fprintf(buffer, "Message-Id: %s\r\n", messageID)
 	}
 
 	multipartBuffer := &bytes.Buffer{}
@@ -188,7 +188,7 @@ func notifyEmailWithContext(ctx context.Context, cfg *config.EmailConfig, server
 		return nil, false, err
 	}
 	tmpl.ExternalURL, _ = url.Parse("http://am")
-	email := New(cfg, tmpl, log.NewNopLogger())
+	email := New(cfg, tmpl, log.NewNopLogger(), nil, "test")
 
 	retry, err := email.Notify(ctx, firingAlert)
 	if err != nil {
@@ -0,0 +1,67 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package escalation lets an operator acknowledge an aggregation group's
+// notification via the API (POST /api/v1/groups/:key/ack), so a
+// Dispatcher can tell whether to re-route the group's still-active alerts
+// to its route's configured escalation_receiver once escalation_timeout
+// elapses without an acknowledgement.
+package escalation
+
+import (
+	"sync"
+	"time"
+)
+
+// Tracker keeps track of the most recent acknowledgement time for each
+// aggregation group, keyed by its group key. The zero value is not
+// usable; use New.
+type Tracker struct {
+	mtx  sync.Mutex
+	acks map[string]time.Time
+}
+
+// New returns a Tracker with no acknowledgements recorded.
+func New() *Tracker {
+	return &Tracker{
+		acks: map[string]time.Time{},
+	}
+}
+
+// Ack records that the group identified by groupKey was acknowledged at
+// now.
+func (t *Tracker) Ack(groupKey string, now time.Time) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.acks[groupKey] = now
+}
+
+// AckedSince reports whether the group identified by groupKey was
+// acknowledged at or after since.
+func (t *Tracker) AckedSince(groupKey string, since time.Time) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	ackedAt, ok := t.acks[groupKey]
+	return ok && !ackedAt.Before(since)
+}
+
+// Clear forgets any acknowledgement recorded for the group identified by
+// groupKey.
+func (t *Tracker) Clear(groupKey string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.acks, groupKey)
+}
@@ -0,0 +1,87 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exec implements a Notifier that runs a local command (or plugin
+// binary) with the rendered alert group, as an escape hatch for exotic
+// integrations that don't warrant their own notifier package.
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/pkg/errors"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Notifier implements a Notifier that runs a local command, passing it the
+// rendered alert group as JSON on stdin.
+type Notifier struct {
+	conf   *config.ExecConfig
+	tmpl   *template.Template
+	logger log.Logger
+	sem    chan struct{}
+}
+
+// New returns a new Notifier that runs conf.Command.
+func New(conf *config.ExecConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	return &Notifier{
+		conf:   conf,
+		tmpl:   t,
+		logger: l,
+		sem:    make(chan struct{}, conf.MaxConcurrent),
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(data); err != nil {
+		return false, err
+	}
+
+	select {
+	case n.sem <- struct{}{}:
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+	defer func() { <-n.sem }()
+
+	if n.conf.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(n.conf.Timeout))
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, n.conf.Command, n.conf.Args...)
+	cmd.Stdin = &buf
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return true, errors.Wrapf(err, "exec %q: %s", n.conf.Command, out)
+	}
+	level.Debug(n.logger).Log("msg", "exec notifier command succeeded", "command", n.conf.Command, "output", string(out))
+
+	return false, nil
+}
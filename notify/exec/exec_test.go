@@ -0,0 +1,66 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestExecRunsCommand(t *testing.T) {
+	conf := &config.ExecConfig{
+		Command:       "true",
+		MaxConcurrent: 1,
+	}
+	notifier, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "high_cpu"},
+			StartsAt: time.Now(),
+		},
+	}
+	retry, err := notifier.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+}
+
+func TestExecCommandFailureIsRetryable(t *testing.T) {
+	conf := &config.ExecConfig{
+		Command:       "false",
+		MaxConcurrent: 1,
+	}
+	notifier, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "high_cpu"},
+			StartsAt: time.Now(),
+		},
+	}
+	retry, err := notifier.Notify(context.Background(), alert)
+	require.Error(t, err)
+	require.True(t, retry)
+}
@@ -0,0 +1,103 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package forward re-posts alerts matched by a route to another
+// alertmanager-compatible endpoint, so that a subtree of the routing tree can
+// be delegated to a team-owned instance. It forwards the raw matched alerts,
+// not rendered notifications, using the existing v2 "POST /api/v2/alerts"
+// endpoint as its wire format.
+package forward
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Forwarder posts matched alerts to peer Alertmanager URLs.
+type Forwarder struct {
+	client *http.Client
+	logger log.Logger
+}
+
+// New returns a Forwarder.
+func New(l log.Logger) *Forwarder {
+	return &Forwarder{
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: l,
+	}
+}
+
+// Send posts alerts to url. It is a best-effort operation: errors are logged
+// but never propagated, so a misbehaving downstream instance never blocks
+// dispatching to the rest of the routing tree.
+func (f *Forwarder) Send(ctx context.Context, url string, alerts ...*types.Alert) {
+	if len(alerts) == 0 {
+		return
+	}
+
+	postable := make(models.PostableAlerts, 0, len(alerts))
+	for _, a := range alerts {
+		postable = append(postable, &models.PostableAlert{
+			Alert: models.Alert{
+				Labels:       labelSet(a.Labels),
+				GeneratorURL: strfmt.URI(a.GeneratorURL),
+			},
+			Annotations: labelSet(a.Annotations),
+			StartsAt:    strfmt.DateTime(a.StartsAt),
+			EndsAt:      strfmt.DateTime(a.EndsAt),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(postable); err != nil {
+		level.Error(f.logger).Log("msg", "failed to encode forwarded alerts", "url", url, "err", err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v2/alerts", url), &buf)
+	if err != nil {
+		level.Error(f.logger).Log("msg", "failed to build forward request", "url", url, "err", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req.WithContext(ctx))
+	if err != nil {
+		level.Warn(f.logger).Log("msg", "failed to forward alerts", "url", url, "err", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		level.Warn(f.logger).Log("msg", "forward endpoint returned unexpected status", "url", url, "status", resp.StatusCode)
+	}
+}
+
+func labelSet(ls model.LabelSet) models.LabelSet {
+	out := make(models.LabelSet, len(ls))
+	for k, v := range ls {
+		out[string(k)] = string(v)
+	}
+	return out
+}
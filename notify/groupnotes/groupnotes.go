@@ -0,0 +1,61 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package groupnotes lets an operator attach a free-form note to an active
+// aggregation group via the API (e.g. "driver rollback in progress"), so
+// that context follows the incident into every subsequent notification
+// sent for the group, without having to reload the configuration.
+package groupnotes
+
+import "sync"
+
+// Tracker keeps track of the notes currently attached to aggregation
+// groups, keyed by their group key. The zero value is not usable; use New.
+type Tracker struct {
+	mtx   sync.Mutex
+	notes map[string]string
+}
+
+// New returns a Tracker with no notes set.
+func New() *Tracker {
+	return &Tracker{
+		notes: map[string]string{},
+	}
+}
+
+// Set attaches notes to the group identified by groupKey, replacing any
+// previous notes for it.
+func (t *Tracker) Set(groupKey, notes string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.notes[groupKey] = notes
+}
+
+// Clear removes any notes attached to the group identified by groupKey.
+func (t *Tracker) Clear(groupKey string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.notes, groupKey)
+}
+
+// Get returns the notes attached to the group identified by groupKey, if
+// any.
+func (t *Tracker) Get(groupKey string) (string, bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	notes, ok := t.notes[groupKey]
+	return notes, ok
+}
@@ -0,0 +1,47 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupnotes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAndClear(t *testing.T) {
+	tr := New()
+
+	_, ok := tr.Get("{}:{alertname=\"Foo\"}")
+	require.False(t, ok)
+
+	tr.Set("{}:{alertname=\"Foo\"}", "driver rollback in progress")
+	notes, ok := tr.Get("{}:{alertname=\"Foo\"}")
+	require.True(t, ok)
+	require.Equal(t, "driver rollback in progress", notes)
+
+	tr.Clear("{}:{alertname=\"Foo\"}")
+	_, ok = tr.Get("{}:{alertname=\"Foo\"}")
+	require.False(t, ok)
+}
+
+func TestSetLeavesOtherGroupsAlone(t *testing.T) {
+	tr := New()
+	tr.Set("group-x", "note for x")
+
+	_, okY := tr.Get("group-y")
+	notesX, okX := tr.Get("group-x")
+	require.True(t, okX)
+	require.Equal(t, "note for x", notesX)
+	require.False(t, okY)
+}
@@ -21,7 +21,6 @@ import (
 	"net/http"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/notify"
@@ -40,7 +39,7 @@ type Notifier struct {
 
 // New returns a new Hipchat notification handler.
 func New(c *config.HipchatConfig, t *template.Template, l log.Logger) (*Notifier, error) {
-	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "hipchat", false)
+	client, err := config.NewClient(c.HTTPConfig, "hipchat")
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +19,6 @@ import (
 	"testing"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
@@ -29,7 +28,7 @@ import (
 func TestHipchatRetry(t *testing.T) {
 	notifier, err := New(
 		&config.HipchatConfig{
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -51,7 +50,7 @@ func TestHipchatRedactedURL(t *testing.T) {
 		&config.HipchatConfig{
 			APIURL:     &config.URL{URL: u},
 			AuthToken:  config.Secret(token),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
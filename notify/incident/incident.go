@@ -0,0 +1,98 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package incident assigns a stable reference to an aggregation group that
+// survives a resolve/refire cycle, so that notification pipelines and,
+// eventually, individual notifiers can treat a prompt refire as a
+// continuation of the same incident (the same dedup key is already
+// guaranteed by the deterministic, label-derived group key) rather than
+// opening a brand new one.
+package incident
+
+import (
+	"sync"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// state is the most recently observed status of a single aggregation
+// group.
+type state struct {
+	ref        string
+	resolvedAt time.Time // zero while the group is still firing.
+}
+
+// Tracker hands out a stable reference per aggregation group and keeps
+// reusing it across a resolve/refire cycle as long as the group refires
+// within the configured window of having fully resolved. Once a group has
+// stayed resolved for longer than that, the next refire starts a new
+// incident with a fresh reference. The zero value is not usable; use New.
+type Tracker struct {
+	mtx    sync.Mutex
+	window time.Duration
+	states map[string]state
+}
+
+// New returns a Tracker that keeps a resolved incident's reference alive
+// for window before the next refire of the same group is treated as a new
+// incident. A window of zero disables continuation: every refire starts a
+// new incident.
+func New(window time.Duration) *Tracker {
+	return &Tracker{
+		window: window,
+		states: map[string]state{},
+	}
+}
+
+// SetWindow updates the continuation window used by future calls to
+// Observe.
+func (t *Tracker) SetWindow(window time.Duration) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.window = window
+}
+
+// Observe records the firing status of the aggregation group identified by
+// groupKey as of now, and returns the incident reference it belongs to
+// along with whether that reference was newly created by this call.
+func (t *Tracker) Observe(groupKey string, firing bool, now time.Time) (ref string, isNew bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	st, ok := t.states[groupKey]
+	continuation := ok && (st.resolvedAt.IsZero() || (t.window > 0 && now.Sub(st.resolvedAt) <= t.window))
+
+	if !continuation {
+		st = state{ref: uuid.NewV4().String()}
+		isNew = true
+	}
+
+	if firing {
+		st.resolvedAt = time.Time{}
+	} else if st.resolvedAt.IsZero() {
+		st.resolvedAt = now
+	}
+
+	t.states[groupKey] = st
+	return st.ref, isNew
+}
+
+// Forget discards any tracked state for groupKey. Callers that know a group
+// no longer exists (e.g. its route was removed) can use it to keep the
+// tracker from retaining state forever.
+func (t *Tracker) Forget(groupKey string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	delete(t.states, groupKey)
+}
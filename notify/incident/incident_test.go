@@ -0,0 +1,80 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package incident
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveNewIncident(t *testing.T) {
+	tr := New(5 * time.Minute)
+	now := time.Now()
+
+	ref, isNew := tr.Observe("group1", true, now)
+	require.True(t, isNew)
+	require.NotEmpty(t, ref)
+}
+
+func TestObserveContinuesWithinWindow(t *testing.T) {
+	tr := New(5 * time.Minute)
+	now := time.Now()
+
+	ref1, _ := tr.Observe("group1", true, now)
+	ref2, isNew := tr.Observe("group1", false, now.Add(time.Minute))
+	require.False(t, isNew)
+	require.Equal(t, ref1, ref2)
+
+	ref3, isNew := tr.Observe("group1", true, now.Add(2*time.Minute))
+	require.False(t, isNew)
+	require.Equal(t, ref1, ref3)
+}
+
+func TestObserveStartsFreshAfterWindow(t *testing.T) {
+	tr := New(5 * time.Minute)
+	now := time.Now()
+
+	ref1, _ := tr.Observe("group1", true, now)
+	_, _ = tr.Observe("group1", false, now.Add(time.Minute))
+
+	ref2, isNew := tr.Observe("group1", true, now.Add(10*time.Minute))
+	require.True(t, isNew)
+	require.NotEqual(t, ref1, ref2)
+}
+
+func TestObserveZeroWindowNeverContinues(t *testing.T) {
+	tr := New(0)
+	now := time.Now()
+
+	ref1, _ := tr.Observe("group1", true, now)
+	_, _ = tr.Observe("group1", false, now)
+
+	ref2, isNew := tr.Observe("group1", true, now)
+	require.True(t, isNew)
+	require.NotEqual(t, ref1, ref2)
+}
+
+func TestForget(t *testing.T) {
+	tr := New(5 * time.Minute)
+	now := time.Now()
+
+	ref1, _ := tr.Observe("group1", true, now)
+	tr.Forget("group1")
+
+	ref2, isNew := tr.Observe("group1", true, now)
+	require.True(t, isNew)
+	require.NotEqual(t, ref1, ref2)
+}
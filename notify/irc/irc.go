@@ -0,0 +1,224 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package irc
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// registrationTimeout bounds how long the notifier waits for the server to
+// finish the connection registration handshake (and, if configured, SASL
+// authentication) before giving up.
+const registrationTimeout = 15 * time.Second
+
+// maxLineLength is the maximum number of bytes the notifier puts on a single
+// PRIVMSG line, leaving headroom under the RFC 2812 512 byte message limit
+// for the "PRIVMSG <channel> :" prefix and line terminator.
+const maxLineLength = 400
+
+// Notifier implements a Notifier that posts alerts to an IRC channel. Each
+// notification opens a fresh connection, registers, optionally
+// authenticates via SASL PLAIN, joins Channel, sends the rendered message
+// (split across multiple PRIVMSGs if needed, throttled by FloodInterval) and
+// disconnects, mirroring the stateless, connect-per-call shape of
+// Alertmanager's HTTP-based notifiers.
+type Notifier struct {
+	conf   *config.IRCConfig
+	tmpl   *template.Template
+	logger log.Logger
+
+	mtx      sync.Mutex
+	lastSent time.Time
+}
+
+// New returns a new IRC notifier.
+func New(c *config.IRCConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	return &Notifier{
+		conf:   c,
+		tmpl:   t,
+		logger: l,
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+	data := notify.GetTemplateData(ctx, n.tmpl, alerts, n.logger)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	message := tmpl(n.conf.Message)
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := n.dial(ctx)
+	if err != nil {
+		return true, err
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	if err := n.register(conn, r); err != nil {
+		return true, err
+	}
+
+	for _, line := range splitLines(message, maxLineLength) {
+		n.waitForFloodInterval(ctx)
+		if _, err := fmt.Fprintf(conn, "PRIVMSG %s :%s\r\n", n.conf.Channel, line); err != nil {
+			return true, err
+		}
+	}
+
+	fmt.Fprintf(conn, "QUIT :notification sent\r\n")
+	return false, nil
+}
+
+func (n *Notifier) dial(ctx context.Context) (net.Conn, error) {
+	addr := fmt.Sprintf("%s:%d", n.conf.Server, n.conf.Port)
+	d := &net.Dialer{}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial IRC server: %w", err)
+	}
+	if !n.conf.TLS {
+		return conn, nil
+	}
+
+	tlsConfig, err := commoncfg.NewTLSConfig(&n.conf.TLSConfig)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("build IRC TLS config: %w", err)
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = n.conf.Server
+	}
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("IRC TLS handshake: %w", err)
+	}
+	return tlsConn, nil
+}
+
+// register performs the connection registration handshake, including SASL
+// PLAIN authentication if SASLUser is set, and joins Channel. It gives up
+// after registrationTimeout.
+func (n *Notifier) register(conn net.Conn, r *bufio.Reader) error {
+	conn.SetDeadline(time.Now().Add(registrationTimeout))
+	defer conn.SetDeadline(time.Time{})
+
+	useSASL := n.conf.SASLUser != ""
+	if useSASL {
+		fmt.Fprintf(conn, "CAP REQ :sasl\r\n")
+	}
+	fmt.Fprintf(conn, "NICK %s\r\n", n.conf.Nick)
+	fmt.Fprintf(conn, "USER %s 0 * :%s\r\n", n.conf.Nick, n.conf.Nick)
+
+	saslDone := !useSASL
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("read from IRC server: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		level.Debug(n.logger).Log("msg", "received IRC line", "line", line)
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case fields[0] == "PING":
+			fmt.Fprintf(conn, "PONG %s\r\n", strings.TrimPrefix(strings.Join(fields[1:], " "), ":"))
+		case len(fields) > 1 && fields[1] == "CAP" && strings.Contains(line, "ACK") && strings.Contains(line, "sasl"):
+			fmt.Fprintf(conn, "AUTHENTICATE PLAIN\r\n")
+		case fields[0] == "AUTHENTICATE" && len(fields) > 1 && fields[1] == "+":
+			payload := fmt.Sprintf("%s\x00%s\x00%s", n.conf.SASLUser, n.conf.SASLUser, string(n.conf.SASLPassword))
+			fmt.Fprintf(conn, "AUTHENTICATE %s\r\n", base64.StdEncoding.EncodeToString([]byte(payload)))
+		case len(fields) > 1 && fields[1] == "903": // RPL_SASLSUCCESS
+			saslDone = true
+			fmt.Fprintf(conn, "CAP END\r\n")
+		case len(fields) > 1 && fields[1] == "904": // ERR_SASLFAIL
+			return fmt.Errorf("SASL authentication failed: %s", line)
+		case len(fields) > 1 && fields[1] == "001": // RPL_WELCOME
+			if !saslDone {
+				continue
+			}
+			fmt.Fprintf(conn, "JOIN %s\r\n", n.conf.Channel)
+			return nil
+		}
+	}
+}
+
+// waitForFloodInterval blocks, if necessary, until FloodInterval has passed
+// since the last line was sent by any call to Notify, so that a burst of
+// notifications does not trip the network's flood protection.
+func (n *Notifier) waitForFloodInterval(ctx context.Context) {
+	n.mtx.Lock()
+	wait := time.Duration(n.conf.FloodInterval) - time.Since(n.lastSent)
+	if wait < 0 {
+		wait = 0
+	}
+	n.lastSent = time.Now().Add(wait)
+	n.mtx.Unlock()
+
+	if wait == 0 {
+		return
+	}
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
+
+// splitLines breaks s into lines of at most n bytes, preferring to split on
+// whitespace so words are not broken across PRIVMSGs.
+func splitLines(s string, n int) []string {
+	var lines []string
+	for _, raw := range strings.Split(s, "\n") {
+		raw = strings.TrimSpace(raw)
+		for len(raw) > n {
+			cut := strings.LastIndex(raw[:n], " ")
+			if cut <= 0 {
+				cut = n
+			}
+			lines = append(lines, raw[:cut])
+			raw = strings.TrimSpace(raw[cut:])
+		}
+		if raw != "" {
+			lines = append(lines, raw)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,181 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package irc
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// fakeServer is a minimal IRC server that accepts a single connection,
+// completes registration (and SASL, if requested), and records the lines
+// the client sends afterwards.
+type fakeServer struct {
+	ln       net.Listener
+	sasl     bool
+	received chan string
+}
+
+func newFakeServer(t *testing.T, sasl bool) *fakeServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeServer{ln: ln, sasl: sasl, received: make(chan string, 16)}
+	go s.run()
+	return s
+}
+
+func (s *fakeServer) addr() (string, int) {
+	tcpAddr := s.ln.Addr().(*net.TCPAddr)
+	return tcpAddr.IP.String(), tcpAddr.Port
+}
+
+func (s *fakeServer) run() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "CAP":
+			if len(fields) > 1 && fields[1] == "REQ" {
+				conn.Write([]byte(":server CAP * ACK :sasl\r\n"))
+			}
+		case "AUTHENTICATE":
+			if fields[1] == "PLAIN" {
+				conn.Write([]byte("AUTHENTICATE +\r\n"))
+			} else {
+				conn.Write([]byte(":server 903 nick :SASL authentication successful\r\n"))
+			}
+		case "NICK":
+			if !s.sasl {
+				conn.Write([]byte(":server 001 nick :Welcome\r\n"))
+			}
+		case "JOIN":
+			s.received <- line
+		case "PRIVMSG":
+			s.received <- line
+		case "QUIT":
+			return
+		}
+		if fields[0] == "CAP" && len(fields) > 1 && fields[1] == "END" {
+			conn.Write([]byte(":server 001 nick :Welcome\r\n"))
+		}
+	}
+}
+
+func (s *fakeServer) close() { s.ln.Close() }
+
+func testAlert() *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "test"},
+			StartsAt: time.Now(),
+		},
+	}
+}
+
+func TestIRCNotifySendsMessage(t *testing.T) {
+	srv := newFakeServer(t, false)
+	defer srv.close()
+	host, port := srv.addr()
+
+	conf := &config.IRCConfig{
+		Server:        host,
+		Port:          port,
+		Nick:          "amnotify",
+		Channel:       "#alerts",
+		Message:       "something happened",
+		FloodInterval: model.Duration(0),
+	}
+	n, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := n.Notify(ctx, testAlert())
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "JOIN #alerts", <-srv.received)
+	require.Equal(t, "PRIVMSG #alerts :something happened", <-srv.received)
+}
+
+func TestIRCNotifySplitsLongMessages(t *testing.T) {
+	srv := newFakeServer(t, false)
+	defer srv.close()
+	host, port := srv.addr()
+
+	long := strings.Repeat("word ", 150)
+	conf := &config.IRCConfig{
+		Server:        host,
+		Port:          port,
+		Nick:          "amnotify",
+		Channel:       "#alerts",
+		Message:       long,
+		FloodInterval: model.Duration(0),
+	}
+	n, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	_, err = n.Notify(ctx, testAlert())
+	require.NoError(t, err)
+
+	require.Equal(t, "JOIN #alerts", <-srv.received)
+	var lines []string
+	for i := 0; i < 2; i++ {
+		lines = append(lines, <-srv.received)
+	}
+	for _, l := range lines {
+		require.True(t, len(l) <= len("PRIVMSG #alerts :")+maxLineLength)
+	}
+}
+
+func TestSplitLines(t *testing.T) {
+	lines := splitLines("one two three four five", 10)
+	for _, l := range lines {
+		require.True(t, len(l) <= 10)
+	}
+	require.Equal(t, "one two three four five", strings.Join(lines, " "))
+}
+
+func TestSplitLinesEmpty(t *testing.T) {
+	require.Nil(t, splitLines("", 10))
+	require.Nil(t, splitLines("   \n  ", 10))
+}
@@ -0,0 +1,114 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kakaotalk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// templateObject is the "memo to me" text template object expected by the
+// Kakao Talk message API.
+type templateObject struct {
+	ObjectType string `json:"object_type"`
+	Text       string `json:"text"`
+	Link       struct {
+		WebURL string `json:"web_url,omitempty"`
+	} `json:"link"`
+}
+
+// Notifier implements a Notifier for KakaoTalk notifications.
+type Notifier struct {
+	conf    *config.KakaoTalkConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+	apiURL  string // for tests.
+}
+
+// New returns a new KakaoTalk notifier.
+func New(c *config.KakaoTalkConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	client, err := config.NewClient(c.HTTPConfig, "kakaotalk")
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{},
+		apiURL:  "https://kapi.kakao.com/v2/api/talk/memo/default/send",
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, ok := notify.GroupKey(ctx)
+	if !ok {
+		return false, fmt.Errorf("group key missing")
+	}
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+
+	level.Debug(n.logger).Log("incident", key)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	message, truncated := notify.Truncate(tmpl(n.conf.Message), 200)
+	if truncated {
+		level.Debug(n.logger).Log("msg", "truncated message", "truncated_message", message, "incident", key)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	obj := templateObject{ObjectType: "text", Text: message}
+	obj.Link.WebURL = data.ExternalURL
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return false, err
+	}
+
+	parameters := url.Values{}
+	parameters.Set("template_object", string(body))
+
+	req, err := http.NewRequest("POST", n.apiURL, strings.NewReader(parameters.Encode()))
+	if err != nil {
+		return true, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", string(n.conf.AccessToken)))
+
+	resp, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
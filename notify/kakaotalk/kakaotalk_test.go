@@ -0,0 +1,103 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kakaotalk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestKakaoTalkRetry(t *testing.T) {
+	notifier, err := New(
+		&config.KakaoTalkConfig{
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	for statusCode, expected := range test.RetryTests(test.DefaultRetryCodes()) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("error on status %d", statusCode))
+	}
+}
+
+func TestKakaoTalkRedactedURL(t *testing.T) {
+	ctx, u, fn := test.GetContextWithCancelingURL()
+	defer fn()
+
+	token := "token"
+	notifier, err := New(
+		&config.KakaoTalkConfig{
+			AccessToken: config.Secret(token),
+			HTTPConfig:  &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	notifier.apiURL = u.String()
+
+	test.AssertNotifyLeaksNoSecret(t, ctx, notifier, token)
+}
+
+func TestKakaoTalkSendsExpectedRequest(t *testing.T) {
+	var gotReq *http.Request
+	var gotObj templateObject
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		require.NoError(t, r.ParseForm())
+		require.NoError(t, json.Unmarshal([]byte(r.PostForm.Get("template_object")), &gotObj))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	conf := &config.KakaoTalkConfig{
+		AccessToken: "secret-token",
+		Message:     `{{ .CommonLabels.summary }}`,
+		HTTPConfig:  &config.HTTPClientConfig{},
+	}
+	notifier, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+	notifier.apiURL = srv.URL
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"summary": "disk is full"},
+			StartsAt: time.Now(),
+		},
+	}
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "Bearer secret-token", gotReq.Header.Get("Authorization"))
+	require.Equal(t, "text", gotObj.ObjectType)
+	require.Equal(t, "disk is full", gotObj.Text)
+}
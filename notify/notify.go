@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,11 +28,32 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 
+	"github.com/prometheus/alertmanager/breaker"
 	"github.com/prometheus/alertmanager/cluster"
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/decisionlog"
+	"github.com/prometheus/alertmanager/history"
 	"github.com/prometheus/alertmanager/inhibit"
 	"github.com/prometheus/alertmanager/nflog"
 	"github.com/prometheus/alertmanager/nflog/nflogpb"
+	"github.com/prometheus/alertmanager/notify/archive"
+	"github.com/prometheus/alertmanager/notify/correlate"
+	"github.com/prometheus/alertmanager/notify/incident"
+	"github.com/prometheus/alertmanager/notify/priority"
+	"github.com/prometheus/alertmanager/notify/receipt"
+	"github.com/prometheus/alertmanager/notify/receivermute"
+	"github.com/prometheus/alertmanager/notify/shadow"
+	"github.com/prometheus/alertmanager/notify/sqlreport"
+	"github.com/prometheus/alertmanager/notify/standby"
+	"github.com/prometheus/alertmanager/notify/timeline"
+	"github.com/prometheus/alertmanager/pkg/timeinterval"
+	"github.com/prometheus/alertmanager/pkg/tracing"
+	"github.com/prometheus/alertmanager/quota"
+	"github.com/prometheus/alertmanager/ratelimit"
+	"github.com/prometheus/alertmanager/report"
 	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/slo"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -96,12 +118,26 @@ type notifyKey int
 
 const (
 	keyReceiverName notifyKey = iota
+	keyReceiverFallback
 	keyRepeatInterval
 	keyGroupLabels
 	keyGroupKey
 	keyFiringAlerts
 	keyResolvedAlerts
 	keyNow
+	keyIncidentRef
+	keyIncidentContinuation
+	keyRelatedGroups
+	keyGroupDiff
+	keyExternalURL
+	keyTimezone
+	keyRepeatOnlyOnChange
+	keyMuteTimeIntervalNames
+	keyActiveTimeIntervalNames
+	keyGroupNotes
+	keyNotificationTimeout
+	keyAlertRenderOpts
+	keyDedupKey
 )
 
 // WithReceiverName populates a context with a receiver name.
@@ -109,11 +145,29 @@ func WithReceiverName(ctx context.Context, rcv string) context.Context {
 	return context.WithValue(ctx, keyReceiverName, rcv)
 }
 
+// WithReceiverFallback populates a context with the name of the receiver
+// to route to instead, if the receiver named via WithReceiverName turns
+// out not to be configured. This supports routes whose receiver is
+// templated from alert labels (see config.Route's receiver field), where
+// the rendered name can't be checked against the configured receiver list
+// until notification time.
+func WithReceiverFallback(ctx context.Context, rcv string) context.Context {
+	return context.WithValue(ctx, keyReceiverFallback, rcv)
+}
+
 // WithGroupKey populates a context with a group key.
 func WithGroupKey(ctx context.Context, s string) context.Context {
 	return context.WithValue(ctx, keyGroupKey, s)
 }
 
+// WithDedupKey populates a context with a deduplication key rendered from a
+// route's dedup_key_template, for integrations that correlate notifications
+// by a key derived from group labels instead of Alertmanager's default
+// opaque group key. Unset if the route has no dedup_key_template.
+func WithDedupKey(ctx context.Context, s string) context.Context {
+	return context.WithValue(ctx, keyDedupKey, s)
+}
+
 // WithFiringAlerts populates a context with a slice of firing alerts.
 func WithFiringAlerts(ctx context.Context, alerts []uint64) context.Context {
 	return context.WithValue(ctx, keyFiringAlerts, alerts)
@@ -146,6 +200,20 @@ func RepeatInterval(ctx context.Context) (time.Duration, bool) {
 	return v, ok
 }
 
+// WithRepeatOnlyOnChange populates a context with whether repeat
+// notifications should be suppressed for an unchanged group.
+func WithRepeatOnlyOnChange(ctx context.Context, b bool) context.Context {
+	return context.WithValue(ctx, keyRepeatOnlyOnChange, b)
+}
+
+// RepeatOnlyOnChange extracts from the context whether repeat notifications
+// should be suppressed for an unchanged group. Iff none exists, both return
+// values are false.
+func RepeatOnlyOnChange(ctx context.Context) (bool, bool) {
+	v, ok := ctx.Value(keyRepeatOnlyOnChange).(bool)
+	return v, ok
+}
+
 // ReceiverName extracts a receiver name from the context. Iff none exists, the
 // second argument is false.
 func ReceiverName(ctx context.Context) (string, bool) {
@@ -153,6 +221,13 @@ func ReceiverName(ctx context.Context) (string, bool) {
 	return v, ok
 }
 
+// ReceiverFallback extracts a receiver fallback name from the context.
+// Iff none exists, the second argument is false.
+func ReceiverFallback(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(keyReceiverFallback).(string)
+	return v, ok
+}
+
 // GroupKey extracts a group key from the context. Iff none exists, the
 // second argument is false.
 func GroupKey(ctx context.Context) (string, bool) {
@@ -160,6 +235,15 @@ func GroupKey(ctx context.Context) (string, bool) {
 	return v, ok
 }
 
+// DedupKey extracts a templated deduplication key from the context. Iff
+// none exists, the second argument is false, meaning the route has no
+// dedup_key_template and the default opaque group key should be used
+// instead.
+func DedupKey(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(keyDedupKey).(string)
+	return v, ok
+}
+
 // GroupLabels extracts grouping label set from the context. Iff none exists, the
 // second argument is false.
 func GroupLabels(ctx context.Context) (model.LabelSet, bool) {
@@ -188,6 +272,169 @@ func ResolvedAlerts(ctx context.Context) ([]uint64, bool) {
 	return v, ok
 }
 
+// WithIncidentRef populates a context with an incident reference.
+func WithIncidentRef(ctx context.Context, ref string) context.Context {
+	return context.WithValue(ctx, keyIncidentRef, ref)
+}
+
+// IncidentRef extracts the incident reference from the context. Iff none
+// exists, the second argument is false.
+func IncidentRef(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(keyIncidentRef).(string)
+	return v, ok
+}
+
+// WithIncidentContinuation populates a context with whether the incident
+// reference it carries continues a previous incident rather than starting
+// a new one.
+func WithIncidentContinuation(ctx context.Context, continues bool) context.Context {
+	return context.WithValue(ctx, keyIncidentContinuation, continues)
+}
+
+// IsIncidentContinuation reports whether the incident reference in the
+// context continues a previous incident rather than starting a new one.
+func IsIncidentContinuation(ctx context.Context) bool {
+	v, _ := ctx.Value(keyIncidentContinuation).(bool)
+	return v
+}
+
+// WithRelatedGroups populates a context with the number of other
+// aggregation groups considered related to the current one.
+func WithRelatedGroups(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, keyRelatedGroups, n)
+}
+
+// RelatedGroups extracts the number of related aggregation groups from the
+// context. Iff none exists, the second argument is false.
+func RelatedGroups(ctx context.Context) (int, bool) {
+	v, ok := ctx.Value(keyRelatedGroups).(int)
+	return v, ok
+}
+
+// GroupDiff summarizes what changed about a group's alerts since the
+// receiver's last notification about it: which alerts are firing for the
+// first time, and which were already firing but have since been updated.
+// For a group's very first notification there is nothing to diff against
+// yet, so every firing alert is listed as new and none as changed.
+type GroupDiff struct {
+	NewFingerprints     []string
+	ChangedFingerprints []string
+}
+
+// WithGroupDiff populates a context with a GroupDiff.
+func WithGroupDiff(ctx context.Context, d GroupDiff) context.Context {
+	return context.WithValue(ctx, keyGroupDiff, d)
+}
+
+// Diff extracts the GroupDiff from the context. Iff none exists, the
+// second argument is false.
+func Diff(ctx context.Context) (GroupDiff, bool) {
+	v, ok := ctx.Value(keyGroupDiff).(GroupDiff)
+	return v, ok
+}
+
+// WithExternalURL populates a context with a route-specific external URL
+// override.
+func WithExternalURL(ctx context.Context, u string) context.Context {
+	return context.WithValue(ctx, keyExternalURL, u)
+}
+
+// ExternalURL extracts the route-specific external URL override from the
+// context. Iff none exists, the second argument is false.
+func ExternalURL(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(keyExternalURL).(string)
+	return v, ok
+}
+
+// WithNotificationTimeout populates a context with a route-specific
+// notification timeout override, bounding how long a single notifier call
+// may block before being cancelled. See RouteOpts.NotificationTimeout.
+func WithNotificationTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, keyNotificationTimeout, d)
+}
+
+// NotificationTimeout extracts the route-specific notification timeout
+// override from the context. Iff none exists, the second argument is
+// false.
+func NotificationTimeout(ctx context.Context) (time.Duration, bool) {
+	v, ok := ctx.Value(keyNotificationTimeout).(time.Duration)
+	return v, ok
+}
+
+// WithTimezone populates a context with the route's configured timezone.
+func WithTimezone(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, keyTimezone, loc)
+}
+
+// Timezone extracts the route's configured timezone from the context. Iff
+// none exists, the second argument is false.
+func Timezone(ctx context.Context) (*time.Location, bool) {
+	v, ok := ctx.Value(keyTimezone).(*time.Location)
+	return v, ok
+}
+
+// WithMuteTimeIntervalNames populates a context with the names of the
+// route's configured mute_time_intervals.
+func WithMuteTimeIntervalNames(ctx context.Context, names []string) context.Context {
+	return context.WithValue(ctx, keyMuteTimeIntervalNames, names)
+}
+
+// MuteTimeIntervalNames extracts the route's configured
+// mute_time_intervals names from the context. Iff none exists, the second
+// argument is false.
+func MuteTimeIntervalNames(ctx context.Context) ([]string, bool) {
+	v, ok := ctx.Value(keyMuteTimeIntervalNames).([]string)
+	return v, ok
+}
+
+// WithActiveTimeIntervalNames populates a context with the names of the
+// route's configured active_time_intervals.
+func WithActiveTimeIntervalNames(ctx context.Context, names []string) context.Context {
+	return context.WithValue(ctx, keyActiveTimeIntervalNames, names)
+}
+
+// ActiveTimeIntervalNames extracts the route's configured
+// active_time_intervals names from the context. Iff none exists, the
+// second argument is false.
+func ActiveTimeIntervalNames(ctx context.Context) ([]string, bool) {
+	v, ok := ctx.Value(keyActiveTimeIntervalNames).([]string)
+	return v, ok
+}
+
+// WithGroupNotes populates a context with the free-form notes currently
+// attached to the group via the API.
+func WithGroupNotes(ctx context.Context, notes string) context.Context {
+	return context.WithValue(ctx, keyGroupNotes, notes)
+}
+
+// GroupNotes extracts the free-form notes attached to the group from the
+// context. Iff none exists, the second argument is false.
+func GroupNotes(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(keyGroupNotes).(string)
+	return v, ok
+}
+
+// AlertRenderOpts controls how a group's alerts are ordered and truncated
+// when rendered into a notification body. See RouteOpts.AlertSortLabel,
+// AlertSortOrder, and MaxAlertsRendered.
+type AlertRenderOpts struct {
+	SortLabel model.LabelName
+	SortOrder []string
+	MaxAlerts int
+}
+
+// WithAlertRenderOpts populates a context with AlertRenderOpts.
+func WithAlertRenderOpts(ctx context.Context, o AlertRenderOpts) context.Context {
+	return context.WithValue(ctx, keyAlertRenderOpts, o)
+}
+
+// AlertRender extracts AlertRenderOpts from the context. Iff none exists,
+// the second argument is false.
+func AlertRender(ctx context.Context) (AlertRenderOpts, bool) {
+	v, ok := ctx.Value(keyAlertRenderOpts).(AlertRenderOpts)
+	return v, ok
+}
+
 // A Stage processes alerts under the constraints of the given context.
 type Stage interface {
 	Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error)
@@ -207,9 +454,11 @@ type NotificationLog interface {
 }
 
 type metrics struct {
-	numNotifications           *prometheus.CounterVec
-	numFailedNotifications     *prometheus.CounterVec
-	notificationLatencySeconds *prometheus.HistogramVec
+	numNotifications             *prometheus.CounterVec
+	numFailedNotifications       *prometheus.CounterVec
+	notificationLatencySeconds   *prometheus.HistogramVec
+	notificationQueueLength      *prometheus.GaugeVec
+	notificationQueueWaitSeconds *prometheus.HistogramVec
 }
 
 func newMetrics(r prometheus.Registerer) *metrics {
@@ -230,6 +479,17 @@ func newMetrics(r prometheus.Registerer) *metrics {
 			Help:      "The latency of notifications in seconds.",
 			Buckets:   []float64{1, 5, 10, 15, 20},
 		}, []string{"integration"}),
+		notificationQueueLength: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "alertmanager",
+			Name:      "notification_queue_length",
+			Help:      "The number of notifications queued or in flight for a receiver with a concurrency limit.",
+		}, []string{"receiver"}),
+		notificationQueueWaitSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "alertmanager",
+			Name:      "notification_queue_wait_seconds",
+			Help:      "How long a notification waited for a free delivery slot on a receiver with a concurrency limit.",
+			Buckets:   []float64{.01, .1, .5, 1, 5, 10, 20},
+		}, []string{"receiver"}),
 	}
 	for _, integration := range []string{
 		"email",
@@ -246,12 +506,37 @@ func newMetrics(r prometheus.Registerer) *metrics {
 		m.numFailedNotifications.WithLabelValues(integration)
 		m.notificationLatencySeconds.WithLabelValues(integration)
 	}
-	r.MustRegister(m.numNotifications, m.numFailedNotifications, m.notificationLatencySeconds)
+	r.MustRegister(m.numNotifications, m.numFailedNotifications, m.notificationLatencySeconds, m.notificationQueueLength, m.notificationQueueWaitSeconds)
 	return m
 }
 
 type PipelineBuilder struct {
-	metrics *metrics
+	metrics     *metrics
+	timeline    *timeline.Sink
+	sqlReport   *sqlreport.Exporter
+	archiver    *archive.Archiver
+	receipts    *receipt.Sink
+	incidents   *incident.Tracker
+	correlation *correlate.Tracker
+	digest      *report.Tracker
+	quota       *quota.Tracker
+	standby     *standby.Tracker
+	slo         *slo.Tracker
+	onSLOBreach func(receiver string)
+	decisions   *decisionlog.Logger
+	history     *history.Log
+	muted       *receivermute.Tracker
+	shadow      *shadow.Tracker
+	breaker     *breaker.Tracker
+	rateLimiter *ratelimit.Tracker
+	muteTimes   map[string][]timeinterval.TimeInterval
+
+	priorityGate   *priority.Gate
+	priorityLabel  model.LabelName
+	priorityValues map[string]int
+
+	startedAt          time.Time
+	startupGracePeriod time.Duration
 }
 
 func NewPipelineBuilder(r prometheus.Registerer) *PipelineBuilder {
@@ -260,7 +545,158 @@ func NewPipelineBuilder(r prometheus.Registerer) *PipelineBuilder {
 	}
 }
 
-// New returns a map of receivers to Stages.
+// SetTimelineSink configures where group lifecycle events (first notified,
+// repeat notified, resolved) are streamed to. Passing nil disables the
+// timeline for pipelines built afterwards.
+func (pb *PipelineBuilder) SetTimelineSink(s *timeline.Sink) {
+	pb.timeline = s
+}
+
+// SetSQLReportExporter configures where successfully delivered notifications
+// are recorded for SQL-based reporting. Passing nil disables reporting for
+// pipelines built afterwards.
+func (pb *PipelineBuilder) SetSQLReportExporter(e *sqlreport.Exporter) {
+	pb.sqlReport = e
+}
+
+// SetArchiver configures where rendered notifications and their delivery
+// results are archived to. Passing nil disables archiving for pipelines
+// built afterwards.
+func (pb *PipelineBuilder) SetArchiver(a *archive.Archiver) {
+	pb.archiver = a
+}
+
+// SetReceiptSink configures where a delivery receipt (receiver, group key,
+// outcome, latency) is posted after each notification attempt. Passing nil
+// disables delivery receipts for pipelines built afterwards.
+func (pb *PipelineBuilder) SetReceiptSink(s *receipt.Sink) {
+	pb.receipts = s
+}
+
+// SetIncidentTracker configures the tracker used to recognize a group that
+// refires shortly after resolving as a continuation of the same incident,
+// rather than a new one. Passing nil disables incident tracking for
+// pipelines built afterwards.
+func (pb *PipelineBuilder) SetIncidentTracker(t *incident.Tracker) {
+	pb.incidents = t
+}
+
+// SetCorrelationTracker configures the tracker used to count, per
+// notification, how many other aggregation groups sharing the configured
+// correlation labels are also currently firing. Passing nil disables
+// correlation for pipelines built afterwards.
+func (pb *PipelineBuilder) SetCorrelationTracker(t *correlate.Tracker) {
+	pb.correlation = t
+}
+
+// SetDigestTracker configures the tracker used to accumulate notification
+// outcomes for the periodic alerting activity report. Passing nil disables
+// digest tracking for pipelines built afterwards.
+func (pb *PipelineBuilder) SetDigestTracker(t *report.Tracker) {
+	pb.digest = t
+}
+
+// SetQuotaTracker configures the tracker used to attribute sent
+// notifications to a tenant for per-tenant usage metrics. Passing nil
+// disables notification tracking for pipelines built afterwards.
+func (pb *PipelineBuilder) SetQuotaTracker(t *quota.Tracker) {
+	pb.quota = t
+}
+
+// SetStandbyTracker configures the tracker consulted to suppress delivery
+// instance-wide while warm-standby mode is enabled. Passing nil disables
+// standby mode for pipelines built afterwards, so delivery is never
+// suppressed by it.
+func (pb *PipelineBuilder) SetStandbyTracker(t *standby.Tracker) {
+	pb.standby = t
+}
+
+// SetSLOTracker configures the tracker used to measure per-receiver paging
+// latency. onBreach, if non-nil, is invoked whenever a receiver's tracked
+// p99 newly exceeds its configured objective. Passing a nil tracker
+// disables SLO tracking for pipelines built afterwards.
+func (pb *PipelineBuilder) SetSLOTracker(t *slo.Tracker, onBreach func(receiver string)) {
+	pb.slo = t
+	pb.onSLOBreach = onBreach
+}
+
+// SetDecisionLog configures where notification delivery outcomes are
+// recorded. Passing nil disables decision logging for pipelines built
+// afterwards.
+func (pb *PipelineBuilder) SetDecisionLog(l *decisionlog.Logger) {
+	pb.decisions = l
+}
+
+// SetHistory configures where per-alert lifecycle events are recorded.
+// Passing nil disables history recording for pipelines built afterwards.
+func (pb *PipelineBuilder) SetHistory(h *history.Log) {
+	pb.history = h
+}
+
+// SetReceiverMuteTracker configures the tracker consulted to decide whether
+// a receiver is currently muted. Passing nil disables receiver muting for
+// pipelines built afterwards.
+func (pb *PipelineBuilder) SetReceiverMuteTracker(t *receivermute.Tracker) {
+	pb.muted = t
+}
+
+// SetShadowTracker configures where notifications suppressed by dry-run
+// mode are recorded, so they can be inspected through the API. Passing nil
+// means suppressed notifications are only logged.
+func (pb *PipelineBuilder) SetShadowTracker(t *shadow.Tracker) {
+	pb.shadow = t
+}
+
+// SetCircuitBreaker configures the tracker consulted to decide whether a
+// receiver's circuit breaker is currently open, and to report the outcome
+// of each delivery attempt back to it. Passing nil disables circuit
+// breaking for pipelines built afterwards.
+func (pb *PipelineBuilder) SetCircuitBreaker(t *breaker.Tracker) {
+	pb.breaker = t
+}
+
+// SetRateLimiter configures the tracker consulted to throttle per-receiver
+// notification delivery to a configured rate. Passing nil disables rate
+// limiting for pipelines built afterwards.
+func (pb *PipelineBuilder) SetRateLimiter(t *ratelimit.Tracker) {
+	pb.rateLimiter = t
+}
+
+// SetMuteTimeIntervals configures the named mute_time_intervals that routes
+// can reference via their MuteTimeIntervals/ActiveTimeIntervals RouteOpts,
+// for pipelines built afterwards.
+func (pb *PipelineBuilder) SetMuteTimeIntervals(intervals map[string][]timeinterval.TimeInterval) {
+	pb.muteTimes = intervals
+}
+
+// SetPriorityGate configures a shared gate that caps how many deliveries run
+// concurrently across every receiver, admitting alerts whose label value
+// resolves the highest priority ahead of lower-priority ones once it is
+// saturated. label and values decide each delivery's priority (see
+// PriorityStage); they are ignored if gate is nil. Passing a nil gate
+// disables priority gating for pipelines built afterwards.
+func (pb *PipelineBuilder) SetPriorityGate(gate *priority.Gate, label model.LabelName, values map[string]int) {
+	pb.priorityGate = gate
+	pb.priorityLabel = label
+	pb.priorityValues = values
+}
+
+// SetStartupGracePeriod configures pipelines built afterwards to hold back
+// repeat notifications for a group until delay has passed since startedAt,
+// so state recovered from the notification log and cluster state settling
+// right after a restart don't immediately trigger a burst of repeats for
+// groups that were already notified about before the restart. A zero delay
+// disables the grace period.
+func (pb *PipelineBuilder) SetStartupGracePeriod(startedAt time.Time, delay time.Duration) {
+	pb.startedAt = startedAt
+	pb.startupGracePeriod = delay
+}
+
+// New returns a map of receivers to Stages. concurrencyLimits caps, per
+// receiver name, how many notifications may be in flight at once; a
+// missing or zero entry leaves the receiver unbounded. dryRun marks, per
+// receiver name, whether delivery should be suppressed and logged to the
+// shadow log instead of sent.
 func (pb *PipelineBuilder) New(
 	receivers map[string][]Integration,
 	wait func() time.Duration,
@@ -268,118 +704,907 @@ func (pb *PipelineBuilder) New(
 	silencer *silence.Silencer,
 	notificationLog NotificationLog,
 	peer *cluster.Peer,
+	tmpl *template.Template,
+	concurrencyLimits map[string]int,
+	dryRun map[string]bool,
+	maxRetryDurations map[string]time.Duration,
+	notificationTimeouts map[string]time.Duration,
+	redactions map[string][]*config.RedactionRule,
 ) RoutingStage {
 	rs := make(RoutingStage, len(receivers))
 
-	ms := NewGossipSettleStage(peer)
-	is := NewMuteStage(inhibitor)
-	ss := NewMuteStage(silencer)
+	ms := NewGossipSettleStage(peer)
+	tms := NewTimeMuteStage(pb.muteTimes)
+	var is Stage = NewMuteStage(inhibitor)
+	if pb.history != nil {
+		is = NewInhibitHistoryStage(is.(*MuteStage), inhibitor, pb.history)
+	}
+	var ss Stage = NewMuteStage(silencer)
+	if pb.history != nil {
+		ss = NewSilenceHistoryStage(ss.(*MuteStage), silencer, pb.history)
+	}
+
+	for name := range receivers {
+		st := createReceiverStage(name, receivers[name], wait, notificationLog, pb.metrics, pb.timeline, pb.sqlReport, pb.archiver, pb.receipts, pb.incidents, pb.correlation, pb.digest, pb.quota, pb.standby, pb.shadow, pb.slo, pb.onSLOBreach, pb.decisions, pb.history, tmpl, concurrencyLimits[name], dryRun[name], maxRetryDurations[name], notificationTimeouts[name], redactions[name], pb.startedAt, pb.startupGracePeriod, pb.priorityGate, pb.priorityLabel, pb.priorityValues)
+		stages := MultiStage{ms, tms, is, ss}
+		if pb.muted != nil {
+			stages = append(stages, NewReceiverMuteStage(pb.muted, name))
+		}
+		rs[name] = append(stages, st)
+	}
+
+	if pb.breaker != nil {
+		raw := make(RoutingStage, len(rs))
+		for name, st := range rs {
+			raw[name] = st
+		}
+		for name, st := range raw {
+			var fallback Stage
+			if fb, ok := pb.breaker.Fallback(name); ok {
+				fallback = raw[fb]
+			}
+			rs[name] = NewCircuitBreakerStage(st, pb.breaker, name, fallback)
+		}
+	}
+
+	if pb.rateLimiter != nil {
+		for name, st := range rs {
+			rs[name] = NewRateLimitStage(st, pb.rateLimiter, name)
+		}
+	}
+
+	return rs
+}
+
+// createReceiverStage creates a pipeline of stages for a receiver.
+func createReceiverStage(
+	name string,
+	integrations []Integration,
+	wait func() time.Duration,
+	notificationLog NotificationLog,
+	metrics *metrics,
+	timelineSink *timeline.Sink,
+	sqlReport *sqlreport.Exporter,
+	archiver *archive.Archiver,
+	receipts *receipt.Sink,
+	incidents *incident.Tracker,
+	correlation *correlate.Tracker,
+	digest *report.Tracker,
+	quotaTracker *quota.Tracker,
+	standbyTracker *standby.Tracker,
+	shadowTracker *shadow.Tracker,
+	sloTracker *slo.Tracker,
+	onSLOBreach func(receiver string),
+	decisions *decisionlog.Logger,
+	history *history.Log,
+	tmpl *template.Template,
+	concurrencyLimit int,
+	dryRun bool,
+	maxRetryDuration time.Duration,
+	notificationTimeout time.Duration,
+	redactions []*config.RedactionRule,
+	startedAt time.Time,
+	startupGracePeriod time.Duration,
+	priorityGate *priority.Gate,
+	priorityLabel model.LabelName,
+	priorityValues map[string]int,
+) Stage {
+	var fs FanoutStage
+	for i := range integrations {
+		recv := &nflogpb.Receiver{
+			GroupName:   name,
+			Integration: integrations[i].Name(),
+			Idx:         uint32(integrations[i].Index()),
+		}
+		var s MultiStage
+		s = append(s, NewWaitStage(wait))
+		s = append(s, NewDedupStage(&integrations[i], notificationLog, recv))
+		s = append(s, NewDiffStage(notificationLog, recv))
+		if startupGracePeriod > 0 {
+			s = append(s, NewStartupSuppressStage(notificationLog, recv, startedAt, startupGracePeriod))
+		}
+		if incidents != nil {
+			s = append(s, NewIncidentStage(incidents))
+		}
+		if correlation != nil {
+			s = append(s, NewCorrelationStage(correlation))
+		}
+		if timelineSink != nil {
+			s = append(s, NewTimelineStage(timelineSink, name, tmpl))
+		}
+		var deliver Stage
+		if dryRun {
+			deliver = NewShadowStage(name, integrations[i].Name(), shadowTracker, tmpl)
+		} else {
+			deliver = NewRetryStage(integrations[i], name, metrics, maxRetryDuration, notificationTimeout)
+		}
+		if len(redactions) > 0 {
+			deliver = NewRedactionStage(deliver, redactions)
+		}
+		if standbyTracker != nil {
+			deliver = NewStandbyStage(deliver, standbyTracker, name, integrations[i].Name())
+		}
+		if priorityGate != nil {
+			deliver = NewPriorityStage(deliver, priorityGate, priorityLabel, priorityValues)
+		}
+		if receipts != nil {
+			deliver = NewReceiptStage(deliver, receipts, name, integrations[i].Name())
+		}
+		if digest != nil {
+			deliver = NewDigestStage(deliver, digest, integrations[i].Name())
+		}
+		if quotaTracker != nil {
+			deliver = NewQuotaStage(deliver, quotaTracker)
+		}
+		if decisions != nil {
+			deliver = NewDecisionLogStage(deliver, decisions, name, integrations[i].Name())
+		}
+		if sloTracker != nil {
+			deliver = NewPagingLatencyStage(deliver, sloTracker, name, onSLOBreach)
+		}
+		s = append(s, deliver)
+		if sqlReport != nil {
+			s = append(s, NewReportStage(sqlReport, name, integrations[i].Name()))
+		}
+		if archiver != nil {
+			s = append(s, NewArchiveStage(archiver, name, integrations[i].Name(), tmpl))
+		}
+		if history != nil {
+			s = append(s, NewHistoryStage(history, name, integrations[i].Name()))
+		}
+		s = append(s, NewSetNotifiesStage(notificationLog, recv))
+
+		fs = append(fs, s)
+	}
+	var st Stage = fs
+	if concurrencyLimit > 0 {
+		st = NewLimitStage(fs, name, concurrencyLimit, metrics)
+	}
+	return st
+}
+
+// TimelineStage streams a lifecycle event for the alerts that survived
+// deduplication to an incident-management timeline.
+type TimelineStage struct {
+	sink     *timeline.Sink
+	receiver string
+	tmpl     *template.Template
+}
+
+// NewTimelineStage returns a stage that reports to sink whenever alerts pass
+// through it.
+func NewTimelineStage(sink *timeline.Sink, receiver string, tmpl *template.Template) *TimelineStage {
+	return &TimelineStage{sink: sink, receiver: receiver, tmpl: tmpl}
+}
+
+// Exec implements the Stage interface.
+func (t *TimelineStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if !t.sink.Enabled() {
+		return ctx, alerts, nil
+	}
+
+	gkey, _ := GroupKey(ctx)
+
+	status := timeline.StatusFiring
+	if types.Alerts(alerts...).Status() == model.AlertResolved {
+		status = timeline.StatusResolved
+	}
+
+	t.sink.Send(ctx, timeline.Event{
+		Timestamp: time.Now(),
+		GroupKey:  gkey,
+		Receiver:  t.receiver,
+		Status:    status,
+		Data:      GetTemplateData(ctx, t.tmpl, alerts, l),
+	})
+
+	return ctx, alerts, nil
+}
+
+// ReportStage records alerts that survived retry (i.e. were actually
+// delivered) to a SQL reporting database.
+type ReportStage struct {
+	exporter    *sqlreport.Exporter
+	receiver    string
+	integration string
+}
+
+// NewReportStage returns a stage that exports alerts passing through it to
+// exporter once a notification for them has succeeded.
+func NewReportStage(exporter *sqlreport.Exporter, receiver, integration string) *ReportStage {
+	return &ReportStage{exporter: exporter, receiver: receiver, integration: integration}
+}
+
+// Exec implements the Stage interface.
+func (r *ReportStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	gkey, _ := GroupKey(ctx)
+	r.exporter.ExportNotification(ctx, r.receiver, r.integration, gkey, alerts...)
+	return ctx, alerts, nil
+}
+
+// ArchiveStage writes a copy of alerts that were successfully delivered,
+// along with the data they were rendered from, to object storage for
+// compliance retention.
+type ArchiveStage struct {
+	archiver    *archive.Archiver
+	receiver    string
+	integration string
+	tmpl        *template.Template
+}
+
+// NewArchiveStage returns a stage that archives alerts passing through it to
+// archiver once a notification for them has succeeded.
+func NewArchiveStage(archiver *archive.Archiver, receiver, integration string, tmpl *template.Template) *ArchiveStage {
+	return &ArchiveStage{archiver: archiver, receiver: receiver, integration: integration, tmpl: tmpl}
+}
+
+// Exec implements the Stage interface.
+func (a *ArchiveStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if !a.archiver.Enabled() {
+		return ctx, alerts, nil
+	}
+
+	gkey, _ := GroupKey(ctx)
+	a.archiver.Write(ctx, archive.Record{
+		Timestamp:   time.Now(),
+		Receiver:    a.receiver,
+		Integration: a.integration,
+		GroupKey:    gkey,
+		Success:     true,
+		Data:        GetTemplateData(ctx, a.tmpl, alerts, l),
+	})
+
+	return ctx, alerts, nil
+}
+
+// HistoryStage records a notified event for alerts that survived retry
+// (i.e. were actually delivered).
+type HistoryStage struct {
+	history     *history.Log
+	receiver    string
+	integration string
+}
+
+// NewHistoryStage returns a stage that records alerts passing through it to
+// h once a notification for them has succeeded.
+func NewHistoryStage(h *history.Log, receiver, integration string) *HistoryStage {
+	return &HistoryStage{history: h, receiver: receiver, integration: integration}
+}
+
+// Exec implements the Stage interface.
+func (h *HistoryStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	detail := h.receiver + "/" + h.integration
+	for _, a := range alerts {
+		h.history.Add(a.Fingerprint(), history.EventNotified, detail)
+	}
+	return ctx, alerts, nil
+}
+
+// RoutingStage executes the inner stages based on the receiver specified in
+// the context.
+type RoutingStage map[string]Stage
+
+// Exec implements the Stage interface. If the receiver named in the
+// context does not have a stage (e.g. it was rendered from a templated
+// route receiver against labels that don't produce a defined receiver
+// name), it falls back to the receiver named by WithReceiverFallback, if
+// any, before giving up.
+func (rs RoutingStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	receiver, ok := ReceiverName(ctx)
+	if !ok {
+		return ctx, nil, fmt.Errorf("receiver missing")
+	}
+
+	s, ok := rs[receiver]
+	if !ok {
+		fallback, hasFallback := ReceiverFallback(ctx)
+		if !hasFallback {
+			return ctx, nil, fmt.Errorf("stage for receiver missing")
+		}
+		s, ok = rs[fallback]
+		if !ok {
+			return ctx, nil, fmt.Errorf("stage for receiver missing")
+		}
+		level.Warn(l).Log("msg", "Templated receiver did not resolve to a defined receiver, using fallback", "receiver", receiver, "fallback", fallback)
+		ctx = WithReceiverName(ctx, fallback)
+	}
+
+	return s.Exec(ctx, l, alerts...)
+}
+
+// A MultiStage executes a series of stages sequentially.
+type MultiStage []Stage
+
+// Exec implements the Stage interface.
+func (ms MultiStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	var err error
+	for _, s := range ms {
+		if len(alerts) == 0 {
+			return ctx, nil, nil
+		}
+
+		ctx, alerts, err = s.Exec(ctx, l, alerts...)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+	return ctx, alerts, nil
+}
+
+// FanoutStage executes its stages concurrently
+type FanoutStage []Stage
+
+// Exec attempts to execute all stages concurrently and discards the results.
+// It returns its input alerts and a types.MultiError if one or more stages fail.
+func (fs FanoutStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	var (
+		wg sync.WaitGroup
+		me types.MultiError
+	)
+	wg.Add(len(fs))
+
+	for _, s := range fs {
+		go func(s Stage) {
+			if _, _, err := s.Exec(ctx, l, alerts...); err != nil {
+				me.Add(err)
+				lvl := level.Error(l)
+				if ctx.Err() == context.Canceled {
+					// It is expected for the context to be canceled on
+					// configuration reload or shutdown. In this case, the
+					// message should only be logged at the debug level.
+					lvl = level.Debug(l)
+				}
+				lvl.Log("msg", "Error on notify", "err", err, "context_err", ctx.Err())
+			}
+			wg.Done()
+		}(s)
+	}
+	wg.Wait()
+
+	if me.Len() > 0 {
+		return ctx, alerts, &me
+	}
+	return ctx, alerts, nil
+}
+
+// LimitStage caps how many notification deliveries for a receiver run at
+// once, queuing excess attempts behind a semaphore. Receivers backed by
+// services that rate limit aggressively (PagerDuty, Slack) can use this to
+// bound their own fan-out instead of bursting every alert group's
+// notification at once and tripping a 429 storm.
+type LimitStage struct {
+	next     Stage
+	sem      chan struct{}
+	receiver string
+	metrics  *metrics
+}
+
+// NewLimitStage returns a new LimitStage wrapping next, allowing at most
+// maxConcurrency concurrent executions of next for the given receiver.
+func NewLimitStage(next Stage, receiver string, maxConcurrency int, metrics *metrics) *LimitStage {
+	return &LimitStage{
+		next:     next,
+		sem:      make(chan struct{}, maxConcurrency),
+		receiver: receiver,
+		metrics:  metrics,
+	}
+}
+
+// Exec implements the Stage interface.
+func (ls *LimitStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	queueLength := ls.metrics.notificationQueueLength.WithLabelValues(ls.receiver)
+	queueLength.Inc()
+	defer queueLength.Dec()
+
+	start := time.Now()
+	select {
+	case ls.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx, nil, ctx.Err()
+	}
+	ls.metrics.notificationQueueWaitSeconds.WithLabelValues(ls.receiver).Observe(time.Since(start).Seconds())
+	defer func() { <-ls.sem }()
+
+	return ls.next.Exec(ctx, l, alerts...)
+}
+
+// lowestPriority is the effective priority assigned to alerts that carry
+// none of the configured priority label's known values, so they queue
+// behind everything that does without being starved outright.
+const lowestPriority = 1 << 30
+
+// PriorityStage gates next behind a shared, priority-ordered Gate so that,
+// once the outbound pipeline is saturated, alerts mapped to a more urgent
+// priority by label are delivered ahead of less urgent ones instead of
+// strictly in arrival order.
+type PriorityStage struct {
+	next   Stage
+	gate   *priority.Gate
+	label  model.LabelName
+	values map[string]int
+}
+
+// NewPriorityStage returns a new PriorityStage wrapping next. The priority
+// of a group of alerts is determined by looking up the first alert's label
+// value for label in values; alerts without a recognized value are treated
+// as lowest priority.
+func NewPriorityStage(next Stage, gate *priority.Gate, label model.LabelName, values map[string]int) *PriorityStage {
+	return &PriorityStage{next: next, gate: gate, label: label, values: values}
+}
+
+// Exec implements the Stage interface.
+func (ps *PriorityStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	p := lowestPriority
+	if len(alerts) > 0 {
+		if v, ok := ps.values[string(alerts[0].Labels[ps.label])]; ok {
+			p = v
+		}
+	}
+
+	if err := ps.gate.Acquire(ctx, p); err != nil {
+		return ctx, nil, err
+	}
+	defer ps.gate.Release()
+
+	return ps.next.Exec(ctx, l, alerts...)
+}
+
+// ShadowStage stands in for the delivery stage of a receiver running in
+// dry-run mode. Instead of notifying the integration, it logs what would
+// have been sent and, if a Tracker is configured, records it there too, so
+// new routing or receiver configs can be staged against production alert
+// traffic -- and inspected through the API -- without risking real
+// delivery.
+type ShadowStage struct {
+	receiver    string
+	integration string
+	tracker     *shadow.Tracker
+	tmpl        *template.Template
+}
+
+// NewShadowStage returns a new ShadowStage for the given receiver and
+// integration name. tracker may be nil, in which case suppressed
+// notifications are only logged.
+func NewShadowStage(receiver, integration string, tracker *shadow.Tracker, tmpl *template.Template) *ShadowStage {
+	return &ShadowStage{receiver: receiver, integration: integration, tracker: tracker, tmpl: tmpl}
+}
+
+// Exec implements the Stage interface.
+func (s *ShadowStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	key, _ := GroupKey(ctx)
+	level.Info(l).Log(
+		"msg", "shadow notify (dry-run, delivery suppressed)",
+		"receiver", s.receiver,
+		"integration", s.integration,
+		"groupKey", key,
+		"numAlerts", len(alerts),
+	)
+	if s.tracker != nil {
+		s.tracker.Add(shadow.Record{
+			Timestamp:   time.Now(),
+			Receiver:    s.receiver,
+			Integration: s.integration,
+			GroupKey:    key,
+			Data:        GetTemplateData(ctx, s.tmpl, alerts, l),
+		})
+	}
+	return ctx, alerts, nil
+}
+
+// StandbyStage stands in for the delivery stage of every receiver while
+// warm-standby mode is enabled instance-wide. Instead of notifying the
+// integration, it logs what would have been sent, the same as a receiver
+// running in dry-run mode, so the instance can keep ingesting, grouping,
+// and deduplicating production alert traffic without ever paging anyone.
+type StandbyStage struct {
+	next        Stage
+	tracker     *standby.Tracker
+	receiver    string
+	integration string
+}
+
+// NewStandbyStage returns a stage that defers to next unless tracker
+// reports standby mode enabled, in which case delivery is suppressed.
+func NewStandbyStage(next Stage, tracker *standby.Tracker, receiver, integration string) *StandbyStage {
+	return &StandbyStage{next: next, tracker: tracker, receiver: receiver, integration: integration}
+}
+
+// Exec implements the Stage interface.
+func (s *StandbyStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if !s.tracker.Enabled() {
+		return s.next.Exec(ctx, l, alerts...)
+	}
+	key, _ := GroupKey(ctx)
+	level.Info(l).Log(
+		"msg", "standby mode (notifications disabled, delivery suppressed)",
+		"receiver", s.receiver,
+		"integration", s.integration,
+		"groupKey", key,
+		"numAlerts", len(alerts),
+	)
+	return ctx, alerts, nil
+}
+
+// IncidentStage tags the pipeline context with a stable incident reference
+// for the current aggregation group, reusing the previous one if the group
+// refires within the tracker's continuation window instead of starting a
+// fresh incident. It does not itself rename threads or tickets; it only
+// makes the reference and whether it is a continuation available, via
+// IncidentRef and IsIncidentContinuation, to any stage or notifier further
+// down the pipeline that wants to reuse an existing one.
+type IncidentStage struct {
+	tracker *incident.Tracker
+}
+
+// NewIncidentStage returns a stage that assigns incident references using
+// tracker.
+func NewIncidentStage(tracker *incident.Tracker) *IncidentStage {
+	return &IncidentStage{tracker: tracker}
+}
+
+// Exec implements the Stage interface.
+func (i *IncidentStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	gkey, ok := GroupKey(ctx)
+	if !ok {
+		return ctx, nil, fmt.Errorf("group key missing")
+	}
+	now, ok := Now(ctx)
+	if !ok {
+		now = time.Now()
+	}
+	firing, _ := FiringAlerts(ctx)
+
+	ref, isNew := i.tracker.Observe(gkey, len(firing) > 0, now)
+	ctx = WithIncidentRef(ctx, ref)
+	ctx = WithIncidentContinuation(ctx, !isNew)
+
+	return ctx, alerts, nil
+}
+
+// CorrelationStage tags the pipeline context with how many other
+// aggregation groups sharing the tracker's correlation labels are also
+// currently firing, so templates and notifiers can surface blast radius
+// (e.g. "3 related alert groups firing").
+type CorrelationStage struct {
+	tracker *correlate.Tracker
+}
+
+// NewCorrelationStage returns a stage that counts related firing groups
+// using tracker.
+func NewCorrelationStage(tracker *correlate.Tracker) *CorrelationStage {
+	return &CorrelationStage{tracker: tracker}
+}
+
+// Exec implements the Stage interface.
+func (c *CorrelationStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	gkey, ok := GroupKey(ctx)
+	if !ok {
+		return ctx, nil, fmt.Errorf("group key missing")
+	}
+	groupLabels, ok := GroupLabels(ctx)
+	if !ok {
+		return ctx, nil, fmt.Errorf("group labels missing")
+	}
+	now, ok := Now(ctx)
+	if !ok {
+		now = time.Now()
+	}
+	firing, _ := FiringAlerts(ctx)
+
+	if related, ok := c.tracker.Observe(groupLabels, gkey, len(firing) > 0, now); ok {
+		ctx = WithRelatedGroups(ctx, related)
+	}
+
+	return ctx, alerts, nil
+}
+
+// ReceiptStage wraps a delivery stage and posts a delivery receipt
+// (receiver, group key, outcome, latency) to an external webhook after each
+// attempt, so an SLO system can track time from alert firing to
+// notification delivered. It never changes the outcome of next: success and
+// failure propagate exactly as if ReceiptStage were not present.
+type ReceiptStage struct {
+	next        Stage
+	sink        *receipt.Sink
+	receiver    string
+	integration string
+}
+
+// NewReceiptStage returns a stage that reports the outcome and latency of
+// next to sink.
+func NewReceiptStage(next Stage, sink *receipt.Sink, receiver, integration string) *ReceiptStage {
+	return &ReceiptStage{next: next, sink: sink, receiver: receiver, integration: integration}
+}
+
+// Exec implements the Stage interface.
+func (r *ReceiptStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if !r.sink.Enabled() {
+		return r.next.Exec(ctx, l, alerts...)
+	}
+
+	start := time.Now()
+	ctx, alerts, err := r.next.Exec(ctx, l, alerts...)
+	latency := time.Since(start)
+
+	gkey, _ := GroupKey(ctx)
+	rec := receipt.Receipt{
+		Timestamp:      start,
+		Receiver:       r.receiver,
+		Integration:    r.integration,
+		GroupKey:       gkey,
+		Outcome:        receipt.OutcomeSuccess,
+		LatencySeconds: latency.Seconds(),
+	}
+	if err != nil {
+		rec.Outcome = receipt.OutcomeFailure
+		rec.Error = err.Error()
+	}
+	r.sink.Send(ctx, rec)
+
+	return ctx, alerts, err
+}
+
+// DigestStage records the outcome of next's delivery attempt to a report.Tracker,
+// so that alert volume and notification failures can be summarized in the
+// periodic alerting activity report.
+type DigestStage struct {
+	next        Stage
+	tracker     *report.Tracker
+	integration string
+}
+
+// NewDigestStage returns a stage that records the outcome of next's delivery
+// attempts to tracker.
+func NewDigestStage(next Stage, tracker *report.Tracker, integration string) *DigestStage {
+	return &DigestStage{next: next, tracker: tracker, integration: integration}
+}
+
+// Exec implements the Stage interface.
+func (d *DigestStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	ctx, alerts, err := d.next.Exec(ctx, l, alerts...)
+	d.tracker.Observe(d.integration, alerts, err)
+	return ctx, alerts, err
+}
+
+// PagingLatencyStage records the end-to-end latency of a successful
+// delivery of next, from the oldest delivered alert's StartsAt to now,
+// against an slo.Tracker, invoking onBreach whenever that push newly
+// exceeds the receiver's configured paging latency objective.
+type PagingLatencyStage struct {
+	next     Stage
+	tracker  *slo.Tracker
+	receiver string
+	onBreach func(receiver string)
+}
+
+// NewPagingLatencyStage returns a stage that records the paging latency of
+// next's successful deliveries to tracker. onBreach may be nil.
+func NewPagingLatencyStage(next Stage, tracker *slo.Tracker, receiver string, onBreach func(receiver string)) *PagingLatencyStage {
+	return &PagingLatencyStage{next: next, tracker: tracker, receiver: receiver, onBreach: onBreach}
+}
+
+// Exec implements the Stage interface.
+func (p *PagingLatencyStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	ctx, alerts, err := p.next.Exec(ctx, l, alerts...)
+	if err == nil && len(alerts) > 0 {
+		oldest := alerts[0].StartsAt
+		for _, a := range alerts[1:] {
+			if a.StartsAt.Before(oldest) {
+				oldest = a.StartsAt
+			}
+		}
+		if breached := p.tracker.Observe(p.receiver, time.Since(oldest)); breached && p.onBreach != nil {
+			p.onBreach(p.receiver)
+		}
+	}
+	return ctx, alerts, err
+}
+
+// RedactionStage applies a receiver's redaction rules to a copy of the
+// alerts before they reach next, so the version sent to the integration has
+// matching label and annotation values replaced while the alerts returned
+// to the rest of the pipeline (history, archiving, reporting) stay intact.
+type RedactionStage struct {
+	next  Stage
+	rules []*config.RedactionRule
+}
+
+// NewRedactionStage returns a stage that redacts alerts passed to next
+// according to rules.
+func NewRedactionStage(next Stage, rules []*config.RedactionRule) *RedactionStage {
+	return &RedactionStage{next: next, rules: rules}
+}
+
+// Exec implements the Stage interface.
+func (rs *RedactionStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	redacted := make([]*types.Alert, len(alerts))
+	for i, a := range alerts {
+		redacted[i] = rs.redactAlert(a)
+	}
+	_, _, err := rs.next.Exec(ctx, l, redacted...)
+	return ctx, alerts, err
+}
+
+// redactAlert returns a copy of a with every label and annotation value
+// that matches one of rs.rules replaced.
+func (rs *RedactionStage) redactAlert(a *types.Alert) *types.Alert {
+	out := *a
+	out.Labels = rs.redactSet(a.Labels)
+	out.Annotations = rs.redactSet(a.Annotations)
+	return &out
+}
 
-	for name := range receivers {
-		st := createReceiverStage(name, receivers[name], wait, notificationLog, pb.metrics)
-		rs[name] = MultiStage{ms, is, ss, st}
+func (rs *RedactionStage) redactSet(set model.LabelSet) model.LabelSet {
+	out := make(model.LabelSet, len(set))
+	for name, value := range set {
+		v := string(value)
+		for _, r := range rs.rules {
+			if redacted, matched := r.Redact(string(name), v); matched {
+				v = redacted
+			}
+		}
+		out[name] = model.LabelValue(v)
 	}
-	return rs
+	return out
 }
 
-// createReceiverStage creates a pipeline of stages for a receiver.
-func createReceiverStage(
-	name string,
-	integrations []Integration,
-	wait func() time.Duration,
-	notificationLog NotificationLog,
-	metrics *metrics,
-) Stage {
-	var fs FanoutStage
-	for i := range integrations {
-		recv := &nflogpb.Receiver{
-			GroupName:   name,
-			Integration: integrations[i].Name(),
-			Idx:         uint32(integrations[i].Index()),
-		}
-		var s MultiStage
-		s = append(s, NewWaitStage(wait))
-		s = append(s, NewDedupStage(&integrations[i], notificationLog, recv))
-		s = append(s, NewRetryStage(integrations[i], name, metrics))
-		s = append(s, NewSetNotifiesStage(notificationLog, recv))
+// QuotaStage records a successful delivery of next against a quota.Tracker,
+// attributing it to the tenant of the first alert, so notifications sent can
+// be counted towards the per-tenant usage metrics alongside alerts ingested
+// and silences created.
+type QuotaStage struct {
+	next    Stage
+	tracker *quota.Tracker
+}
 
-		fs = append(fs, s)
+// NewQuotaStage returns a stage that records successful deliveries of next
+// to tracker.
+func NewQuotaStage(next Stage, tracker *quota.Tracker) *QuotaStage {
+	return &QuotaStage{next: next, tracker: tracker}
+}
+
+// Exec implements the Stage interface.
+func (q *QuotaStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	ctx, alerts, err := q.next.Exec(ctx, l, alerts...)
+	if err == nil && len(alerts) > 0 {
+		q.tracker.ObserveNotification(q.tracker.Tenant(alerts[0].Labels))
 	}
-	return fs
+	return ctx, alerts, err
 }
 
-// RoutingStage executes the inner stages based on the receiver specified in
-// the context.
-type RoutingStage map[string]Stage
+// CircuitBreakerStage gates delivery through next behind a per-receiver
+// breaker.Tracker: once enough consecutive failures trip the breaker open,
+// further alerts are routed to fallback instead of next (or dropped, if
+// fallback is nil) until a half-open probe succeeds, instead of retrying
+// against a provider that is already down.
+type CircuitBreakerStage struct {
+	next     Stage
+	breaker  *breaker.Tracker
+	receiver string
+	fallback Stage
+}
+
+// NewCircuitBreakerStage returns a stage that consults b before every call
+// to next, and reports the outcome of each attempt back to b.
+func NewCircuitBreakerStage(next Stage, b *breaker.Tracker, receiver string, fallback Stage) *CircuitBreakerStage {
+	return &CircuitBreakerStage{next: next, breaker: b, receiver: receiver, fallback: fallback}
+}
 
 // Exec implements the Stage interface.
-func (rs RoutingStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
-	receiver, ok := ReceiverName(ctx)
-	if !ok {
-		return ctx, nil, fmt.Errorf("receiver missing")
+func (c *CircuitBreakerStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if !c.breaker.Allow(c.receiver) {
+		if c.fallback != nil {
+			return c.fallback.Exec(ctx, l, alerts...)
+		}
+		return ctx, nil, fmt.Errorf("circuit breaker open for receiver %q", c.receiver)
 	}
 
-	s, ok := rs[receiver]
-	if !ok {
-		return ctx, nil, fmt.Errorf("stage for receiver missing")
+	ctx, alerts, err := c.next.Exec(ctx, l, alerts...)
+	if err != nil {
+		c.breaker.RecordFailure(c.receiver)
+	} else {
+		c.breaker.RecordSuccess(c.receiver)
 	}
+	return ctx, alerts, err
+}
 
-	return s.Exec(ctx, l, alerts...)
+// RateLimitStage gates delivery through next behind a per-receiver
+// ratelimit.Tracker: once a receiver's configured rate limit is exceeded,
+// notifications are dropped, queued until a token frees up, or collapsed
+// into a single summary notification, depending on the receiver's
+// configured overflow policy, instead of hammering an endpoint that can't
+// keep up.
+type RateLimitStage struct {
+	next     Stage
+	limiter  *ratelimit.Tracker
+	receiver string
 }
 
-// A MultiStage executes a series of stages sequentially.
-type MultiStage []Stage
+// NewRateLimitStage returns a stage that consults t before every call to
+// next.
+func NewRateLimitStage(next Stage, t *ratelimit.Tracker, receiver string) *RateLimitStage {
+	return &RateLimitStage{next: next, limiter: t, receiver: receiver}
+}
 
 // Exec implements the Stage interface.
-func (ms MultiStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
-	var err error
-	for _, s := range ms {
-		if len(alerts) == 0 {
-			return ctx, nil, nil
-		}
+func (r *RateLimitStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	ok, overflow, suppressed := r.limiter.Allow(r.receiver)
+	if ok {
+		return r.next.Exec(ctx, l, alerts...)
+	}
 
-		ctx, alerts, err = s.Exec(ctx, l, alerts...)
-		if err != nil {
-			return ctx, nil, err
+	switch overflow {
+	case ratelimit.OverflowQueue:
+		level.Warn(l).Log("msg", "Rate limit exceeded, queueing notification", "receiver", r.receiver)
+		if !r.limiter.Wait(r.receiver, ctx.Done()) {
+			return ctx, nil, ctx.Err()
 		}
+		return r.next.Exec(ctx, l, alerts...)
+	case ratelimit.OverflowCollapse:
+		level.Warn(l).Log("msg", "Rate limit exceeded, collapsing notification", "receiver", r.receiver, "suppressed", suppressed)
+		return r.next.Exec(ctx, l, collapseAlerts(alerts, suppressed))
+	default:
+		level.Warn(l).Log("msg", "Rate limit exceeded, dropping notification", "receiver", r.receiver)
+		return ctx, nil, nil
 	}
-	return ctx, alerts, nil
 }
 
-// FanoutStage executes its stages concurrently
-type FanoutStage []Stage
+// collapseAlerts folds alerts into a single synthetic alert summarizing how
+// many notifications have been suppressed by a receiver's rate limit, so an
+// overflow policy of collapse still lets responders know something
+// happened without sending one message per suppressed notification.
+func collapseAlerts(alerts []*types.Alert, suppressed int) *types.Alert {
+	labels := model.LabelSet{}
+	if len(alerts) > 0 {
+		labels = alerts[0].Labels.Clone()
+	}
+	now := time.Now()
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: labels,
+			Annotations: model.LabelSet{
+				"summary": model.LabelValue(fmt.Sprintf("%d notifications suppressed by rate limit", suppressed)),
+			},
+			StartsAt: now,
+		},
+		UpdatedAt: now,
+	}
+}
 
-// Exec attempts to execute all stages concurrently and discards the results.
-// It returns its input alerts and a types.MultiError if one or more stages fail.
-func (fs FanoutStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
-	var (
-		wg sync.WaitGroup
-		me types.MultiError
-	)
-	wg.Add(len(fs))
+// DecisionLogStage records the outcome of next's delivery attempt to a
+// decisionlog.Logger, so notification decisions can be analyzed offline or
+// replayed as regression tests alongside the dispatcher's routing decisions.
+type DecisionLogStage struct {
+	next        Stage
+	decisions   *decisionlog.Logger
+	receiver    string
+	integration string
+}
 
-	for _, s := range fs {
-		go func(s Stage) {
-			if _, _, err := s.Exec(ctx, l, alerts...); err != nil {
-				me.Add(err)
-				lvl := level.Error(l)
-				if ctx.Err() == context.Canceled {
-					// It is expected for the context to be canceled on
-					// configuration reload or shutdown. In this case, the
-					// message should only be logged at the debug level.
-					lvl = level.Debug(l)
-				}
-				lvl.Log("msg", "Error on notify", "err", err, "context_err", ctx.Err())
-			}
-			wg.Done()
-		}(s)
-	}
-	wg.Wait()
+// NewDecisionLogStage returns a stage that records the outcome of next's
+// delivery attempts to decisions.
+func NewDecisionLogStage(next Stage, decisions *decisionlog.Logger, receiver, integration string) *DecisionLogStage {
+	return &DecisionLogStage{next: next, decisions: decisions, receiver: receiver, integration: integration}
+}
 
-	if me.Len() > 0 {
-		return ctx, alerts, &me
+// Exec implements the Stage interface.
+func (d *DecisionLogStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	ctx, alerts, err := d.next.Exec(ctx, l, alerts...)
+
+	action, reason := "delivered", ""
+	if err != nil {
+		action, reason = "failed", err.Error()
 	}
-	return ctx, alerts, nil
+	routePath := fmt.Sprintf("%s/%s", d.receiver, d.integration)
+	for _, a := range alerts {
+		d.decisions.Log(decisionlog.Decision{
+			Fingerprint: a.Fingerprint().String(),
+			RoutePath:   routePath,
+			Action:      action,
+			Reason:      reason,
+		})
+	}
+	return ctx, alerts, err
 }
 
 // GossipSettleStage waits until the Gossip has settled to forward alerts.
@@ -415,7 +1640,7 @@ func (n *MuteStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 	for _, a := range alerts {
 		// TODO(fabxc): increment total alerts counter.
 		// Do not send the alert if muted.
-		if !n.muter.Mutes(a.Labels) {
+		if !n.muter.Mutes(a.Labels, a.Annotations) {
 			filtered = append(filtered, a)
 		}
 		// TODO(fabxc): increment muted alerts counter if muted.
@@ -423,6 +1648,178 @@ func (n *MuteStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 	return ctx, filtered, nil
 }
 
+// TimeMuteStage filters alerts based on the mute_time_intervals and
+// active_time_intervals configured on the route they were grouped under,
+// evaluated against the "now" timestamp in the context.
+type TimeMuteStage struct {
+	intervals map[string][]timeinterval.TimeInterval
+}
+
+// NewTimeMuteStage returns a new TimeMuteStage. intervals maps a
+// mute_time_intervals name to its configured calendar windows.
+func NewTimeMuteStage(intervals map[string][]timeinterval.TimeInterval) *TimeMuteStage {
+	return &TimeMuteStage{intervals: intervals}
+}
+
+// Exec implements the Stage interface.
+func (tms *TimeMuteStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	now, ok := Now(ctx)
+	if !ok {
+		return ctx, nil, fmt.Errorf("missing now timestamp")
+	}
+
+	if muteNames, ok := MuteTimeIntervalNames(ctx); ok {
+		muted, err := tms.matchesAny(muteNames, now)
+		if err != nil {
+			return ctx, nil, err
+		}
+		if muted {
+			level.Debug(l).Log("msg", "muting notifications: inside a mute time interval")
+			return ctx, nil, nil
+		}
+	}
+
+	if activeNames, ok := ActiveTimeIntervalNames(ctx); ok && len(activeNames) > 0 {
+		active, err := tms.matchesAny(activeNames, now)
+		if err != nil {
+			return ctx, nil, err
+		}
+		if !active {
+			level.Debug(l).Log("msg", "muting notifications: outside every active time interval")
+			return ctx, nil, nil
+		}
+	}
+
+	return ctx, alerts, nil
+}
+
+func (tms *TimeMuteStage) matchesAny(names []string, t time.Time) (bool, error) {
+	for _, name := range names {
+		for _, ti := range tms.intervals[name] {
+			match, err := ti.ContainsTime(t)
+			if err != nil {
+				return false, err
+			}
+			if match {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// SilenceHistoryStage wraps a Silencer's MuteStage and records a silenced
+// event for every alert it newly filters out.
+type SilenceHistoryStage struct {
+	muted    *MuteStage
+	silencer *silence.Silencer
+	history  *history.Log
+}
+
+// NewSilenceHistoryStage returns a stage that records to h, with the
+// applicable silence IDs as detail, every alert that muted newly filters
+// out.
+func NewSilenceHistoryStage(muted *MuteStage, silencer *silence.Silencer, h *history.Log) *SilenceHistoryStage {
+	return &SilenceHistoryStage{muted: muted, silencer: silencer, history: h}
+}
+
+// Exec implements the Stage interface.
+func (s *SilenceHistoryStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	before := make(map[model.Fingerprint]struct{}, len(alerts))
+	for _, a := range alerts {
+		before[a.Fingerprint()] = struct{}{}
+	}
+
+	ctx, filtered, err := s.muted.Exec(ctx, l, alerts...)
+	if err != nil {
+		return ctx, filtered, err
+	}
+
+	after := make(map[model.Fingerprint]struct{}, len(filtered))
+	for _, a := range filtered {
+		after[a.Fingerprint()] = struct{}{}
+	}
+
+	for _, a := range alerts {
+		fp := a.Fingerprint()
+		if _, stillPresent := after[fp]; stillPresent {
+			continue
+		}
+		ids := s.silencer.SilencedIDs(fp)
+		s.history.Add(fp, history.EventSilenced, strings.Join(ids, ","))
+	}
+
+	return ctx, filtered, nil
+}
+
+// InhibitHistoryStage wraps the inhibitor's MuteStage and records an
+// inhibited event, with the inhibiting alerts' fingerprints as detail, for
+// every alert it newly filters out.
+type InhibitHistoryStage struct {
+	muted     *MuteStage
+	inhibitor *inhibit.Inhibitor
+	history   *history.Log
+}
+
+// NewInhibitHistoryStage returns a stage that records to h, with the
+// inhibiting alerts' fingerprints as detail, every alert that muted newly
+// filters out.
+func NewInhibitHistoryStage(muted *MuteStage, inhibitor *inhibit.Inhibitor, h *history.Log) *InhibitHistoryStage {
+	return &InhibitHistoryStage{muted: muted, inhibitor: inhibitor, history: h}
+}
+
+// Exec implements the Stage interface.
+func (s *InhibitHistoryStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	before := make(map[model.Fingerprint]struct{}, len(alerts))
+	for _, a := range alerts {
+		before[a.Fingerprint()] = struct{}{}
+	}
+
+	ctx, filtered, err := s.muted.Exec(ctx, l, alerts...)
+	if err != nil {
+		return ctx, filtered, err
+	}
+
+	after := make(map[model.Fingerprint]struct{}, len(filtered))
+	for _, a := range filtered {
+		after[a.Fingerprint()] = struct{}{}
+	}
+
+	for _, a := range alerts {
+		fp := a.Fingerprint()
+		if _, stillPresent := after[fp]; stillPresent {
+			continue
+		}
+		ids := s.inhibitor.InhibitedBy(fp)
+		s.history.Add(fp, history.EventInhibited, strings.Join(ids, ","))
+	}
+
+	return ctx, filtered, nil
+}
+
+// ReceiverMuteStage drops all alerts for a receiver that has been
+// temporarily muted via the API, e.g. while its downstream integration is
+// known to be unreachable.
+type ReceiverMuteStage struct {
+	muted    *receivermute.Tracker
+	receiver string
+}
+
+// NewReceiverMuteStage returns a stage that drops every alert passing
+// through it for as long as receiver is muted in muted.
+func NewReceiverMuteStage(muted *receivermute.Tracker, receiver string) *ReceiverMuteStage {
+	return &ReceiverMuteStage{muted: muted, receiver: receiver}
+}
+
+// Exec implements the Stage interface.
+func (r *ReceiverMuteStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if ok, until := r.muted.Muted(r.receiver); ok {
+		level.Debug(l).Log("msg", "Suppressing notifications for muted receiver", "receiver", r.receiver, "until", until)
+		return ctx, nil, nil
+	}
+	return ctx, alerts, nil
+}
+
 // WaitStage waits for a certain amount of time before continuing or until the
 // context is done.
 type WaitStage struct {
@@ -513,7 +1910,7 @@ func hashAlert(a *types.Alert) uint64 {
 	return hash
 }
 
-func (n *DedupStage) needsUpdate(entry *nflogpb.Entry, firing, resolved map[uint64]struct{}, repeat time.Duration) bool {
+func (n *DedupStage) needsUpdate(entry *nflogpb.Entry, firing, resolved map[uint64]struct{}, repeat time.Duration, onlyOnChange bool) bool {
 	// If we haven't notified about the alert group before, notify right away
 	// unless we only have resolved alerts.
 	if entry == nil {
@@ -539,7 +1936,12 @@ func (n *DedupStage) needsUpdate(entry *nflogpb.Entry, firing, resolved map[uint
 		return true
 	}
 
-	// Nothing changed, only notify if the repeat interval has passed.
+	// Nothing changed. Normally we only notify if the repeat interval has
+	// passed, but routes with RepeatOnlyOnChange skip that repeat entirely
+	// for a group that hasn't changed since the last notification.
+	if onlyOnChange {
+		return false
+	}
 	return entry.Timestamp.Before(n.now().Add(-repeat))
 }
 
@@ -588,31 +1990,172 @@ func (n *DedupStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Al
 	case 2:
 		return ctx, nil, fmt.Errorf("unexpected entry result size %d", len(entries))
 	}
-	if n.needsUpdate(entry, firingSet, resolvedSet, repeatInterval) {
+	repeatOnlyOnChange, _ := RepeatOnlyOnChange(ctx)
+	if n.needsUpdate(entry, firingSet, resolvedSet, repeatInterval, repeatOnlyOnChange) {
 		return ctx, alerts, nil
 	}
 	return ctx, nil, nil
 }
 
+// DiffStage computes a GroupDiff for the group against the receiver's last
+// notification and attaches it to the context, so templates for repeat
+// notifications can call out what's new or changed instead of repeating an
+// unchanged alert list.
+type DiffStage struct {
+	nflog NotificationLog
+	recv  *nflogpb.Receiver
+	hash  func(*types.Alert) uint64
+}
+
+// NewDiffStage returns a new DiffStage that diffs against the given
+// notification log.
+func NewDiffStage(l NotificationLog, recv *nflogpb.Receiver) *DiffStage {
+	return &DiffStage{
+		nflog: l,
+		recv:  recv,
+		hash:  hashAlert,
+	}
+}
+
+// Exec implements the Stage interface.
+func (n *DiffStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	gkey, ok := GroupKey(ctx)
+	if !ok {
+		return ctx, nil, fmt.Errorf("group key missing")
+	}
+
+	entries, err := n.nflog.Query(nflog.QGroupKey(gkey), nflog.QReceiver(n.recv))
+	if err != nil && err != nflog.ErrNotFound {
+		return ctx, nil, err
+	}
+	var entry *nflogpb.Entry
+	if len(entries) == 1 {
+		entry = entries[0]
+	}
+
+	var diff GroupDiff
+	if entry == nil {
+		// Nothing to diff against yet: every firing alert is new.
+		for _, a := range alerts {
+			if !a.Resolved() {
+				diff.NewFingerprints = append(diff.NewFingerprints, a.Fingerprint().String())
+			}
+		}
+		return WithGroupDiff(ctx, diff), alerts, nil
+	}
+
+	prevFiring := make(map[uint64]struct{}, len(entry.FiringAlerts))
+	for _, h := range entry.FiringAlerts {
+		prevFiring[h] = struct{}{}
+	}
+
+	for _, a := range alerts {
+		if a.Resolved() {
+			continue
+		}
+		if _, ok := prevFiring[n.hash(a)]; !ok {
+			diff.NewFingerprints = append(diff.NewFingerprints, a.Fingerprint().String())
+		} else if a.UpdatedAt.After(entry.Timestamp) {
+			diff.ChangedFingerprints = append(diff.ChangedFingerprints, a.Fingerprint().String())
+		}
+	}
+
+	return WithGroupDiff(ctx, diff), alerts, nil
+}
+
+// StartupSuppressStage holds back repeat notifications for a grace period
+// after process start, so groups that were already notified about before a
+// restart don't immediately re-fire while state recovers from the
+// notification log and the cluster settles. Alerts for a group that was
+// never notified about before are passed through unaffected, since those
+// are not duplicates of anything already delivered.
+type StartupSuppressStage struct {
+	nflog NotificationLog
+	recv  *nflogpb.Receiver
+
+	startedAt time.Time
+	delay     time.Duration
+	now       func() time.Time
+}
+
+// NewStartupSuppressStage returns a new StartupSuppressStage.
+func NewStartupSuppressStage(l NotificationLog, recv *nflogpb.Receiver, startedAt time.Time, delay time.Duration) *StartupSuppressStage {
+	return &StartupSuppressStage{
+		nflog:     l,
+		recv:      recv,
+		startedAt: startedAt,
+		delay:     delay,
+		now:       utcNow,
+	}
+}
+
+// Exec implements the Stage interface.
+func (s *StartupSuppressStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if s.now().Sub(s.startedAt) >= s.delay {
+		return ctx, alerts, nil
+	}
+
+	gkey, ok := GroupKey(ctx)
+	if !ok {
+		return ctx, nil, fmt.Errorf("group key missing")
+	}
+
+	entries, err := s.nflog.Query(nflog.QGroupKey(gkey), nflog.QReceiver(s.recv))
+	if err != nil && err != nflog.ErrNotFound {
+		return ctx, nil, err
+	}
+	if len(entries) > 0 {
+		level.Debug(l).Log("msg", "suppressing repeat notification during startup grace period", "group_key", gkey)
+		return ctx, nil, nil
+	}
+	return ctx, alerts, nil
+}
+
 // RetryStage notifies via passed integration with exponential backoff until it
-// succeeds. It aborts if the context is canceled or timed out.
+// succeeds. It aborts if the context is canceled or timed out, or, if
+// maxRetryDuration is positive, once that much time has passed since the
+// first attempt.
 type RetryStage struct {
-	integration Integration
-	groupName   string
-	metrics     *metrics
+	integration         Integration
+	groupName           string
+	metrics             *metrics
+	maxRetryDuration    time.Duration
+	notificationTimeout time.Duration
 }
 
-// NewRetryStage returns a new instance of a RetryStage.
-func NewRetryStage(i Integration, groupName string, metrics *metrics) *RetryStage {
+// NewRetryStage returns a new instance of a RetryStage. A maxRetryDuration of
+// zero retries for as long as ctx allows. notificationTimeout, if positive,
+// bounds how long any single Notify call may block before being cancelled;
+// a route-specific override set via WithNotificationTimeout takes
+// precedence over it.
+func NewRetryStage(i Integration, groupName string, metrics *metrics, maxRetryDuration, notificationTimeout time.Duration) *RetryStage {
 	return &RetryStage{
-		integration: i,
-		groupName:   groupName,
-		metrics:     metrics,
+		integration:         i,
+		groupName:           groupName,
+		metrics:             metrics,
+		maxRetryDuration:    maxRetryDuration,
+		notificationTimeout: notificationTimeout,
+	}
+}
+
+// fingerprintsOf returns the fingerprint of each alert, in order, for log
+// lines that need to name exactly which alerts were involved.
+func fingerprintsOf(alerts []*types.Alert) []string {
+	fps := make([]string, len(alerts))
+	for i, a := range alerts {
+		fps[i] = a.Fingerprint().String()
 	}
+	return fps
 }
 
 // Exec implements the Stage interface.
 func (r RetryStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+	if r.maxRetryDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.maxRetryDuration)
+		defer cancel()
+	}
+
 	var sent []*types.Alert
 
 	// If we shouldn't send notifications for resolved alerts, but there are only
@@ -643,6 +2186,11 @@ func (r RetryStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 	)
 	defer tick.Stop()
 
+	notificationTimeout := r.notificationTimeout
+	if d, ok := NotificationTimeout(ctx); ok {
+		notificationTimeout = d
+	}
+
 	for {
 		i++
 		// Always check the context first to not notify again.
@@ -658,13 +2206,27 @@ func (r RetryStage) Exec(ctx context.Context, l log.Logger, alerts ...*types.Ale
 
 		select {
 		case <-tick.C:
+			callCtx := ctx
+			callCancel := func() {}
+			if notificationTimeout > 0 {
+				callCtx, callCancel = context.WithTimeout(ctx, notificationTimeout)
+			}
+			callCtx, span := tracing.Start(callCtx, "notify.integration_send")
+			span.SetAttr("integration", r.integration.Name())
+			span.SetAttr("attempt", i)
+
 			now := time.Now()
-			retry, err := r.integration.Notify(ctx, sent...)
+			retry, err := r.integration.Notify(callCtx, sent...)
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.End()
+			callCancel()
 			r.metrics.notificationLatencySeconds.WithLabelValues(r.integration.Name()).Observe(time.Since(now).Seconds())
 			r.metrics.numNotifications.WithLabelValues(r.integration.Name()).Inc()
 			if err != nil {
 				r.metrics.numFailedNotifications.WithLabelValues(r.integration.Name()).Inc()
-				level.Debug(l).Log("msg", "Notify attempt failed", "attempt", i, "integration", r.integration.Name(), "receiver", r.groupName, "err", err)
+				level.Debug(l).Log("msg", "Notify attempt failed", "attempt", i, "integration", r.integration.Name(), "receiver", r.groupName, "fingerprints", fingerprintsOf(sent), "err", err)
 				if !retry {
 					return ctx, alerts, fmt.Errorf("cancelling notify retry for %q due to unrecoverable error: %s", r.integration.Name(), err)
 				}
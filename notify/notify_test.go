@@ -15,10 +15,16 @@ package notify
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,11 +32,28 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 
+	"github.com/prometheus/alertmanager/breaker"
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/history"
+	"github.com/prometheus/alertmanager/inhibit"
 	"github.com/prometheus/alertmanager/nflog"
 	"github.com/prometheus/alertmanager/nflog/nflogpb"
+	"github.com/prometheus/alertmanager/notify/correlate"
+	"github.com/prometheus/alertmanager/notify/incident"
+	"github.com/prometheus/alertmanager/notify/priority"
+	"github.com/prometheus/alertmanager/notify/receipt"
+	"github.com/prometheus/alertmanager/notify/receivermute"
+	"github.com/prometheus/alertmanager/notify/shadow"
+	"github.com/prometheus/alertmanager/pkg/timeinterval"
+	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/ratelimit"
+	"github.com/prometheus/alertmanager/report"
 	"github.com/prometheus/alertmanager/silence"
 	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/slo"
+	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
 
@@ -94,6 +117,7 @@ func TestDedupStageNeedsUpdate(t *testing.T) {
 		resolvedAlerts map[uint64]struct{}
 		repeat         time.Duration
 		resolve        bool
+		onlyOnChange   bool
 
 		res bool
 	}{
@@ -138,6 +162,17 @@ func TestDedupStageNeedsUpdate(t *testing.T) {
 			repeat:       10 * time.Minute,
 			firingAlerts: alertHashSet(1, 2, 3),
 			res:          true,
+		}, {
+			// Identical sets of alerts shouldn't update after repeat_interval
+			// when onlyOnChange is set.
+			entry: &nflogpb.Entry{
+				FiringAlerts: []uint64{1, 2, 3},
+				Timestamp:    now.Add(-11 * time.Minute),
+			},
+			repeat:       10 * time.Minute,
+			firingAlerts: alertHashSet(1, 2, 3),
+			onlyOnChange: true,
+			res:          false,
 		}, {
 			// Different sets of resolved alerts without firing alerts shouldn't update after repeat_interval.
 			entry: &nflogpb.Entry{
@@ -205,7 +240,7 @@ func TestDedupStageNeedsUpdate(t *testing.T) {
 			now: func() time.Time { return now },
 			rs:  sendResolved(c.resolve),
 		}
-		res := s.needsUpdate(c.entry, c.firingAlerts, c.resolvedAlerts, c.repeat)
+		res := s.needsUpdate(c.entry, c.firingAlerts, c.resolvedAlerts, c.repeat, c.onlyOnChange)
 		require.Equal(t, c.res, res)
 	}
 }
@@ -295,6 +330,114 @@ func TestDedupStage(t *testing.T) {
 	require.Equal(t, alerts, res, "unexpected alerts returned")
 }
 
+func TestStartupSuppressStage(t *testing.T) {
+	now := utcNow()
+	recv := &nflogpb.Receiver{GroupName: "test"}
+	alerts := []*types.Alert{{}, {}}
+
+	// Outside the grace period, alerts pass through unaffected regardless
+	// of notification log contents.
+	s := NewStartupSuppressStage(&testNflog{qerr: errors.New("must not be queried")}, recv, now.Add(-time.Hour), time.Minute)
+	s.now = func() time.Time { return now }
+	ctx := WithGroupKey(context.Background(), "1")
+	_, res, err := s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Equal(t, alerts, res)
+
+	// Inside the grace period, a group with no prior notification log entry
+	// passes through.
+	s = NewStartupSuppressStage(&testNflog{qerr: nflog.ErrNotFound}, recv, now, time.Hour)
+	s.now = func() time.Time { return now }
+	_, res, err = s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Equal(t, alerts, res)
+
+	// Inside the grace period, a group with a prior notification log entry
+	// is suppressed.
+	s = NewStartupSuppressStage(&testNflog{qres: []*nflogpb.Entry{{FiringAlerts: []uint64{0, 1}}}}, recv, now, time.Hour)
+	s.now = func() time.Time { return now }
+	_, res, err = s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	// Missing group key is an error.
+	s = NewStartupSuppressStage(&testNflog{qres: []*nflogpb.Entry{{}}}, recv, now, time.Hour)
+	s.now = func() time.Time { return now }
+	_, _, err = s.Exec(context.Background(), log.NewNopLogger(), alerts...)
+	require.EqualError(t, err, "group key missing")
+
+	// Notification log query errors are propagated.
+	s = NewStartupSuppressStage(&testNflog{qerr: errors.New("bad things")}, recv, now, time.Hour)
+	s.now = func() time.Time { return now }
+	_, _, err = s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.EqualError(t, err, "bad things")
+}
+
+func TestDiffStage(t *testing.T) {
+	now := utcNow()
+	recv := &nflogpb.Receiver{GroupName: "test"}
+
+	newAlert := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"alertname": "New"},
+		},
+	}
+	changedAlert := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"alertname": "Changed"},
+		},
+		UpdatedAt: now,
+	}
+	unchangedAlert := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"alertname": "Unchanged"},
+		},
+		UpdatedAt: now.Add(-time.Hour),
+	}
+	alerts := []*types.Alert{newAlert, changedAlert, unchangedAlert}
+
+	ctx := WithGroupKey(context.Background(), "1")
+
+	// Nothing to diff against yet: every firing alert is new.
+	s := NewDiffStage(&testNflog{qerr: nflog.ErrNotFound}, recv)
+	resCtx, res, err := s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Equal(t, alerts, res)
+	diff, ok := Diff(resCtx)
+	require.True(t, ok)
+	require.ElementsMatch(t, []string{
+		newAlert.Fingerprint().String(),
+		changedAlert.Fingerprint().String(),
+		unchangedAlert.Fingerprint().String(),
+	}, diff.NewFingerprints)
+	require.Empty(t, diff.ChangedFingerprints)
+
+	// With a prior entry, alerts already in the firing set are either
+	// unchanged or changed depending on whether they were updated after
+	// the prior notification; alerts missing from the firing set are new.
+	s = NewDiffStage(&testNflog{qres: []*nflogpb.Entry{{
+		FiringAlerts: []uint64{hashAlert(changedAlert), hashAlert(unchangedAlert)},
+		Timestamp:    now.Add(-time.Minute),
+	}}}, recv)
+	resCtx, res, err = s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Equal(t, alerts, res)
+	diff, ok = Diff(resCtx)
+	require.True(t, ok)
+	require.Equal(t, []string{newAlert.Fingerprint().String()}, diff.NewFingerprints)
+	require.Equal(t, []string{changedAlert.Fingerprint().String()}, diff.ChangedFingerprints)
+
+	// Missing group key is an error.
+	s = NewDiffStage(&testNflog{qres: []*nflogpb.Entry{{}}}, recv)
+	_, _, err = s.Exec(context.Background(), log.NewNopLogger(), alerts...)
+	require.EqualError(t, err, "group key missing")
+
+	// Notification log query errors are propagated.
+	s = NewDiffStage(&testNflog{qerr: errors.New("bad things")}, recv)
+	_, _, err = s.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.EqualError(t, err, "bad things")
+}
+
 func TestMultiStage(t *testing.T) {
 	var (
 		alerts1 = []*types.Alert{{}}
@@ -332,6 +475,363 @@ func TestMultiStage(t *testing.T) {
 	}
 }
 
+func TestLimitStage(t *testing.T) {
+	var (
+		inFlight    int32
+		maxInFlight int32
+		release     = make(chan struct{})
+	)
+	next := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			old := atomic.LoadInt32(&maxInFlight)
+			if n <= old || atomic.CompareAndSwapInt32(&maxInFlight, old, n) {
+				break
+			}
+		}
+		<-release
+		atomic.AddInt32(&inFlight, -1)
+		return ctx, alerts, nil
+	})
+
+	metrics := newMetrics(prometheus.NewRegistry())
+	stage := NewLimitStage(next, "test", 2, metrics)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+		}()
+	}
+
+	// Give the goroutines a chance to pile up against the semaphore before
+	// letting any of them complete.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.True(t, atomic.LoadInt32(&maxInFlight) <= 2)
+}
+
+func TestPriorityStage(t *testing.T) {
+	gate := priority.NewGate(1, 0)
+	require.NoError(t, gate.Acquire(context.Background(), 0))
+
+	next := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, alerts, nil
+	})
+	stage := NewPriorityStage(next, gate, "severity", map[string]int{"critical": 0, "warning": 5})
+
+	var order []string
+	var wg sync.WaitGroup
+	started := make(chan struct{}, 2)
+	for _, severity := range []string{"warning", "critical"} { // warning queues first, critical second
+		severity := severity
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			started <- struct{}{}
+			time.Sleep(20 * time.Millisecond) // ensure both are queued before the gate is released
+			alert := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"severity": model.LabelValue(severity)}}}
+			stage.Exec(context.Background(), log.NewNopLogger(), alert)
+			order = append(order, severity)
+		}()
+		<-started
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	gate.Release()
+	wg.Wait()
+
+	require.Equal(t, []string{"critical", "warning"}, order)
+}
+
+func TestShadowStage(t *testing.T) {
+	tmpl, err := template.FromGlobs()
+	require.NoError(t, err)
+	tmpl.ExternalURL, err = url.Parse("http://am")
+	require.NoError(t, err)
+
+	tracker := shadow.New(0)
+	stage := NewShadowStage("team-x", "slack", tracker, tmpl)
+
+	ctx := WithGroupKey(context.Background(), "1")
+	alerts := []*types.Alert{{}}
+
+	_, resultAlerts, err := stage.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Equal(t, alerts, resultAlerts)
+
+	records := tracker.List()
+	require.Len(t, records, 1)
+	require.Equal(t, "team-x", records[0].Receiver)
+	require.Equal(t, "slack", records[0].Integration)
+	require.Equal(t, "1", records[0].GroupKey)
+}
+
+func TestReceiptStage(t *testing.T) {
+	var got receipt.Receipt
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sink := receipt.New(log.NewNopLogger())
+	sink.Set(srv.URL)
+
+	next := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, alerts, nil
+	})
+	stage := NewReceiptStage(next, sink, "team-x", "slack")
+
+	ctx := WithGroupKey(context.Background(), "1")
+	alerts := []*types.Alert{{}}
+
+	_, resultAlerts, err := stage.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Equal(t, alerts, resultAlerts)
+	require.Equal(t, "team-x", got.Receiver)
+	require.Equal(t, "slack", got.Integration)
+	require.Equal(t, receipt.OutcomeSuccess, got.Outcome)
+}
+
+func TestReceiptStageFailure(t *testing.T) {
+	var got receipt.Receipt
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sink := receipt.New(log.NewNopLogger())
+	sink.Set(srv.URL)
+
+	next := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, nil, errors.New("boom")
+	})
+	stage := NewReceiptStage(next, sink, "team-x", "slack")
+
+	ctx := WithGroupKey(context.Background(), "1")
+	_, _, err := stage.Exec(ctx, log.NewNopLogger(), []*types.Alert{{}}...)
+	require.EqualError(t, err, "boom")
+	require.Equal(t, receipt.OutcomeFailure, got.Outcome)
+	require.Equal(t, "boom", got.Error)
+}
+
+func TestIncidentStageNewIncident(t *testing.T) {
+	tracker := incident.New(5 * time.Minute)
+	stage := NewIncidentStage(tracker)
+
+	ctx := WithGroupKey(context.Background(), "group1")
+	ctx = WithNow(ctx, time.Now())
+	ctx = WithFiringAlerts(ctx, []uint64{1})
+
+	ctx, alerts, err := stage.Exec(ctx, log.NewNopLogger(), []*types.Alert{{}}...)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+
+	ref, ok := IncidentRef(ctx)
+	require.True(t, ok)
+	require.NotEmpty(t, ref)
+	require.False(t, IsIncidentContinuation(ctx))
+}
+
+func TestIncidentStageContinuation(t *testing.T) {
+	tracker := incident.New(5 * time.Minute)
+	stage := NewIncidentStage(tracker)
+	now := time.Now()
+
+	ctx := WithGroupKey(context.Background(), "group1")
+	ctx = WithNow(ctx, now)
+	ctx = WithFiringAlerts(ctx, []uint64{1})
+	ctx, _, err := stage.Exec(ctx, log.NewNopLogger(), []*types.Alert{{}}...)
+	require.NoError(t, err)
+	firstRef, _ := IncidentRef(ctx)
+
+	ctx = WithGroupKey(context.Background(), "group1")
+	ctx = WithNow(ctx, now.Add(time.Minute))
+	ctx = WithFiringAlerts(ctx, nil)
+	ctx, _, err = stage.Exec(ctx, log.NewNopLogger(), []*types.Alert{{}}...)
+	require.NoError(t, err)
+
+	ctx = WithGroupKey(context.Background(), "group1")
+	ctx = WithNow(ctx, now.Add(2*time.Minute))
+	ctx = WithFiringAlerts(ctx, []uint64{1})
+	ctx, _, err = stage.Exec(ctx, log.NewNopLogger(), []*types.Alert{{}}...)
+	require.NoError(t, err)
+
+	secondRef, ok := IncidentRef(ctx)
+	require.True(t, ok)
+	require.Equal(t, firstRef, secondRef)
+	require.True(t, IsIncidentContinuation(ctx))
+}
+
+func TestIncidentStageMissingGroupKey(t *testing.T) {
+	stage := NewIncidentStage(incident.New(time.Minute))
+	_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), []*types.Alert{{}}...)
+	require.Error(t, err)
+}
+
+func TestCorrelationStage(t *testing.T) {
+	tracker := correlate.New([]model.LabelName{"cluster"}, time.Minute)
+	stage := NewCorrelationStage(tracker)
+	now := time.Now()
+
+	ctx := WithGroupKey(context.Background(), "group1")
+	ctx = WithGroupLabels(ctx, model.LabelSet{"cluster": "prod"})
+	ctx = WithNow(ctx, now)
+	ctx = WithFiringAlerts(ctx, []uint64{1})
+	ctx, _, err := stage.Exec(ctx, log.NewNopLogger(), []*types.Alert{{}}...)
+	require.NoError(t, err)
+	related, ok := RelatedGroups(ctx)
+	require.True(t, ok)
+	require.Equal(t, 0, related)
+
+	ctx = WithGroupKey(context.Background(), "group2")
+	ctx = WithGroupLabels(ctx, model.LabelSet{"cluster": "prod"})
+	ctx = WithNow(ctx, now)
+	ctx = WithFiringAlerts(ctx, []uint64{1})
+	ctx, _, err = stage.Exec(ctx, log.NewNopLogger(), []*types.Alert{{}}...)
+	require.NoError(t, err)
+	related, ok = RelatedGroups(ctx)
+	require.True(t, ok)
+	require.Equal(t, 1, related)
+}
+
+func TestCorrelationStageDisabled(t *testing.T) {
+	tracker := correlate.New(nil, 0)
+	stage := NewCorrelationStage(tracker)
+
+	ctx := WithGroupKey(context.Background(), "group1")
+	ctx = WithGroupLabels(ctx, model.LabelSet{"cluster": "prod"})
+	ctx = WithNow(ctx, time.Now())
+	ctx, _, err := stage.Exec(ctx, log.NewNopLogger(), []*types.Alert{{}}...)
+	require.NoError(t, err)
+	_, ok := RelatedGroups(ctx)
+	require.False(t, ok)
+}
+
+func TestDigestStageRecordsOutcome(t *testing.T) {
+	tracker := report.NewTracker()
+
+	ok := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, alerts, nil
+	})
+	stage := NewDigestStage(ok, tracker, "slack")
+	_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	require.NoError(t, err)
+
+	failing := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, alerts, errors.New("unreachable")
+	})
+	stage = NewDigestStage(failing, tracker, "slack")
+	_, _, err = stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	require.Error(t, err)
+
+	summary := tracker.Snapshot(time.Now())
+	require.Equal(t, 2, summary.Notifications)
+	require.Equal(t, 1, summary.NotificationFailures)
+	require.Equal(t, map[string]int{"slack": 1}, summary.FailuresByIntegration)
+}
+
+func TestPagingLatencyStageRecordsAndReportsBreach(t *testing.T) {
+	tracker := slo.New(nil)
+	tracker.Configure(map[string]slo.Settings{"slack": {Objective: time.Millisecond}})
+
+	ok := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, alerts, nil
+	})
+
+	var breached []string
+	stage := NewPagingLatencyStage(ok, tracker, "slack", func(receiver string) {
+		breached = append(breached, receiver)
+	})
+
+	alert := &types.Alert{Alert: model.Alert{StartsAt: time.Now().Add(-time.Hour)}}
+	for i := 0; i < 200; i++ {
+		_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), alert)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []string{"slack"}, breached)
+
+	failing := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, nil, errors.New("unreachable")
+	})
+	stage = NewPagingLatencyStage(failing, tracker, "slack", func(receiver string) {
+		t.Fatalf("onBreach should not be called for a failed delivery")
+	})
+	_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), alert)
+	require.Error(t, err)
+}
+
+func TestRedactionStageRedactsOnlyTheDeliveredCopy(t *testing.T) {
+	var rcv config.Receiver
+	require.NoError(t, yaml.Unmarshal([]byte(`
+name: payments
+redactions:
+  - label_pattern: ssn
+`), &rcv))
+
+	var gotLabels model.LabelSet
+	deliver := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		gotLabels = alerts[0].Labels
+		return ctx, alerts, nil
+	})
+	stage := NewRedactionStage(deliver, rcv.Redactions)
+
+	alert := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{
+		"alertname": "Test",
+		"ssn":       "123-45-6789",
+	}}}
+	_, alerts, err := stage.Exec(context.Background(), log.NewNopLogger(), alert)
+	require.NoError(t, err)
+
+	require.Equal(t, model.LabelValue("<redacted>"), gotLabels["ssn"])
+	require.Equal(t, model.LabelValue("123-45-6789"), alerts[0].Labels["ssn"],
+		"the alert returned to the rest of the pipeline must keep its original value")
+}
+
+func TestCircuitBreakerStageOpensAfterThreshold(t *testing.T) {
+	tracker := breaker.New(nil)
+	tracker.Configure(map[string]breaker.Settings{"slack": {FailureThreshold: 1, CooldownPeriod: time.Hour}})
+
+	failing := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, nil, errors.New("unreachable")
+	})
+	stage := NewCircuitBreakerStage(failing, tracker, "slack", nil)
+
+	_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	require.EqualError(t, err, "unreachable")
+
+	_, _, err = stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	require.EqualError(t, err, `circuit breaker open for receiver "slack"`)
+}
+
+func TestCircuitBreakerStageRoutesToFallbackWhenOpen(t *testing.T) {
+	tracker := breaker.New(nil)
+	tracker.Configure(map[string]breaker.Settings{"slack": {FailureThreshold: 1, CooldownPeriod: time.Hour}})
+
+	failing := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, nil, errors.New("unreachable")
+	})
+	fallback := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		return ctx, alerts, nil
+	})
+	stage := NewCircuitBreakerStage(failing, tracker, "slack", fallback)
+
+	alerts := []*types.Alert{{}}
+	_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), alerts...)
+	require.Error(t, err)
+
+	_, resultAlerts, err := stage.Exec(context.Background(), log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.Equal(t, alerts, resultAlerts)
+}
+
 func TestMultiStageFailure(t *testing.T) {
 	var (
 		ctx   = context.Background()
@@ -345,6 +845,60 @@ func TestMultiStageFailure(t *testing.T) {
 	}
 }
 
+func TestRateLimitStageAllowsWithinBudget(t *testing.T) {
+	tracker := ratelimit.New(nil)
+	tracker.Configure(map[string]ratelimit.Settings{"slack": {PerMinute: 60, Burst: 1}})
+
+	var called int32
+	next := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		atomic.AddInt32(&called, 1)
+		return ctx, alerts, nil
+	})
+	stage := NewRateLimitStage(next, tracker, "slack")
+
+	_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, atomic.LoadInt32(&called))
+}
+
+func TestRateLimitStageDropsOverBudget(t *testing.T) {
+	tracker := ratelimit.New(nil)
+	tracker.Configure(map[string]ratelimit.Settings{"slack": {PerMinute: 60, Burst: 1, Overflow: ratelimit.OverflowDrop}})
+
+	var called int32
+	next := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		atomic.AddInt32(&called, 1)
+		return ctx, alerts, nil
+	})
+	stage := NewRateLimitStage(next, tracker, "slack")
+
+	_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	require.NoError(t, err)
+
+	_, alerts, err := stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	require.NoError(t, err)
+	require.Nil(t, alerts)
+	require.EqualValues(t, 1, atomic.LoadInt32(&called))
+}
+
+func TestRateLimitStageCollapsesOverBudget(t *testing.T) {
+	tracker := ratelimit.New(nil)
+	tracker.Configure(map[string]ratelimit.Settings{"slack": {PerMinute: 60, Burst: 1, Overflow: ratelimit.OverflowCollapse}})
+
+	var received []*types.Alert
+	next := StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+		received = alerts
+		return ctx, alerts, nil
+	})
+	stage := NewRateLimitStage(next, tracker, "slack")
+
+	stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{})
+	_, _, err := stage.Exec(context.Background(), log.NewNopLogger(), &types.Alert{}, &types.Alert{})
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	require.Equal(t, model.LabelValue("1 notifications suppressed by rate limit"), received[0].Annotations["summary"])
+}
+
 func TestRoutingStage(t *testing.T) {
 	var (
 		alerts1 = []*types.Alert{{}}
@@ -373,6 +927,47 @@ func TestRoutingStage(t *testing.T) {
 	}
 }
 
+func TestRoutingStageFallback(t *testing.T) {
+	alerts1 := []*types.Alert{{}}
+	alerts2 := []*types.Alert{{}, {}}
+
+	stage := RoutingStage{
+		"fallback": StageFunc(func(ctx context.Context, l log.Logger, alerts ...*types.Alert) (context.Context, []*types.Alert, error) {
+			if !reflect.DeepEqual(alerts, alerts1) {
+				t.Fatal("Input not equal to input of RoutingStage")
+			}
+			return ctx, alerts2, nil
+		}),
+	}
+
+	ctx := WithReceiverName(context.Background(), "team-a-slack")
+	ctx = WithReceiverFallback(ctx, "fallback")
+
+	rctx, alerts, err := stage.Exec(ctx, log.NewNopLogger(), alerts1...)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if !reflect.DeepEqual(alerts, alerts2) {
+		t.Fatal("Output of RoutingStage is not equal to the output of the inner stage")
+	}
+	if rcv, _ := ReceiverName(rctx); rcv != "fallback" {
+		t.Errorf("expected receiver rewritten to fallback, got %q", rcv)
+	}
+}
+
+func TestRoutingStageNoFallback(t *testing.T) {
+	stage := RoutingStage{
+		"name": failStage{},
+	}
+
+	ctx := WithReceiverName(context.Background(), "missing")
+
+	_, _, err := stage.Exec(ctx, log.NewNopLogger(), &types.Alert{})
+	if err == nil || err.Error() != "stage for receiver missing" {
+		t.Fatalf("expected %q, got %v", "stage for receiver missing", err)
+	}
+}
+
 func TestRetryStageWithError(t *testing.T) {
 	fail, retry := true, true
 	sent := []*types.Alert{}
@@ -521,6 +1116,102 @@ func TestRetryStageSendResolved(t *testing.T) {
 	require.NotNil(t, resctx)
 }
 
+func TestRetryStageMaxRetryDuration(t *testing.T) {
+	i := Integration{
+		notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+			return true, errors.New("fail to deliver notification")
+		}),
+		rs: sendResolved(false),
+	}
+	r := RetryStage{
+		integration:      i,
+		metrics:          newMetrics(prometheus.NewRegistry()),
+		maxRetryDuration: 10 * time.Millisecond,
+	}
+
+	alerts := []*types.Alert{
+		&types.Alert{
+			Alert: model.Alert{
+				EndsAt: time.Now().Add(time.Hour),
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = WithFiringAlerts(ctx, []uint64{0})
+
+	start := time.Now()
+	_, _, err := r.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.Error(t, err)
+	require.True(t, time.Since(start) < time.Second, "retries should have stopped once maxRetryDuration elapsed")
+}
+
+func TestRetryStageNotificationTimeoutCancelsCall(t *testing.T) {
+	var sawDeadline bool
+	i := Integration{
+		notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+			_, sawDeadline = ctx.Deadline()
+			<-ctx.Done()
+			// Unrecoverable: the call's own deadline, not a retryable
+			// failure, is what we're testing for here.
+			return false, ctx.Err()
+		}),
+		rs: sendResolved(false),
+	}
+	r := RetryStage{
+		integration:         i,
+		metrics:             newMetrics(prometheus.NewRegistry()),
+		notificationTimeout: 10 * time.Millisecond,
+	}
+
+	alerts := []*types.Alert{
+		&types.Alert{
+			Alert: model.Alert{
+				EndsAt: time.Now().Add(time.Hour),
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = WithFiringAlerts(ctx, []uint64{0})
+
+	_, _, err := r.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.Error(t, err)
+	require.True(t, sawDeadline, "expected each call's context to carry the per-call notification timeout deadline")
+}
+
+func TestRetryStageNotificationTimeoutOverriddenByContext(t *testing.T) {
+	var gotDeadline bool
+	i := Integration{
+		notifier: notifierFunc(func(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+			_, gotDeadline = ctx.Deadline()
+			return true, nil
+		}),
+		rs: sendResolved(false),
+	}
+	r := RetryStage{
+		integration:         i,
+		metrics:             newMetrics(prometheus.NewRegistry()),
+		notificationTimeout: time.Hour,
+	}
+
+	alerts := []*types.Alert{
+		&types.Alert{
+			Alert: model.Alert{
+				EndsAt: time.Now().Add(time.Hour),
+			},
+		},
+	}
+
+	ctx := context.Background()
+	ctx = WithFiringAlerts(ctx, []uint64{0})
+	ctx = WithNotificationTimeout(ctx, 5*time.Millisecond)
+
+	_, _, err := r.Exec(ctx, log.NewNopLogger(), alerts...)
+	require.NoError(t, err)
+	require.True(t, gotDeadline, "expected the route-specific override from the context to apply a per-call deadline")
+}
+
 func TestSetNotifiesStage(t *testing.T) {
 	tnflog := &testNflog{}
 	s := &SetNotifiesStage{
@@ -581,7 +1272,7 @@ func TestSetNotifiesStage(t *testing.T) {
 
 func TestMuteStage(t *testing.T) {
 	// Mute all label sets that have a "mute" key.
-	muter := types.MuteFunc(func(lset model.LabelSet) bool {
+	muter := types.MuteFunc(func(lset, annotations model.LabelSet) bool {
 		_, ok := lset["mute"]
 		return ok
 	})
@@ -627,6 +1318,72 @@ func TestMuteStage(t *testing.T) {
 	}
 }
 
+func TestTimeMuteStage(t *testing.T) {
+	intervals := map[string][]timeinterval.TimeInterval{
+		"nights": {{Times: []timeinterval.TimeRange{{StartMinute: 20 * 60, EndMinute: 24 * 60}}}},
+	}
+	stage := NewTimeMuteStage(intervals)
+
+	alerts := []*types.Alert{{Alert: model.Alert{Labels: model.LabelSet{"foo": "bar"}}}}
+
+	ctx := context.Background()
+	ctx = WithNow(ctx, time.Date(2020, 1, 6, 21, 0, 0, 0, time.UTC))
+	ctx = WithMuteTimeIntervalNames(ctx, []string{"nights"})
+
+	_, got, err := stage.Exec(ctx, log.NewNopLogger(), alerts...)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected alerts to be muted during the configured time interval, got %v", got)
+	}
+
+	ctx = context.Background()
+	ctx = WithNow(ctx, time.Date(2020, 1, 6, 9, 0, 0, 0, time.UTC))
+	ctx = WithMuteTimeIntervalNames(ctx, []string{"nights"})
+
+	_, got, err = stage.Exec(ctx, log.NewNopLogger(), alerts...)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("expected alerts to pass through outside the configured time interval, got %v", got)
+	}
+}
+
+func TestTimeMuteStageActiveTimeIntervals(t *testing.T) {
+	intervals := map[string][]timeinterval.TimeInterval{
+		"business-hours": {{Times: []timeinterval.TimeRange{{StartMinute: 9 * 60, EndMinute: 17 * 60}}}},
+	}
+	stage := NewTimeMuteStage(intervals)
+
+	alerts := []*types.Alert{{Alert: model.Alert{Labels: model.LabelSet{"foo": "bar"}}}}
+
+	ctx := context.Background()
+	ctx = WithNow(ctx, time.Date(2020, 1, 6, 21, 0, 0, 0, time.UTC))
+	ctx = WithActiveTimeIntervalNames(ctx, []string{"business-hours"})
+
+	_, got, err := stage.Exec(ctx, log.NewNopLogger(), alerts...)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected alerts to be muted outside every active time interval, got %v", got)
+	}
+
+	ctx = context.Background()
+	ctx = WithNow(ctx, time.Date(2020, 1, 6, 12, 0, 0, 0, time.UTC))
+	ctx = WithActiveTimeIntervalNames(ctx, []string{"business-hours"})
+
+	_, got, err = stage.Exec(ctx, log.NewNopLogger(), alerts...)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("expected alerts to pass through inside an active time interval, got %v", got)
+	}
+}
+
 func TestMuteStageWithSilences(t *testing.T) {
 	silences, err := silence.New(silence.Options{Retention: time.Hour})
 	if err != nil {
@@ -719,3 +1476,171 @@ func TestMuteStageWithSilences(t *testing.T) {
 		t.Fatalf("Unmuting failed, expected: %v\ngot %v", in, got)
 	}
 }
+
+func TestHistoryStage(t *testing.T) {
+	h := history.New(0)
+	stage := NewHistoryStage(h, "team-X-receiver", "webhook")
+
+	alert := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"alertname": "test"}}}
+
+	_, alerts, err := stage.Exec(context.Background(), log.NewNopLogger(), alert)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(alerts))
+	}
+
+	events := h.Get(alert.Fingerprint())
+	require.Len(t, events, 1)
+	require.Equal(t, history.EventNotified, events[0].Type)
+	require.Equal(t, "team-X-receiver/webhook", events[0].Detail)
+}
+
+func TestSilenceHistoryStage(t *testing.T) {
+	silences, err := silence.New(silence.Options{Retention: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	silID, err := silences.Set(&silencepb.Silence{
+		EndsAt:   utcNow().Add(time.Hour),
+		Matchers: []*silencepb.Matcher{{Name: "mute", Pattern: "me"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	silencer := silence.NewSilencer(silences, marker, log.NewNopLogger())
+	h := history.New(0)
+	stage := NewSilenceHistoryStage(NewMuteStage(silencer), silencer, h)
+
+	muted := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"mute": "me"}}}
+	unmuted := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"not": "muted"}}}
+
+	_, alerts, err := stage.Exec(context.Background(), log.NewNopLogger(), muted, unmuted)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("expected 1 alert to pass through, got %d", len(alerts))
+	}
+
+	mutedEvents := h.Get(muted.Fingerprint())
+	require.Len(t, mutedEvents, 1)
+	require.Equal(t, history.EventSilenced, mutedEvents[0].Type)
+	require.Equal(t, silID, mutedEvents[0].Detail)
+
+	require.Len(t, h.Get(unmuted.Fingerprint()), 0)
+}
+
+func TestInhibitHistoryStage(t *testing.T) {
+	now := time.Now()
+	source := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"s": "1", "e": "f"},
+			StartsAt: now.Add(-time.Minute),
+			EndsAt:   now.Add(time.Hour),
+		},
+	}
+
+	ap := newInhibitTestAlerts(source)
+	marker := types.NewMarker(prometheus.NewRegistry())
+	inhibitor := inhibit.NewInhibitor(ap, []*config.InhibitRule{{
+		SourceMatch: map[string]string{"s": "1"},
+		TargetMatch: map[string]string{"t": "1"},
+		Equal:       model.LabelNames{"e"},
+	}}, marker, log.NewNopLogger())
+
+	go func() {
+		for ap.finished != nil {
+			select {
+			case <-ap.finished:
+				ap.finished = nil
+			default:
+			}
+		}
+		inhibitor.Stop()
+	}()
+	inhibitor.Run()
+
+	h := history.New(0)
+	stage := NewInhibitHistoryStage(NewMuteStage(inhibitor), inhibitor, h)
+
+	inhibited := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"t": "1", "e": "f"}}}
+	uninhibited := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"t": "1", "e": "g"}}}
+
+	_, alerts, err := stage.Exec(context.Background(), log.NewNopLogger(), inhibited, uninhibited)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+
+	events := h.Get(inhibited.Fingerprint())
+	require.Len(t, events, 1)
+	require.Equal(t, history.EventInhibited, events[0].Type)
+	require.Equal(t, source.Fingerprint().String(), events[0].Detail)
+
+	require.Len(t, h.Get(uninhibited.Fingerprint()), 0)
+}
+
+// inhibitTestAlerts is a minimal provider.Alerts that feeds a fixed set of
+// alerts to an Inhibitor's Run loop, so inhibition rules can be exercised
+// without a full alert store.
+type inhibitTestAlerts struct {
+	alerts   []*types.Alert
+	finished chan struct{}
+}
+
+func newInhibitTestAlerts(alerts ...*types.Alert) *inhibitTestAlerts {
+	return &inhibitTestAlerts{alerts: alerts, finished: make(chan struct{})}
+}
+
+func (f *inhibitTestAlerts) GetPending() provider.AlertIterator          { return nil }
+func (f *inhibitTestAlerts) Get(model.Fingerprint) (*types.Alert, error) { return nil, nil }
+func (f *inhibitTestAlerts) Put(...*types.Alert) error                   { return nil }
+func (f *inhibitTestAlerts) Subscribe() provider.AlertIterator {
+	ch := make(chan *types.Alert)
+	done := make(chan struct{})
+	go func() {
+		for _, a := range f.alerts {
+			ch <- a
+		}
+		close(f.finished)
+		<-done
+	}()
+	return provider.NewAlertIterator(ch, done, nil)
+}
+
+func TestReceiverMuteStage(t *testing.T) {
+	tracker := receivermute.New()
+	stage := NewReceiverMuteStage(tracker, "team-x-pager")
+
+	alert := &types.Alert{Alert: model.Alert{Labels: model.LabelSet{"alertname": "test"}}}
+
+	_, alerts, err := stage.Exec(context.Background(), log.NewNopLogger(), alert)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("expected alert to pass through while unmuted, got %d alerts", len(alerts))
+	}
+
+	tracker.Mute("team-x-pager", time.Hour)
+
+	_, alerts, err = stage.Exec(context.Background(), log.NewNopLogger(), alert)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(alerts) != 0 {
+		t.Fatalf("expected alert to be dropped while muted, got %d alerts", len(alerts))
+	}
+
+	tracker.Unmute("team-x-pager")
+
+	_, alerts, err = stage.Exec(context.Background(), log.NewNopLogger(), alert)
+	if err != nil {
+		t.Fatalf("Exec failed: %s", err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("expected alert to pass through after unmute, got %d alerts", len(alerts))
+	}
+}
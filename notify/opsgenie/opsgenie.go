@@ -24,7 +24,6 @@ import (
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
 	"github.com/pkg/errors"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
 
 	"github.com/prometheus/alertmanager/config"
@@ -44,7 +43,7 @@ type Notifier struct {
 
 // New returns a new OpsGenie notifier.
 func New(c *config.OpsGenieConfig, t *template.Template, l log.Logger) (*Notifier, error) {
-	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "opsgenie", false)
+	client, err := config.NewClient(c.HTTPConfig, "opsgenie")
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +176,11 @@ func (n *Notifier) createRequest(ctx context.Context, as ...*types.Alert) (*http
 		}
 	}
 
-	apiKey := tmpl(string(n.conf.APIKey))
+	resolvedKey, keyErr := notify.ResolveSecret(n.conf.APIKey, n.conf.APIKeyFile)
+	if keyErr != nil {
+		return nil, false, keyErr
+	}
+	apiKey := tmpl(resolvedKey)
 
 	if err != nil {
 		return nil, false, errors.Wrap(err, "templating error")
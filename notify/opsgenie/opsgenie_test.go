@@ -23,7 +23,6 @@ import (
 	"time"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
@@ -36,7 +35,7 @@ import (
 func TestOpsGenieRetry(t *testing.T) {
 	notifier, err := New(
 		&config.OpsGenieConfig{
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -59,7 +58,7 @@ func TestOpsGenieRedactedURL(t *testing.T) {
 		&config.OpsGenieConfig{
 			APIURL:     &config.URL{URL: u},
 			APIKey:     config.Secret(key),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -98,7 +97,7 @@ func TestOpsGenie(t *testing.T) {
 		Priority:   `{{ .CommonLabels.Priority }}`,
 		APIKey:     `{{ .ExternalURL }}`,
 		APIURL:     &config.URL{URL: u},
-		HTTPConfig: &commoncfg.HTTPClientConfig{},
+		HTTPConfig: &config.HTTPClientConfig{},
 	}
 	notifier, err := New(conf, tmpl, logger)
 	require.NoError(t, err)
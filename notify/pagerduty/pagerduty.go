@@ -25,7 +25,6 @@ import (
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
 	"github.com/pkg/errors"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
 
 	"github.com/prometheus/alertmanager/config"
@@ -46,12 +45,12 @@ type Notifier struct {
 
 // New returns a new PagerDuty notifier.
 func New(c *config.PagerdutyConfig, t *template.Template, l log.Logger) (*Notifier, error) {
-	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "pagerduty", false)
+	client, err := config.NewClient(c.HTTPConfig, "pagerduty")
 	if err != nil {
 		return nil, err
 	}
 	n := &Notifier{conf: c, tmpl: t, logger: l, client: client}
-	if c.ServiceKey != "" {
+	if c.ServiceKey != "" || c.ServiceKeyFile != "" {
 		n.apiV1 = "https://events.pagerduty.com/generic/2010-04-15/create_event.json"
 		// Retrying can solve the issue on 403 (rate limiting) and 5xx response codes.
 		// https://v2.developer.pagerduty.com/docs/trigger-events
@@ -115,6 +114,11 @@ func (n *Notifier) notifyV1(
 	details map[string]string,
 	as ...*types.Alert,
 ) (bool, error) {
+	serviceKey, err := notify.ResolveSecret(n.conf.ServiceKey, n.conf.ServiceKeyFile)
+	if err != nil {
+		return false, err
+	}
+
 	var tmplErr error
 	tmpl := notify.TmplText(n.tmpl, data, &tmplErr)
 
@@ -124,7 +128,7 @@ func (n *Notifier) notifyV1(
 	}
 
 	msg := &pagerDutyMessage{
-		ServiceKey:  tmpl(string(n.conf.ServiceKey)),
+		ServiceKey:  tmpl(serviceKey),
 		EventType:   eventType,
 		IncidentKey: key.Hash(),
 		Description: description,
@@ -167,6 +171,11 @@ func (n *Notifier) notifyV2(
 	details map[string]string,
 	as ...*types.Alert,
 ) (bool, error) {
+	routingKey, err := notify.ResolveSecret(n.conf.RoutingKey, n.conf.RoutingKeyFile)
+	if err != nil {
+		return false, err
+	}
+
 	var tmplErr error
 	tmpl := notify.TmplText(n.tmpl, data, &tmplErr)
 
@@ -182,7 +191,7 @@ func (n *Notifier) notifyV2(
 	msg := &pagerDutyMessage{
 		Client:      tmpl(n.conf.Client),
 		ClientURL:   tmpl(n.conf.ClientURL),
-		RoutingKey:  tmpl(string(n.conf.RoutingKey)),
+		RoutingKey:  tmpl(routingKey),
 		EventAction: eventType,
 		DedupKey:    key.Hash(),
 		Images:      make([]pagerDutyImage, len(n.conf.Images)),
@@ -238,6 +247,9 @@ func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error)
 	if err != nil {
 		return false, err
 	}
+	if dedupKey, ok := notify.DedupKey(ctx); ok {
+		key = notify.Key(dedupKey)
+	}
 
 	var (
 		alerts    = types.Alerts(as...)
@@ -26,7 +26,6 @@ import (
 	"time"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
@@ -40,7 +39,7 @@ func TestPagerDutyRetryV1(t *testing.T) {
 	notifier, err := New(
 		&config.PagerdutyConfig{
 			ServiceKey: config.Secret("01234567890123456789012345678901"),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -58,7 +57,7 @@ func TestPagerDutyRetryV2(t *testing.T) {
 	notifier, err := New(
 		&config.PagerdutyConfig{
 			RoutingKey: config.Secret("01234567890123456789012345678901"),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -80,7 +79,7 @@ func TestPagerDutyRedactedURLV1(t *testing.T) {
 	notifier, err := New(
 		&config.PagerdutyConfig{
 			ServiceKey: config.Secret(key),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -100,7 +99,7 @@ func TestPagerDutyRedactedURLV2(t *testing.T) {
 		&config.PagerdutyConfig{
 			URL:        &config.URL{URL: u},
 			RoutingKey: config.Secret(key),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -200,7 +199,7 @@ func TestPagerDutyTemplating(t *testing.T) {
 	} {
 		t.Run(tc.title, func(t *testing.T) {
 			tc.cfg.URL = &config.URL{URL: u}
-			tc.cfg.HTTPConfig = &commoncfg.HTTPClientConfig{}
+			tc.cfg.HTTPConfig = &config.HTTPClientConfig{}
 			pd, err := New(tc.cfg, test.CreateTmpl(t), log.NewNopLogger())
 			require.NoError(t, err)
 			if pd.apiV1 != "" {
@@ -232,6 +231,40 @@ func TestPagerDutyTemplating(t *testing.T) {
 	}
 }
 
+func TestPagerDutyDedupKeyTemplate(t *testing.T) {
+	var gotBody map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+	}))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+
+	cfg := &config.PagerdutyConfig{
+		RoutingKey: config.Secret("01234567890123456789012345678901"),
+		URL:        &config.URL{URL: u},
+		HTTPConfig: &config.HTTPClientConfig{},
+	}
+	pd, err := New(cfg, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ctx = notify.WithGroupKey(ctx, "opaque-group-key")
+	ctx = notify.WithDedupKey(ctx, "prod-1-NodeDown")
+
+	_, err = pd.Notify(ctx, []*types.Alert{
+		{
+			Alert: model.Alert{
+				Labels:   model.LabelSet{"lbl1": "val1"},
+				StartsAt: time.Now(),
+				EndsAt:   time.Now().Add(time.Hour),
+			},
+		},
+	}...)
+	require.NoError(t, err)
+
+	require.Equal(t, notify.Key("prod-1-NodeDown").Hash(), gotBody["dedup_key"])
+}
+
 func TestErrDetails(t *testing.T) {
 	for _, tc := range []struct {
 		status int
@@ -0,0 +1,157 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package preview renders the payload a receiver's integrations would send
+// for a given alert group, without performing the notify step itself. It is
+// used by the API to let operators check template and mapping changes
+// against real-looking alerts before they reach a live endpoint.
+package preview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Result is the rendered fields a single integration would have sent for the
+// previewed alert group.
+type Result struct {
+	Integration string            `json:"integration"`
+	Index       int               `json:"index"`
+	Fields      map[string]string `json:"fields"`
+}
+
+// Render renders the templated fields of the integrations configured on rcv
+// against alerts, using the same template data a live Notifier.Notify call
+// would see (see notify.GetTemplateData). No network call is made. Telegram,
+// MS Teams, SNS, Pub/Sub, exec and alertmanager configs are not rendered and
+// yield no Result; webhook Results carry the configured URL and payload
+// version without templating.
+func Render(rcv *config.Receiver, tmpl *template.Template, alerts []*types.Alert, l log.Logger) ([]Result, error) {
+	ctx := notify.WithReceiverName(context.Background(), rcv.Name)
+	ctx = notify.WithGroupKey(ctx, fmt.Sprintf("preview:%s", rcv.Name))
+	ctx = notify.WithGroupLabels(ctx, commonLabels(alerts))
+
+	data := notify.GetTemplateData(ctx, tmpl, alerts, l)
+
+	var err error
+	tmplText := notify.TmplText(tmpl, data, &err)
+
+	var results []Result
+	add := func(integration string, idx int, fields map[string]string) {
+		rendered := make(map[string]string, len(fields))
+		for name, text := range fields {
+			if text == "" {
+				continue
+			}
+			rendered[name] = tmplText(text)
+		}
+		results = append(results, Result{Integration: integration, Index: idx, Fields: rendered})
+	}
+
+	for i, c := range rcv.EmailConfigs {
+		add("email", i, map[string]string{"to": c.To, "from": c.From, "html": c.HTML, "text": c.Text})
+	}
+	for i, c := range rcv.PagerdutyConfigs {
+		add("pagerduty", i, map[string]string{
+			"client": c.Client, "client_url": c.ClientURL, "description": c.Description,
+			"group": c.Group, "class": c.Class, "component": c.Component,
+		})
+	}
+	for i, c := range rcv.HipchatConfigs {
+		add("hipchat", i, map[string]string{"from": c.From, "message": c.Message})
+	}
+	for i, c := range rcv.SlackConfigs {
+		add("slack", i, map[string]string{
+			"channel": c.Channel, "title": c.Title, "pretext": c.Pretext,
+			"text": c.Text, "footer": c.Footer, "fallback": c.Fallback,
+		})
+	}
+	for i, c := range rcv.WebhookConfigs {
+		fields := map[string]string{"payload_version": c.PayloadVersion}
+		if c.URL != nil {
+			fields["url"] = c.URL.String()
+		}
+		results = append(results, Result{Integration: "webhook", Index: i, Fields: fields})
+	}
+	for i, c := range rcv.OpsGenieConfigs {
+		add("opsgenie", i, map[string]string{
+			"message": c.Message, "description": c.Description, "source": c.Source, "note": c.Note,
+		})
+	}
+	for i, c := range rcv.WechatConfigs {
+		add("wechat", i, map[string]string{"message": c.Message})
+	}
+	for i, c := range rcv.PushoverConfigs {
+		add("pushover", i, map[string]string{"title": c.Title, "message": c.Message, "url": c.URL, "url_title": c.URLTitle})
+	}
+	for i, c := range rcv.VictorOpsConfigs {
+		add("victorops", i, map[string]string{"state_message": c.StateMessage, "entity_display_name": c.EntityDisplayName})
+	}
+	for i, c := range rcv.IRCConfigs {
+		add("irc", i, map[string]string{"channel": c.Channel, "message": c.Message})
+	}
+	for i, c := range rcv.XMPPConfigs {
+		add("xmpp", i, map[string]string{"room": c.Room, "message": c.Message})
+	}
+	for i, c := range rcv.ZulipConfigs {
+		add("zulip", i, map[string]string{"stream": c.Stream, "topic": c.Topic, "message": c.Message})
+	}
+	for i, c := range rcv.LineNotifyConfigs {
+		add("linenotify", i, map[string]string{"message": c.Message})
+	}
+	for i, c := range rcv.KakaoTalkConfigs {
+		add("kakaotalk", i, map[string]string{"message": c.Message})
+	}
+	for i, c := range rcv.SMPPConfigs {
+		add("smpp", i, map[string]string{"destination_addr": c.DestinationAddr, "message": c.Message})
+	}
+	for i, c := range rcv.AlertaConfigs {
+		add("alerta", i, map[string]string{
+			"environment": c.Environment, "resource": c.Resource, "event": c.Event,
+			"severity": c.Severity, "group": c.Group, "text": c.Text,
+		})
+	}
+	for i, c := range rcv.ZabbixConfigs {
+		add("zabbix", i, map[string]string{"host": c.Host, "key": c.Key, "value": c.Value})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// commonLabels returns the labels shared by every alert, the same grouping
+// Alertmanager would use if no route-specific group_by applied.
+func commonLabels(alerts []*types.Alert) model.LabelSet {
+	if len(alerts) == 0 {
+		return model.LabelSet{}
+	}
+	common := alerts[0].Labels.Clone()
+	for _, a := range alerts[1:] {
+		for name, value := range common {
+			if v, ok := a.Labels[name]; !ok || v != value {
+				delete(common, name)
+			}
+		}
+	}
+	return common
+}
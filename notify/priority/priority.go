@@ -0,0 +1,141 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package priority caps how many notification deliveries run concurrently
+// and, once that cap is reached, admits queued deliveries in priority order
+// instead of first-come-first-served, so a burst of low-severity alerts
+// cannot delay a concurrent critical one.
+package priority
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultAgingInterval is the aging interval used by deliveries gated
+// without an explicit one.
+const DefaultAgingInterval = 30 * time.Second
+
+// Gate limits how many notification deliveries run concurrently, admitting
+// the lowest-numbered (most urgent) priority waiting first once every slot
+// is in use. To keep a steady stream of high-priority deliveries from
+// starving low-priority ones indefinitely, every AgingInterval a waiter
+// spends queued promotes its effective priority by one. The zero value is
+// not usable; use NewGate.
+type Gate struct {
+	capacity      int
+	agingInterval time.Duration
+
+	mtx      sync.Mutex
+	inFlight int
+	waiters  []*waiter
+	seq      int
+}
+
+type waiter struct {
+	priority   int
+	enqueuedAt time.Time
+	seq        int
+	ready      chan struct{}
+}
+
+// NewGate returns a Gate admitting at most capacity deliveries at once.
+// agingInterval, if positive, is how long a waiter can be queued before its
+// effective priority is promoted by one; a non-positive value disables
+// aging (and thus starvation protection).
+func NewGate(capacity int, agingInterval time.Duration) *Gate {
+	return &Gate{capacity: capacity, agingInterval: agingInterval}
+}
+
+// Acquire blocks until a delivery slot is free for priority (lower values
+// are more urgent), or until ctx is done. Every call that returns a nil
+// error must be matched with exactly one call to Release.
+func (g *Gate) Acquire(ctx context.Context, priority int) error {
+	g.mtx.Lock()
+	if g.inFlight < g.capacity {
+		g.inFlight++
+		g.mtx.Unlock()
+		return nil
+	}
+	g.seq++
+	w := &waiter{
+		priority:   priority,
+		enqueuedAt: time.Now(),
+		seq:        g.seq,
+		ready:      make(chan struct{}),
+	}
+	g.waiters = append(g.waiters, w)
+	g.mtx.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		g.abandon(w)
+		return ctx.Err()
+	}
+}
+
+// abandon removes w from the queue, unless it has already been handed a
+// slot, in which case the slot is released back to the gate instead.
+func (g *Gate) abandon(w *waiter) {
+	g.mtx.Lock()
+	for i, o := range g.waiters {
+		if o == w {
+			g.waiters = append(g.waiters[:i], g.waiters[i+1:]...)
+			g.mtx.Unlock()
+			return
+		}
+	}
+	g.mtx.Unlock()
+
+	// w was already handed a slot between ctx firing and us taking the
+	// lock; give it back.
+	<-w.ready
+	g.Release()
+}
+
+// Release returns a delivery slot, handing it directly to the
+// highest-effective-priority waiter, if any, so it never competes with a
+// fresh Acquire call for the slot it was promised.
+func (g *Gate) Release() {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if len(g.waiters) == 0 {
+		g.inFlight--
+		return
+	}
+
+	now := time.Now()
+	best := 0
+	bestPriority := g.effectivePriority(g.waiters[0], now)
+	for i := 1; i < len(g.waiters); i++ {
+		p := g.effectivePriority(g.waiters[i], now)
+		if p < bestPriority || (p == bestPriority && g.waiters[i].seq < g.waiters[best].seq) {
+			best, bestPriority = i, p
+		}
+	}
+
+	w := g.waiters[best]
+	g.waiters = append(g.waiters[:best], g.waiters[best+1:]...)
+	close(w.ready)
+}
+
+func (g *Gate) effectivePriority(w *waiter, now time.Time) int {
+	if g.agingInterval <= 0 {
+		return w.priority
+	}
+	return w.priority - int(now.Sub(w.enqueuedAt)/g.agingInterval)
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priority
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireWithinCapacity(t *testing.T) {
+	g := NewGate(2, 0)
+
+	require.NoError(t, g.Acquire(context.Background(), 0))
+	require.NoError(t, g.Acquire(context.Background(), 0))
+}
+
+func TestAcquireBlocksUntilRelease(t *testing.T) {
+	g := NewGate(1, 0)
+	require.NoError(t, g.Acquire(context.Background(), 0))
+
+	acquired := make(chan struct{})
+	go func() {
+		g.Acquire(context.Background(), 0)
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("expected Acquire to block while the only slot is held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	g.Release()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expected Acquire to unblock after Release")
+	}
+}
+
+func TestAcquirePrefersHigherPriority(t *testing.T) {
+	g := NewGate(1, 0)
+	require.NoError(t, g.Acquire(context.Background(), 0))
+
+	var order []int
+	var wg sync.WaitGroup
+	started := make(chan struct{}, 2)
+	for _, p := range []int{10, 0} { // low priority queues first, high priority second
+		p := p
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			started <- struct{}{}
+			time.Sleep(20 * time.Millisecond) // ensure both are queued before Release
+			require.NoError(t, g.Acquire(context.Background(), p))
+			order = append(order, p)
+			g.Release()
+		}()
+		<-started
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	g.Release()
+	wg.Wait()
+
+	require.Equal(t, []int{0, 10}, order)
+}
+
+func TestAcquireContextCancellation(t *testing.T) {
+	g := NewGate(1, 0)
+	require.NoError(t, g.Acquire(context.Background(), 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := g.Acquire(ctx, 0)
+	require.Equal(t, context.Canceled, err)
+
+	// The slot should still be free for another waiter once released.
+	g.Release()
+	require.NoError(t, g.Acquire(context.Background(), 0))
+}
+
+func TestAgingPromotesStarvedWaiter(t *testing.T) {
+	g := NewGate(1, 10*time.Millisecond)
+	require.NoError(t, g.Acquire(context.Background(), 0))
+
+	lowDone := make(chan struct{})
+	go func() {
+		require.NoError(t, g.Acquire(context.Background(), 5))
+		close(lowDone)
+		g.Release()
+	}()
+	time.Sleep(100 * time.Millisecond) // let the low-priority waiter age past priority 0
+
+	highDone := make(chan struct{})
+	go func() {
+		require.NoError(t, g.Acquire(context.Background(), 0))
+		close(highDone)
+		g.Release()
+	}()
+	time.Sleep(20 * time.Millisecond) // let the fresh high-priority waiter queue up
+
+	g.Release()
+
+	select {
+	case <-lowDone:
+	case <-time.After(time.Second):
+		t.Fatal("expected the aged, starved waiter to be admitted first")
+	}
+	<-highDone
+}
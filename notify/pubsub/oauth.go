@@ -0,0 +1,189 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const pubsubScope = "https://www.googleapis.com/auth/pubsub"
+
+// serviceAccountKey is the subset of fields used out of a GCP service
+// account JSON key file.
+type serviceAccountKey struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// token obtains an OAuth2 access token scoped to Pub/Sub, either by
+// exchanging a signed JWT for a service account key (if credentialsFile is
+// set), or by asking the GCE/GKE metadata server for the instance's default
+// service account token.
+func token(client *http.Client, credentialsFile string) (string, error) {
+	if credentialsFile == "" {
+		return metadataServerToken(client)
+	}
+	return serviceAccountToken(client, credentialsFile)
+}
+
+func serviceAccountToken(client *http.Client, credentialsFile string) (string, error) {
+	raw, err := ioutil.ReadFile(credentialsFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read pubsub credentials_file: %w", err)
+	}
+	var key serviceAccountKey
+	if err := json.Unmarshal(raw, &key); err != nil {
+		return "", fmt.Errorf("failed to parse pubsub credentials_file: %w", err)
+	}
+	if key.ClientEmail == "" || key.PrivateKey == "" {
+		return "", fmt.Errorf("pubsub credentials_file is missing client_email or private_key")
+	}
+	tokenURI := key.TokenURI
+	if tokenURI == "" {
+		tokenURI = "https://oauth2.googleapis.com/token"
+	}
+
+	privateKey, err := parsePrivateKey(key.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse pubsub private key: %w", err)
+	}
+
+	now := time.Now()
+	assertion, err := signJWT(key.ClientEmail, tokenURI, now, privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign pubsub JWT assertion: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	resp, err := client.PostForm(tokenURI, form)
+	if err != nil {
+		return "", fmt.Errorf("failed to exchange pubsub JWT assertion: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return "", fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tokResp struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokResp); err != nil {
+		return "", fmt.Errorf("failed to decode pubsub token response: %w", err)
+	}
+	if tokResp.AccessToken == "" {
+		return "", fmt.Errorf("token endpoint returned no access_token")
+	}
+	return tokResp.AccessToken, nil
+}
+
+func parsePrivateKey(pemKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// signJWT builds and RS256-signs a JWT-bearer assertion per
+// https://developers.google.com/identity/protocols/oauth2/service-account#jwt-auth.
+func signJWT(clientEmail, tokenURI string, now time.Time, key *rsa.PrivateKey) (string, error) {
+	header := base64URLEncode([]byte(`{"alg":"RS256","typ":"JWT"}`))
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   clientEmail,
+		"scope": pubsubScope,
+		"aud":   tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := header + "." + base64URLEncode(claims)
+
+	hashed := crypto.SHA256.New()
+	hashed.Write([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed.Sum(nil))
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64URLEncode(signature), nil
+}
+
+func base64URLEncode(b []byte) string {
+	return strings.TrimRight(base64.URLEncoding.EncodeToString(b), "=")
+}
+
+// metadataServerToken fetches an access token for the GCE/GKE instance's
+// default service account from the metadata server, analogous to an AWS IAM
+// instance role.
+func metadataServerToken(client *http.Client) (string, error) {
+	const tokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+
+	req, err := http.NewRequest("GET", tokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("no pubsub credentials_file configured and failed to reach metadata server: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata server returned status %d", resp.StatusCode)
+	}
+
+	var tokResp struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokResp); err != nil {
+		return "", fmt.Errorf("failed to decode metadata server token response: %w", err)
+	}
+	if tokResp.AccessToken == "" {
+		return "", fmt.Errorf("metadata server returned no access_token")
+	}
+	return tokResp.AccessToken, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pubsub implements a Notifier that publishes alert groups to a
+// Google Cloud Pub/Sub topic. Access tokens are obtained by hand, rather
+// than via the Google Cloud SDK, so that this notifier carries no
+// dependency on it.
+package pubsub
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Notifier implements a Notifier for Google Cloud Pub/Sub notifications.
+type Notifier struct {
+	conf    *config.PubsubConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+
+	// endpoint overrides the Pub/Sub publish endpoint. It is only ever set
+	// by tests.
+	endpoint string
+}
+
+// New returns a new Pub/Sub notifier.
+func New(c *config.PubsubConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	client, err := config.NewClient(c.HTTPConfig, "pubsub")
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{},
+	}, nil
+}
+
+type pubsubMessage struct {
+	Data       string            `json:"data"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+type publishRequest struct {
+	Messages []pubsubMessage `json:"messages"`
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, ok := notify.GroupKey(ctx)
+	if !ok {
+		return false, fmt.Errorf("group key missing")
+	}
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+
+	level.Debug(n.logger).Log("incident", key)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	message := tmpl(n.conf.Message)
+	if err != nil {
+		return false, err
+	}
+
+	msg := pubsubMessage{Data: base64.StdEncoding.EncodeToString([]byte(message))}
+	if n.conf.AttachLabels != nil && *n.conf.AttachLabels {
+		msg.Attributes = data.CommonLabels
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(&publishRequest{Messages: []pubsubMessage{msg}}); err != nil {
+		return false, err
+	}
+
+	endpoint := n.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://pubsub.googleapis.com/v1/projects/%s/topics/%s:publish", n.conf.ProjectID, n.conf.Topic)
+	}
+
+	accessToken, err := token(n.client, n.conf.CredentialsFile)
+	if err != nil {
+		return true, err
+	}
+
+	req, err := http.NewRequest("POST", endpoint, &buf)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestPubsubRetry(t *testing.T) {
+	notifier, err := New(
+		&config.PubsubConfig{
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	for statusCode, expected := range test.RetryTests(test.DefaultRetryCodes()) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("error on status %d", statusCode))
+	}
+}
+
+func writeServiceAccountKey(t *testing.T, dir, tokenURI string) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyBytes := x509.MarshalPKCS1PrivateKey(key)
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: keyBytes})
+
+	sa := serviceAccountKey{
+		ClientEmail: "alertmanager@example-project.iam.gserviceaccount.com",
+		PrivateKey:  string(pemBytes),
+		TokenURI:    tokenURI,
+	}
+	raw, err := json.Marshal(sa)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "credentials.json")
+	require.NoError(t, ioutil.WriteFile(path, raw, 0o600))
+	return path
+}
+
+func TestPubsubSendsExpectedRequest(t *testing.T) {
+	var gotPublishReq publishRequest
+	var gotAuth string
+	publishSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotPublishReq))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"messageIds":["1"]}`))
+	}))
+	defer publishSrv.Close()
+
+	tokenSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"test-token","expires_in":3600,"token_type":"Bearer"}`))
+	}))
+	defer tokenSrv.Close()
+
+	credentialsFile := writeServiceAccountKey(t, t.TempDir(), tokenSrv.URL)
+
+	attach := true
+	conf := &config.PubsubConfig{
+		ProjectID:       "example-project",
+		Topic:           "alerts",
+		CredentialsFile: credentialsFile,
+		Message:         `{{ .CommonLabels.summary }}`,
+		AttachLabels:    &attach,
+		HTTPConfig:      &config.HTTPClientConfig{},
+	}
+	notifier, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+	notifier.endpoint = publishSrv.URL
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "high_cpu", "summary": "CPU is too high"},
+			StartsAt: time.Now(),
+		},
+	}
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "Bearer test-token", gotAuth)
+	require.Len(t, gotPublishReq.Messages, 1)
+
+	decoded, err := base64.StdEncoding.DecodeString(gotPublishReq.Messages[0].Data)
+	require.NoError(t, err)
+	require.Equal(t, "CPU is too high", string(decoded))
+	require.Equal(t, "high_cpu", gotPublishReq.Messages[0].Attributes["alertname"])
+}
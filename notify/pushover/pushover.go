@@ -23,7 +23,6 @@ import (
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
-	commoncfg "github.com/prometheus/common/config"
 
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/notify"
@@ -43,7 +42,7 @@ type Notifier struct {
 
 // New returns a new Pushover notifier.
 func New(c *config.PushoverConfig, t *template.Template, l log.Logger) (*Notifier, error) {
-	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "pushover", false)
+	client, err := config.NewClient(c.HTTPConfig, "pushover")
 	if err != nil {
 		return nil, err
 	}
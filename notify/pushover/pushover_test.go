@@ -18,7 +18,6 @@ import (
 	"testing"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
@@ -28,7 +27,7 @@ import (
 func TestPushoverRetry(t *testing.T) {
 	notifier, err := New(
 		&config.PushoverConfig{
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -49,7 +48,7 @@ func TestPushoverRedactedURL(t *testing.T) {
 		&config.PushoverConfig{
 			UserKey:    config.Secret(key),
 			Token:      config.Secret(token),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -0,0 +1,115 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package receipt posts a small delivery receipt to an external webhook
+// after every notification attempt, so an SLO system can track time from
+// alert firing to notification delivered without having to scrape
+// Alertmanager's own metrics.
+package receipt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+// Outcome values reported for a Receipt.
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Receipt describes the result of a single notification attempt.
+type Receipt struct {
+	Timestamp      time.Time `json:"timestamp"`
+	Receiver       string    `json:"receiver"`
+	Integration    string    `json:"integration"`
+	GroupKey       string    `json:"groupKey"`
+	Outcome        string    `json:"outcome"`
+	LatencySeconds float64   `json:"latencySeconds"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// Sink posts Receipts to a configurable URL. It is safe to reconfigure via
+// Set while in use, e.g. across configuration reloads.
+type Sink struct {
+	client *http.Client
+	logger log.Logger
+
+	mtx sync.Mutex
+	url string
+}
+
+// New returns a Sink that is initially disabled. Call Set to point it at a
+// delivery-receipt webhook endpoint.
+func New(l log.Logger) *Sink {
+	return &Sink{
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: l,
+	}
+}
+
+// Set (re-)configures the destination URL. An empty url disables the sink.
+func (s *Sink) Set(url string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.url = url
+}
+
+// Enabled reports whether a destination URL is currently configured.
+func (s *Sink) Enabled() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.url != ""
+}
+
+// Send posts rec to the configured URL. It is a best-effort operation:
+// errors are logged but never propagated, so a receipt outage never blocks
+// actual notification delivery.
+func (s *Sink) Send(ctx context.Context, rec Receipt) {
+	s.mtx.Lock()
+	url := s.url
+	s.mtx.Unlock()
+	if url == "" {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(rec); err != nil {
+		level.Error(s.logger).Log("msg", "failed to encode delivery receipt", "err", err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, &buf)
+	if err != nil {
+		level.Error(s.logger).Log("msg", "failed to build delivery receipt request", "err", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		level.Warn(s.logger).Log("msg", "failed to send delivery receipt", "err", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		level.Warn(s.logger).Log("msg", "delivery receipt endpoint returned unexpected status", "status", resp.StatusCode)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receipt
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinkSend(t *testing.T) {
+	var got Receipt
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	s := New(log.NewNopLogger())
+	require.False(t, s.Enabled())
+
+	s.Set(srv.URL)
+	require.True(t, s.Enabled())
+
+	s.Send(context.Background(), Receipt{GroupKey: "gk", Receiver: "team-X", Integration: "slack", Outcome: OutcomeSuccess, LatencySeconds: 0.5})
+
+	require.Equal(t, "gk", got.GroupKey)
+	require.Equal(t, "team-X", got.Receiver)
+	require.Equal(t, "slack", got.Integration)
+	require.Equal(t, OutcomeSuccess, got.Outcome)
+	require.Equal(t, 0.5, got.LatencySeconds)
+}
+
+func TestSinkSendDisabled(t *testing.T) {
+	var hit bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer srv.Close()
+
+	s := New(log.NewNopLogger())
+	s.Send(context.Background(), Receipt{GroupKey: "gk"})
+
+	require.False(t, hit)
+}
@@ -0,0 +1,74 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package receivermute lets a receiver be temporarily muted by name, e.g.
+// while its downstream integration (a Slack workspace being migrated, a
+// PagerDuty service being reconfigured) is known to be unreachable, without
+// having to reload the configuration or mute every alert that would route
+// to it.
+package receivermute
+
+import (
+	"sync"
+	"time"
+)
+
+// Tracker keeps track of which receivers are currently muted and until
+// when. The zero value is not usable; use New.
+type Tracker struct {
+	mtx        sync.Mutex
+	mutedUntil map[string]time.Time
+	now        func() time.Time
+}
+
+// New returns a Tracker with no receivers muted.
+func New() *Tracker {
+	return &Tracker{
+		mutedUntil: map[string]time.Time{},
+		now:        time.Now,
+	}
+}
+
+// Mute mutes receiver for the given duration, replacing any previous mute
+// for it.
+func (t *Tracker) Mute(receiver string, d time.Duration) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.mutedUntil[receiver] = t.now().Add(d)
+}
+
+// Unmute lifts any active mute on receiver.
+func (t *Tracker) Unmute(receiver string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.mutedUntil, receiver)
+}
+
+// Muted reports whether receiver is currently muted, and until when. It
+// lazily forgets expired mutes.
+func (t *Tracker) Muted(receiver string) (bool, time.Time) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	until, ok := t.mutedUntil[receiver]
+	if !ok {
+		return false, time.Time{}
+	}
+	if !t.now().Before(until) {
+		delete(t.mutedUntil, receiver)
+		return false, time.Time{}
+	}
+	return true, until
+}
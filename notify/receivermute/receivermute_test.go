@@ -0,0 +1,61 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivermute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMuteAndUnmute(t *testing.T) {
+	tr := New()
+
+	muted, _ := tr.Muted("team-x")
+	require.False(t, muted)
+
+	tr.Mute("team-x", time.Hour)
+	muted, until := tr.Muted("team-x")
+	require.True(t, muted)
+	require.False(t, until.IsZero())
+
+	tr.Unmute("team-x")
+	muted, _ = tr.Muted("team-x")
+	require.False(t, muted)
+}
+
+func TestMuteExpires(t *testing.T) {
+	tr := New()
+	now := time.Now()
+	tr.now = func() time.Time { return now }
+
+	tr.Mute("team-x", time.Minute)
+	muted, _ := tr.Muted("team-x")
+	require.True(t, muted)
+
+	tr.now = func() time.Time { return now.Add(2 * time.Minute) }
+	muted, _ = tr.Muted("team-x")
+	require.False(t, muted)
+}
+
+func TestMuteLeavesOtherReceiversAlone(t *testing.T) {
+	tr := New()
+	tr.Mute("team-x", time.Hour)
+
+	mutedX, _ := tr.Muted("team-x")
+	mutedY, _ := tr.Muted("team-y")
+	require.True(t, mutedX)
+	require.False(t, mutedY)
+}
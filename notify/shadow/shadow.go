@@ -0,0 +1,76 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shadow keeps a bounded, in-memory record of the notifications
+// that dry-run mode suppressed, so a routing-tree change can be rehearsed
+// against real alert traffic in staging and the notifications it would
+// have sent inspected through the API instead of scrolling through logs.
+package shadow
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/alertmanager/template"
+)
+
+// Record describes a single notification that dry-run mode suppressed.
+type Record struct {
+	Timestamp   time.Time      `json:"timestamp"`
+	Receiver    string         `json:"receiver"`
+	Integration string         `json:"integration"`
+	GroupKey    string         `json:"groupKey"`
+	Data        *template.Data `json:"data"`
+}
+
+// DefaultMaxRecords bounds the in-memory buffer in the absence of an
+// explicit limit.
+const DefaultMaxRecords = 1000
+
+// Tracker keeps the most recent dry-run Records in a bounded,
+// goroutine-safe buffer, discarding the oldest once the bound is reached.
+type Tracker struct {
+	mtx     sync.Mutex
+	max     int
+	records []Record
+}
+
+// New returns a Tracker that retains at most maxRecords Records. A
+// non-positive maxRecords falls back to DefaultMaxRecords.
+func New(maxRecords int) *Tracker {
+	if maxRecords <= 0 {
+		maxRecords = DefaultMaxRecords
+	}
+	return &Tracker{max: maxRecords}
+}
+
+// Add records rec, discarding the oldest record if the Tracker is full.
+func (t *Tracker) Add(rec Record) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.records = append(t.records, rec)
+	if len(t.records) > t.max {
+		t.records = t.records[len(t.records)-t.max:]
+	}
+}
+
+// List returns the currently retained Records, oldest first.
+func (t *Tracker) List() []Record {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	out := make([]Record, len(t.records))
+	copy(out, t.records)
+	return out
+}
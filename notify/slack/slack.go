@@ -20,7 +20,6 @@ import (
 	"net/http"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/notify"
@@ -39,7 +38,7 @@ type Notifier struct {
 
 // New returns a new Slack notification handler.
 func New(c *config.SlackConfig, t *template.Template, l log.Logger) (*Notifier, error) {
-	client, err := commoncfg.NewClientFromConfig(*c.HTTPConfig, "slack", false)
+	client, err := config.NewClient(c.HTTPConfig, "slack")
 	if err != nil {
 		return nil, err
 	}
@@ -18,7 +18,6 @@ import (
 	"testing"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
@@ -28,7 +27,7 @@ import (
 func TestSlackRetry(t *testing.T) {
 	notifier, err := New(
 		&config.SlackConfig{
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -48,7 +47,7 @@ func TestSlackRedactedURL(t *testing.T) {
 	notifier, err := New(
 		&config.SlackConfig{
 			APIURL:     &config.SecretURL{URL: u},
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -0,0 +1,289 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package smpp implements a minimal SMPP (Short Message Peer-to-Peer)
+// v3.4 client sufficient to bind as a transmitter and submit a short
+// message, so that alerts can be paged out as SMS through a
+// self-hosted gateway without depending on a SaaS SMS provider.
+package smpp
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// SMPP v3.4 command IDs used by this client.
+const (
+	cmdBindTransmitter     uint32 = 0x00000002
+	cmdBindTransmitterResp uint32 = 0x80000002
+	cmdSubmitSM            uint32 = 0x00000004
+	cmdSubmitSMResp        uint32 = 0x80000004
+	cmdUnbind              uint32 = 0x00000006
+	cmdUnbindResp          uint32 = 0x80000006
+	cmdGenericNack         uint32 = 0x80000000
+)
+
+const interfaceVersion = 0x34 // SMPP v3.4
+
+// sessionTimeout bounds how long the notifier waits for the bind and
+// submit_sm responses before giving up.
+const sessionTimeout = 15 * time.Second
+
+// Notifier implements a Notifier that sends alerts as SMS through an SMPP
+// gateway. Each notification opens a fresh connection, binds as a
+// transmitter, submits one short message and unbinds, mirroring the
+// stateless, connect-per-call shape of Alertmanager's other notifiers.
+type Notifier struct {
+	conf   *config.SMPPConfig
+	tmpl   *template.Template
+	logger log.Logger
+}
+
+// New returns a new SMPP notifier.
+func New(c *config.SMPPConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	return &Notifier{
+		conf:   c,
+		tmpl:   t,
+		logger: l,
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+	data := notify.GetTemplateData(ctx, n.tmpl, alerts, n.logger)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	message := tmpl(n.conf.Message)
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := n.dial(ctx)
+	if err != nil {
+		return true, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(sessionTimeout))
+
+	r := bufio.NewReader(conn)
+
+	seq := uint32(1)
+	if err := n.bind(conn, r, seq); err != nil {
+		return true, err
+	}
+	seq++
+
+	if err := n.submit(conn, r, seq, message); err != nil {
+		return true, err
+	}
+	seq++
+
+	n.unbind(conn, r, seq)
+	return false, nil
+}
+
+func (n *Notifier) dial(ctx context.Context) (net.Conn, error) {
+	addr := fmt.Sprintf("%s:%d", n.conf.Server, n.conf.Port)
+	d := &net.Dialer{}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial SMPP gateway: %w", err)
+	}
+	if !n.conf.TLS {
+		return conn, nil
+	}
+
+	tlsConfig, err := commoncfg.NewTLSConfig(&n.conf.TLSConfig)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("build SMPP TLS config: %w", err)
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = n.conf.Server
+	}
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("SMPP TLS handshake: %w", err)
+	}
+	return tlsConn, nil
+}
+
+// bind sends bind_transmitter and waits for a successful
+// bind_transmitter_resp.
+func (n *Notifier) bind(conn net.Conn, r *bufio.Reader, seq uint32) error {
+	var body []byte
+	body = appendCString(body, n.conf.SystemID)
+	body = appendCString(body, string(n.conf.Password))
+	body = appendCString(body, n.conf.SystemType)
+	body = append(body, interfaceVersion)
+	body = append(body, 0x00) // addr_ton
+	body = append(body, 0x00) // addr_npi
+	body = appendCString(body, "")
+
+	if err := writePDU(conn, cmdBindTransmitter, seq, body); err != nil {
+		return fmt.Errorf("send bind_transmitter: %w", err)
+	}
+
+	resp, err := readPDU(r)
+	if err != nil {
+		return fmt.Errorf("read bind_transmitter_resp: %w", err)
+	}
+	level.Debug(n.logger).Log("msg", "received SMPP PDU", "command_id", fmt.Sprintf("0x%08x", resp.commandID), "status", resp.commandStatus)
+	if resp.commandID != cmdBindTransmitterResp {
+		return fmt.Errorf("unexpected SMPP response to bind_transmitter: command_id=0x%08x", resp.commandID)
+	}
+	if resp.commandStatus != 0 {
+		return fmt.Errorf("SMPP bind_transmitter failed: status=0x%08x", resp.commandStatus)
+	}
+	return nil
+}
+
+// submit sends submit_sm and waits for a successful submit_sm_resp.
+func (n *Notifier) submit(conn net.Conn, r *bufio.Reader, seq uint32, message string) error {
+	shortMessage := []byte(message)
+	if len(shortMessage) > 254 {
+		shortMessage = shortMessage[:254]
+	}
+
+	var body []byte
+	body = appendCString(body, "")                     // service_type
+	body = append(body, 0x00)                          // source_addr_ton
+	body = append(body, 0x00)                          // source_addr_npi
+	body = appendCString(body, n.conf.SourceAddr)      // source_addr
+	body = append(body, 0x01)                          // dest_addr_ton (international)
+	body = append(body, 0x01)                          // dest_addr_npi (ISDN)
+	body = appendCString(body, n.conf.DestinationAddr) // destination_addr
+	body = append(body, 0x00)                          // esm_class
+	body = append(body, 0x00)                          // protocol_id
+	body = append(body, 0x00)                          // priority_flag
+	body = appendCString(body, "")                     // schedule_delivery_time
+	body = appendCString(body, "")                     // validity_period
+	body = append(body, 0x00)                          // registered_delivery
+	body = append(body, 0x00)                          // replace_if_present_flag
+	body = append(body, 0x00)                          // data_coding
+	body = append(body, 0x00)                          // sm_default_msg_id
+	body = append(body, byte(len(shortMessage)))       // sm_length
+	body = append(body, shortMessage...)               // short_message
+
+	if err := writePDU(conn, cmdSubmitSM, seq, body); err != nil {
+		return fmt.Errorf("send submit_sm: %w", err)
+	}
+
+	resp, err := readPDU(r)
+	if err != nil {
+		return fmt.Errorf("read submit_sm_resp: %w", err)
+	}
+	if resp.commandID != cmdSubmitSMResp {
+		return fmt.Errorf("unexpected SMPP response to submit_sm: command_id=0x%08x", resp.commandID)
+	}
+	if resp.commandStatus != 0 {
+		return fmt.Errorf("SMPP submit_sm failed: status=0x%08x", resp.commandStatus)
+	}
+	return nil
+}
+
+// unbind sends unbind and waits briefly for unbind_resp, on a best-effort
+// basis since the message has already been submitted.
+func (n *Notifier) unbind(conn net.Conn, r *bufio.Reader, seq uint32) {
+	if err := writePDU(conn, cmdUnbind, seq, nil); err != nil {
+		return
+	}
+	readPDU(r)
+}
+
+type pdu struct {
+	commandID     uint32
+	commandStatus uint32
+	sequence      uint32
+	body          []byte
+}
+
+func writePDU(w net.Conn, commandID, sequence uint32, body []byte) error {
+	length := uint32(16 + len(body))
+	header := make([]byte, 16)
+	binary.BigEndian.PutUint32(header[0:4], length)
+	binary.BigEndian.PutUint32(header[4:8], commandID)
+	binary.BigEndian.PutUint32(header[8:12], 0)
+	binary.BigEndian.PutUint32(header[12:16], sequence)
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if len(body) > 0 {
+		if _, err := w.Write(body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readPDU(r *bufio.Reader) (*pdu, error) {
+	header := make([]byte, 16)
+	if _, err := readFull(r, header); err != nil {
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[0:4])
+	if length < 16 {
+		return nil, fmt.Errorf("invalid SMPP PDU length %d", length)
+	}
+
+	p := &pdu{
+		commandID:     binary.BigEndian.Uint32(header[4:8]),
+		commandStatus: binary.BigEndian.Uint32(header[8:12]),
+		sequence:      binary.BigEndian.Uint32(header[12:16]),
+	}
+
+	if bodyLen := length - 16; bodyLen > 0 {
+		p.body = make([]byte, bodyLen)
+		if _, err := readFull(r, p.body); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func readFull(r *bufio.Reader, buf []byte) (int, error) {
+	n := 0
+	for n < len(buf) {
+		m, err := r.Read(buf[n:])
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// appendCString appends s followed by a NUL terminator, as required for
+// C-octet-string fields in SMPP PDUs.
+func appendCString(dst []byte, s string) []byte {
+	dst = append(dst, []byte(s)...)
+	return append(dst, 0x00)
+}
@@ -0,0 +1,167 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smpp
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// fakeServer is a minimal SMPP gateway that accepts a single connection,
+// acknowledges bind_transmitter and submit_sm, and records the submit_sm
+// PDU it receives.
+type fakeServer struct {
+	ln       net.Listener
+	received chan *pdu
+	fail     bool
+}
+
+func newFakeServer(t *testing.T, fail bool) *fakeServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeServer{ln: ln, received: make(chan *pdu, 4), fail: fail}
+	go s.run()
+	return s
+}
+
+func (s *fakeServer) addr() (string, int) {
+	tcpAddr := s.ln.Addr().(*net.TCPAddr)
+	return tcpAddr.IP.String(), tcpAddr.Port
+}
+
+func (s *fakeServer) close() { s.ln.Close() }
+
+func (s *fakeServer) run() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+
+	for {
+		req, err := readPDU(r)
+		if err != nil {
+			return
+		}
+		switch req.commandID {
+		case cmdBindTransmitter:
+			writePDU(conn, cmdBindTransmitterResp, req.sequence, nil)
+		case cmdSubmitSM:
+			s.received <- req
+			status := uint32(0)
+			if s.fail {
+				status = 0x0000000b // ESME_RINVDSTADR, arbitrary failure
+			}
+			header := make([]byte, 16)
+			writePDUWithStatus(conn, cmdSubmitSMResp, status, req.sequence, header)
+		case cmdUnbind:
+			writePDU(conn, cmdUnbindResp, req.sequence, nil)
+			return
+		}
+	}
+}
+
+// writePDUWithStatus writes a response PDU carrying a non-zero
+// command_status, which writePDU (always status 0) cannot express.
+func writePDUWithStatus(conn net.Conn, commandID, status, sequence uint32, scratch []byte) {
+	length := uint32(16)
+	scratch = scratch[:16]
+	putUint32(scratch[0:4], length)
+	putUint32(scratch[4:8], commandID)
+	putUint32(scratch[8:12], status)
+	putUint32(scratch[12:16], sequence)
+	conn.Write(scratch)
+}
+
+func putUint32(b []byte, v uint32) {
+	b[0] = byte(v >> 24)
+	b[1] = byte(v >> 16)
+	b[2] = byte(v >> 8)
+	b[3] = byte(v)
+}
+
+func testAlert() *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "test"},
+			StartsAt: time.Now(),
+		},
+	}
+}
+
+func TestSMPPNotifySendsMessage(t *testing.T) {
+	srv := newFakeServer(t, false)
+	defer srv.close()
+	host, port := srv.addr()
+
+	conf := &config.SMPPConfig{
+		Server:          host,
+		Port:            port,
+		SystemID:        "alertmanager",
+		Password:        "secret",
+		SourceAddr:      "12345",
+		DestinationAddr: "15551234567",
+		Message:         "something happened",
+	}
+	n, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := n.Notify(ctx, testAlert())
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	select {
+	case got := <-srv.received:
+		require.Contains(t, string(got.body), "something happened")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for submit_sm")
+	}
+}
+
+func TestSMPPNotifyRetriesOnSubmitFailure(t *testing.T) {
+	srv := newFakeServer(t, true)
+	defer srv.close()
+	host, port := srv.addr()
+
+	conf := &config.SMPPConfig{
+		Server:          host,
+		Port:            port,
+		SystemID:        "alertmanager",
+		Password:        "secret",
+		SourceAddr:      "12345",
+		DestinationAddr: "15551234567",
+		Message:         "something happened",
+	}
+	n, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := n.Notify(ctx, testAlert())
+	require.Error(t, err)
+	require.True(t, retry)
+}
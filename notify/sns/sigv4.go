@@ -0,0 +1,142 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sns
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// credentials are the AWS credentials used to sign a request. SessionToken
+// is optional and only present for temporary (STS or instance role)
+// credentials.
+type credentials struct {
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+}
+
+// signSNSRequest signs req in place for the SNS Query API using AWS
+// Signature Version 4, following
+// https://docs.aws.amazon.com/general/latest/gr/sigv4-signing-requests.html.
+// req.Body is not consulted; the caller must set the X-Amz-Content-Sha256
+// header to the hex-encoded SHA-256 of the exact bytes that will be sent
+// before calling it.
+func signSNSRequest(req *http.Request, creds credentials, region string, now time.Time) {
+	const service = "sns"
+
+	amzDate := now.UTC().Format("20060102T150405Z")
+	dateStamp := now.UTC().Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	signedHeaders, canonicalHeaders := canonicalizeHeaders(req)
+	bodyHash := req.Header.Get("X-Amz-Content-Sha256")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		bodyHash,
+	}, "\n")
+
+	credentialScope := strings.Join([]string{dateStamp, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		credentialScope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := signingKey(creds.SecretKey, dateStamp, region, service)
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	authHeader := "AWS4-HMAC-SHA256 " +
+		"Credential=" + creds.AccessKey + "/" + credentialScope + ", " +
+		"SignedHeaders=" + signedHeaders + ", " +
+		"Signature=" + signature
+	req.Header.Set("Authorization", authHeader)
+}
+
+// canonicalURI returns the canonical, percent-encoded request path. The SNS
+// Query API is always called against "/", which needs no further encoding.
+func canonicalURI(req *http.Request) string {
+	if req.URL.Path == "" {
+		return "/"
+	}
+	return req.URL.Path
+}
+
+// canonicalizeHeaders returns the semicolon-joined list of signed header
+// names and the newline-joined "name:value" canonical header block SigV4
+// requires: header names lower-cased and sorted, values trimmed of
+// surrounding whitespace.
+func canonicalizeHeaders(req *http.Request) (signedHeaders, canonicalHeaders string) {
+	names := make([]string, 0, len(req.Header)+1)
+	values := map[string]string{}
+
+	add := func(name, value string) {
+		name = strings.ToLower(name)
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
+		}
+		values[name] = strings.TrimSpace(value)
+	}
+
+	add("host", req.Host)
+	for name, vs := range req.Header {
+		add(name, strings.Join(vs, ","))
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteByte(':')
+		b.WriteString(values[name])
+		b.WriteByte('\n')
+	}
+	return strings.Join(names, ";"), b.String()
+}
+
+func hashHex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// signingKey derives the SigV4 signing key through the
+// kSecret -> kDate -> kRegion -> kService -> kSigning HMAC chain.
+func signingKey(secretKey, dateStamp, region, service string) []byte {
+	kDate := hmacSHA256([]byte("AWS4"+secretKey), dateStamp)
+	kRegion := hmacSHA256(kDate, region)
+	kService := hmacSHA256(kRegion, service)
+	return hmacSHA256(kService, "aws4_request")
+}
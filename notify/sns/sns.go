@@ -0,0 +1,238 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sns implements a Notifier that publishes alert groups to an AWS
+// SNS topic. Requests are signed with AWS Signature Version 4 by hand,
+// rather than via the AWS SDK, so that this notifier carries no dependency
+// on it.
+package sns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Notifier implements a Notifier for AWS SNS notifications.
+type Notifier struct {
+	conf    *config.SNSConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+
+	// endpoint overrides the SNS endpoint derived from conf.Region. It is
+	// only ever set by tests.
+	endpoint string
+}
+
+// New returns a new SNS notifier.
+func New(c *config.SNSConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	client, err := config.NewClient(c.HTTPConfig, "sns")
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{},
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, ok := notify.GroupKey(ctx)
+	if !ok {
+		return false, fmt.Errorf("group key missing")
+	}
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+
+	level.Debug(n.logger).Log("incident", key)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	message := tmpl(n.conf.Message)
+	subject := tmpl(n.conf.Subject)
+	if err != nil {
+		return false, err
+	}
+
+	creds, err := resolveCredentials(n.conf)
+	if err != nil {
+		return false, err
+	}
+
+	params := url.Values{}
+	params.Set("Action", "Publish")
+	params.Set("Version", "2010-03-31")
+	params.Set("TopicArn", n.conf.TopicARN)
+	params.Set("Message", message)
+	if subject != "" {
+		params.Set("Subject", subject)
+	}
+	if n.conf.AttachLabels != nil && *n.conf.AttachLabels {
+		i := 1
+		for name, value := range data.CommonLabels {
+			params.Set(fmt.Sprintf("MessageAttributes.entry.%d.Name", i), name)
+			params.Set(fmt.Sprintf("MessageAttributes.entry.%d.Value.DataType", i), "String")
+			params.Set(fmt.Sprintf("MessageAttributes.entry.%d.Value.StringValue", i), value)
+			i++
+		}
+	}
+
+	body := params.Encode()
+	endpoint := n.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://sns.%s.amazonaws.com/", n.conf.Region)
+	}
+
+	req, err := http.NewRequest("POST", endpoint, strings.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Amz-Content-Sha256", hashHex([]byte(body)))
+	signSNSRequest(req, creds, n.conf.Region, time.Now())
+
+	resp, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
+
+// resolveCredentials determines the AWS credentials to sign the request
+// with, preferring explicit configuration over the environment, and falling
+// back to the EC2/ECS instance IAM role.
+func resolveCredentials(c *config.SNSConfig) (credentials, error) {
+	accessKey, err := notify.ResolveSecret(c.AccessKey, "")
+	if err != nil {
+		return credentials{}, err
+	}
+	secretKey, err := notify.ResolveSecret(c.SecretKey, "")
+	if err != nil {
+		return credentials{}, err
+	}
+	sessionToken, err := notify.ResolveSecret(c.SessionToken, "")
+	if err != nil {
+		return credentials{}, err
+	}
+
+	if accessKey != "" && secretKey != "" {
+		return credentials{AccessKey: accessKey, SecretKey: secretKey, SessionToken: sessionToken}, nil
+	}
+
+	if envKey, envSecret := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY"); envKey != "" && envSecret != "" {
+		return credentials{AccessKey: envKey, SecretKey: envSecret, SessionToken: os.Getenv("AWS_SESSION_TOKEN")}, nil
+	}
+
+	return instanceRoleCredentials()
+}
+
+// instanceRoleCredentials fetches temporary credentials for the EC2/ECS
+// instance's attached IAM role from the instance metadata service.
+func instanceRoleCredentials() (credentials, error) {
+	const metadataBase = "http://169.254.169.254/latest/meta-data/iam/security-credentials/"
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	roleResp, err := client.Get(metadataBase)
+	if err != nil {
+		return credentials{}, fmt.Errorf("no SNS credentials configured and failed to reach instance metadata service: %w", err)
+	}
+	role, err := readMetadataBody(roleResp)
+	if err != nil {
+		return credentials{}, err
+	}
+	role = strings.TrimSpace(role)
+	if role == "" {
+		return credentials{}, fmt.Errorf("no IAM role attached to instance")
+	}
+
+	credResp, err := client.Get(metadataBase + role)
+	if err != nil {
+		return credentials{}, fmt.Errorf("failed to fetch instance role credentials: %w", err)
+	}
+	body, err := readMetadataBody(credResp)
+	if err != nil {
+		return credentials{}, err
+	}
+
+	return parseInstanceRoleCredentials(body)
+}
+
+func readMetadataBody(resp *http.Response) (string, error) {
+	defer notify.Drain(resp)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("instance metadata service returned status %d", resp.StatusCode)
+	}
+	buf := make([]byte, 0, 4096)
+	tmp := make([]byte, 4096)
+	for {
+		n, err := resp.Body.Read(tmp)
+		buf = append(buf, tmp[:n]...)
+		if err != nil {
+			break
+		}
+	}
+	return string(buf), nil
+}
+
+// parseInstanceRoleCredentials extracts AccessKeyId, SecretAccessKey and
+// Token from the instance metadata service's JSON response without pulling
+// in a JSON dependency beyond what's already used elsewhere, since the
+// response is a small flat object.
+func parseInstanceRoleCredentials(body string) (credentials, error) {
+	get := func(field string) string {
+		idx := strings.Index(body, `"`+field+`"`)
+		if idx == -1 {
+			return ""
+		}
+		rest := body[idx+len(field)+2:]
+		start := strings.Index(rest, `"`)
+		if start == -1 {
+			return ""
+		}
+		rest = rest[start+1:]
+		end := strings.Index(rest, `"`)
+		if end == -1 {
+			return ""
+		}
+		return rest[:end]
+	}
+
+	accessKey := get("AccessKeyId")
+	secretKey := get("SecretAccessKey")
+	token := get("Token")
+	if accessKey == "" || secretKey == "" {
+		return credentials{}, fmt.Errorf("instance metadata service returned incomplete credentials")
+	}
+	return credentials{AccessKey: accessKey, SecretKey: secretKey, SessionToken: token}, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestSNSRetry(t *testing.T) {
+	notifier, err := New(
+		&config.SNSConfig{
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	for statusCode, expected := range test.RetryTests(test.DefaultRetryCodes()) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("error on status %d", statusCode))
+	}
+}
+
+func TestSNSSendsExpectedRequest(t *testing.T) {
+	var gotReq *http.Request
+	var gotBody url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		require.NoError(t, r.ParseForm())
+		gotBody = r.PostForm
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	attach := true
+	conf := &config.SNSConfig{
+		TopicARN:     "arn:aws:sns:us-east-1:123456789012:my-topic",
+		Region:       "us-east-1",
+		AccessKey:    "AKIAEXAMPLE",
+		SecretKey:    "secretkey",
+		Message:      `{{ .CommonLabels.summary }}`,
+		Subject:      `{{ .CommonLabels.alertname }}`,
+		AttachLabels: &attach,
+		HTTPConfig:   &config.HTTPClientConfig{},
+	}
+	notifier, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+	notifier.endpoint = srv.URL
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "high_cpu", "summary": "CPU is too high"},
+			StartsAt: time.Now(),
+		},
+	}
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "Publish", gotBody.Get("Action"))
+	require.Equal(t, "arn:aws:sns:us-east-1:123456789012:my-topic", gotBody.Get("TopicArn"))
+	require.Equal(t, "CPU is too high", gotBody.Get("Message"))
+	require.Equal(t, "high_cpu", gotBody.Get("Subject"))
+	require.Contains(t, gotReq.Header.Get("Authorization"), "AWS4-HMAC-SHA256")
+	require.Equal(t, "String", gotBody.Get("MessageAttributes.entry.1.Value.DataType"))
+}
+
+func TestSigSNSRequestIsDeterministic(t *testing.T) {
+	req, err := http.NewRequest("POST", "https://sns.us-east-1.amazonaws.com/", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Amz-Content-Sha256", hashHex(nil))
+
+	creds := credentials{AccessKey: "AKIAEXAMPLE", SecretKey: "secretkey"}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	signSNSRequest(req, creds, "us-east-1", now)
+	first := req.Header.Get("Authorization")
+
+	req2, err := http.NewRequest("POST", "https://sns.us-east-1.amazonaws.com/", nil)
+	require.NoError(t, err)
+	req2.Header.Set("X-Amz-Content-Sha256", hashHex(nil))
+	signSNSRequest(req2, creds, "us-east-1", now)
+	second := req2.Header.Get("Authorization")
+
+	require.Equal(t, first, second)
+	require.Contains(t, first, "Credential=AKIAEXAMPLE/20260102/us-east-1/sns/aws4_request")
+}
@@ -0,0 +1,213 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlreport exports notification and alert lifecycle records to a
+// SQL database, so that reports like pages-per-team or mean-time-to-alert
+// can be produced with ordinary SQL instead of scraping logs or metrics.
+//
+// It only depends on database/sql: the concrete driver (e.g. "postgres" or
+// "mysql") must be registered by the binary embedding Alertmanager via the
+// usual blank import of a driver package. If the configured driver name is
+// not registered, Set returns an error and the exporter stays disabled.
+package sqlreport
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/prometheus/alertmanager/types"
+)
+
+const (
+	notificationsTable = "alertmanager_notifications"
+	alertsTable        = "alertmanager_alerts"
+)
+
+// Exporter appends notification and alert lifecycle records to a SQL
+// database. It is safe to reconfigure via Set while in use, e.g. across
+// configuration reloads.
+type Exporter struct {
+	logger log.Logger
+
+	mtx    sync.Mutex
+	db     *sql.DB
+	driver string
+
+	exportsTotal *prometheus.CounterVec
+	errorsTotal  prometheus.Counter
+}
+
+// New returns an Exporter that is initially disabled. Call Set to point it
+// at a database.
+func New(r prometheus.Registerer, l log.Logger) *Exporter {
+	e := &Exporter{
+		logger: l,
+		exportsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_sql_report_rows_total",
+			Help: "Number of rows written to the SQL reporting database, by table.",
+		}, []string{"table"}),
+		errorsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_sql_report_errors_total",
+			Help: "Number of failed writes to the SQL reporting database.",
+		}),
+	}
+	if r != nil {
+		r.MustRegister(e.exportsTotal, e.errorsTotal)
+	}
+	return e
+}
+
+// Set (re-)configures the destination database. Passing an empty driver
+// disables the exporter. On success, the reporting tables are created if
+// they do not already exist.
+func (e *Exporter) Set(driver, dsn string) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.db != nil {
+		e.db.Close()
+		e.db = nil
+	}
+	if driver == "" {
+		return nil
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return fmt.Errorf("open SQL reporting database: %w", err)
+	}
+	if err := ensureSchema(db); err != nil {
+		db.Close()
+		return fmt.Errorf("create SQL reporting schema: %w", err)
+	}
+	e.db = db
+	e.driver = driver
+	return nil
+}
+
+// Enabled reports whether a destination database is currently configured.
+func (e *Exporter) Enabled() bool {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	return e.db != nil
+}
+
+// ExportNotification records that alerts were sent to receiver via
+// integration as part of group groupKey. It is a best-effort operation:
+// errors are logged but never propagated, so a reporting database outage
+// never blocks actual notification delivery.
+func (e *Exporter) ExportNotification(ctx context.Context, receiver, integration, groupKey string, alerts ...*types.Alert) {
+	e.mtx.Lock()
+	db := e.db
+	driver := e.driver
+	e.mtx.Unlock()
+	if db == nil {
+		return
+	}
+
+	sentAt := time.Now()
+	for _, a := range alerts {
+		status := "firing"
+		if a.Resolved() {
+			status = "resolved"
+		}
+		_, err := db.ExecContext(ctx,
+			fmt.Sprintf(`INSERT INTO %s (sent_at, receiver, integration, group_key, fingerprint, status, alert_starts_at) VALUES (%s)`, notificationsTable, placeholders(driver, 7)),
+			sentAt, receiver, integration, groupKey, a.Fingerprint().String(), status, a.StartsAt,
+		)
+		if err != nil {
+			e.errorsTotal.Inc()
+			level.Error(e.logger).Log("msg", "failed to export notification record", "receiver", receiver, "err", err)
+			continue
+		}
+		e.exportsTotal.WithLabelValues(notificationsTable).Inc()
+	}
+}
+
+// ExportAlert records an observation of an alert's current lifecycle state.
+func (e *Exporter) ExportAlert(ctx context.Context, a *types.Alert) {
+	e.mtx.Lock()
+	db := e.db
+	driver := e.driver
+	e.mtx.Unlock()
+	if db == nil {
+		return
+	}
+
+	_, err := db.ExecContext(ctx,
+		fmt.Sprintf(`INSERT INTO %s (fingerprint, observed_at, starts_at, ends_at) VALUES (%s)`, alertsTable, placeholders(driver, 4)),
+		a.Fingerprint().String(), time.Now(), a.StartsAt, a.EndsAt,
+	)
+	if err != nil {
+		e.errorsTotal.Inc()
+		level.Error(e.logger).Log("msg", "failed to export alert record", "fingerprint", a.Fingerprint(), "err", err)
+		return
+	}
+	e.exportsTotal.WithLabelValues(alertsTable).Inc()
+}
+
+// placeholders returns n comma-separated positional parameter markers for an
+// INSERT's VALUES clause, in the syntax the given driver expects: numbered
+// "$1, $2, ..." for "postgres", the driver lib/pq requires, and "?, ?, ..."
+// for every other driver (including "mysql").
+func placeholders(driver string, n int) string {
+	ph := make([]string, n)
+	for i := range ph {
+		if driver == "postgres" {
+			ph[i] = fmt.Sprintf("$%d", i+1)
+		} else {
+			ph[i] = "?"
+		}
+	}
+	return strings.Join(ph, ", ")
+}
+
+// ensureSchema creates the reporting tables if they do not already exist.
+// Both tables are append-only logs rather than upserted current-state
+// tables, so the schema and the inserts above stay portable across SQL
+// dialects (no ON CONFLICT / ON DUPLICATE KEY differences to bridge).
+// Monthly reports (pages per team, time from alert start to first
+// notification) are expected to aggregate over these logs with GROUP BY.
+func ensureSchema(db *sql.DB) error {
+	statements := []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			sent_at TIMESTAMP NOT NULL,
+			receiver VARCHAR(256) NOT NULL,
+			integration VARCHAR(64) NOT NULL,
+			group_key VARCHAR(512) NOT NULL,
+			fingerprint VARCHAR(64) NOT NULL,
+			status VARCHAR(16) NOT NULL,
+			alert_starts_at TIMESTAMP NOT NULL
+		)`, notificationsTable),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			fingerprint VARCHAR(64) NOT NULL,
+			observed_at TIMESTAMP NOT NULL,
+			starts_at TIMESTAMP NOT NULL,
+			ends_at TIMESTAMP
+		)`, alertsTable),
+	}
+	for _, stmt := range statements {
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlreport
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/types"
+)
+
+// fakeDriver is a minimal database/sql driver that records the statements it
+// was asked to execute, so tests can exercise Exporter without a real
+// database.
+type fakeDriver struct {
+	mtx        sync.Mutex
+	statements []string
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{d: d}, nil
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{c: c, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type fakeStmt struct {
+	c     *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.d.mtx.Lock()
+	s.c.d.statements = append(s.c.d.statements, s.query)
+	s.c.d.mtx.Unlock()
+	return driver.RowsAffected(1), nil
+}
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, driver.ErrSkip
+}
+
+var registerOnce sync.Once
+var theFakeDriver = &fakeDriver{}
+
+func newTestExporter(t *testing.T) *Exporter {
+	registerOnce.Do(func() { sql.Register("sqlreport-fake", theFakeDriver) })
+
+	e := New(nil, log.NewNopLogger())
+	require.NoError(t, e.Set("sqlreport-fake", "whatever"))
+	return e
+}
+
+func TestExporterDisabledByDefault(t *testing.T) {
+	e := New(nil, log.NewNopLogger())
+	require.False(t, e.Enabled())
+	// Should be a no-op, not a panic, when nothing is configured.
+	e.ExportAlert(context.Background(), newTestAlert())
+}
+
+func TestExporterSetEmptyDriverDisables(t *testing.T) {
+	e := newTestExporter(t)
+	require.True(t, e.Enabled())
+	require.NoError(t, e.Set("", ""))
+	require.False(t, e.Enabled())
+}
+
+func TestExporterSetUnknownDriverErrors(t *testing.T) {
+	e := New(nil, log.NewNopLogger())
+	require.Error(t, e.Set("no-such-driver", "whatever"))
+	require.False(t, e.Enabled())
+}
+
+func TestExporterExportNotificationAndAlert(t *testing.T) {
+	e := newTestExporter(t)
+
+	e.ExportNotification(context.Background(), "team-receiver", "email", "group-key", newTestAlert())
+	e.ExportAlert(context.Background(), newTestAlert())
+
+	var m dto.Metric
+	require.NoError(t, e.exportsTotal.WithLabelValues(notificationsTable).Write(&m))
+	require.Equal(t, 1.0, m.GetCounter().GetValue())
+
+	require.NoError(t, e.exportsTotal.WithLabelValues(alertsTable).Write(&m))
+	require.Equal(t, 1.0, m.GetCounter().GetValue())
+}
+
+func TestPlaceholders(t *testing.T) {
+	require.Equal(t, "?, ?, ?", placeholders("mysql", 3))
+	require.Equal(t, "?, ?, ?", placeholders("", 3))
+	require.Equal(t, "$1, $2, $3", placeholders("postgres", 3))
+}
+
+func TestExporterUsesPostgresPlaceholders(t *testing.T) {
+	registerOnce.Do(func() { sql.Register("sqlreport-fake", theFakeDriver) })
+
+	e := New(nil, log.NewNopLogger())
+	require.NoError(t, e.Set("sqlreport-fake", "whatever"))
+	e.driver = "postgres"
+
+	theFakeDriver.mtx.Lock()
+	theFakeDriver.statements = nil
+	theFakeDriver.mtx.Unlock()
+
+	e.ExportAlert(context.Background(), newTestAlert())
+
+	theFakeDriver.mtx.Lock()
+	defer theFakeDriver.mtx.Unlock()
+	require.NotEmpty(t, theFakeDriver.statements)
+	last := theFakeDriver.statements[len(theFakeDriver.statements)-1]
+	require.Contains(t, last, "VALUES ($1, $2, $3, $4)")
+}
+
+func newTestAlert() *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "test"},
+			StartsAt: time.Now(),
+		},
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package standby lets the whole instance be switched into warm-standby
+// mode at runtime: alerts keep being ingested, grouped, deduplicated, and
+// otherwise tracked as usual, but no notification is actually delivered to
+// any receiver. This is useful for a DR standby that should stay warm
+// without paging anyone, or for safely testing a new version against
+// mirrored production traffic.
+package standby
+
+import "sync"
+
+// Tracker reports whether notification delivery is currently suppressed
+// instance-wide. The zero value is not usable; use New.
+type Tracker struct {
+	mtx     sync.Mutex
+	enabled bool
+}
+
+// New returns a Tracker with standby mode initially set to enabled.
+func New(enabled bool) *Tracker {
+	return &Tracker{enabled: enabled}
+}
+
+// SetEnabled toggles standby mode.
+func (t *Tracker) SetEnabled(enabled bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.enabled = enabled
+}
+
+// Enabled reports whether standby mode, and with it notification
+// suppression, is currently enabled.
+func (t *Tracker) Enabled() bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.enabled
+}
@@ -0,0 +1,36 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standby
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDefaultsToGivenState(t *testing.T) {
+	require.True(t, New(true).Enabled())
+	require.False(t, New(false).Enabled())
+}
+
+func TestSetEnabledToggles(t *testing.T) {
+	tr := New(false)
+	require.False(t, tr.Enabled())
+
+	tr.SetEnabled(true)
+	require.True(t, tr.Enabled())
+
+	tr.SetEnabled(false)
+	require.False(t, tr.Enabled())
+}
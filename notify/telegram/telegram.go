@@ -0,0 +1,98 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Notifier implements a Notifier for Telegram notifications.
+type Notifier struct {
+	conf    *config.TelegramConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Telegram notifier.
+func New(c *config.TelegramConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	client, err := config.NewClient(c.HTTPConfig, "telegram")
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{},
+	}, nil
+}
+
+type sendMessageRequest struct {
+	ChatID    int64  `json:"chat_id"`
+	Text      string `json:"text"`
+	ParseMode string `json:"parse_mode,omitempty"`
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, ok := notify.GroupKey(ctx)
+	if !ok {
+		return false, fmt.Errorf("group key missing")
+	}
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+
+	level.Debug(n.logger).Log("incident", key)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	message := tmpl(n.conf.Message)
+	if err != nil {
+		return false, err
+	}
+
+	var buf strings.Builder
+	if err := json.NewEncoder(&buf).Encode(&sendMessageRequest{
+		ChatID:    n.conf.ChatID,
+		Text:      message,
+		ParseMode: n.conf.ParseMode,
+	}); err != nil {
+		return false, err
+	}
+
+	u := fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimSuffix(n.conf.APIUrl, "/"), n.conf.BotToken)
+
+	resp, err := notify.PostJSON(ctx, n.client, u, strings.NewReader(buf.String()))
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
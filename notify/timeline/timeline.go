@@ -0,0 +1,115 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package timeline streams group lifecycle events (first notified, repeat
+// notified, resolved) to an external incident-management timeline, such as a
+// generic webhook endpoint or a Jira comment stream, giving responders an
+// automatic chronology of a group's life.
+package timeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/template"
+)
+
+// Status values reported for an Event.
+const (
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+)
+
+// Event describes a single lifecycle transition of an aggregation group.
+type Event struct {
+	Timestamp time.Time      `json:"timestamp"`
+	GroupKey  string         `json:"groupKey"`
+	Receiver  string         `json:"receiver"`
+	Status    string         `json:"status"`
+	Data      *template.Data `json:"data"`
+}
+
+// Sink posts Events to a configurable URL. It is safe to reconfigure via Set
+// while in use, e.g. across configuration reloads.
+type Sink struct {
+	client *http.Client
+	logger log.Logger
+
+	mtx sync.Mutex
+	url string
+}
+
+// New returns a Sink that is initially disabled. Call Set to point it at an
+// incident-management timeline endpoint.
+func New(l log.Logger) *Sink {
+	return &Sink{
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: l,
+	}
+}
+
+// Set (re-)configures the destination URL. An empty url disables the sink.
+func (s *Sink) Set(url string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.url = url
+}
+
+// Enabled reports whether a destination URL is currently configured.
+func (s *Sink) Enabled() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.url != ""
+}
+
+// Send posts ev to the configured URL. It is a best-effort operation: errors
+// are logged but never propagated, so a timeline outage never blocks actual
+// notification delivery.
+func (s *Sink) Send(ctx context.Context, ev Event) {
+	s.mtx.Lock()
+	url := s.url
+	s.mtx.Unlock()
+	if url == "" {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(ev); err != nil {
+		level.Error(s.logger).Log("msg", "failed to encode timeline event", "err", err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, &buf)
+	if err != nil {
+		level.Error(s.logger).Log("msg", "failed to build timeline request", "err", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		level.Warn(s.logger).Log("msg", "failed to send timeline event", "err", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		level.Warn(s.logger).Log("msg", "timeline endpoint returned unexpected status", "status", resp.StatusCode)
+	}
+}
@@ -21,11 +21,14 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
 	"github.com/pkg/errors"
 
+	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
@@ -59,6 +62,22 @@ func post(ctx context.Context, client *http.Client, url string, bodyType string,
 	return client.Do(req.WithContext(ctx))
 }
 
+// ResolveSecret returns the value to use for a secret that may be configured
+// either as a literal or via a "_file" companion field, preferring the file's
+// contents if both are set. The file is re-read on every call (rather than
+// once at config load) so that a secret mounted from e.g. a Kubernetes
+// secret volume can be rotated without requiring a config reload.
+func ResolveSecret(secret config.Secret, file string) (string, error) {
+	if file == "" {
+		return string(secret), nil
+	}
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read secret file %q", file)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
 // Drain consumes and closes the response's body to make sure that the
 // HTTP client can reuse existing connections.
 func Drain(r *http.Response) {
@@ -138,7 +157,46 @@ func GetTemplateData(ctx context.Context, tmpl *template.Template, alerts []*typ
 	if !ok {
 		level.Error(l).Log("msg", "Missing group labels")
 	}
-	return tmpl.Data(recv, groupLabels, alerts...)
+	data := tmpl.Data(recv, groupLabels, alerts...)
+	data.RelatedGroups, _ = RelatedGroups(ctx)
+	if u, ok := ExternalURL(ctx); ok {
+		data.ExternalURL = u
+	}
+	if loc, ok := Timezone(ctx); ok {
+		data.Timezone = loc.String()
+	} else {
+		data.Timezone = time.UTC.String()
+	}
+	if notes, ok := GroupNotes(ctx); ok {
+		data.Notes = notes
+	}
+
+	if opts, ok := AlertRender(ctx); ok {
+		if opts.SortLabel != "" {
+			data.Alerts = data.Alerts.SortByLabelOrder(string(opts.SortLabel), opts.SortOrder)
+		}
+		data.Alerts, data.TruncatedAlerts = data.Alerts.Truncate(opts.MaxAlerts)
+	}
+
+	if diff, ok := Diff(ctx); ok {
+		newFPs := make(map[string]struct{}, len(diff.NewFingerprints))
+		for _, fp := range diff.NewFingerprints {
+			newFPs[fp] = struct{}{}
+		}
+		changedFPs := make(map[string]struct{}, len(diff.ChangedFingerprints))
+		for _, fp := range diff.ChangedFingerprints {
+			changedFPs[fp] = struct{}{}
+		}
+		for _, a := range data.Alerts {
+			if _, ok := newFPs[a.Fingerprint]; ok {
+				data.NewAlerts = append(data.NewAlerts, a)
+			} else if _, ok := changedFPs[a.Fingerprint]; ok {
+				data.ChangedAlerts = append(data.ChangedAlerts, a)
+			}
+		}
+	}
+
+	return data
 }
 
 func readAll(r io.Reader) string {
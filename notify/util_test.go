@@ -15,15 +15,52 @@ package notify
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
 	"testing"
 
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
 )
 
+func TestGetTemplateDataSortsAndTruncatesAlerts(t *testing.T) {
+	tmpl, err := template.FromGlobs()
+	require.NoError(t, err)
+	tmpl.ExternalURL, err = url.Parse("http://am")
+	require.NoError(t, err)
+
+	alerts := []*types.Alert{
+		{Alert: model.Alert{Labels: model.LabelSet{"severity": "warning", "alertname": "a"}}},
+		{Alert: model.Alert{Labels: model.LabelSet{"severity": "critical", "alertname": "b"}}},
+		{Alert: model.Alert{Labels: model.LabelSet{"severity": "info", "alertname": "c"}}},
+	}
+
+	ctx := WithReceiverName(context.Background(), "team-X")
+	ctx = WithGroupLabels(ctx, model.LabelSet{})
+	ctx = WithAlertRenderOpts(ctx, AlertRenderOpts{
+		SortLabel: "severity",
+		SortOrder: []string{"critical", "warning", "info"},
+		MaxAlerts: 2,
+	})
+
+	data := GetTemplateData(ctx, tmpl, alerts, log.NewNopLogger())
+
+	require.Len(t, data.Alerts, 2)
+	require.Equal(t, "b", data.Alerts[0].Labels["alertname"])
+	require.Equal(t, "a", data.Alerts[1].Labels["alertname"])
+	require.Equal(t, 1, data.TruncatedAlerts)
+}
+
 func TestTruncate(t *testing.T) {
 	testCases := []struct {
 		in string
@@ -85,6 +122,26 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestResolveSecret(t *testing.T) {
+	key, err := ResolveSecret(config.Secret("from-config"), "")
+	require.NoError(t, err)
+	require.Equal(t, "from-config", key)
+
+	f, err := ioutil.TempFile("", "resolve-secret")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("from-file\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	key, err = ResolveSecret(config.Secret("from-config"), f.Name())
+	require.NoError(t, err)
+	require.Equal(t, "from-file", key)
+
+	_, err = ResolveSecret("", "/does/not/exist")
+	require.Error(t, err)
+}
+
 type brokenReader struct{}
 
 func (b brokenReader) Read([]byte) (int, error) {
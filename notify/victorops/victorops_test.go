@@ -22,7 +22,6 @@ import (
 	"time"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
@@ -51,7 +50,7 @@ func TestVictorOpsCustomFields(t *testing.T) {
 		CustomFields: map[string]string{
 			"Field_A": "{{ .CommonLabels.Message }}",
 		},
-		HTTPConfig: &commoncfg.HTTPClientConfig{},
+		HTTPConfig: &config.HTTPClientConfig{},
 	}
 
 	notifier, err := New(conf, tmpl, logger)
@@ -86,7 +85,7 @@ func TestVictorOpsRetry(t *testing.T) {
 	notifier, err := New(
 		&config.VictorOpsConfig{
 			APIKey:     config.Secret("secret"),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -107,7 +106,7 @@ func TestVictorOpsRedactedURL(t *testing.T) {
 		&config.VictorOpsConfig{
 			APIURL:     &config.URL{URL: u},
 			APIKey:     config.Secret(secret),
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
@@ -0,0 +1,115 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package discovery resolves webhook targets dynamically via DNS SRV
+// records, as exposed by Consul's DNS interface or a Kubernetes headless
+// service, instead of a single hardcoded URL. Resolved targets are
+// TCP-health-checked before use and rotated between on successive calls, so
+// that a notifier backed by a Resolver fails over to a healthy peer instead
+// of repeatedly hitting a dead target.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/config"
+)
+
+// healthCheckTimeout bounds how long a single target's TCP health check may
+// take, so that one unreachable target cannot stall a refresh.
+const healthCheckTimeout = 5 * time.Second
+
+// Resolver resolves a WebhookSDConfig's DNSName into a rotating set of
+// healthy targets, re-resolving at most once per RefreshInterval.
+type Resolver struct {
+	conf   *config.WebhookSDConfig
+	logger log.Logger
+	lookup func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	dial   func(ctx context.Context, address string) error
+
+	mtx         sync.Mutex
+	targets     []string
+	next        int
+	lastRefresh time.Time
+}
+
+// New returns a Resolver for conf.
+func New(conf *config.WebhookSDConfig, l log.Logger) *Resolver {
+	return &Resolver{
+		conf:   conf,
+		logger: l,
+		lookup: net.DefaultResolver.LookupSRV,
+		dial: func(ctx context.Context, address string) error {
+			conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
+// Target returns the next healthy target's URL, rotating across all
+// currently healthy targets. It re-resolves DNSName if the cached set of
+// targets is older than RefreshInterval.
+func (r *Resolver) Target(ctx context.Context) (string, error) {
+	r.mtx.Lock()
+	stale := time.Since(r.lastRefresh) > time.Duration(r.conf.RefreshInterval)
+	r.mtx.Unlock()
+	if stale {
+		r.refresh(ctx)
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if len(r.targets) == 0 {
+		return "", fmt.Errorf("no healthy webhook targets for %q", r.conf.DNSName)
+	}
+	target := r.targets[r.next%len(r.targets)]
+	r.next++
+	return fmt.Sprintf("%s://%s%s", r.conf.Scheme, target, r.conf.Path), nil
+}
+
+func (r *Resolver) refresh(ctx context.Context) {
+	_, srvs, err := r.lookup(ctx, "", "", r.conf.DNSName)
+	if err != nil {
+		level.Warn(r.logger).Log("msg", "failed to resolve webhook targets", "dns_name", r.conf.DNSName, "err", err)
+		return
+	}
+
+	var healthy []string
+	for _, srv := range srvs {
+		target := fmt.Sprintf("%s:%d", strings.TrimSuffix(srv.Target, "."), srv.Port)
+		hctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := r.dial(hctx, target)
+		cancel()
+		if err != nil {
+			level.Warn(r.logger).Log("msg", "webhook target failed health check", "target", target, "err", err)
+			continue
+		}
+		healthy = append(healthy, target)
+	}
+
+	r.mtx.Lock()
+	r.targets = healthy
+	r.lastRefresh = time.Now()
+	r.mtx.Unlock()
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+)
+
+func testConf() *config.WebhookSDConfig {
+	return &config.WebhookSDConfig{
+		DNSName:         "_http._tcp.alerts.service.consul",
+		Scheme:          "http",
+		Path:            "/webhook",
+		RefreshInterval: model.Duration(time.Minute),
+	}
+}
+
+func TestResolverRotatesAcrossHealthyTargets(t *testing.T) {
+	r := New(testConf(), log.NewNopLogger())
+	r.lookup = func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		return "", []*net.SRV{
+			{Target: "a.service.consul.", Port: 8080},
+			{Target: "b.service.consul.", Port: 8080},
+		}, nil
+	}
+	r.dial = func(ctx context.Context, address string) error { return nil }
+
+	seen := map[string]bool{}
+	for i := 0; i < 4; i++ {
+		target, err := r.Target(context.Background())
+		require.NoError(t, err)
+		seen[target] = true
+	}
+	require.Equal(t, map[string]bool{
+		"http://a.service.consul:8080/webhook": true,
+		"http://b.service.consul:8080/webhook": true,
+	}, seen)
+}
+
+func TestResolverSkipsUnhealthyTargets(t *testing.T) {
+	r := New(testConf(), log.NewNopLogger())
+	r.lookup = func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		return "", []*net.SRV{
+			{Target: "dead.service.consul.", Port: 8080},
+			{Target: "alive.service.consul.", Port: 8080},
+		}, nil
+	}
+	r.dial = func(ctx context.Context, address string) error {
+		if address == "dead.service.consul:8080" {
+			return fmt.Errorf("connection refused")
+		}
+		return nil
+	}
+
+	target, err := r.Target(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "http://alive.service.consul:8080/webhook", target)
+}
+
+func TestResolverErrorsWithNoHealthyTargets(t *testing.T) {
+	r := New(testConf(), log.NewNopLogger())
+	r.lookup = func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		return "", []*net.SRV{{Target: "dead.service.consul.", Port: 8080}}, nil
+	}
+	r.dial = func(ctx context.Context, address string) error { return fmt.Errorf("connection refused") }
+
+	_, err := r.Target(context.Background())
+	require.Error(t, err)
+}
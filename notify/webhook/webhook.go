@@ -16,18 +16,27 @@ package webhook
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
-	commoncfg "github.com/prometheus/common/config"
+	"github.com/pkg/errors"
 	"github.com/prometheus/common/version"
 
 	"github.com/prometheus/alertmanager/config"
 	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/webhook/discovery"
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/silence/silencepb"
 	"github.com/prometheus/alertmanager/template"
 	"github.com/prometheus/alertmanager/types"
 )
@@ -36,41 +45,124 @@ var userAgentHeader = fmt.Sprintf("Alertmanager/%s", version.Version)
 
 // Notifier implements a Notifier for generic webhooks.
 type Notifier struct {
-	conf    *config.WebhookConfig
-	tmpl    *template.Template
-	logger  log.Logger
-	client  *http.Client
-	retrier *notify.Retrier
+	conf     *config.WebhookConfig
+	tmpl     *template.Template
+	logger   log.Logger
+	client   *http.Client
+	retrier  *notify.Retrier
+	resolver *discovery.Resolver
+	silences *silence.Silences
 }
 
 // New returns a new Webhook.
-func New(conf *config.WebhookConfig, t *template.Template, l log.Logger) (*Notifier, error) {
-	client, err := commoncfg.NewClientFromConfig(*conf.HTTPConfig, "webhook", false)
+func New(conf *config.WebhookConfig, t *template.Template, l log.Logger, silences *silence.Silences) (*Notifier, error) {
+	client, err := config.NewClient(conf.HTTPConfig, "webhook")
 	if err != nil {
 		return nil, err
 	}
-	return &Notifier{
-		conf:   conf,
-		tmpl:   t,
-		logger: l,
-		client: client,
-		// Webhooks are assumed to respond with 2xx response codes on a successful
-		// request and 5xx response codes are assumed to be recoverable.
-		retrier: &notify.Retrier{
-			CustomDetailsFunc: func(int, io.Reader) string {
-				return conf.URL.String()
-			},
+	n := &Notifier{
+		conf:     conf,
+		tmpl:     t,
+		logger:   l,
+		client:   client,
+		silences: silences,
+	}
+	if conf.ServiceDiscovery != nil {
+		n.resolver = discovery.New(conf.ServiceDiscovery, l)
+	}
+	// Webhooks are assumed to respond with 2xx response codes on a successful
+	// request and 5xx response codes are assumed to be recoverable.
+	n.retrier = &notify.Retrier{
+		CustomDetailsFunc: func(int, io.Reader) string {
+			return n.targetURL()
 		},
-	}, nil
+	}
+	return n, nil
+}
+
+// targetURL returns the target URL for display purposes (logging, the
+// retrier's error details), best-effort: a url_file read failure is reported
+// as an empty string rather than propagated, since callers in this role
+// cannot return an error.
+func (n *Notifier) targetURL() string {
+	url, err := n.resolveURL()
+	if err != nil {
+		return ""
+	}
+	return url
 }
 
-// Message defines the JSON object send to webhook endpoints.
+// resolveURL returns the static configured URL, the contents of url_file if
+// that is configured instead, or the service discovery DNS name if the
+// notifier uses service discovery (Notify then asks the resolver for the
+// actual target; the DNS name is not a URL). url_file is re-read on every notification
+// so that a mounted secret can be rotated without an Alertmanager reload.
+func (n *Notifier) resolveURL() (string, error) {
+	if n.conf.URL != nil {
+		return n.conf.URL.String(), nil
+	}
+	if n.conf.URLFile != "" {
+		content, err := ioutil.ReadFile(n.conf.URLFile)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read url_file %q", n.conf.URLFile)
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+	return n.conf.ServiceDiscovery.DNSName, nil
+}
+
+// Message defines the JSON object send to webhook endpoints on payload
+// version 4. It is the latest schema.
 type Message struct {
 	*template.Data
 
 	// The protocol version.
 	Version  string `json:"version"`
 	GroupKey string `json:"groupKey"`
+
+	// DedupKey is the group's templated deduplication key (see
+	// config.Route.DedupKeyTemplate), letting a receiver correlate
+	// notifications by a value derived from group labels instead of
+	// GroupKey, which is opaque and changes across Alertmanager restarts
+	// and routing tree edits. Omitted if the route has no
+	// dedup_key_template.
+	DedupKey string `json:"dedupKey,omitempty"`
+}
+
+// messageV3 is the payload schema used before the groupKey field was added.
+// It is kept so that consumers pinned to payload_version: "3" keep working
+// across Alertmanager upgrades.
+type messageV3 struct {
+	*template.Data
+
+	Version string `json:"version"`
+}
+
+// payloadVersionHeader advertises the schema version of the request body so
+// that a receiver can detect a mismatch with what it expects.
+const payloadVersionHeader = "X-Alertmanager-Payload-Version"
+
+// signatureHeader carries the hex-encoded HMAC-SHA256 of the request body,
+// computed with the configured HMACSecret, so a receiver can verify the
+// request came from this Alertmanager.
+const signatureHeader = "X-Alertmanager-Signature"
+
+// response is the optional JSON payload a webhook receiver may reply with to
+// drive simple bidirectional actions, e.g. {"ack": true, "silence_minutes": 60}.
+// A receiver that has no use for this can keep replying with an empty body.
+type response struct {
+	// Ack, if true, is logged as an acknowledgement of the notified group.
+	Ack bool `json:"ack"`
+	// SilenceMinutes, if greater than zero, silences the notified group for
+	// that many minutes.
+	SilenceMinutes int `json:"silence_minutes"`
+}
+
+// sign returns the hex-encoded HMAC-SHA256 of body, keyed with secret.
+func sign(secret config.Secret, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
 }
 
 // Notify implements the Notifier interface.
@@ -82,10 +174,18 @@ func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, er
 		level.Error(n.logger).Log("err", err)
 	}
 
-	msg := &Message{
-		Version:  "4",
-		Data:     data,
-		GroupKey: groupKey.String(),
+	version := n.conf.PayloadVersion
+	if version == "" {
+		version = "4"
+	}
+
+	var msg interface{}
+	switch version {
+	case "3":
+		msg = &messageV3{Version: version, Data: data}
+	default:
+		dedupKey, _ := notify.DedupKey(ctx)
+		msg = &Message{Version: version, Data: data, GroupKey: groupKey.String(), DedupKey: dedupKey}
 	}
 
 	var buf bytes.Buffer
@@ -93,18 +193,103 @@ func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, er
 		return false, err
 	}
 
-	req, err := http.NewRequest("POST", n.conf.URL.String(), &buf)
+	url, err := n.resolveURL()
+	if err != nil {
+		return false, err
+	}
+	if n.resolver != nil {
+		url, err = n.resolver.Target(ctx)
+		if err != nil {
+			return true, err
+		}
+	}
+
+	req, err := http.NewRequest("POST", url, &buf)
 	if err != nil {
 		return true, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", userAgentHeader)
+	req.Header.Set(payloadVersionHeader, version)
+	if n.conf.HMACSecret != "" {
+		req.Header.Set(signatureHeader, sign(n.conf.HMACSecret, buf.Bytes()))
+	}
 
 	resp, err := n.client.Do(req.WithContext(ctx))
 	if err != nil {
 		return true, err
 	}
-	notify.Drain(resp)
+	defer notify.Drain(resp)
+
+	if supported := resp.Header.Get(payloadVersionHeader + "-Supported"); supported != "" && !strings.Contains(supported, version) {
+		level.Warn(n.logger).Log(
+			"msg", "webhook receiver does not advertise support for the configured payload version",
+			"configured", version,
+			"supported", supported,
+		)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return true, err
+	}
+
+	if retry, err := n.retrier.Check(resp.StatusCode, bytes.NewReader(body)); err != nil {
+		return retry, err
+	}
+
+	n.applyResponse(body, data.GroupLabels)
 
-	return n.retrier.Check(resp.StatusCode, nil)
+	return false, nil
+}
+
+// applyResponse interprets body as an optional response and applies the
+// actions it requests. It only logs on failure, since the notification
+// itself has already succeeded by the time it is called.
+func (n *Notifier) applyResponse(body []byte, groupLabels template.KV) {
+	if len(body) == 0 {
+		return
+	}
+	var resp response
+	if err := json.Unmarshal(body, &resp); err != nil {
+		// Not every receiver replies with a body we understand; that's fine.
+		return
+	}
+
+	if resp.Ack {
+		level.Info(n.logger).Log("msg", "webhook receiver acknowledged group", "url", n.targetURL())
+	}
+
+	if resp.SilenceMinutes <= 0 {
+		return
+	}
+	if n.silences == nil {
+		level.Warn(n.logger).Log("msg", "webhook requested a silence but no silence store is configured")
+		return
+	}
+	if len(groupLabels) == 0 {
+		level.Warn(n.logger).Log("msg", "webhook requested a silence but the notified group has no labels to match on")
+		return
+	}
+
+	sil := &silencepb.Silence{
+		StartsAt:  time.Now(),
+		EndsAt:    time.Now().Add(time.Duration(resp.SilenceMinutes) * time.Minute),
+		CreatedBy: "webhook",
+		Comment:   fmt.Sprintf("Silenced in response to a webhook notification to %s", n.targetURL()),
+	}
+	for name, value := range groupLabels {
+		sil.Matchers = append(sil.Matchers, &silencepb.Matcher{
+			Type:    silencepb.Matcher_EQUAL,
+			Name:    name,
+			Pattern: value,
+		})
+	}
+
+	id, err := n.silences.Set(sil)
+	if err != nil {
+		level.Error(n.logger).Log("msg", "failed to create silence requested by webhook", "err", err)
+		return
+	}
+	level.Info(n.logger).Log("msg", "created silence requested by webhook", "silence", id, "minutes", resp.SilenceMinutes)
 }
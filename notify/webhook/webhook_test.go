@@ -14,16 +14,27 @@
 package webhook
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
+	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
 	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/types"
 )
 
 func TestWebhookRetry(t *testing.T) {
@@ -34,10 +45,11 @@ func TestWebhookRetry(t *testing.T) {
 	notifier, err := New(
 		&config.WebhookConfig{
 			URL:        &config.URL{URL: u},
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 		},
 		test.CreateTmpl(t),
 		log.NewNopLogger(),
+		nil,
 	)
 	if err != nil {
 		require.NoError(t, err)
@@ -47,3 +59,202 @@ func TestWebhookRetry(t *testing.T) {
 		require.Equal(t, expected, actual, fmt.Sprintf("error on status %d", statusCode))
 	}
 }
+
+func TestWebhookPayloadVersion3OmitsGroupKey(t *testing.T) {
+	var gotBody map[string]interface{}
+	var gotVersionHeader string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotVersionHeader = r.Header.Get(payloadVersionHeader)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	notifier, err := New(
+		&config.WebhookConfig{
+			URL:            &config.URL{URL: u},
+			HTTPConfig:     &config.HTTPClientConfig{},
+			PayloadVersion: "3",
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+		nil,
+	)
+	require.NoError(t, err)
+
+	_, err = notifier.Notify(context.Background(), []*types.Alert{}...)
+	require.NoError(t, err)
+
+	require.Equal(t, "3", gotVersionHeader)
+	require.Equal(t, "3", gotBody["version"])
+	_, hasGroupKey := gotBody["groupKey"]
+	require.False(t, hasGroupKey)
+}
+
+func TestWebhookIncludesDedupKeyWhenSet(t *testing.T) {
+	var gotBody map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	notifier, err := New(
+		&config.WebhookConfig{
+			URL:        &config.URL{URL: u},
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+		nil,
+	)
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "opaque-group-key")
+	ctx = notify.WithDedupKey(ctx, "prod-1-NodeDown")
+
+	_, err = notifier.Notify(ctx, []*types.Alert{}...)
+	require.NoError(t, err)
+
+	require.Equal(t, "opaque-group-key", gotBody["groupKey"])
+	require.Equal(t, "prod-1-NodeDown", gotBody["dedupKey"])
+}
+
+func TestWebhookOmitsDedupKeyWhenUnset(t *testing.T) {
+	var gotBody map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	notifier, err := New(
+		&config.WebhookConfig{
+			URL:        &config.URL{URL: u},
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+		nil,
+	)
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "opaque-group-key")
+
+	_, err = notifier.Notify(ctx, []*types.Alert{}...)
+	require.NoError(t, err)
+
+	_, hasDedupKey := gotBody["dedupKey"]
+	require.False(t, hasDedupKey)
+}
+
+func TestWebhookCreatesSilenceFromResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"ack":             true,
+			"silence_minutes": 60,
+		})
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	silences, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+
+	notifier, err := New(
+		&config.WebhookConfig{
+			URL:        &config.URL{URL: u},
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+		silences,
+	)
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	ctx = notify.WithGroupLabels(ctx, model.LabelSet{"alertname": "test"})
+
+	_, err = notifier.Notify(ctx, []*types.Alert{}...)
+	require.NoError(t, err)
+
+	sils, _, err := silences.Query()
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+	require.Len(t, sils[0].Matchers, 1)
+	require.Equal(t, "alertname", sils[0].Matchers[0].Name)
+	require.Equal(t, "test", sils[0].Matchers[0].Pattern)
+}
+
+func TestWebhookSignsRequestWithHMACSecret(t *testing.T) {
+	var gotSignature string
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(signatureHeader)
+		gotBody, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	notifier, err := New(
+		&config.WebhookConfig{
+			URL:        &config.URL{URL: u},
+			HTTPConfig: &config.HTTPClientConfig{},
+			HMACSecret: "super-secret",
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+		nil,
+	)
+	require.NoError(t, err)
+
+	_, err = notifier.Notify(context.Background(), []*types.Alert{}...)
+	require.NoError(t, err)
+
+	mac := hmac.New(sha256.New, []byte("super-secret"))
+	mac.Write(gotBody)
+	require.Equal(t, hex.EncodeToString(mac.Sum(nil)), gotSignature)
+}
+
+func TestWebhookOmitsSignatureWithoutHMACSecret(t *testing.T) {
+	var sawHeader bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, sawHeader = r.Header[signatureHeader]
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	notifier, err := New(
+		&config.WebhookConfig{
+			URL:        &config.URL{URL: u},
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+		nil,
+	)
+	require.NoError(t, err)
+
+	_, err = notifier.Notify(context.Background(), []*types.Alert{}...)
+	require.NoError(t, err)
+
+	require.False(t, sawHeader, "signature header should not be set without an hmac_secret")
+}
@@ -19,7 +19,6 @@ import (
 	"testing"
 
 	"github.com/go-kit/kit/log"
-	commoncfg "github.com/prometheus/common/config"
 	"github.com/stretchr/testify/require"
 
 	"github.com/prometheus/alertmanager/config"
@@ -34,7 +33,7 @@ func TestWechatRedactedURLOnInitialAuthentication(t *testing.T) {
 	notifier, err := New(
 		&config.WechatConfig{
 			APIURL:     &config.URL{URL: u},
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 			CorpID:     "corpid",
 			APISecret:  config.Secret(secret),
 		},
@@ -56,7 +55,7 @@ func TestWechatRedactedURLOnNotify(t *testing.T) {
 	notifier, err := New(
 		&config.WechatConfig{
 			APIURL:     &config.URL{URL: u},
-			HTTPConfig: &commoncfg.HTTPClientConfig{},
+			HTTPConfig: &config.HTTPClientConfig{},
 			CorpID:     "corpid",
 			APISecret:  config.Secret(secret),
 		},
@@ -0,0 +1,244 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xmpp
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	commoncfg "github.com/prometheus/common/config"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// handshakeTimeout bounds how long the notifier waits for stream negotiation,
+// SASL authentication, resource binding and joining Room before giving up.
+const handshakeTimeout = 15 * time.Second
+
+// Notifier implements a Notifier that posts alerts to an XMPP MUC room. Each
+// notification opens a fresh connection, negotiates a stream, authenticates
+// via SASL PLAIN, binds a resource, joins Room, sends the rendered message as
+// a single groupchat message and disconnects, mirroring the stateless,
+// connect-per-call shape of Alertmanager's other notifiers.
+type Notifier struct {
+	conf   *config.XMPPConfig
+	tmpl   *template.Template
+	logger log.Logger
+}
+
+// New returns a new XMPP notifier.
+func New(c *config.XMPPConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	return &Notifier{
+		conf:   c,
+		tmpl:   t,
+		logger: l,
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+	data := notify.GetTemplateData(ctx, n.tmpl, alerts, n.logger)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	message := tmpl(n.conf.Message)
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := n.dial(ctx)
+	if err != nil {
+		return true, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+
+	w := bufio.NewWriter(conn)
+	dec := xml.NewDecoder(conn)
+
+	if err := n.negotiateStream(w, dec); err != nil {
+		return true, err
+	}
+	if err := n.authenticate(w, dec); err != nil {
+		return true, err
+	}
+	// Authentication restarts the stream.
+	if err := n.negotiateStream(w, dec); err != nil {
+		return true, err
+	}
+	if err := n.bindResource(w, dec); err != nil {
+		return true, err
+	}
+	if err := n.joinRoom(w, dec); err != nil {
+		return true, err
+	}
+
+	conn.SetDeadline(time.Time{})
+	if err := n.sendMessage(w, message); err != nil {
+		return true, err
+	}
+	level.Debug(n.logger).Log("msg", "sent XMPP groupchat message", "room", n.conf.Room)
+
+	fmt.Fprint(w, "</stream:stream>")
+	w.Flush()
+	return false, nil
+}
+
+func (n *Notifier) dial(ctx context.Context) (net.Conn, error) {
+	addr := fmt.Sprintf("%s:%d", n.conf.Server, n.conf.Port)
+	d := &net.Dialer{}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial XMPP server: %w", err)
+	}
+	if !n.conf.TLS {
+		return conn, nil
+	}
+
+	tlsConfig, err := commoncfg.NewTLSConfig(&n.conf.TLSConfig)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("build XMPP TLS config: %w", err)
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = n.conf.Server
+	}
+	tlsConn := tls.Client(conn, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("XMPP TLS handshake: %w", err)
+	}
+	return tlsConn, nil
+}
+
+// negotiateStream opens (or re-opens, after SASL authentication) the XML
+// stream and reads until the server has announced its features.
+func (n *Notifier) negotiateStream(w *bufio.Writer, dec *xml.Decoder) error {
+	fmt.Fprintf(w, "<?xml version='1.0'?><stream:stream to='%s' xmlns='jabber:client' xmlns:stream='http://etherx.jabber.org/streams' version='1.0'>", xmlEscape(n.domain()))
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("open XMPP stream: %w", err)
+	}
+	_, err := readElement(dec, "features")
+	if err != nil {
+		return fmt.Errorf("negotiate XMPP stream: %w", err)
+	}
+	return nil
+}
+
+// authenticate performs SASL PLAIN authentication.
+func (n *Notifier) authenticate(w *bufio.Writer, dec *xml.Decoder) error {
+	payload := fmt.Sprintf("\x00%s\x00%s", n.conf.Username, string(n.conf.Password))
+	fmt.Fprintf(w, "<auth xmlns='urn:ietf:params:xml:ns:xmpp-sasl' mechanism='PLAIN'>%s</auth>",
+		base64.StdEncoding.EncodeToString([]byte(payload)))
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("send XMPP SASL auth: %w", err)
+	}
+
+	el, err := readElement(dec, "success", "failure")
+	if err != nil {
+		return fmt.Errorf("read XMPP SASL response: %w", err)
+	}
+	if el.Local == "failure" {
+		return fmt.Errorf("XMPP SASL authentication failed")
+	}
+	return nil
+}
+
+// bindResource binds a resource so the server assigns the notifier a full
+// JID to send and receive stanzas with.
+func (n *Notifier) bindResource(w *bufio.Writer, dec *xml.Decoder) error {
+	fmt.Fprint(w, "<iq type='set' id='bind1'><bind xmlns='urn:ietf:params:xml:ns:xmpp-bind'><resource>alertmanager</resource></bind></iq>")
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("send XMPP resource bind: %w", err)
+	}
+	if _, err := readElement(dec, "iq"); err != nil {
+		return fmt.Errorf("read XMPP resource bind response: %w", err)
+	}
+	return nil
+}
+
+// joinRoom sends MUC presence to join Room under Nick.
+func (n *Notifier) joinRoom(w *bufio.Writer, dec *xml.Decoder) error {
+	fmt.Fprintf(w, "<presence to='%s/%s'><x xmlns='http://jabber.org/protocol/muc'/></presence>",
+		xmlEscape(n.conf.Room), xmlEscape(n.conf.Nick))
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("send XMPP MUC join presence: %w", err)
+	}
+	if _, err := readElement(dec, "presence"); err != nil {
+		return fmt.Errorf("read XMPP MUC join response: %w", err)
+	}
+	return nil
+}
+
+// sendMessage sends body as a single groupchat message to Room.
+func (n *Notifier) sendMessage(w *bufio.Writer, body string) error {
+	fmt.Fprintf(w, "<message to='%s' type='groupchat'><body>%s</body></message>", xmlEscape(n.conf.Room), xmlEscape(body))
+	return w.Flush()
+}
+
+func (n *Notifier) domain() string {
+	return n.conf.Server
+}
+
+// readElement reads stream-level tokens until it sees the start of an
+// element whose local name matches one of names, and returns its StartElement.
+func readElement(dec *xml.Decoder, names ...string) (xml.Name, error) {
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return xml.Name{}, err
+		}
+		se, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		for _, name := range names {
+			if se.Name.Local == name {
+				return se.Name, nil
+			}
+		}
+	}
+}
+
+func xmlEscape(s string) string {
+	var buf []byte
+	for _, r := range s {
+		switch r {
+		case '&':
+			buf = append(buf, "&amp;"...)
+		case '<':
+			buf = append(buf, "&lt;"...)
+		case '>':
+			buf = append(buf, "&gt;"...)
+		case '\'':
+			buf = append(buf, "&apos;"...)
+		case '"':
+			buf = append(buf, "&quot;"...)
+		default:
+			buf = append(buf, string(r)...)
+		}
+	}
+	return string(buf)
+}
@@ -0,0 +1,161 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xmpp
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// fakeServer is a minimal XMPP server that accepts a single connection,
+// negotiates a stream, authenticates via SASL PLAIN, binds a resource,
+// acknowledges joining the MUC room, and records the message stanza sent
+// afterwards.
+type fakeServer struct {
+	ln       net.Listener
+	received chan string
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeServer{ln: ln, received: make(chan string, 4)}
+	go s.run()
+	return s
+}
+
+func (s *fakeServer) addr() (string, int) {
+	tcpAddr := s.ln.Addr().(*net.TCPAddr)
+	return tcpAddr.IP.String(), tcpAddr.Port
+}
+
+func (s *fakeServer) close() { s.ln.Close() }
+
+func (s *fakeServer) run() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	w := bufio.NewWriter(conn)
+	dec := xml.NewDecoder(conn)
+
+	// Stream open, then features.
+	if _, err := readElement(dec, "stream"); err != nil {
+		return
+	}
+	w.WriteString("<stream:features><mechanisms xmlns='urn:ietf:params:xml:ns:xmpp-sasl'><mechanism>PLAIN</mechanism></mechanisms></stream:features>")
+	w.Flush()
+
+	// SASL auth.
+	if _, err := readElement(dec, "auth"); err != nil {
+		return
+	}
+	w.WriteString("<success xmlns='urn:ietf:params:xml:ns:xmpp-sasl'/>")
+	w.Flush()
+
+	// Stream re-open, then features again.
+	if _, err := readElement(dec, "stream"); err != nil {
+		return
+	}
+	w.WriteString("<stream:features/>")
+	w.Flush()
+
+	// Resource bind.
+	if _, err := readElement(dec, "iq"); err != nil {
+		return
+	}
+	w.WriteString("<iq type='result' id='bind1'/>")
+	w.Flush()
+
+	// MUC join presence.
+	if _, err := readElement(dec, "presence"); err != nil {
+		return
+	}
+	w.WriteString("<presence/>")
+	w.Flush()
+
+	// Message.
+	tok, err := dec.Token()
+	for err == nil {
+		if se, ok := tok.(xml.StartElement); ok && se.Name.Local == "message" {
+			var body struct {
+				XMLName xml.Name `xml:"message"`
+				Body    string   `xml:"body"`
+			}
+			if decErr := dec.DecodeElement(&body, &se); decErr == nil {
+				s.received <- body.Body
+			}
+			return
+		}
+		tok, err = dec.Token()
+	}
+}
+
+func testAlert() *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "test"},
+			StartsAt: time.Now(),
+		},
+	}
+}
+
+func TestXMPPNotifySendsMessage(t *testing.T) {
+	srv := newFakeServer(t)
+	defer srv.close()
+	host, port := srv.addr()
+
+	conf := &config.XMPPConfig{
+		Server:   host,
+		Port:     port,
+		Username: "alertmanager@example.com",
+		Password: "secret",
+		Room:     "alerts@conference.example.com",
+		Nick:     "alertmanager",
+		Message:  "something happened",
+	}
+	n, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := n.Notify(ctx, testAlert())
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	select {
+	case body := <-srv.received:
+		require.Equal(t, "something happened", body)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message")
+	}
+}
+
+func TestXMLEscape(t *testing.T) {
+	require.Equal(t, "a &amp; b &lt;c&gt; &apos;d&apos; &quot;e&quot;", xmlEscape(`a & b <c> 'd' "e"`))
+}
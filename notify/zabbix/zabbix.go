@@ -0,0 +1,195 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zabbix implements a minimal client for the Zabbix sender
+// ("trapper") protocol, so that alerts can be forwarded as values on a
+// Zabbix trapper item without depending on the zabbix_sender binary or
+// a vendored client library, neither of which this repository carries.
+package zabbix
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// header is the fixed 5-byte magic that precedes every Zabbix sender
+// protocol message.
+var header = []byte("ZBXD\x01")
+
+// sessionTimeout bounds how long the notifier waits to send the request
+// and read the trapper's response.
+const sessionTimeout = 15 * time.Second
+
+// Notifier implements a Notifier that forwards alerts as values on a
+// Zabbix trapper item. Each notification opens a fresh connection to the
+// Zabbix server, sends one "sender data" request and closes it, mirroring
+// the stateless, connect-per-call shape of Alertmanager's other notifiers.
+type Notifier struct {
+	conf   *config.ZabbixConfig
+	tmpl   *template.Template
+	logger log.Logger
+}
+
+// New returns a new Zabbix notifier.
+func New(c *config.ZabbixConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	return &Notifier{
+		conf:   c,
+		tmpl:   t,
+		logger: l,
+	}, nil
+}
+
+// item is a single value in a Zabbix sender "sender data" request.
+type item struct {
+	Host  string `json:"host"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Clock int64  `json:"clock"`
+}
+
+// senderRequest is the JSON payload of a Zabbix sender protocol request.
+type senderRequest struct {
+	Request string `json:"request"`
+	Data    []item `json:"data"`
+}
+
+// senderResponse is the JSON payload of a Zabbix sender protocol response.
+type senderResponse struct {
+	Response string `json:"response"`
+	Info     string `json:"info"`
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, alerts ...*types.Alert) (bool, error) {
+	data := notify.GetTemplateData(ctx, n.tmpl, alerts, n.logger)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+	host := tmpl(n.conf.Host)
+	key := tmpl(n.conf.Key)
+	value := tmpl(n.conf.Value)
+	if err != nil {
+		return false, err
+	}
+
+	conn, err := n.dial(ctx)
+	if err != nil {
+		return true, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(sessionTimeout))
+
+	req := senderRequest{
+		Request: "sender data",
+		Data: []item{{
+			Host:  host,
+			Key:   key,
+			Value: value,
+			Clock: time.Now().Unix(),
+		}},
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return false, err
+	}
+
+	if err := writeMessage(conn, payload); err != nil {
+		return true, fmt.Errorf("send Zabbix sender data: %w", err)
+	}
+
+	resp, err := readMessage(bufio.NewReader(conn))
+	if err != nil {
+		return true, fmt.Errorf("read Zabbix sender response: %w", err)
+	}
+
+	var sr senderResponse
+	if err := json.Unmarshal(resp, &sr); err != nil {
+		return true, fmt.Errorf("decode Zabbix sender response: %w", err)
+	}
+	if sr.Response != "success" {
+		return true, fmt.Errorf("Zabbix trapper rejected data: %s", sr.Info)
+	}
+	return false, nil
+}
+
+func (n *Notifier) dial(ctx context.Context) (net.Conn, error) {
+	addr := fmt.Sprintf("%s:%d", n.conf.Server, n.conf.Port)
+	d := &net.Dialer{}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial Zabbix trapper: %w", err)
+	}
+	return conn, nil
+}
+
+// writeMessage writes payload prefixed with the Zabbix sender protocol
+// header: the 5-byte magic "ZBXD\x01" followed by the payload length as an
+// unsigned 64-bit little-endian integer.
+func writeMessage(w net.Conn, payload []byte) error {
+	length := make([]byte, 8)
+	binary.LittleEndian.PutUint64(length, uint64(len(payload)))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.Write(length); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// readMessage reads a Zabbix sender protocol message and returns its
+// payload.
+func readMessage(r *bufio.Reader) ([]byte, error) {
+	hdr := make([]byte, 13)
+	if _, err := readFull(r, hdr); err != nil {
+		return nil, err
+	}
+	for i, b := range header {
+		if hdr[i] != b {
+			return nil, fmt.Errorf("invalid Zabbix sender protocol header")
+		}
+	}
+
+	length := binary.LittleEndian.Uint64(hdr[5:13])
+	payload := make([]byte, length)
+	if _, err := readFull(r, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func readFull(r *bufio.Reader, buf []byte) (int, error) {
+	n := 0
+	for n < len(buf) {
+		m, err := r.Read(buf[n:])
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
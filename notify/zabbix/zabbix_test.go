@@ -0,0 +1,143 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zabbix
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// fakeServer is a minimal Zabbix trapper that accepts a single connection,
+// records the sender data request it receives, and replies success or
+// failure.
+type fakeServer struct {
+	ln       net.Listener
+	received chan senderRequest
+	fail     bool
+}
+
+func newFakeServer(t *testing.T, fail bool) *fakeServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeServer{ln: ln, received: make(chan senderRequest, 1), fail: fail}
+	go s.run()
+	return s
+}
+
+func (s *fakeServer) addr() (string, int) {
+	tcpAddr := s.ln.Addr().(*net.TCPAddr)
+	return tcpAddr.IP.String(), tcpAddr.Port
+}
+
+func (s *fakeServer) close() { s.ln.Close() }
+
+func (s *fakeServer) run() {
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	payload, err := readMessage(bufio.NewReader(conn))
+	if err != nil {
+		return
+	}
+	var req senderRequest
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return
+	}
+	s.received <- req
+
+	resp := senderResponse{Response: "success"}
+	if s.fail {
+		resp.Response = "failed"
+		resp.Info = "processed: 0; failed: 1"
+	}
+	respPayload, _ := json.Marshal(resp)
+	writeMessage(conn, respPayload)
+}
+
+func testAlert() *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "test", "instance": "host1"},
+			StartsAt: time.Now(),
+		},
+	}
+}
+
+func TestZabbixNotifySendsValue(t *testing.T) {
+	srv := newFakeServer(t, false)
+	defer srv.close()
+	host, port := srv.addr()
+
+	conf := &config.ZabbixConfig{
+		Server: host,
+		Port:   port,
+		Host:   `{{ .CommonLabels.instance }}`,
+		Key:    "alertmanager.trap",
+		Value:  "something happened",
+	}
+	n, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := n.Notify(ctx, testAlert())
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	select {
+	case got := <-srv.received:
+		require.Len(t, got.Data, 1)
+		require.Equal(t, "host1", got.Data[0].Host)
+		require.Equal(t, "alertmanager.trap", got.Data[0].Key)
+		require.Equal(t, "something happened", got.Data[0].Value)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for sender data")
+	}
+}
+
+func TestZabbixNotifyRetriesOnFailure(t *testing.T) {
+	srv := newFakeServer(t, true)
+	defer srv.close()
+	host, port := srv.addr()
+
+	conf := &config.ZabbixConfig{
+		Server: host,
+		Port:   port,
+		Host:   "host1",
+		Key:    "alertmanager.trap",
+		Value:  "something happened",
+	}
+	n, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	retry, err := n.Notify(ctx, testAlert())
+	require.Error(t, err)
+	require.True(t, retry)
+}
@@ -0,0 +1,101 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zulip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/template"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Notifier implements a Notifier for Zulip notifications.
+type Notifier struct {
+	conf    *config.ZulipConfig
+	tmpl    *template.Template
+	logger  log.Logger
+	client  *http.Client
+	retrier *notify.Retrier
+}
+
+// New returns a new Zulip notifier.
+func New(c *config.ZulipConfig, t *template.Template, l log.Logger) (*Notifier, error) {
+	client, err := config.NewClient(c.HTTPConfig, "zulip")
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		conf:    c,
+		tmpl:    t,
+		logger:  l,
+		client:  client,
+		retrier: &notify.Retrier{},
+	}, nil
+}
+
+// Notify implements the Notifier interface.
+func (n *Notifier) Notify(ctx context.Context, as ...*types.Alert) (bool, error) {
+	key, ok := notify.GroupKey(ctx)
+	if !ok {
+		return false, fmt.Errorf("group key missing")
+	}
+	data := notify.GetTemplateData(ctx, n.tmpl, as, n.logger)
+
+	level.Debug(n.logger).Log("incident", key)
+
+	var err error
+	tmpl := notify.TmplText(n.tmpl, data, &err)
+
+	topic, truncated := notify.Truncate(tmpl(n.conf.Topic), 60)
+	if truncated {
+		level.Debug(n.logger).Log("msg", "truncated topic", "truncated_topic", topic, "incident", key)
+	}
+	message := tmpl(n.conf.Message)
+	if err != nil {
+		return false, err
+	}
+
+	parameters := url.Values{}
+	parameters.Set("type", "stream")
+	parameters.Set("to", n.conf.Stream)
+	parameters.Set("topic", topic)
+	parameters.Set("content", message)
+
+	u := n.conf.APIURL.Copy()
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/messages"
+
+	req, err := http.NewRequest("POST", u.String(), strings.NewReader(parameters.Encode()))
+	if err != nil {
+		return true, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(n.conf.BotEmail, string(n.conf.APIKey))
+
+	resp, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, notify.RedactURL(err)
+	}
+	defer notify.Drain(resp)
+
+	return n.retrier.Check(resp.StatusCode, resp.Body)
+}
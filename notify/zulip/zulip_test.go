@@ -0,0 +1,97 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zulip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/notify"
+	"github.com/prometheus/alertmanager/notify/test"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestZulipRetry(t *testing.T) {
+	notifier, err := New(
+		&config.ZulipConfig{
+			HTTPConfig: &config.HTTPClientConfig{},
+		},
+		test.CreateTmpl(t),
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+	for statusCode, expected := range test.RetryTests(test.DefaultRetryCodes()) {
+		actual, _ := notifier.retrier.Check(statusCode, nil)
+		require.Equal(t, expected, actual, fmt.Sprintf("error on status %d", statusCode))
+	}
+}
+
+func TestZulipSendsExpectedRequest(t *testing.T) {
+	var gotReq *http.Request
+	var gotBody url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		require.NoError(t, r.ParseForm())
+		gotBody = r.PostForm
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL + "/api/v1")
+	require.NoError(t, err)
+
+	conf := &config.ZulipConfig{
+		APIURL:     &config.URL{URL: u},
+		BotEmail:   "bot@example.com",
+		APIKey:     "secret",
+		Stream:     "alerts",
+		Topic:      `{{ .CommonLabels.alertname }}`,
+		Message:    `{{ .CommonLabels.summary }}`,
+		HTTPConfig: &config.HTTPClientConfig{},
+	}
+	notifier, err := New(conf, test.CreateTmpl(t), log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := notify.WithGroupKey(context.Background(), "1")
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"alertname": "high_cpu", "summary": "CPU is too high"},
+			StartsAt: time.Now(),
+		},
+	}
+	retry, err := notifier.Notify(ctx, alert)
+	require.NoError(t, err)
+	require.False(t, retry)
+
+	require.Equal(t, "/api/v1/messages", gotReq.URL.Path)
+	user, pass, ok := gotReq.BasicAuth()
+	require.True(t, ok)
+	require.Equal(t, "bot@example.com", user)
+	require.Equal(t, "secret", pass)
+
+	require.Equal(t, "stream", gotBody.Get("type"))
+	require.Equal(t, "alerts", gotBody.Get("to"))
+	require.Equal(t, "high_cpu", gotBody.Get("topic"))
+	require.Equal(t, "CPU is too high", gotBody.Get("content"))
+}
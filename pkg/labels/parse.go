@@ -15,79 +15,197 @@ package labels
 
 import (
 	"fmt"
-	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
-var (
-	re      = regexp.MustCompile(`(?:\s?)(\w+)(=|=~|!=|!~)(?:\"([^"=~!]+)\"|([^"=~!]+)|\"\")`)
-	typeMap = map[string]MatchType{
-		"=":  MatchEqual,
-		"!=": MatchNotEqual,
-		"=~": MatchRegexp,
-		"!~": MatchNotRegexp,
-	}
-)
+var matchOps = []struct {
+	op string
+	t  MatchType
+}{
+	// =~ and !~ must be checked before = and != so the shorter operator
+	// doesn't shadow the longer one sharing its first character.
+	{"=~", MatchRegexp},
+	{"!~", MatchNotRegexp},
+	{"!=", MatchNotEqual},
+	{"=", MatchEqual},
+}
 
+// ParseMatchers parses a brace-delimited, comma-separated list of matcher
+// expressions in the canonical syntax shared by the alerts API, silences,
+// and amtool (e.g. `{foo="bar", baz=~"qu.x", team!="infra"}`). The
+// enclosing braces are optional. Both the label name and value may be a
+// bareword or a double-quoted string; quoting is required for a name
+// containing whitespace, a comma, '=' or '!', and for a value containing a
+// comma (a bareword value runs to the end of its matcher), and allows any
+// UTF-8 text via the usual `\"` and `\\` escapes.
 func ParseMatchers(s string) ([]*Matcher, error) {
-	matchers := []*Matcher{}
+	s = strings.TrimSpace(s)
 	s = strings.TrimPrefix(s, "{")
 	s = strings.TrimSuffix(s, "}")
 
-	var insideQuotes bool
-	var token string
-	var tokens []string
-	for _, r := range s {
-		if !insideQuotes && r == ',' {
-			tokens = append(tokens, token)
-			token = ""
-			continue
-		}
-		token += string(r)
-		if r == '"' {
-			insideQuotes = !insideQuotes
-		}
-	}
-	if token != "" {
-		tokens = append(tokens, token)
+	tokens, err := splitMatchers(s)
+	if err != nil {
+		return nil, err
 	}
+
+	matchers := make([]*Matcher, 0, len(tokens))
 	for _, token := range tokens {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
 		m, err := ParseMatcher(token)
 		if err != nil {
 			return nil, err
 		}
 		matchers = append(matchers, m)
 	}
-
 	return matchers, nil
 }
 
-func ParseMatcher(s string) (*Matcher, error) {
+// splitMatchers splits s on commas that are not inside a double-quoted
+// name or value, so a quoted value containing a literal comma (e.g.
+// `foo="bar,quux"`) is not mistaken for two matchers.
+func splitMatchers(s string) ([]string, error) {
 	var (
-		name, value string
-		matchType   MatchType
+		tokens   []string
+		cur      strings.Builder
+		inQuotes bool
+		escaped  bool
 	)
-
-	ms := re.FindStringSubmatch(s)
-	if len(ms) < 4 {
-		return nil, fmt.Errorf("bad matcher format: %s", s)
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case inQuotes && r == '\\':
+			cur.WriteRune(r)
+			escaped = true
+		case r == '"':
+			inQuotes = !inQuotes
+			cur.WriteRune(r)
+		case r == ',' && !inQuotes:
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted string in matcher list: %s", s)
 	}
+	if cur.Len() > 0 {
+		tokens = append(tokens, cur.String())
+	}
+	return tokens, nil
+}
 
-	name = ms[1]
+// ParseMatcher parses a single matcher expression, e.g. `foo="bar"` or
+// `"weird name"=~"bar.*"`. See ParseMatchers for the full syntax.
+func ParseMatcher(s string) (*Matcher, error) {
+	orig := s
+	name, rest, err := scanMatcherName(s)
+	if err != nil {
+		return nil, fmt.Errorf("bad matcher format: %s: %s", orig, err)
+	}
 	if name == "" {
 		return nil, fmt.Errorf("failed to parse label name")
 	}
 
-	matchType, found := typeMap[ms[2]]
-	if !found {
-		return nil, fmt.Errorf("failed to find match operator")
+	matchType, rest, ok := scanMatchOp(rest)
+	if !ok {
+		return nil, fmt.Errorf("bad matcher format: %s", orig)
 	}
 
-	if ms[3] != "" {
-		value = ms[3]
-	} else {
-		value = ms[4]
+	value, err := scanMatcherValue(rest)
+	if err != nil {
+		return nil, fmt.Errorf("bad matcher format: %s: %s", orig, err)
 	}
 
 	return NewMatcher(matchType, name, value)
 }
+
+// scanMatcherName consumes a label name from the start of s: either a
+// double-quoted, possibly-escaped UTF-8 string, or a bareword running up
+// to the next operator or whitespace. It returns the name and the
+// unconsumed remainder of s.
+func scanMatcherName(s string) (name, rest string, err error) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	if strings.HasPrefix(s, `"`) {
+		return scanQuoted(s)
+	}
+
+	i := 0
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '=' || r == '!' || unicode.IsSpace(r) {
+			break
+		}
+		i += size
+	}
+	return s[:i], s[i:], nil
+}
+
+// scanMatchOp consumes one of =~, !~, !=, = from the start of s (after
+// skipping leading whitespace), returning the matched type and the
+// unconsumed remainder.
+func scanMatchOp(s string) (MatchType, string, bool) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	for _, o := range matchOps {
+		if strings.HasPrefix(s, o.op) {
+			return o.t, s[len(o.op):], true
+		}
+	}
+	return 0, s, false
+}
+
+// scanMatcherValue consumes a matcher's value: either a double-quoted,
+// possibly-escaped UTF-8 string with nothing but whitespace following it,
+// or a bareword running to the end of s.
+func scanMatcherValue(s string) (string, error) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	if strings.HasPrefix(s, `"`) {
+		value, rest, err := scanQuoted(s)
+		if err != nil {
+			return "", err
+		}
+		if strings.TrimSpace(rest) != "" {
+			return "", fmt.Errorf("unexpected trailing characters after quoted value: %q", rest)
+		}
+		return value, nil
+	}
+
+	value := strings.TrimRightFunc(s, unicode.IsSpace)
+	if value == "" {
+		return "", fmt.Errorf("failed to parse label value")
+	}
+	return value, nil
+}
+
+// scanQuoted consumes a double-quoted string starting at s[0], unescaping
+// `\"` and `\\`; any other backslash sequence (e.g. `\d` in a regex value)
+// is left untouched. It returns the unescaped contents and the remainder
+// of s following the closing quote.
+func scanQuoted(s string) (value, rest string, err error) {
+	var b strings.Builder
+	i := len(`"`)
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == '\\' && i+size < len(s) {
+			next, nsize := utf8.DecodeRuneInString(s[i+size:])
+			if next == '"' || next == '\\' {
+				b.WriteRune(next)
+				i += size + nsize
+				continue
+			}
+		}
+		if r == '"' {
+			return b.String(), s[i+size:], nil
+		}
+		b.WriteRune(r)
+		i += size
+	}
+	return "", "", fmt.Errorf("unterminated quoted string")
+}
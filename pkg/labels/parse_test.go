@@ -143,3 +143,70 @@ func TestMatchers(t *testing.T) {
 	}
 
 }
+
+func TestParseMatchersUTF8AndQuoting(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  []*Matcher
+	}{
+		{
+			// Unicode barewords are allowed in both the name and value.
+			input: `{héllo="日本語"}`,
+			want: func() []*Matcher {
+				m, _ := NewMatcher(MatchEqual, "héllo", "日本語")
+				return []*Matcher{m}
+			}(),
+		},
+		{
+			// A quoted name allows characters a bareword can't, such as
+			// whitespace and dots.
+			input: `{"weird name.foo"="bar"}`,
+			want: func() []*Matcher {
+				m, _ := NewMatcher(MatchEqual, "weird name.foo", "bar")
+				return []*Matcher{m}
+			}(),
+		},
+		{
+			// \" and \\ are unescaped in a quoted value...
+			input: `{foo="a \"quoted\" value with a \\ backslash"}`,
+			want: func() []*Matcher {
+				m, _ := NewMatcher(MatchEqual, "foo", `a "quoted" value with a \ backslash`)
+				return []*Matcher{m}
+			}(),
+		},
+		{
+			// ...but any other backslash sequence, like a regex's \d, is
+			// left untouched.
+			input: `{foo=~"\d+\.\d+"}`,
+			want: func() []*Matcher {
+				m, _ := NewMatcher(MatchRegexp, "foo", `\d+\.\d+`)
+				return []*Matcher{m}
+			}(),
+		},
+	}
+
+	for i, tc := range testCases {
+		got, err := ParseMatchers(tc.input)
+		if err != nil {
+			t.Fatalf("unexpected error (i=%d): %s", i, err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("labels not equal (i=%d):\ngot  %v\nwant %v", i, got, tc.want)
+		}
+	}
+}
+
+func TestParseMatchersErrors(t *testing.T) {
+	testCases := []string{
+		`{foo="unterminated}`,
+		`{="bar"}`,
+		`{foo bar}`,
+		`{foo="bar" trailing}`,
+	}
+
+	for i, input := range testCases {
+		if _, err := ParseMatchers(input); err == nil {
+			t.Fatalf("expected an error for input %q (i=%d)", input, i)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testing lets platform teams write Go unit tests for their alert
+// routing configuration the way they test any other code: load a config,
+// inject a synthetic alert, and assert on the receiver, grouping, and
+// timing it would produce. It builds on dispatch.Route's exported
+// MatchingReceivers and GroupLabels helpers, so it evaluates routing
+// decisions the same way a running Dispatcher would, without starting one.
+package testing
+
+import (
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Harness evaluates synthetic alerts against a routing tree.
+type Harness struct {
+	root *dispatch.Route
+}
+
+// New returns a Harness evaluating alerts against conf's routing tree.
+func New(conf *config.Config) *Harness {
+	return &Harness{root: dispatch.NewRoute(conf.Route, nil, config.ReceiversByName(conf.Receivers))}
+}
+
+// Load parses yamlText the same way the alertmanager binary parses its
+// config file, and returns a Harness for it, so a test can keep its routing
+// config inline next to the assertions that exercise it.
+func Load(yamlText string) (*Harness, error) {
+	conf, err := config.Load(yamlText)
+	if err != nil {
+		return nil, err
+	}
+	return New(conf), nil
+}
+
+// Alert is a synthetic alert injected into a Harness for evaluation.
+type Alert struct {
+	Labels      model.LabelSet
+	Annotations model.LabelSet
+	StartsAt    time.Time
+	EndsAt      time.Time
+}
+
+// Decision describes the routing decision alert would produce.
+type Decision struct {
+	// Receivers are the names of the receivers alert resolves to, in the
+	// order dispatch.Route.Match would reach them.
+	Receivers []string
+
+	// GroupLabels are the labels alert would be grouped by, under the
+	// last matching route (the one whose RouteOpts apply to delivery).
+	GroupLabels model.LabelSet
+
+	// GroupWait, GroupInterval, and RepeatInterval are the timing
+	// options of the last matching route.
+	GroupWait      time.Duration
+	GroupInterval  time.Duration
+	RepeatInterval time.Duration
+}
+
+// Route evaluates alert against h's routing tree.
+func (h *Harness) Route(alert Alert) Decision {
+	a := &types.Alert{
+		Alert: model.Alert{
+			Labels:      alert.Labels,
+			Annotations: alert.Annotations,
+			StartsAt:    alert.StartsAt,
+			EndsAt:      alert.EndsAt,
+		},
+	}
+
+	matches := h.root.Match(alert.Labels, alert.Annotations)
+	d := Decision{Receivers: make([]string, 0, len(matches))}
+	for _, m := range matches {
+		d.Receivers = append(d.Receivers, m.RouteOpts.Receiver)
+	}
+	if len(matches) > 0 {
+		last := matches[len(matches)-1]
+		d.GroupLabels = dispatch.GroupLabels(a, last)
+		d.GroupWait = last.RouteOpts.GroupWait
+		d.GroupInterval = last.RouteOpts.GroupInterval
+		d.RepeatInterval = last.RouteOpts.RepeatInterval
+	}
+	return d
+}
+
+// Clock is a settable fake clock for stamping synthetic alerts with
+// deterministic, controllable timestamps, so a test can assert how a
+// routing decision changes as time passes (e.g. an alert that ages past a
+// group_wait) without sleeping in real time.
+type Clock struct {
+	now time.Time
+}
+
+// NewClock returns a Clock starting at start.
+func NewClock(start time.Time) *Clock {
+	return &Clock{now: start}
+}
+
+// Now returns the clock's current time.
+func (c *Clock) Now() time.Time {
+	return c.now
+}
+
+// Advance moves the clock forward by d and returns the new time.
+func (c *Clock) Advance(d time.Duration) time.Time {
+	c.now = c.now.Add(d)
+	return c.now
+}
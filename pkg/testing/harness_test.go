@@ -0,0 +1,69 @@
+// Copyright 2022 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `
+route:
+  receiver: default
+  group_by: ['alertname']
+  group_wait: 10s
+  group_interval: 1m
+  repeat_interval: 1h
+  routes:
+  - receiver: payments
+    match:
+      team: payments
+    group_wait: 1s
+receivers:
+- name: default
+- name: payments
+`
+
+func TestHarnessRouteMatchesReceiverAndTiming(t *testing.T) {
+	h, err := Load(testConfig)
+	require.NoError(t, err)
+
+	d := h.Route(Alert{Labels: model.LabelSet{"alertname": "Test", "team": "payments"}})
+	require.Equal(t, []string{"payments"}, d.Receivers)
+	require.Equal(t, time.Second, d.GroupWait)
+	require.Equal(t, time.Minute, d.GroupInterval)
+	require.Equal(t, model.LabelSet{"alertname": "Test"}, d.GroupLabels)
+}
+
+func TestHarnessRouteFallsBackToDefaultReceiver(t *testing.T) {
+	h, err := Load(testConfig)
+	require.NoError(t, err)
+
+	d := h.Route(Alert{Labels: model.LabelSet{"alertname": "Test"}})
+	require.Equal(t, []string{"default"}, d.Receivers)
+	require.Equal(t, 10*time.Second, d.GroupWait)
+}
+
+func TestClockAdvance(t *testing.T) {
+	start := time.Unix(0, 0).UTC()
+	c := NewClock(start)
+	require.Equal(t, start, c.Now())
+
+	advanced := c.Advance(time.Minute)
+	require.Equal(t, start.Add(time.Minute), advanced)
+	require.Equal(t, advanced, c.Now())
+}
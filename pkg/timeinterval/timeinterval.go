@@ -0,0 +1,373 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package timeinterval implements the calendar-based "is this moment inside
+// one of these windows" logic behind mute_time_intervals /
+// active_time_intervals: weekdays, days of the month, months and clock
+// times, each optionally restricted to a range, and all ANDed together
+// within a single TimeInterval.
+package timeinterval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var months = map[string]int{
+	"january":   1,
+	"february":  2,
+	"march":     3,
+	"april":     4,
+	"may":       5,
+	"june":      6,
+	"july":      7,
+	"august":    8,
+	"september": 9,
+	"october":   10,
+	"november":  11,
+	"december":  12,
+}
+
+var weekdays = map[string]int{
+	"sunday":    0,
+	"monday":    1,
+	"tuesday":   2,
+	"wednesday": 3,
+	"thursday":  4,
+	"friday":    5,
+	"saturday":  6,
+}
+
+// TimeInterval describes a set of calendar windows. A time matches a
+// TimeInterval if it falls within every non-empty field; an empty field
+// matches any value, so e.g. a TimeInterval with only Weekdays set matches
+// those weekdays at any time of any day of any month.
+type TimeInterval struct {
+	Times       []TimeRange       `yaml:"times,omitempty" json:"times,omitempty"`
+	Weekdays    []WeekdayRange    `yaml:"weekdays,omitempty" json:"weekdays,omitempty"`
+	DaysOfMonth []DayOfMonthRange `yaml:"days_of_month,omitempty" json:"days_of_month,omitempty"`
+	Months      []MonthRange      `yaml:"months,omitempty" json:"months,omitempty"`
+	Years       []YearRange       `yaml:"years,omitempty" json:"years,omitempty"`
+
+	// Location is the IANA Time Zone Database name that the other fields
+	// are evaluated in. Defaults to UTC.
+	Location string `yaml:"location,omitempty" json:"location,omitempty"`
+}
+
+// ContainsTime reports whether t falls within ti, evaluated in ti's
+// configured Location (UTC if unset).
+func (ti TimeInterval) ContainsTime(t time.Time) (bool, error) {
+	loc := time.UTC
+	if ti.Location != "" {
+		var err error
+		loc, err = time.LoadLocation(ti.Location)
+		if err != nil {
+			return false, fmt.Errorf("invalid location %q: %s", ti.Location, err)
+		}
+	}
+	t = t.In(loc)
+
+	if len(ti.Times) > 0 {
+		minuteOfDay := t.Hour()*60 + t.Minute()
+		var match bool
+		for _, tr := range ti.Times {
+			if minuteOfDay >= tr.StartMinute && minuteOfDay < tr.EndMinute {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false, nil
+		}
+	}
+
+	if len(ti.Weekdays) > 0 {
+		wd := int(t.Weekday())
+		var match bool
+		for _, wr := range ti.Weekdays {
+			if wd >= wr.Begin && wd <= wr.End {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false, nil
+		}
+	}
+
+	if len(ti.DaysOfMonth) > 0 {
+		dom := t.Day()
+		lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, loc).Day()
+		var match bool
+		for _, dr := range ti.DaysOfMonth {
+			begin, end := dr.Begin, dr.End
+			if begin < 0 {
+				begin = lastDay + begin + 1
+			}
+			if end < 0 {
+				end = lastDay + end + 1
+			}
+			if dom >= begin && dom <= end {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false, nil
+		}
+	}
+
+	if len(ti.Months) > 0 {
+		m := int(t.Month())
+		var match bool
+		for _, mr := range ti.Months {
+			if m >= mr.Begin && m <= mr.End {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false, nil
+		}
+	}
+
+	if len(ti.Years) > 0 {
+		y := t.Year()
+		var match bool
+		for _, yr := range ti.Years {
+			if y >= yr.Begin && y <= yr.End {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// TimeRange is a range of minute-of-day offsets, parsed from "HH:MM-HH:MM".
+// End is exclusive, and may be "24:00" to mean midnight at the end of the
+// day.
+type TimeRange struct {
+	StartMinute, EndMinute int
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for TimeRange.
+func (tr *TimeRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid time range %q: expected <start>-<end>", s)
+	}
+	start, err := parseMinute(parts[0])
+	if err != nil {
+		return fmt.Errorf("invalid time range %q: %s", s, err)
+	}
+	end, err := parseMinute(parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid time range %q: %s", s, err)
+	}
+	if end <= start {
+		return fmt.Errorf("invalid time range %q: end must be after start", s)
+	}
+	tr.StartMinute, tr.EndMinute = start, end
+	return nil
+}
+
+func parseMinute(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	hm := strings.SplitN(s, ":", 2)
+	if len(hm) != 2 {
+		return 0, fmt.Errorf("%q is not of the form HH:MM", s)
+	}
+	h, err := strconv.Atoi(hm[0])
+	if err != nil {
+		return 0, fmt.Errorf("%q is not of the form HH:MM", s)
+	}
+	m, err := strconv.Atoi(hm[1])
+	if err != nil {
+		return 0, fmt.Errorf("%q is not of the form HH:MM", s)
+	}
+	if h < 0 || h > 24 || m < 0 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("%q is out of range", s)
+	}
+	return h*60 + m, nil
+}
+
+// WeekdayRange is an inclusive range of weekdays (0 = Sunday .. 6 =
+// Saturday), parsed from a single day name or "<day>:<day>".
+type WeekdayRange struct {
+	Begin, End int
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for WeekdayRange.
+func (wr *WeekdayRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	begin, end, err := parseNamedRange(s, weekdays)
+	if err != nil {
+		return fmt.Errorf("invalid weekday range %q: %s", s, err)
+	}
+	wr.Begin, wr.End = begin, end
+	return nil
+}
+
+// MonthRange is an inclusive range of months (1 = January .. 12 =
+// December), parsed from a single month name/number or "<month>:<month>".
+type MonthRange struct {
+	Begin, End int
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for MonthRange.
+func (mr *MonthRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	begin, end, err := parseNumericOrNamedRange(s, months, 1, 12)
+	if err != nil {
+		return fmt.Errorf("invalid month range %q: %s", s, err)
+	}
+	mr.Begin, mr.End = begin, end
+	return nil
+}
+
+// DayOfMonthRange is an inclusive range of days of the month, parsed from a
+// single day or "<day>:<day>". A negative value counts back from the last
+// day of the month, so "-1" is always the last day regardless of month
+// length.
+type DayOfMonthRange struct {
+	Begin, End int
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for
+// DayOfMonthRange.
+func (dr *DayOfMonthRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	begin, end, err := parseIntRange(s, -31, 31)
+	if err != nil {
+		return fmt.Errorf("invalid day-of-month range %q: %s", s, err)
+	}
+	if begin == 0 || end == 0 {
+		return fmt.Errorf("invalid day-of-month range %q: day 0 does not exist", s)
+	}
+	dr.Begin, dr.End = begin, end
+	return nil
+}
+
+// YearRange is an inclusive range of years, parsed from a single year or
+// "<year>:<year>".
+type YearRange struct {
+	Begin, End int
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for YearRange.
+func (yr *YearRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	begin, end, err := parseIntRange(s, 0, 9999)
+	if err != nil {
+		return fmt.Errorf("invalid year range %q: %s", s, err)
+	}
+	yr.Begin, yr.End = begin, end
+	return nil
+}
+
+// parseNamedRange parses "name" or "name:name" against names, a lowercase
+// name-to-value lookup table.
+func parseNamedRange(s string, names map[string]int) (begin, end int, err error) {
+	parts := strings.SplitN(s, ":", 2)
+	begin, ok := names[strings.ToLower(strings.TrimSpace(parts[0]))]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown name %q", parts[0])
+	}
+	if len(parts) == 1 {
+		return begin, begin, nil
+	}
+	end, ok = names[strings.ToLower(strings.TrimSpace(parts[1]))]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown name %q", parts[1])
+	}
+	if end < begin {
+		return 0, 0, fmt.Errorf("end before start")
+	}
+	return begin, end, nil
+}
+
+// parseNumericOrNamedRange is like parseNamedRange, but also accepts plain
+// integers in [min, max] in place of a name.
+func parseNumericOrNamedRange(s string, names map[string]int, min, max int) (begin, end int, err error) {
+	parts := strings.SplitN(s, ":", 2)
+	begin, err = parseNameOrInt(parts[0], names, min, max)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(parts) == 1 {
+		return begin, begin, nil
+	}
+	end, err = parseNameOrInt(parts[1], names, min, max)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < begin {
+		return 0, 0, fmt.Errorf("end before start")
+	}
+	return begin, end, nil
+}
+
+func parseNameOrInt(s string, names map[string]int, min, max int) (int, error) {
+	s = strings.TrimSpace(s)
+	if v, ok := names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("%q is not a valid name or a number in [%d, %d]", s, min, max)
+	}
+	return n, nil
+}
+
+// parseIntRange parses "n" or "n:n" as plain integers in [min, max].
+func parseIntRange(s string, min, max int) (begin, end int, err error) {
+	parts := strings.SplitN(s, ":", 2)
+	begin, err = strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil || begin < min || begin > max {
+		return 0, 0, fmt.Errorf("%q is not a number in [%d, %d]", parts[0], min, max)
+	}
+	if len(parts) == 1 {
+		return begin, begin, nil
+	}
+	end, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil || end < min || end > max {
+		return 0, 0, fmt.Errorf("%q is not a number in [%d, %d]", parts[1], min, max)
+	}
+	if end < begin {
+		return 0, 0, fmt.Errorf("end before start")
+	}
+	return begin, end, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func mustParse(t *testing.T, in string) TimeInterval {
+	var ti TimeInterval
+	require.NoError(t, yaml.Unmarshal([]byte(in), &ti))
+	return ti
+}
+
+func TestContainsTimeWeekdays(t *testing.T) {
+	ti := mustParse(t, `
+weekdays: ['saturday']
+`)
+	// 2020-01-04 is a Saturday, 2020-01-06 is a Monday.
+	match, err := ti.ContainsTime(time.Date(2020, 1, 4, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, match)
+
+	match, err = ti.ContainsTime(time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, match)
+}
+
+func TestContainsTimeOfDay(t *testing.T) {
+	ti := mustParse(t, `
+times: ['09:00-17:00']
+`)
+	match, err := ti.ContainsTime(time.Date(2020, 1, 6, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, match)
+
+	match, err = ti.ContainsTime(time.Date(2020, 1, 6, 20, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, match)
+
+	match, err = ti.ContainsTime(time.Date(2020, 1, 6, 17, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, match, "end of range is exclusive")
+}
+
+func TestContainsTimeDaysOfMonthNegative(t *testing.T) {
+	ti := mustParse(t, `
+days_of_month: ['-1']
+`)
+	// January 2020 has 31 days.
+	match, err := ti.ContainsTime(time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, match)
+
+	match, err = ti.ContainsTime(time.Date(2020, 1, 30, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, match)
+}
+
+func TestContainsTimeMonths(t *testing.T) {
+	ti := mustParse(t, `
+months: ['june:august']
+`)
+	match, err := ti.ContainsTime(time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, match)
+
+	match, err = ti.ContainsTime(time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, match)
+}
+
+func TestContainsTimeLocation(t *testing.T) {
+	ti := mustParse(t, `
+times: ['09:00-17:00']
+location: America/New_York
+`)
+	// 14:00 UTC is 09:00 in New York (EST, UTC-5) in January.
+	match, err := ti.ContainsTime(time.Date(2020, 1, 6, 14, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, match)
+
+	match, err = ti.ContainsTime(time.Date(2020, 1, 6, 13, 59, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, match)
+}
+
+func TestContainsTimeAllFieldsANDed(t *testing.T) {
+	ti := mustParse(t, `
+weekdays: ['monday:friday']
+times: ['09:00-17:00']
+`)
+	// 2020-01-06 is a Monday.
+	match, err := ti.ContainsTime(time.Date(2020, 1, 6, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, match)
+
+	// 2020-01-04 is a Saturday.
+	match, err = ti.ContainsTime(time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, match)
+}
+
+func TestUnmarshalInvalidTimeRange(t *testing.T) {
+	var ti TimeInterval
+	err := yaml.Unmarshal([]byte(`times: ['25:00-26:00']`), &ti)
+	require.Error(t, err)
+}
+
+func TestUnmarshalInvalidWeekday(t *testing.T) {
+	var ti TimeInterval
+	err := yaml.Unmarshal([]byte(`weekdays: ['funday']`), &ti)
+	require.Error(t, err)
+}
+
+func TestContainsTimeInvalidLocation(t *testing.T) {
+	ti := mustParse(t, `
+location: Not/A_Real_Zone
+`)
+	_, err := ti.ContainsTime(time.Now())
+	require.Error(t, err)
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing defines the minimal span/tracer seam the alerting
+// pipeline instruments itself against (ingest, dispatch, group flush,
+// notifier send). It intentionally does not depend on any particular
+// tracing backend: by default every span is a no-op, so instrumentation
+// has no cost unless a Tracer is installed. Wiring this up to a real
+// distributed tracing backend such as OpenTelemetry is left to the
+// binary's startup code, which can call SetTracer with an adapter; this
+// package does not vendor an OpenTelemetry SDK itself.
+package tracing
+
+import "context"
+
+// Span represents a single unit of traced work. End must be called exactly
+// once, typically via defer, once that unit of work completes.
+type Span interface {
+	// SetAttr attaches a key/value pair describing the span, e.g. the
+	// receiver name or integration being notified.
+	SetAttr(key string, value interface{})
+	// RecordError marks the span as having failed with err. A nil err is
+	// a no-op.
+	RecordError(err error)
+	// End completes the span.
+	End()
+}
+
+// Tracer starts spans for named units of work.
+type Tracer interface {
+	// Start begins a new span called name as a child of any span found in
+	// ctx, returning a context carrying the new span alongside it.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttr(string, interface{}) {}
+func (noopSpan) RecordError(error)           {}
+func (noopSpan) End()                        {}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+var global Tracer = noopTracer{}
+
+// SetTracer installs t as the Tracer used by Start for the remainder of the
+// process's lifetime. It is not safe to call concurrently with Start and is
+// intended to be called once, at startup.
+func SetTracer(t Tracer) {
+	if t == nil {
+		t = noopTracer{}
+	}
+	global = t
+}
+
+// Start begins a new span called name using the globally installed Tracer,
+// or a no-op span if none has been installed via SetTracer.
+func Start(ctx context.Context, name string) (context.Context, Span) {
+	return global.Start(ctx, name)
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartDefaultsToNoop(t *testing.T) {
+	ctx, span := Start(context.Background(), "unit.test")
+	require.NotNil(t, ctx)
+	require.NotNil(t, span)
+
+	// None of these should panic even though no Tracer was installed.
+	span.SetAttr("key", "value")
+	span.RecordError(errors.New("boom"))
+	span.End()
+}
+
+type recordingTracer struct {
+	started []string
+}
+
+type recordingSpan struct {
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *recordingSpan) SetAttr(key string, value interface{}) {
+	s.attrs[key] = value
+}
+
+func (s *recordingSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *recordingSpan) End() {
+	s.ended = true
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	t.started = append(t.started, name)
+	return ctx, &recordingSpan{attrs: map[string]interface{}{}}
+}
+
+func TestSetTracerInstallsCustomTracer(t *testing.T) {
+	rt := &recordingTracer{}
+	SetTracer(rt)
+	defer SetTracer(nil)
+
+	_, span := Start(context.Background(), "unit.custom")
+	require.Equal(t, []string{"unit.custom"}, rt.started)
+
+	rs := span.(*recordingSpan)
+	span.SetAttr("receiver", "team-a")
+	span.RecordError(errors.New("boom"))
+	span.End()
+
+	require.Equal(t, "team-a", rs.attrs["receiver"])
+	require.EqualError(t, rs.err, "boom")
+	require.True(t, rs.ended)
+}
+
+func TestSetTracerNilResetsToNoop(t *testing.T) {
+	SetTracer(&recordingTracer{})
+	SetTracer(nil)
+	defer SetTracer(nil)
+
+	// Should behave like the untouched default: no panics, span is usable.
+	_, span := Start(context.Background(), "unit.reset")
+	span.End()
+}
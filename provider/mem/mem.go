@@ -14,14 +14,24 @@
 package mem
 
 import (
+	"bytes"
 	"context"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 
+	"github.com/prometheus/alertmanager/crypto/atrest"
+	"github.com/prometheus/alertmanager/history"
 	"github.com/prometheus/alertmanager/provider"
 	"github.com/prometheus/alertmanager/store"
 	"github.com/prometheus/alertmanager/types"
@@ -38,7 +48,9 @@ type Alerts struct {
 	listeners map[int]listeningAlerts
 	next      int
 
-	logger log.Logger
+	history *history.Log
+	logger  log.Logger
+	keyring *atrest.KeyRing
 }
 
 type listeningAlerts struct {
@@ -56,12 +68,23 @@ func NewAlerts(ctx context.Context, m types.Marker, intervalGC time.Duration, l
 		next:      0,
 		logger:    log.With(l, "component", "provider"),
 	}
+	a.alerts.SetLogger(a.logger)
 	a.alerts.SetGCCallback(func(alerts []*types.Alert) {
 		for _, alert := range alerts {
 			// As we don't persist alerts, we no longer consider them after
 			// they are resolved. Alerts waiting for resolved notifications are
 			// held in memory in aggregation groups redundantly.
 			m.Delete(alert.Fingerprint())
+
+			// alert.Timeout marks an alert resolved by an expired
+			// resolve_timeout rather than an explicit resolved update (see
+			// Put); the latter already records EventResolved on receipt, so
+			// only log here for the former, otherwise "why did this alert
+			// stop firing" would go unanswered for the exact case this
+			// matters most: a source that crashed and stopped re-posting.
+			if a.history != nil && alert.Timeout {
+				a.history.Add(alert.Fingerprint(), history.EventResolved, "resolve_timeout expired without a further update")
+			}
 		}
 
 		a.mtx.Lock()
@@ -81,6 +104,171 @@ func NewAlerts(ctx context.Context, m types.Marker, intervalGC time.Duration, l
 	return a, nil
 }
 
+// SetHistory attaches h as the destination for per-alert lifecycle events.
+// It is a no-op if never called.
+func (a *Alerts) SetHistory(h *history.Log) {
+	a.history = h
+}
+
+// SetEncryption configures kr to encrypt snapshots written by Snapshot and
+// decrypt the snapshot read by LoadSnapshot. A nil or disabled kr leaves
+// snapshots in the clear. It must be called before LoadSnapshot.
+func (a *Alerts) SetEncryption(kr *atrest.KeyRing) {
+	a.keyring = kr
+}
+
+// SetLimits configures the maximum number of alerts and approximate maximum
+// size in bytes the store may hold, evicting the least-recently-used
+// resolved alerts to stay under them. Either may be 0 to leave that
+// dimension unbounded. See store.Alerts.SetLimits.
+func (a *Alerts) SetLimits(maxAlerts int, maxBytes int64) {
+	a.alerts.SetLimits(maxAlerts, maxBytes)
+}
+
+// SetMetrics registers the store's size and eviction counters with r.
+func (a *Alerts) SetMetrics(r prometheus.Registerer) {
+	a.alerts.SetMetrics(r)
+}
+
+// LoadSnapshot replaces the current set of alerts with the one read from r,
+// as written by Snapshot. It is meant to be called once at startup, before
+// any alert has been Put, so that active alert groups survive a restart
+// instead of starting empty and waiting to be rediscovered from the next
+// scrape.
+func (a *Alerts) LoadSnapshot(r io.Reader) error {
+	if a.keyring != nil {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		b, err = a.keyring.Decrypt(b)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+
+	var alerts []*types.Alert
+	if err := gob.NewDecoder(r).Decode(&alerts); err != nil {
+		return err
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	for _, alert := range alerts {
+		if err := a.alerts.Set(alert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Snapshot writes the full set of currently known alerts to w and returns
+// the number of bytes written.
+func (a *Alerts) Snapshot(w io.Writer) (int64, error) {
+	if a.keyring == nil {
+		cw := &countingWriter{w: w}
+		if err := gob.NewEncoder(cw).Encode(a.alerts.List()); err != nil {
+			return cw.n, err
+		}
+		return cw.n, nil
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(a.alerts.List()); err != nil {
+		return 0, err
+	}
+	b, err := a.keyring.Encrypt(buf.Bytes())
+	if err != nil {
+		return 0, err
+	}
+	return io.Copy(w, bytes.NewReader(b))
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Maintenance periodically writes a snapshot of the alert set to snapf, so
+// that state is not lost across restarts. It terminates on receiving from
+// stopc, writing a final snapshot before returning, unless snapf is empty.
+func (a *Alerts) Maintenance(interval time.Duration, snapf string, stopc <-chan struct{}) {
+	if snapf == "" {
+		<-stopc
+		return
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	f := func() error {
+		start := time.Now()
+		var size int64
+		defer func() {
+			level.Debug(a.logger).Log("msg", "Creating alert snapshot done", "duration", time.Since(start), "size", size)
+		}()
+
+		f, err := openReplace(snapf)
+		if err != nil {
+			return err
+		}
+		if size, err = a.Snapshot(f); err != nil {
+			return err
+		}
+		return f.Close()
+	}
+
+Loop:
+	for {
+		select {
+		case <-stopc:
+			break Loop
+		case <-t.C:
+			if err := f(); err != nil {
+				level.Info(a.logger).Log("msg", "Creating alert snapshot failed", "err", err)
+			}
+		}
+	}
+	if err := f(); err != nil {
+		level.Info(a.logger).Log("msg", "Creating shutdown alert snapshot failed", "err", err)
+	}
+}
+
+// replaceFile wraps a file that is moved to another filename on closing.
+type replaceFile struct {
+	*os.File
+	filename string
+}
+
+func (f *replaceFile) Close() error {
+	if err := f.File.Sync(); err != nil {
+		return err
+	}
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.File.Name(), f.filename)
+}
+
+// openReplace opens a new temporary file that is moved to filename on closing.
+func openReplace(filename string) (*replaceFile, error) {
+	tmpFilename := fmt.Sprintf("%s.%x", filename, uint64(rand.Int63()))
+
+	f, err := os.Create(tmpFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replaceFile{File: f, filename: filename}, nil
+}
+
 // Close the alert provider.
 func (a *Alerts) Close() {
 	if a.cancel != nil {
@@ -146,6 +334,14 @@ func (a *Alerts) Get(fp model.Fingerprint) (*types.Alert, error) {
 	return a.alerts.Get(fp)
 }
 
+// ByLabel returns the alerts whose label set has name set to exactly value,
+// using the store's inverted label index. It lets callers that only need an
+// equality lookup (e.g. API filter queries) skip scanning every active
+// alert.
+func (a *Alerts) ByLabel(name model.LabelName, value model.LabelValue) []*types.Alert {
+	return a.alerts.ByLabel(name, value)
+}
+
 // Put adds the given alert to the set.
 func (a *Alerts) Put(alerts ...*types.Alert) error {
 
@@ -167,6 +363,13 @@ func (a *Alerts) Put(alerts ...*types.Alert) error {
 			continue
 		}
 
+		if a.history != nil {
+			a.history.Add(fp, history.EventReceived, "")
+			if alert.ResolvedAt(time.Now()) {
+				a.history.Add(fp, history.EventResolved, "")
+			}
+		}
+
 		a.mtx.Lock()
 		for _, l := range a.listeners {
 			select {
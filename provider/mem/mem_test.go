@@ -14,6 +14,7 @@
 package mem
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"reflect"
@@ -25,6 +26,8 @@ import (
 
 	"github.com/go-kit/kit/log"
 	"github.com/kylelemons/godebug/pretty"
+	"github.com/prometheus/alertmanager/crypto/atrest"
+	"github.com/prometheus/alertmanager/history"
 	"github.com/prometheus/alertmanager/store"
 	"github.com/prometheus/alertmanager/types"
 	"github.com/prometheus/client_golang/prometheus"
@@ -159,6 +162,101 @@ func TestAlertsPut(t *testing.T) {
 	}
 }
 
+func TestAlertsSnapshotRoundtrip(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := NewAlerts(context.Background(), marker, 30*time.Minute, log.NewNopLogger())
+	require.NoError(t, err)
+
+	insert := []*types.Alert{alert1, alert2, alert3}
+	require.NoError(t, alerts.Put(insert...))
+
+	var buf bytes.Buffer
+	n, err := alerts.Snapshot(&buf)
+	require.NoError(t, err)
+	require.True(t, n > 0)
+
+	restoredMarker := types.NewMarker(prometheus.NewRegistry())
+	restored, err := NewAlerts(context.Background(), restoredMarker, 30*time.Minute, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NoError(t, restored.LoadSnapshot(&buf))
+
+	for _, a := range insert {
+		res, err := restored.Get(a.Fingerprint())
+		require.NoError(t, err)
+		require.True(t, alertsEqual(res, a))
+	}
+}
+
+func TestAlertsSnapshotRoundtripEncrypted(t *testing.T) {
+	kr := atrest.NewKeyRing()
+	key := make([]byte, atrest.KeySize)
+	require.NoError(t, kr.Configure(map[string][]byte{"k1": key}, "k1"))
+
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := NewAlerts(context.Background(), marker, 30*time.Minute, log.NewNopLogger())
+	require.NoError(t, err)
+	alerts.SetEncryption(kr)
+
+	insert := []*types.Alert{alert1, alert2, alert3}
+	require.NoError(t, alerts.Put(insert...))
+
+	var buf bytes.Buffer
+	n, err := alerts.Snapshot(&buf)
+	require.NoError(t, err)
+	require.True(t, n > 0)
+	require.NotContains(t, buf.String(), "example.com")
+
+	restoredMarker := types.NewMarker(prometheus.NewRegistry())
+	restored, err := NewAlerts(context.Background(), restoredMarker, 30*time.Minute, log.NewNopLogger())
+	require.NoError(t, err)
+	restored.SetEncryption(kr)
+	require.NoError(t, restored.LoadSnapshot(&buf))
+
+	for _, a := range insert {
+		res, err := restored.Get(a.Fingerprint())
+		require.NoError(t, err)
+		require.True(t, alertsEqual(res, a))
+	}
+}
+
+func TestAlertsPutRecordsHistory(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := NewAlerts(context.Background(), marker, 30*time.Minute, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := history.New(0)
+	alerts.SetHistory(h)
+
+	firing := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"bar": "firing"},
+			StartsAt: t0,
+			EndsAt:   t0.Add(time.Hour),
+		},
+		UpdatedAt: t0,
+	}
+	resolved := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"bar": "resolved"},
+			StartsAt: t0.Add(-time.Hour),
+			EndsAt:   t0.Add(-time.Minute),
+		},
+		UpdatedAt: t0,
+	}
+
+	require.NoError(t, alerts.Put(firing, resolved))
+
+	firingEvents := h.Get(firing.Fingerprint())
+	require.Len(t, firingEvents, 1)
+	require.Equal(t, history.EventReceived, firingEvents[0].Type)
+
+	resolvedEvents := h.Get(resolved.Fingerprint())
+	require.Len(t, resolvedEvents, 2)
+	require.Equal(t, history.EventReceived, resolvedEvents[0].Type)
+	require.Equal(t, history.EventResolved, resolvedEvents[1].Type)
+}
+
 func TestAlertsSubscribe(t *testing.T) {
 	marker := types.NewMarker(prometheus.NewRegistry())
 
@@ -316,6 +414,83 @@ func TestAlertsGC(t *testing.T) {
 	}
 }
 
+func TestAlertsGCRecordsHistoryForTimeoutAlerts(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := NewAlerts(context.Background(), marker, 200*time.Millisecond, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := history.New(0)
+	alerts.SetHistory(h)
+
+	now := time.Now()
+	timeout := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"bar": "timeout"},
+			StartsAt: now,
+			EndsAt:   now.Add(100 * time.Millisecond),
+		},
+		UpdatedAt: now,
+		Timeout:   true,
+	}
+	explicit := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"bar": "explicit"},
+			StartsAt: now,
+			EndsAt:   now.Add(100 * time.Millisecond),
+		},
+		UpdatedAt: now,
+		Timeout:   false,
+	}
+
+	require.NoError(t, alerts.Put(timeout, explicit))
+
+	time.Sleep(300 * time.Millisecond)
+
+	timeoutEvents := h.Get(timeout.Fingerprint())
+	require.Len(t, timeoutEvents, 2)
+	require.Equal(t, history.EventResolved, timeoutEvents[1].Type)
+
+	explicitEvents := h.Get(explicit.Fingerprint())
+	require.Len(t, explicitEvents, 1)
+	require.Equal(t, history.EventReceived, explicitEvents[0].Type)
+}
+
+func TestAlertsSetLimitsEvictsResolvedAlerts(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := NewAlerts(context.Background(), marker, 30*time.Minute, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	alerts.SetLimits(1, 0)
+
+	resolved := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"bar": "resolved"},
+			StartsAt: t0.Add(-time.Hour),
+			EndsAt:   t0.Add(-time.Minute),
+		},
+		UpdatedAt: t0,
+	}
+	require.NoError(t, alerts.Put(resolved))
+
+	otherResolved := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"bar": "resolved2"},
+			StartsAt: t0.Add(-time.Hour),
+			EndsAt:   t0.Add(-time.Minute),
+		},
+		UpdatedAt: t0,
+	}
+	require.NoError(t, alerts.Put(otherResolved))
+
+	_, err = alerts.Get(resolved.Fingerprint())
+	require.Equal(t, store.ErrNotFound, err)
+
+	_, err = alerts.Get(otherResolved.Fingerprint())
+	require.NoError(t, err)
+}
+
 func alertsEqual(a1, a2 *types.Alert) bool {
 	if a1 == nil || a2 == nil {
 		return false
@@ -0,0 +1,205 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package quota tracks alert, notification, and silence activity per
+// tenant -- the value of a configured label -- and optionally rejects
+// further alerts or silences once a tenant's configured cap is reached, so a
+// single noisy or misbehaving tenant in a shared deployment cannot crowd out
+// another tenant or run up its bill. Activity is also exposed as Prometheus
+// metrics and, via Snapshot, a small usage API for chargeback.
+package quota
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+)
+
+// Limits caps how much activity a single tenant may generate. A zero field
+// disables the corresponding limit.
+type Limits struct {
+	MaxAlerts   int
+	MaxSilences int
+}
+
+type counts struct {
+	alerts        int
+	notifications int
+	silences      int
+}
+
+// Tracker counts alerts ingested, notifications sent, and silences created
+// per tenant, exposes the totals as Prometheus metrics, and enforces an
+// optional per-tenant Limits. It is safe for concurrent use. The zero value
+// is not usable; use New.
+type Tracker struct {
+	mtx    sync.Mutex
+	label  model.LabelName
+	limits map[string]Limits
+	counts map[string]*counts
+
+	alertsTotal        *prometheus.CounterVec
+	notificationsTotal *prometheus.CounterVec
+	silencesTotal      *prometheus.CounterVec
+}
+
+// New returns a Tracker with no tenant label configured, so Tenant always
+// returns "" and no quota is enforced until Configure is called, e.g. from a
+// configuration reload.
+func New(r prometheus.Registerer) *Tracker {
+	t := &Tracker{
+		counts: map[string]*counts{},
+		alertsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_tenant_alerts_ingested_total",
+			Help: "Total number of alerts ingested, per tenant.",
+		}, []string{"tenant"}),
+		notificationsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_tenant_notifications_total",
+			Help: "Total number of notifications sent, per tenant.",
+		}, []string{"tenant"}),
+		silencesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_tenant_silences_total",
+			Help: "Total number of silences created, per tenant.",
+		}, []string{"tenant"}),
+	}
+	if r != nil {
+		r.MustRegister(t.alertsTotal, t.notificationsTotal, t.silencesTotal)
+	}
+	return t
+}
+
+// Configure (re-)sets the label used to attribute activity to a tenant and
+// the per-tenant quotas enforced against it. An empty label disables both
+// tracking and enforcement; Tenant then always returns "".
+func (t *Tracker) Configure(label model.LabelName, limits map[string]Limits) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.label = label
+	t.limits = limits
+}
+
+// Tenant returns the tenant ls is attributed to: the value of the
+// configured label, or "" if unset or no label is configured.
+func (t *Tracker) Tenant(ls model.LabelSet) string {
+	t.mtx.Lock()
+	label := t.label
+	t.mtx.Unlock()
+	if label == "" {
+		return ""
+	}
+	return string(ls[label])
+}
+
+// CheckAlert reports an error if tenant has already reached its MaxAlerts
+// quota. Callers should reject the alert rather than call ObserveAlert.
+func (t *Tracker) CheckAlert(tenant string) error {
+	return t.check(tenant, "alerts", func(l Limits) int { return l.MaxAlerts }, func(c *counts) int { return c.alerts })
+}
+
+// ObserveAlert records that an alert was ingested for tenant.
+func (t *Tracker) ObserveAlert(tenant string) {
+	t.alertsTotal.WithLabelValues(tenant).Inc()
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.countsFor(tenant).alerts++
+}
+
+// CheckSilence reports an error if tenant has already reached its
+// MaxSilences quota. Callers should reject the silence rather than call
+// ObserveSilence.
+func (t *Tracker) CheckSilence(tenant string) error {
+	return t.check(tenant, "silences", func(l Limits) int { return l.MaxSilences }, func(c *counts) int { return c.silences })
+}
+
+// ObserveSilence records that a silence was created for tenant.
+func (t *Tracker) ObserveSilence(tenant string) {
+	t.silencesTotal.WithLabelValues(tenant).Inc()
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.countsFor(tenant).silences++
+}
+
+// ObserveNotification records that a notification was sent for tenant.
+// There is no corresponding Check: a notification is the outcome of a
+// decision made earlier in the pipeline, so by the time one would be sent
+// it is too late to reject it on quota grounds.
+func (t *Tracker) ObserveNotification(tenant string) {
+	t.notificationsTotal.WithLabelValues(tenant).Inc()
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.countsFor(tenant).notifications++
+}
+
+func (t *Tracker) check(tenant, kind string, limitOf func(Limits) int, countOf func(*counts) int) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	limit := limitOf(t.limits[tenant])
+	if limit <= 0 {
+		return nil
+	}
+	if c, ok := t.counts[tenant]; ok && countOf(c) >= limit {
+		return fmt.Errorf("tenant %q has reached its quota of %d %s", tenant, limit, kind)
+	}
+	return nil
+}
+
+func (t *Tracker) countsFor(tenant string) *counts {
+	c, ok := t.counts[tenant]
+	if !ok {
+		c = &counts{}
+		t.counts[tenant] = c
+	}
+	return c
+}
+
+// Usage is a point-in-time snapshot of one tenant's cumulative activity and
+// configured limits, returned by Snapshot for serving over an API.
+type Usage struct {
+	Tenant        string `json:"tenant"`
+	Alerts        int    `json:"alerts"`
+	Notifications int    `json:"notifications"`
+	Silences      int    `json:"silences"`
+	Limits        Limits `json:"limits"`
+}
+
+// Snapshot returns the cumulative usage of every tenant observed so far, or
+// with a configured quota, sorted by tenant name.
+func (t *Tracker) Snapshot() []Usage {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	seen := make(map[string]struct{}, len(t.counts))
+	out := make([]Usage, 0, len(t.counts))
+	for tenant, c := range t.counts {
+		seen[tenant] = struct{}{}
+		out = append(out, Usage{
+			Tenant:        tenant,
+			Alerts:        c.alerts,
+			Notifications: c.notifications,
+			Silences:      c.silences,
+			Limits:        t.limits[tenant],
+		})
+	}
+	for tenant, l := range t.limits {
+		if _, ok := seen[tenant]; ok {
+			continue
+		}
+		out = append(out, Usage{Tenant: tenant, Limits: l})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Tenant < out[j].Tenant })
+	return out
+}
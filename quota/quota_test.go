@@ -0,0 +1,83 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantWithoutLabelConfigured(t *testing.T) {
+	tr := New(nil)
+	require.Equal(t, "", tr.Tenant(model.LabelSet{"team": "payments"}))
+}
+
+func TestTenantUsesConfiguredLabel(t *testing.T) {
+	tr := New(nil)
+	tr.Configure("team", nil)
+	require.Equal(t, "payments", tr.Tenant(model.LabelSet{"team": "payments"}))
+}
+
+func TestCheckAlertEnforcesQuota(t *testing.T) {
+	tr := New(nil)
+	tr.Configure("team", map[string]Limits{"payments": {MaxAlerts: 2}})
+
+	require.NoError(t, tr.CheckAlert("payments"))
+	tr.ObserveAlert("payments")
+	require.NoError(t, tr.CheckAlert("payments"))
+	tr.ObserveAlert("payments")
+
+	err := tr.CheckAlert("payments")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "payments")
+
+	// A different tenant is unaffected.
+	require.NoError(t, tr.CheckAlert("search"))
+}
+
+func TestCheckSilenceEnforcesQuota(t *testing.T) {
+	tr := New(nil)
+	tr.Configure("team", map[string]Limits{"payments": {MaxSilences: 1}})
+
+	require.NoError(t, tr.CheckSilence("payments"))
+	tr.ObserveSilence("payments")
+	require.Error(t, tr.CheckSilence("payments"))
+}
+
+func TestZeroLimitDisablesEnforcement(t *testing.T) {
+	tr := New(nil)
+	tr.Configure("team", map[string]Limits{"payments": {}})
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, tr.CheckAlert("payments"))
+		tr.ObserveAlert("payments")
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	tr := New(nil)
+	tr.Configure("team", map[string]Limits{"payments": {MaxAlerts: 5}, "search": {MaxSilences: 1}})
+
+	tr.ObserveAlert("payments")
+	tr.ObserveAlert("payments")
+	tr.ObserveNotification("payments")
+	tr.ObserveSilence("search")
+
+	snap := tr.Snapshot()
+	require.Len(t, snap, 2)
+	require.Equal(t, Usage{Tenant: "payments", Alerts: 2, Notifications: 1, Limits: Limits{MaxAlerts: 5}}, snap[0])
+	require.Equal(t, Usage{Tenant: "search", Silences: 1, Limits: Limits{MaxSilences: 1}}, snap[1])
+}
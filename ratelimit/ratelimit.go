@@ -0,0 +1,203 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit throttles per-receiver notification delivery to a
+// configured rate with a burst allowance, using a token bucket per
+// receiver, so a label explosion on one noisy alert source can no longer
+// run up thousands of calls against an external endpoint in under a
+// minute and get it blacklisted. What happens to a notification that
+// arrives with no token available is governed by the receiver's
+// configured Overflow policy.
+package ratelimit
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Overflow names what happens to a notification attempt that arrives with
+// no token available.
+type Overflow string
+
+const (
+	// OverflowDrop silently discards the notification.
+	OverflowDrop Overflow = "drop"
+	// OverflowQueue holds the notification until a token becomes
+	// available (or the caller's context is done).
+	OverflowQueue Overflow = "queue"
+	// OverflowCollapse discards the notification but remembers that it
+	// was suppressed, so the next notification that does get a token can
+	// be annotated with how many were folded into it.
+	OverflowCollapse Overflow = "collapse"
+)
+
+// Settings configures the token bucket for a single receiver. The zero
+// value disables rate limiting: Tracker treats a receiver with no Settings
+// as unlimited.
+type Settings struct {
+	// PerMinute is the steady-state rate at which tokens are added to the
+	// bucket. Must be positive to enable limiting.
+	PerMinute float64
+	// Burst is the bucket's capacity, i.e. how many notifications may be
+	// sent back-to-back before the steady-state rate applies. Defaults to
+	// PerMinute (rounded up) if zero.
+	Burst int
+	// Overflow is the policy applied once the bucket is empty. Defaults
+	// to OverflowDrop if empty.
+	Overflow Overflow
+}
+
+type bucket struct {
+	tokens     float64
+	updatedAt  time.Time
+	suppressed int
+}
+
+// Tracker holds per-receiver token buckets and enforces the configured
+// Settings. It is safe for concurrent use. The zero value is not usable;
+// use New.
+type Tracker struct {
+	mtx      sync.Mutex
+	settings map[string]Settings
+	buckets  map[string]*bucket
+	now      func() time.Time
+
+	limited *prometheus.CounterVec
+}
+
+// New returns a Tracker with no receivers configured, so Allow always
+// returns true until Configure is called.
+func New(r prometheus.Registerer) *Tracker {
+	t := &Tracker{
+		settings: map[string]Settings{},
+		buckets:  map[string]*bucket{},
+		now:      time.Now,
+		limited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "alertmanager_notifications_rate_limited_total",
+			Help: "Total number of notifications held back by a receiver's rate limit, per receiver and overflow policy.",
+		}, []string{"receiver", "overflow"}),
+	}
+	if r != nil {
+		r.MustRegister(t.limited)
+	}
+	return t
+}
+
+// Configure (re-)sets the per-receiver rate limit settings, e.g. from a
+// configuration reload. A receiver missing from settings (or the zero
+// Settings) is never limited.
+func (t *Tracker) Configure(settings map[string]Settings) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.settings = settings
+}
+
+// Allow reports whether a notification attempt to receiver may proceed
+// right now, consuming a token if so. When it returns false, overflow is
+// the receiver's configured Overflow policy and suppressed is the number
+// of consecutive attempts (including this one) that have found the bucket
+// empty since the last one that was allowed through.
+func (t *Tracker) Allow(receiver string) (ok bool, overflow Overflow, suppressed int) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	cfg, configured := t.settings[receiver]
+	if !configured || cfg.PerMinute <= 0 {
+		return true, "", 0
+	}
+	overflow = cfg.Overflow
+	if overflow == "" {
+		overflow = OverflowDrop
+	}
+
+	b := t.refill(receiver, cfg)
+	if b.tokens >= 1 {
+		b.tokens--
+		b.suppressed = 0
+		return true, overflow, 0
+	}
+
+	b.suppressed++
+	t.limited.WithLabelValues(receiver, string(overflow)).Inc()
+	return false, overflow, b.suppressed
+}
+
+// refill advances receiver's bucket to now, adding tokens at cfg's rate up
+// to its burst capacity, and returns it. Callers must hold t.mtx.
+func (t *Tracker) refill(receiver string, cfg Settings) *bucket {
+	burst := cfg.Burst
+	if burst <= 0 {
+		burst = int(cfg.PerMinute + 0.999999)
+	}
+
+	now := t.now()
+	b, ok := t.buckets[receiver]
+	if !ok {
+		b = &bucket{tokens: float64(burst), updatedAt: now}
+		t.buckets[receiver] = b
+		return b
+	}
+
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	if elapsed > 0 {
+		b.tokens += elapsed * (cfg.PerMinute / 60)
+		if b.tokens > float64(burst) {
+			b.tokens = float64(burst)
+		}
+		b.updatedAt = now
+	}
+	return b
+}
+
+// Wait blocks until receiver has a token available, consuming it before
+// returning, or until stop fires, whichever comes first. It is meant for
+// OverflowQueue: the caller has already seen Allow return false and wants
+// to hold the notification rather than drop or collapse it.
+func (t *Tracker) Wait(receiver string, stop <-chan struct{}) bool {
+	const pollInterval = 50 * time.Millisecond
+	for {
+		if ok, _, _ := t.Allow(receiver); ok {
+			return true
+		}
+		select {
+		case <-stop:
+			return false
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// Status is a point-in-time snapshot of one receiver's rate limit state,
+// returned by Snapshot for serving over an API.
+type Status struct {
+	Receiver   string  `json:"receiver"`
+	Tokens     float64 `json:"tokens"`
+	Suppressed int     `json:"suppressed"`
+}
+
+// Snapshot returns the current bucket status of every receiver that has
+// made at least one rate-limited Allow call, sorted by receiver name.
+func (t *Tracker) Snapshot() []Status {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	out := make([]Status, 0, len(t.buckets))
+	for receiver, b := range t.buckets {
+		out = append(out, Status{Receiver: receiver, Tokens: b.tokens, Suppressed: b.suppressed})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Receiver < out[j].Receiver })
+	return out
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowWithoutSettingsIsUnlimited(t *testing.T) {
+	tr := New(nil)
+	for i := 0; i < 100; i++ {
+		ok, _, _ := tr.Allow("payments")
+		require.True(t, ok)
+	}
+}
+
+func TestAllowEnforcesBurst(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {PerMinute: 60, Burst: 2}})
+
+	ok, _, _ := tr.Allow("payments")
+	require.True(t, ok)
+	ok, _, _ = tr.Allow("payments")
+	require.True(t, ok)
+
+	ok, overflow, suppressed := tr.Allow("payments")
+	require.False(t, ok)
+	require.Equal(t, OverflowDrop, overflow)
+	require.Equal(t, 1, suppressed)
+}
+
+func TestAllowRefillsOverTime(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {PerMinute: 60, Burst: 1}})
+	now := time.Now()
+	tr.now = func() time.Time { return now }
+
+	ok, _, _ := tr.Allow("payments")
+	require.True(t, ok)
+	ok, _, _ = tr.Allow("payments")
+	require.False(t, ok)
+
+	now = now.Add(time.Second)
+	ok, _, _ = tr.Allow("payments")
+	require.True(t, ok)
+}
+
+func TestAllowDefaultsOverflowToDrop(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {PerMinute: 60, Burst: 1}})
+
+	tr.Allow("payments")
+	_, overflow, _ := tr.Allow("payments")
+	require.Equal(t, OverflowDrop, overflow)
+}
+
+func TestAllowTracksConfiguredOverflow(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {PerMinute: 60, Burst: 1, Overflow: OverflowCollapse}})
+
+	tr.Allow("payments")
+	_, overflow, suppressed := tr.Allow("payments")
+	require.Equal(t, OverflowCollapse, overflow)
+	require.Equal(t, 1, suppressed)
+
+	_, _, suppressed = tr.Allow("payments")
+	require.Equal(t, 2, suppressed)
+}
+
+func TestWaitReturnsOnceATokenIsAvailable(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {PerMinute: 1200, Burst: 1}})
+
+	tr.Allow("payments")
+	require.True(t, tr.Wait("payments", nil))
+}
+
+func TestWaitReturnsFalseOnStop(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {PerMinute: 1, Burst: 1}})
+
+	tr.Allow("payments")
+	stop := make(chan struct{})
+	close(stop)
+	require.False(t, tr.Wait("payments", stop))
+}
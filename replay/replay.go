@@ -0,0 +1,110 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replay evaluates a candidate routing configuration against an
+// archived decisionlog.Logger output, so a routing change can be validated
+// against real historical traffic -- how notification volume per receiver
+// would have changed, and which alerts would have started or stopped
+// paging -- before it is rolled out.
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/prometheus/alertmanager/decisionlog"
+	"github.com/prometheus/alertmanager/dispatch"
+)
+
+// ReadDecisions parses a decisionlog JSON-lines file as written by
+// decisionlog.Logger.SetFile.
+func ReadDecisions(r io.Reader) ([]decisionlog.Decision, error) {
+	var decisions []decisionlog.Decision
+	s := bufio.NewScanner(r)
+	// Decision logs can run for a long time; allow lines larger than the
+	// scanner's conservative default.
+	s.Buffer(nil, 1024*1024)
+	for s.Scan() {
+		line := s.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var d decisionlog.Decision
+		if err := json.Unmarshal(line, &d); err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, d)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return decisions, nil
+}
+
+// ReceiverCounts maps a receiver name to the number of alerts routed to it.
+type ReceiverCounts map[string]int
+
+// Result reports how re-evaluating a set of archived routing decisions
+// against a candidate route would have changed notification delivery.
+type Result struct {
+	// Before counts, per receiver, how many decisions were originally
+	// routed to it.
+	Before ReceiverCounts
+	// After counts, per receiver, how many decisions the candidate route
+	// would send to it.
+	After ReceiverCounts
+	// NewPages lists the fingerprints of alerts that did not page before
+	// but would page under the candidate route.
+	NewPages []string
+	// RemovedPages lists the fingerprints of alerts that paged before but
+	// would no longer page under the candidate route.
+	RemovedPages []string
+}
+
+// Evaluate replays each "routed" decision in decisions against route,
+// comparing the receiver it originally reached (Decision.Reason) against the
+// receivers the candidate route resolves for its recorded Labels. Decisions
+// without recorded labels -- logs written before Labels was introduced, or
+// any non-routing decision -- are skipped.
+func Evaluate(decisions []decisionlog.Decision, route *dispatch.Route) *Result {
+	res := &Result{Before: ReceiverCounts{}, After: ReceiverCounts{}}
+
+	for _, d := range decisions {
+		if d.Action != "routed" || len(d.Labels) == 0 {
+			continue
+		}
+
+		before := d.Reason
+		res.Before[before]++
+
+		after := route.MatchingReceivers(d.Labels, nil)
+		for _, r := range after {
+			res.After[r]++
+		}
+
+		pagedBefore := before != ""
+		pagedAfter := len(after) > 0
+		switch {
+		case !pagedBefore && pagedAfter:
+			res.NewPages = append(res.NewPages, d.Fingerprint)
+		case pagedBefore && !pagedAfter:
+			res.RemovedPages = append(res.RemovedPages, d.Fingerprint)
+		}
+	}
+
+	sort.Strings(res.NewPages)
+	sort.Strings(res.RemovedPages)
+	return res
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/decisionlog"
+	"github.com/prometheus/alertmanager/dispatch"
+)
+
+func TestReadDecisions(t *testing.T) {
+	input := `{"timestamp":"2021-01-01T00:00:00Z","fingerprint":"a","labels":{"severity":"page"},"routePath":"{}","action":"routed","reason":"team-X-pager"}
+{"timestamp":"2021-01-01T00:00:01Z","fingerprint":"b","routePath":"{}","action":"forwarded","reason":"http://example.com"}
+`
+	decisions, err := ReadDecisions(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+	require.Equal(t, "a", decisions[0].Fingerprint)
+	require.Equal(t, model.LabelSet{"severity": "page"}, decisions[0].Labels)
+}
+
+func TestEvaluate(t *testing.T) {
+	cr := &config.Route{
+		Receiver: "team-X-pager",
+		Routes: []*config.Route{
+			{Receiver: "team-Y-pager", Match: map[string]string{"severity": "page"}},
+		},
+	}
+	route := dispatch.NewRoute(cr, nil, nil)
+
+	decisions := []decisionlog.Decision{
+		{
+			Fingerprint: "a",
+			Labels:      model.LabelSet{"severity": "page"},
+			Action:      "routed",
+			Reason:      "team-X-pager",
+		},
+		{
+			Fingerprint: "b",
+			Labels:      model.LabelSet{"severity": "warning"},
+			Action:      "routed",
+			Reason:      "team-X-pager",
+		},
+		// No recorded labels: predates the Labels field, must be skipped.
+		{Fingerprint: "c", Action: "routed", Reason: "team-X-pager"},
+	}
+
+	res := Evaluate(decisions, route)
+
+	require.Equal(t, ReceiverCounts{"team-X-pager": 2}, res.Before)
+	require.Equal(t, ReceiverCounts{"team-Y-pager": 1, "team-X-pager": 1}, res.After)
+	require.Empty(t, res.NewPages)
+	require.Empty(t, res.RemovedPages)
+}
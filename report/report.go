@@ -0,0 +1,271 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package report periodically summarizes recent alerting activity -- alert
+// volume, the noisiest alertnames, notification failures, and silence usage
+// per team -- and posts the summary to a configurable webhook, so a team
+// gets a periodic pulse on alerting health without having to dig through
+// dashboards or logs. Alert volume and notification failures are tallied
+// from pipeline observations via Tracker; silence usage is read directly
+// from the silence store at report time.
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/silence"
+	pb "github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// DefaultInterval is the reporting interval used when none is configured.
+const DefaultInterval = 24 * time.Hour
+
+// AlertnameCount is the number of notification attempts observed for a
+// single alertname during a reporting period.
+type AlertnameCount struct {
+	Alertname string `json:"alertname"`
+	Count     int    `json:"count"`
+}
+
+// Summary is a single reporting period's worth of alerting activity.
+type Summary struct {
+	PeriodStart time.Time `json:"periodStart"`
+	PeriodEnd   time.Time `json:"periodEnd"`
+
+	Notifications         int              `json:"notifications"`
+	NotificationFailures  int              `json:"notificationFailures"`
+	FailuresByIntegration map[string]int   `json:"failuresByIntegration,omitempty"`
+	TopAlertnames         []AlertnameCount `json:"topAlertnames,omitempty"`
+
+	SilencesByTeam map[string]int `json:"silencesByTeam,omitempty"`
+}
+
+// Tracker accumulates notification outcomes between reporting periods. It is
+// safe for concurrent use and is intended to be fed from the notification
+// pipeline, e.g. from notify.RetryStage.
+type Tracker struct {
+	mtx sync.Mutex
+
+	periodStart           time.Time
+	notifications         int
+	failures              int
+	failuresByIntegration map[string]int
+	alertnames            map[string]int
+}
+
+// NewTracker returns an empty Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		failuresByIntegration: map[string]int{},
+		alertnames:            map[string]int{},
+		periodStart:           time.Now(),
+	}
+}
+
+// Observe records the outcome of a single notification attempt to
+// integration for alerts. err is the error returned by the attempt, or nil
+// on success.
+func (t *Tracker) Observe(integration string, alerts []*types.Alert, err error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.notifications++
+	if err != nil {
+		t.failures++
+		t.failuresByIntegration[integration]++
+	}
+	for _, a := range alerts {
+		t.alertnames[a.Name()]++
+	}
+}
+
+// Snapshot returns the current period as a Summary and resets the tracker
+// for the next period.
+func (t *Tracker) Snapshot(now time.Time) Summary {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	top := make([]AlertnameCount, 0, len(t.alertnames))
+	for name, count := range t.alertnames {
+		top = append(top, AlertnameCount{Alertname: name, Count: count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Alertname < top[j].Alertname
+	})
+
+	s := Summary{
+		PeriodStart:           t.periodStart,
+		PeriodEnd:             now,
+		Notifications:         t.notifications,
+		NotificationFailures:  t.failures,
+		FailuresByIntegration: t.failuresByIntegration,
+		TopAlertnames:         top,
+	}
+
+	t.periodStart = now
+	t.notifications = 0
+	t.failures = 0
+	t.failuresByIntegration = map[string]int{}
+	t.alertnames = map[string]int{}
+
+	return s
+}
+
+// Reporter posts periodic Summary digests of a Tracker's activity, plus
+// current silence usage, to a configurable webhook URL. It is safe to
+// reconfigure via Set while Run is executing, e.g. across configuration
+// reloads.
+type Reporter struct {
+	client *http.Client
+	logger log.Logger
+
+	mtx       sync.Mutex
+	url       string
+	interval  time.Duration
+	teamLabel model.LabelName
+}
+
+// New returns a Reporter that is initially disabled. Call Set to configure
+// it with a webhook URL before or during Run.
+func New(l log.Logger) *Reporter {
+	return &Reporter{
+		client: &http.Client{Timeout: 10 * time.Second},
+		logger: l,
+	}
+}
+
+// Set (re-)configures the destination webhook URL, reporting interval, and
+// the label used to group silence usage by team. An empty url disables
+// reporting. An empty teamLabel omits silence usage from the summary.
+func (r *Reporter) Set(url string, interval time.Duration, teamLabel string) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.url = url
+	r.interval = interval
+	r.teamLabel = model.LabelName(teamLabel)
+}
+
+func (r *Reporter) current() (string, time.Duration, model.LabelName) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.url, r.interval, r.teamLabel
+}
+
+// Enabled reports whether a destination webhook URL is currently configured.
+func (r *Reporter) Enabled() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.url != ""
+}
+
+// Run sends a Summary of tracker's activity and silences' current usage on
+// every tick of the configured interval, until stopc is closed. It is a
+// no-op for as long as no URL has been configured via Set; the tracker still
+// accumulates in the meantime, so the first report after a reload covers the
+// full gap.
+func (r *Reporter) Run(ctx context.Context, tracker *Tracker, silences *silence.Silences, stopc <-chan struct{}) {
+	t := time.NewTimer(r.waitDuration())
+	defer t.Stop()
+	for {
+		select {
+		case <-stopc:
+			return
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			url, _, teamLabel := r.current()
+			if url != "" {
+				r.send(ctx, url, tracker, silences, teamLabel)
+			}
+			t.Reset(r.waitDuration())
+		}
+	}
+}
+
+func (r *Reporter) waitDuration() time.Duration {
+	_, interval, _ := r.current()
+	if interval <= 0 {
+		return DefaultInterval
+	}
+	return interval
+}
+
+func (r *Reporter) send(ctx context.Context, url string, tracker *Tracker, silences *silence.Silences, teamLabel model.LabelName) {
+	summary := tracker.Snapshot(time.Now())
+	if teamLabel != "" && silences != nil {
+		summary.SilencesByTeam = silencesByTeam(silences, teamLabel)
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(summary); err != nil {
+		level.Error(r.logger).Log("msg", "failed to encode alerting activity report", "err", err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, &buf)
+	if err != nil {
+		level.Error(r.logger).Log("msg", "failed to build alerting activity report request", "err", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req.WithContext(ctx))
+	if err != nil {
+		level.Warn(r.logger).Log("msg", "failed to send alerting activity report", "err", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		level.Warn(r.logger).Log("msg", "alerting activity report endpoint returned unexpected status", "status", resp.StatusCode)
+	}
+}
+
+// silencesByTeam counts active and pending silences by the value of
+// teamLabel among their matchers. Silences that don't match teamLabel with
+// an equality matcher are omitted.
+func silencesByTeam(silences *silence.Silences, teamLabel model.LabelName) map[string]int {
+	sils, _, err := silences.Query(
+		silence.QState(types.SilenceStateActive, types.SilenceStatePending),
+	)
+	if err != nil {
+		return nil
+	}
+
+	byTeam := map[string]int{}
+	for _, sil := range sils {
+		for _, m := range sil.Matchers {
+			if m.Name == string(teamLabel) && m.Type == pb.Matcher_EQUAL {
+				byTeam[m.Pattern]++
+				break
+			}
+		}
+	}
+	return byTeam
+}
@@ -0,0 +1,115 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestTrackerObserveAndSnapshot(t *testing.T) {
+	tr := NewTracker()
+
+	tr.Observe("slack", []*types.Alert{alertNamed("DiskFull"), alertNamed("DiskFull")}, nil)
+	tr.Observe("email", []*types.Alert{alertNamed("DiskFull")}, fmt.Errorf("boom"))
+	tr.Observe("email", []*types.Alert{alertNamed("HighLatency")}, nil)
+
+	s := tr.Snapshot(time.Now())
+	require.Equal(t, 3, s.Notifications)
+	require.Equal(t, 1, s.NotificationFailures)
+	require.Equal(t, map[string]int{"email": 1}, s.FailuresByIntegration)
+	require.Equal(t, []AlertnameCount{{Alertname: "DiskFull", Count: 3}, {Alertname: "HighLatency", Count: 1}}, s.TopAlertnames)
+
+	// The period resets after a snapshot.
+	empty := tr.Snapshot(time.Now())
+	require.Equal(t, 0, empty.Notifications)
+	require.Empty(t, empty.TopAlertnames)
+}
+
+func alertNamed(name string) *types.Alert {
+	return &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{model.AlertNameLabel: model.LabelValue(name)},
+		},
+	}
+}
+
+func TestSilencesByTeam(t *testing.T) {
+	s, err := silence.New(silence.Options{})
+	require.NoError(t, err)
+
+	now := time.Now()
+	_, err = s.Set(&silencepb.Silence{
+		Matchers:  []*silencepb.Matcher{{Name: "team", Pattern: "payments", Type: silencepb.Matcher_EQUAL}},
+		StartsAt:  now.Add(-time.Minute),
+		EndsAt:    now.Add(time.Hour),
+		CreatedBy: "test",
+		Comment:   "test",
+	})
+	require.NoError(t, err)
+	_, err = s.Set(&silencepb.Silence{
+		Matchers:  []*silencepb.Matcher{{Name: "team", Pattern: "payments", Type: silencepb.Matcher_EQUAL}},
+		StartsAt:  now.Add(-time.Minute),
+		EndsAt:    now.Add(time.Hour),
+		CreatedBy: "test",
+		Comment:   "test",
+	})
+	require.NoError(t, err)
+	_, err = s.Set(&silencepb.Silence{
+		Matchers:  []*silencepb.Matcher{{Name: "team", Pattern: "checkout", Type: silencepb.Matcher_EQUAL}},
+		StartsAt:  now.Add(-time.Minute),
+		EndsAt:    now.Add(time.Hour),
+		CreatedBy: "test",
+		Comment:   "test",
+	})
+	require.NoError(t, err)
+
+	byTeam := silencesByTeam(s, "team")
+	require.Equal(t, map[string]int{"payments": 2, "checkout": 1}, byTeam)
+}
+
+func TestReporterSend(t *testing.T) {
+	var got Summary
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	r := New(log.NewNopLogger())
+	require.False(t, r.Enabled())
+	r.Set(srv.URL, time.Hour, "")
+	require.True(t, r.Enabled())
+
+	tr := NewTracker()
+	tr.Observe("slack", []*types.Alert{alertNamed("DiskFull")}, nil)
+
+	r.send(context.Background(), srv.URL, tr, nil, "")
+
+	require.Equal(t, 1, got.Notifications)
+	require.Equal(t, []AlertnameCount{{Alertname: "DiskFull", Count: 1}}, got.TopAlertnames)
+}
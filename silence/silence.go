@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"reflect"
@@ -32,11 +33,15 @@ import (
 	"github.com/matttproud/golang_protobuf_extensions/pbutil"
 	"github.com/pkg/errors"
 	"github.com/prometheus/alertmanager/cluster"
+	"github.com/prometheus/alertmanager/crypto/atrest"
+	"github.com/prometheus/alertmanager/pkg/timeinterval"
+	"github.com/prometheus/alertmanager/quota"
 	pb "github.com/prometheus/alertmanager/silence/silencepb"
 	"github.com/prometheus/alertmanager/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 	uuid "github.com/satori/go.uuid"
+	"gopkg.in/yaml.v2"
 )
 
 // ErrNotFound is returned if a silence was not found.
@@ -71,8 +76,9 @@ func (c matcherCache) add(s *pb.Silence) (types.Matchers, error) {
 
 	for _, m := range s.Matchers {
 		mt = &types.Matcher{
-			Name:  m.Name,
-			Value: m.Pattern,
+			Name:       m.Name,
+			Value:      m.Pattern,
+			Annotation: m.IsAnnotation,
 		}
 		switch m.Type {
 		case pb.Matcher_EQUAL:
@@ -93,6 +99,43 @@ func (c matcherCache) add(s *pb.Silence) (types.Matchers, error) {
 	return ms, nil
 }
 
+type recurrenceCache map[*pb.Silence][]timeinterval.TimeInterval
+
+// Get retrieves the parsed recurrence windows for a given silence. If it is
+// a missed cache access, it parses and adds the recurrence of the requested
+// silence to the cache.
+func (c recurrenceCache) Get(s *pb.Silence) ([]timeinterval.TimeInterval, error) {
+	if ti, ok := c[s]; ok {
+		return ti, nil
+	}
+	return c.add(s)
+}
+
+// add parses a silence's recurrence and adds it to the cache. It returns the
+// parsed time intervals.
+func (c recurrenceCache) add(s *pb.Silence) ([]timeinterval.TimeInterval, error) {
+	if s.Recurrence == "" {
+		c[s] = nil
+		return nil, nil
+	}
+	tis, err := parseRecurrence(s.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	c[s] = tis
+	return tis, nil
+}
+
+// parseRecurrence parses the YAML-encoded list of timeinterval.TimeInterval
+// calendar windows stored in a silence's Recurrence field.
+func parseRecurrence(s string) ([]timeinterval.TimeInterval, error) {
+	var tis []timeinterval.TimeInterval
+	if err := yaml.Unmarshal([]byte(s), &tis); err != nil {
+		return nil, err
+	}
+	return tis, nil
+}
+
 // Silencer binds together a Marker and a Silences to implement the Muter
 // interface.
 type Silencer struct {
@@ -111,7 +154,7 @@ func NewSilencer(s *Silences, m types.Marker, l log.Logger) *Silencer {
 }
 
 // Mutes implements the Muter interface.
-func (s *Silencer) Mutes(lset model.LabelSet) bool {
+func (s *Silencer) Mutes(lset, annotations model.LabelSet) bool {
 	fp := lset.Fingerprint()
 	ids, markerVersion, _ := s.marker.Silenced(fp)
 
@@ -142,7 +185,7 @@ func (s *Silencer) Mutes(lset model.LabelSet) bool {
 		// New silences have been added, do a full query.
 		sils, newVersion, err = s.silences.Query(
 			QState(types.SilenceStateActive),
-			QMatches(lset),
+			QMatches(lset, annotations),
 		)
 	}
 	if err != nil {
@@ -177,6 +220,14 @@ func (s *Silencer) Mutes(lset model.LabelSet) bool {
 	return true
 }
 
+// SilencedIDs returns the IDs of the silences currently applied to the alert
+// with fingerprint fp, as last recorded by Mutes. It returns an empty slice
+// if the alert is not currently silenced.
+func (s *Silencer) SilencedIDs(fp model.Fingerprint) []string {
+	ids, _, _ := s.marker.Silenced(fp)
+	return ids
+}
+
 // Silences holds a silence state that can be modified, queried, and snapshot.
 type Silences struct {
 	logger    log.Logger
@@ -189,6 +240,9 @@ type Silences struct {
 	version   int // Increments whenever silences are added.
 	broadcast func([]byte)
 	mc        matcherCache
+	rc        recurrenceCache
+	quota     *quota.Tracker
+	keyring   *atrest.KeyRing
 }
 
 type metrics struct {
@@ -202,6 +256,7 @@ type metrics struct {
 	silencesPending         prometheus.GaugeFunc
 	silencesExpired         prometheus.GaugeFunc
 	propagatedMessagesTotal prometheus.Counter
+	conflictsTotal          prometheus.Counter
 }
 
 func newSilenceMetricByState(s *Silences, st types.SilenceState) prometheus.GaugeFunc {
@@ -254,6 +309,10 @@ func newMetrics(r prometheus.Registerer, s *Silences) *metrics {
 		Name: "alertmanager_silences_gossip_messages_propagated_total",
 		Help: "Number of received gossip messages that have been further gossiped.",
 	})
+	m.conflictsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "alertmanager_silences_merge_conflicts_total",
+		Help: "Number of times a merge had to deterministically resolve two concurrent edits of the same silence.",
+	})
 	if s != nil {
 		m.silencesActive = newSilenceMetricByState(s, types.SilenceStateActive)
 		m.silencesPending = newSilenceMetricByState(s, types.SilenceStatePending)
@@ -272,6 +331,7 @@ func newMetrics(r prometheus.Registerer, s *Silences) *metrics {
 			m.silencesPending,
 			m.silencesExpired,
 			m.propagatedMessagesTotal,
+			m.conflictsTotal,
 		)
 	}
 	return m
@@ -289,6 +349,11 @@ type Options struct {
 	// garbage collected after the given duration after they ended.
 	Retention time.Duration
 
+	// Encryption, if its current key is set, encrypts snapshots written by
+	// Snapshot and decrypts the snapshot loaded from SnapshotFile or
+	// SnapshotReader.
+	Encryption *atrest.KeyRing
+
 	// A logger used by background processing.
 	Logger  log.Logger
 	Metrics prometheus.Registerer
@@ -317,11 +382,13 @@ func New(o Options) (*Silences, error) {
 	}
 	s := &Silences{
 		mc:        matcherCache{},
+		rc:        recurrenceCache{},
 		logger:    log.NewNopLogger(),
 		retention: o.Retention,
 		now:       utcNow,
 		broadcast: func([]byte) {},
 		st:        state{},
+		keyring:   o.Encryption,
 	}
 	s.metrics = newMetrics(o.Metrics, s)
 
@@ -408,6 +475,7 @@ func (s *Silences) GC() (int, error) {
 		if !sil.ExpiresAt.After(now) {
 			delete(s.st, id)
 			delete(s.mc, sil.Silence)
+			delete(s.rc, sil.Silence)
 			n++
 		}
 	}
@@ -475,6 +543,15 @@ func validateSilence(s *pb.Silence) error {
 	if s.UpdatedAt.IsZero() {
 		return errors.New("invalid zero update timestamp")
 	}
+	if s.Recurrence != "" {
+		tis, err := parseRecurrence(s.Recurrence)
+		if err != nil {
+			return errors.Wrap(err, "invalid recurrence")
+		}
+		if len(tis) == 0 {
+			return errors.New("recurrence must contain at least one time interval")
+		}
+	}
 	return nil
 }
 
@@ -494,6 +571,14 @@ func (s *Silences) getSilence(id string) (*pb.Silence, bool) {
 
 func (s *Silences) setSilence(sil *pb.Silence, now time.Time) error {
 	sil.UpdatedAt = now
+	// Bump the Lamport clock so concurrent edits on different peers can be
+	// ordered deterministically on merge, even if their wall clocks are
+	// skewed or equal.
+	if prev, ok := s.st[sil.Id]; ok {
+		sil.Version = prev.Silence.Version + 1
+	} else {
+		sil.Version = 1
+	}
 
 	if err := validateSilence(sil); err != nil {
 		return errors.Wrap(err, "silence invalid")
@@ -508,7 +593,7 @@ func (s *Silences) setSilence(sil *pb.Silence, now time.Time) error {
 		return err
 	}
 
-	if s.st.merge(msil, now) {
+	if merged, _ := s.st.merge(msil, now); merged {
 		s.version++
 	}
 	s.broadcast(b)
@@ -529,10 +614,10 @@ func (s *Silences) Set(sil *pb.Silence) (string, error) {
 		return "", ErrNotFound
 	}
 	if ok {
-		if canUpdate(prev, sil, now) {
+		if canUpdate(s, prev, sil, now) {
 			return sil.Id, s.setSilence(sil, now)
 		}
-		if getState(prev, s.now()) != types.SilenceStateExpired {
+		if s.getState(prev, s.now()) != types.SilenceStateExpired {
 			// We cannot update the silence, expire the old one.
 			if err := s.expire(prev.Id); err != nil {
 				return "", errors.Wrap(err, "expire previous silence")
@@ -540,23 +625,49 @@ func (s *Silences) Set(sil *pb.Silence) (string, error) {
 		}
 	}
 	// If we got here it's either a new silence or a replacing one.
+	var tenant string
+	if s.quota != nil {
+		tenant = s.quota.Tenant(tenantLabelSet(sil.Matchers))
+		if err := s.quota.CheckSilence(tenant); err != nil {
+			return "", err
+		}
+	}
+
 	sil.Id = uuid.NewV4().String()
 
 	if sil.StartsAt.Before(now) {
 		sil.StartsAt = now
 	}
 
-	return sil.Id, s.setSilence(sil, now)
+	err := s.setSilence(sil, now)
+	if err == nil && s.quota != nil {
+		s.quota.ObserveSilence(tenant)
+	}
+	return sil.Id, err
+}
+
+// tenantLabelSet turns a silence's equality matchers into a LabelSet, for
+// resolving the quota.Tracker tenant it should be attributed to. Regexp
+// matchers are ignored, mirroring how report.silencesByTeam identifies a
+// silence's team.
+func tenantLabelSet(matchers []*pb.Matcher) model.LabelSet {
+	ls := model.LabelSet{}
+	for _, m := range matchers {
+		if m.Type == pb.Matcher_EQUAL {
+			ls[model.LabelName(m.Name)] = model.LabelValue(m.Pattern)
+		}
+	}
+	return ls
 }
 
 // canUpdate returns true if silence a can be updated to b without
 // affecting the historic view of silencing.
-func canUpdate(a, b *pb.Silence, now time.Time) bool {
+func canUpdate(s *Silences, a, b *pb.Silence, now time.Time) bool {
 	if !reflect.DeepEqual(a.Matchers, b.Matchers) {
 		return false
 	}
 	// Allowed timestamp modifications depend on the current time.
-	switch st := getState(a, now); st {
+	switch st := s.getState(a, now); st {
 	case types.SilenceStateActive:
 		if !b.StartsAt.Equal(a.StartsAt) {
 			return false
@@ -592,7 +703,7 @@ func (s *Silences) expire(id string) error {
 	sil = cloneSilence(sil)
 	now := s.now()
 
-	switch getState(sil, now) {
+	switch s.getState(sil, now) {
 	case types.SilenceStateExpired:
 		return errors.Errorf("silence %s already expired", id)
 	case types.SilenceStateActive:
@@ -626,37 +737,118 @@ func QIDs(ids ...string) QueryParam {
 	}
 }
 
-// QMatches returns silences that match the given label set.
-func QMatches(set model.LabelSet) QueryParam {
+// QMatches returns silences that match the given label set and annotations.
+func QMatches(set, annotations model.LabelSet) QueryParam {
 	return func(q *query) error {
 		f := func(sil *pb.Silence, s *Silences, _ time.Time) (bool, error) {
 			m, err := s.mc.Get(sil)
 			if err != nil {
 				return true, err
 			}
-			return m.Match(set), nil
+			return m.Match(set, annotations), nil
 		}
 		q.filters = append(q.filters, f)
 		return nil
 	}
 }
 
-// getState returns a silence's SilenceState at the given timestamp.
-func getState(sil *pb.Silence, ts time.Time) types.SilenceState {
+// getState returns a silence's SilenceState at the given timestamp. A
+// silence whose recurrence windows don't cover ts is reported as pending
+// even while ts falls within StartsAt/EndsAt, since it isn't currently
+// silencing anything.
+func (s *Silences) getState(sil *pb.Silence, ts time.Time) types.SilenceState {
 	if ts.Before(sil.StartsAt) {
 		return types.SilenceStatePending
 	}
 	if ts.After(sil.EndsAt) {
 		return types.SilenceStateExpired
 	}
+	if sil.Recurrence != "" {
+		tis, err := s.rc.Get(sil)
+		if err != nil {
+			level.Error(s.logger).Log("msg", "invalid recurrence on stored silence, ignoring", "silence", sil.Id, "err", err)
+			return types.SilenceStateActive
+		}
+		active := false
+		for _, ti := range tis {
+			if ok, _ := ti.ContainsTime(ts); ok {
+				active = true
+				break
+			}
+		}
+		if !active {
+			return types.SilenceStatePending
+		}
+	}
 	return types.SilenceStateActive
 }
 
+// CalcSilenceState returns the SilenceState that sil would have at ts,
+// taking its recurrence (if any) into account. Unlike (*Silences).getState,
+// it parses the recurrence directly rather than through a cache, since it
+// is meant for one-off rendering (e.g. an API response) rather than the
+// Mutes() hot path.
+func CalcSilenceState(sil *pb.Silence, ts time.Time) (types.SilenceState, error) {
+	if ts.Before(sil.StartsAt) {
+		return types.SilenceStatePending, nil
+	}
+	if ts.After(sil.EndsAt) {
+		return types.SilenceStateExpired, nil
+	}
+	if sil.Recurrence == "" {
+		return types.SilenceStateActive, nil
+	}
+	tis, err := parseRecurrence(sil.Recurrence)
+	if err != nil {
+		return "", err
+	}
+	for _, ti := range tis {
+		if ok, _ := ti.ContainsTime(ts); ok {
+			return types.SilenceStateActive, nil
+		}
+	}
+	return types.SilenceStatePending, nil
+}
+
+// maxRecurrenceLookahead bounds how far into the future NextActive searches
+// for the next occurrence of a recurring silence's time intervals.
+const maxRecurrenceLookahead = 366 * 24 * time.Hour
+
+// NextActive returns the next point in time at or after from, and not after
+// sil's EndsAt, at which sil's recurrence makes it active. It returns false
+// if sil has no recurrence, or no occurrence is found within
+// maxRecurrenceLookahead of from.
+func NextActive(sil *pb.Silence, from time.Time) (time.Time, bool) {
+	if sil.Recurrence == "" {
+		return time.Time{}, false
+	}
+	if from.Before(sil.StartsAt) {
+		from = sil.StartsAt
+	}
+	tis, err := parseRecurrence(sil.Recurrence)
+	if err != nil || len(tis) == 0 {
+		return time.Time{}, false
+	}
+	end := sil.EndsAt
+	if horizon := from.Add(maxRecurrenceLookahead); horizon.Before(end) {
+		end = horizon
+	}
+	const step = time.Minute
+	for t := from; !t.After(end); t = t.Add(step) {
+		for _, ti := range tis {
+			if ok, _ := ti.ContainsTime(t); ok {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
 // QState filters queried silences by the given states.
 func QState(states ...types.SilenceState) QueryParam {
 	return func(q *query) error {
-		f := func(sil *pb.Silence, _ *Silences, now time.Time) (bool, error) {
-			s := getState(sil, now)
+		f := func(sil *pb.Silence, sils *Silences, now time.Time) (bool, error) {
+			s := sils.getState(sil, now)
 
 			for _, ps := range states {
 				if s == ps {
@@ -764,6 +956,18 @@ func (s *Silences) query(q *query, now time.Time) ([]*pb.Silence, int, error) {
 // loadSnapshot loads a snapshot generated by Snapshot() into the state.
 // Any previous state is wiped.
 func (s *Silences) loadSnapshot(r io.Reader) error {
+	if s.keyring != nil {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		b, err = s.keyring.Decrypt(b)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+
 	st, err := decodeState(r)
 	if err != nil {
 		return err
@@ -792,13 +996,19 @@ func (s *Silences) Snapshot(w io.Writer) (int64, error) {
 	defer func() { s.metrics.snapshotDuration.Observe(time.Since(start).Seconds()) }()
 
 	s.mtx.RLock()
-	defer s.mtx.RUnlock()
-
 	b, err := s.st.MarshalBinary()
+	s.mtx.RUnlock()
 	if err != nil {
 		return 0, err
 	}
 
+	if s.keyring != nil {
+		b, err = s.keyring.Encrypt(b)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	return io.Copy(w, bytes.NewReader(b))
 }
 
@@ -822,7 +1032,12 @@ func (s *Silences) Merge(b []byte) error {
 	now := s.now()
 
 	for _, e := range st {
-		if merged := s.st.merge(e, now); merged {
+		merged, conflict := s.st.merge(e, now)
+		if conflict {
+			s.metrics.conflictsTotal.Inc()
+			level.Debug(s.logger).Log("msg", "Resolved conflicting concurrent silence edit", "silence", e.Silence.Id)
+		}
+		if merged {
 			s.version++
 			if !cluster.OversizedMessage(b) {
 				// If this is the first we've seen the message and it's
@@ -846,12 +1061,25 @@ func (s *Silences) SetBroadcast(f func([]byte)) {
 	s.mtx.Unlock()
 }
 
+// SetQuotaTracker configures the tracker consulted to attribute new silences
+// to a tenant and enforce its silence quota. Passing nil disables both.
+func (s *Silences) SetQuotaTracker(t *quota.Tracker) {
+	s.mtx.Lock()
+	s.quota = t
+	s.mtx.Unlock()
+}
+
 type state map[string]*pb.MeshSilence
 
-func (s state) merge(e *pb.MeshSilence, now time.Time) bool {
+// merge folds e into the state. The first return value reports whether e
+// replaced the previously stored entry; the second reports whether e and
+// the previous entry are two concurrent edits of the same silence, i.e. a
+// conflict that had to be resolved deterministically rather than e simply
+// being a newer or already-seen copy.
+func (s state) merge(e *pb.MeshSilence, now time.Time) (bool, bool) {
 	id := e.Silence.Id
 	if e.ExpiresAt.Before(now) {
-		return false
+		return false, false
 	}
 	// Comments list was moved to a single comment. Apply upgrade
 	// on silences received from peers.
@@ -862,11 +1090,40 @@ func (s state) merge(e *pb.MeshSilence, now time.Time) bool {
 	}
 
 	prev, ok := s[id]
-	if !ok || prev.Silence.UpdatedAt.Before(e.Silence.UpdatedAt) {
+	if !ok {
+		s[id] = e
+		return true, false
+	}
+	if reflect.DeepEqual(prev.Silence, e.Silence) {
+		return false, false
+	}
+	if silenceWins(prev.Silence, e.Silence) {
 		s[id] = e
-		return true
+		return true, true
+	}
+	return false, true
+}
+
+// silenceWins reports whether next should replace prev when both are
+// present for the same silence ID, using a total order that every peer
+// computes identically so a cluster partition can never let a stale edit
+// resurrect a silence deleted elsewhere. UpdatedAt (wall clock) is tried
+// first, then Version (a Lamport clock bumped on every local edit, immune
+// to clock skew), then, if still tied, a comparison of the marshaled
+// silences themselves so the outcome is fully deterministic.
+func silenceWins(prev, next *pb.Silence) bool {
+	if !prev.UpdatedAt.Equal(next.UpdatedAt) {
+		return prev.UpdatedAt.Before(next.UpdatedAt)
+	}
+	if prev.Version != next.Version {
+		return prev.Version < next.Version
+	}
+	prevB, errPrev := prev.Marshal()
+	nextB, errNext := next.Marshal()
+	if errPrev != nil || errNext != nil {
+		return false
 	}
-	return false
+	return bytes.Compare(nextB, prevB) > 0
 }
 
 func (s state) MarshalBinary() ([]byte, error) {
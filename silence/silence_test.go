@@ -22,7 +22,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/log"
 	"github.com/matttproud/golang_protobuf_extensions/pbutil"
+	"github.com/prometheus/alertmanager/crypto/atrest"
+	"github.com/prometheus/alertmanager/quota"
 	pb "github.com/prometheus/alertmanager/silence/silencepb"
 	"github.com/prometheus/alertmanager/types"
 	"github.com/prometheus/common/model"
@@ -164,6 +167,45 @@ func TestSilencesSnapshot(t *testing.T) {
 	}
 }
 
+func TestSilencesSnapshotEncrypted(t *testing.T) {
+	now := utcNow()
+	entry := &pb.MeshSilence{
+		Silence: &pb.Silence{
+			Id:        "3be80475-e219-4ee7-b6fc-4b65114e362f",
+			Matchers:  []*pb.Matcher{{Name: "label1", Pattern: "val1", Type: pb.Matcher_EQUAL}},
+			StartsAt:  now,
+			EndsAt:    now,
+			UpdatedAt: now,
+		},
+		ExpiresAt: now,
+	}
+
+	kr := atrest.NewKeyRing()
+	key := make([]byte, atrest.KeySize)
+	require.NoError(t, kr.Configure(map[string][]byte{"k1": key}, "k1"))
+
+	s1 := &Silences{st: state{}, metrics: newMetrics(nil, nil), keyring: kr}
+	s1.st[entry.Silence.Id] = entry
+
+	f, err := ioutil.TempFile("", "snapshot")
+	require.NoError(t, err)
+	_, err = s1.Snapshot(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	raw, err := ioutil.ReadFile(f.Name())
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "label1", "snapshot must not contain the plaintext matcher name")
+
+	f, err = os.Open(f.Name())
+	require.NoError(t, err)
+	defer f.Close()
+
+	s2 := &Silences{mc: matcherCache{}, st: state{}, keyring: kr}
+	require.NoError(t, s2.loadSnapshot(f))
+	require.Equal(t, s1.st, s2.st)
+}
+
 func TestSilencesSetSilence(t *testing.T) {
 	s, err := New(Options{
 		Retention: time.Minute,
@@ -243,6 +285,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  now1.Add(2 * time.Minute),
 				EndsAt:    now1.Add(5 * time.Minute),
 				UpdatedAt: now1,
+				Version:   1,
 			},
 			ExpiresAt: now1.Add(5*time.Minute + s.retention),
 		},
@@ -270,6 +313,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  now2,
 				EndsAt:    now2.Add(1 * time.Minute),
 				UpdatedAt: now2,
+				Version:   1,
 			},
 			ExpiresAt: now2.Add(1*time.Minute + s.retention),
 		},
@@ -296,6 +340,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  now2,
 				EndsAt:    now3.Add(100 * time.Minute),
 				UpdatedAt: now3,
+				Version:   2,
 			},
 			ExpiresAt: now3.Add(100*time.Minute + s.retention),
 		},
@@ -322,6 +367,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  now2,
 				EndsAt:    now4,
 				UpdatedAt: now4,
+				Version:   3,
 			},
 			ExpiresAt: now4.Add(s.retention),
 		},
@@ -332,6 +378,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  now4,
 				EndsAt:    now3.Add(100 * time.Minute),
 				UpdatedAt: now4,
+				Version:   1,
 			},
 			ExpiresAt: now3.Add(100*time.Minute + s.retention),
 		},
@@ -361,6 +408,7 @@ func TestSilenceSet(t *testing.T) {
 				StartsAt:  now5,
 				EndsAt:    now5.Add(5 * time.Minute),
 				UpdatedAt: now5,
+				Version:   1,
 			},
 			ExpiresAt: now5.Add(5*time.Minute + s.retention),
 		},
@@ -393,8 +441,44 @@ func TestSilencesSetFail(t *testing.T) {
 	}
 }
 
+func TestSilenceSetEnforcesTenantQuota(t *testing.T) {
+	s, err := New(Options{})
+	require.NoError(t, err)
+
+	now := utcNow()
+	s.now = func() time.Time { return now }
+
+	tracker := quota.New(nil)
+	tracker.Configure("team", map[string]quota.Limits{"payments": {MaxSilences: 1}})
+	s.SetQuotaTracker(tracker)
+
+	newSilence := func() *pb.Silence {
+		return &pb.Silence{
+			Matchers:  []*pb.Matcher{{Name: "team", Pattern: "payments", Type: pb.Matcher_EQUAL}},
+			StartsAt:  now,
+			EndsAt:    now.Add(time.Minute),
+			CreatedBy: "x",
+			Comment:   "x",
+		}
+	}
+
+	_, err = s.Set(newSilence())
+	require.NoError(t, err)
+
+	_, err = s.Set(newSilence())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "payments")
+
+	// A silence for a different tenant is unaffected.
+	other := newSilence()
+	other.Matchers[0].Pattern = "search"
+	_, err = s.Set(other)
+	require.NoError(t, err)
+}
+
 func TestQState(t *testing.T) {
 	now := utcNow()
+	sils := &Silences{rc: recurrenceCache{}, logger: log.NewNopLogger()}
 
 	cases := []struct {
 		sil    *pb.Silence
@@ -425,13 +509,27 @@ func TestQState(t *testing.T) {
 			states: []types.SilenceState{types.SilenceStateExpired, types.SilenceStatePending},
 			keep:   true,
 		},
+		{
+			// Active overall, but recurrence excludes "now" (a Tuesday).
+			sil: &pb.Silence{
+				StartsAt:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndsAt:     time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+				Recurrence: "- weekdays: ['saturday', 'sunday']\n",
+			},
+			states: []types.SilenceState{types.SilenceStatePending},
+			keep:   true,
+		},
 	}
 	for i, c := range cases {
 		q := &query{}
 		QState(c.states...)(q)
 		f := q.filters[0]
 
-		keep, err := f(c.sil, nil, now)
+		ts := now
+		if c.sil.Recurrence != "" {
+			ts = time.Date(2020, 1, 7, 12, 0, 0, 0, time.UTC) // a Tuesday
+		}
+		keep, err := f(c.sil, sils, ts)
 		require.NoError(t, err)
 		require.Equal(t, c.keep, keep, "unexpected filter result for case %d", i)
 	}
@@ -443,7 +541,7 @@ func TestQMatches(t *testing.T) {
 		"instance": "web-1",
 		"path":     "/user/profile",
 		"method":   "GET",
-	})
+	}, nil)
 
 	q := &query{}
 	qp(q)
@@ -495,6 +593,29 @@ func TestQMatches(t *testing.T) {
 	}
 }
 
+func TestQMatchesAnnotation(t *testing.T) {
+	lset := model.LabelSet{"runbook_url": "http://example.com/legacy"}
+	annotations := model.LabelSet{"runbook_url": "http://example.com/legacy"}
+
+	sil := &pb.Silence{
+		Matchers: []*pb.Matcher{
+			{Name: "runbook_url", Pattern: ".*legacy.*", Type: pb.Matcher_REGEXP, IsAnnotation: true},
+		},
+	}
+
+	q := &query{}
+	QMatches(lset, nil)(q)
+	match, err := q.filters[0](sil, &Silences{mc: matcherCache{}, st: state{}}, time.Time{})
+	require.NoError(t, err)
+	require.False(t, match, "an annotation matcher must not match against the label set")
+
+	q = &query{}
+	QMatches(nil, annotations)(q)
+	match, err = q.filters[0](sil, &Silences{mc: matcherCache{}, st: state{}}, time.Time{})
+	require.NoError(t, err)
+	require.True(t, match, "an annotation matcher must match against the annotation set")
+}
+
 func TestSilencesQuery(t *testing.T) {
 	s, err := New(Options{})
 	require.NoError(t, err)
@@ -581,6 +702,7 @@ func (s silencesByID) Less(i, j int) bool { return s[i].Id < s[j].Id }
 
 func TestSilenceCanUpdate(t *testing.T) {
 	now := utcNow()
+	s := &Silences{rc: recurrenceCache{}, logger: log.NewNopLogger()}
 
 	cases := []struct {
 		a, b *pb.Silence
@@ -695,7 +817,7 @@ func TestSilenceCanUpdate(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		ok := canUpdate(c.a, c.b, now)
+		ok := canUpdate(s, c.a, c.b, now)
 		if ok && !c.ok {
 			t.Errorf("expected not-updateable but was: %v, %v", c.a, c.b)
 		}
@@ -761,6 +883,7 @@ func TestSilenceExpire(t *testing.T) {
 		StartsAt:  now,
 		EndsAt:    now,
 		UpdatedAt: now,
+		Version:   1,
 	}, sil)
 
 	// Let time pass...
@@ -787,6 +910,7 @@ func TestSilenceExpire(t *testing.T) {
 		StartsAt:  now.Add(-time.Minute),
 		EndsAt:    now,
 		UpdatedAt: now,
+		Version:   1,
 	}, sil)
 
 	sil, err = s.QueryOne(QIDs("expired"))
@@ -1032,12 +1156,98 @@ func TestValidateSilence(t *testing.T) {
 			},
 			err: "invalid zero update timestamp",
 		},
+		{
+			s: &pb.Silence{
+				Id: "some_id",
+				Matchers: []*pb.Matcher{
+					&pb.Matcher{Name: "a", Pattern: "b"},
+				},
+				StartsAt:   validTimestamp,
+				EndsAt:     validTimestamp,
+				UpdatedAt:  validTimestamp,
+				Recurrence: "not valid yaml: [",
+			},
+			err: "invalid recurrence",
+		},
+		{
+			s: &pb.Silence{
+				Id: "some_id",
+				Matchers: []*pb.Matcher{
+					&pb.Matcher{Name: "a", Pattern: "b"},
+				},
+				StartsAt:   validTimestamp,
+				EndsAt:     validTimestamp,
+				UpdatedAt:  validTimestamp,
+				Recurrence: "[]",
+			},
+			err: "recurrence must contain at least one time interval",
+		},
+		{
+			s: &pb.Silence{
+				Id: "some_id",
+				Matchers: []*pb.Matcher{
+					&pb.Matcher{Name: "a", Pattern: "b"},
+				},
+				StartsAt:   validTimestamp,
+				EndsAt:     validTimestamp,
+				UpdatedAt:  validTimestamp,
+				Recurrence: "- weekdays: ['saturday', 'sunday']\n",
+			},
+			err: "",
+		},
 	}
 	for _, c := range cases {
 		checkErr(t, c.err, validateSilence(c.s))
 	}
 }
 
+func TestCalcSilenceState(t *testing.T) {
+	// 2020-01-04 was a Saturday, 2020-01-07 a Tuesday.
+	saturday := time.Date(2020, 1, 4, 12, 0, 0, 0, time.UTC)
+	tuesday := time.Date(2020, 1, 7, 12, 0, 0, 0, time.UTC)
+
+	sil := &pb.Silence{
+		StartsAt:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:     time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+		Recurrence: "- weekdays: ['saturday', 'sunday']\n",
+	}
+
+	st, err := CalcSilenceState(sil, saturday)
+	require.NoError(t, err)
+	require.Equal(t, types.SilenceStateActive, st)
+
+	st, err = CalcSilenceState(sil, tuesday)
+	require.NoError(t, err)
+	require.Equal(t, types.SilenceStatePending, st)
+
+	sil.Recurrence = "not valid yaml: ["
+	_, err = CalcSilenceState(sil, saturday)
+	require.Error(t, err)
+}
+
+func TestNextActive(t *testing.T) {
+	// 2020-01-07 was a Tuesday; the following Saturday is 2020-01-11.
+	tuesday := time.Date(2020, 1, 7, 12, 0, 0, 0, time.UTC)
+	nextSaturday := time.Date(2020, 1, 11, 0, 0, 0, 0, time.UTC)
+
+	_, ok := NextActive(&pb.Silence{StartsAt: tuesday, EndsAt: tuesday.Add(time.Hour)}, tuesday)
+	require.False(t, ok, "silence without recurrence has no next active window")
+
+	sil := &pb.Silence{
+		StartsAt:   tuesday,
+		EndsAt:     tuesday.Add(14 * 24 * time.Hour),
+		Recurrence: "- weekdays: ['saturday', 'sunday']\n",
+	}
+	next, ok := NextActive(sil, tuesday)
+	require.True(t, ok)
+	require.False(t, next.Before(nextSaturday))
+	require.True(t, next.Weekday() == time.Saturday || next.Weekday() == time.Sunday)
+
+	sil.EndsAt = tuesday.Add(time.Hour)
+	_, ok = NextActive(sil, tuesday)
+	require.False(t, ok, "no weekend occurs before the silence ends")
+}
+
 func TestStateMerge(t *testing.T) {
 	now := utcNow()
 
@@ -1086,6 +1296,39 @@ func TestStateMerge(t *testing.T) {
 	}
 }
 
+func TestStateMergeConflictResolution(t *testing.T) {
+	now := utcNow()
+	exp := now.Add(time.Minute)
+
+	newSilence := func(ts time.Time, version uint64) *pb.MeshSilence {
+		return &pb.MeshSilence{
+			Silence:   &pb.Silence{Id: "a1", UpdatedAt: ts, Version: version},
+			ExpiresAt: exp,
+		}
+	}
+
+	// Two peers concurrently edit the same silence: UpdatedAt alone cannot
+	// tell them apart, so the higher Lamport Version must win.
+	st := state{"a1": newSilence(now, 2)}
+	incoming := newSilence(now, 3)
+	merged, conflict := st.merge(incoming, now)
+	require.True(t, merged, "higher version should win a tied-timestamp conflict")
+	require.True(t, conflict)
+	require.Equal(t, incoming, st["a1"])
+
+	// A stale edit with a lower version must not resurrect over a newer one.
+	stale := newSilence(now, 1)
+	merged, conflict = st.merge(stale, now)
+	require.False(t, merged, "lower version must not overwrite a higher one")
+	require.True(t, conflict)
+	require.Equal(t, incoming, st["a1"], "state must be unaffected by the stale edit")
+
+	// Re-merging the exact same entry is not a conflict.
+	merged, conflict = st.merge(incoming, now)
+	require.False(t, merged)
+	require.False(t, conflict)
+}
+
 func TestStateCoding(t *testing.T) {
 	// Check whether encoding and decoding the data is symmetric.
 	now := utcNow()
@@ -1186,7 +1429,7 @@ func benchmarkSilencesQuery(b *testing.B, numSilences int) {
 	// Run things once to populate the matcherCache.
 	sils, _, err := s.Query(
 		QState(types.SilenceStateActive),
-		QMatches(lset),
+		QMatches(lset, nil),
 	)
 	require.NoError(b, err)
 	require.Equal(b, numSilences/10, len(sils))
@@ -1195,7 +1438,7 @@ func benchmarkSilencesQuery(b *testing.B, numSilences int) {
 	for i := 0; i < b.N; i++ {
 		sils, _, err := s.Query(
 			QState(types.SilenceStateActive),
-			QMatches(lset),
+			QMatches(lset, nil),
 		)
 		require.NoError(b, err)
 		require.Equal(b, numSilences/10, len(sils))
@@ -62,7 +62,10 @@ type Matcher struct {
 	// checks the pattern.
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// The pattern being checked according to the matcher's type.
-	Pattern              string   `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// IsAnnotation, if true, makes the matcher check the pattern against an
+	// alert's annotations instead of its labels.
+	IsAnnotation         bool     `protobuf:"varint,4,opt,name=is_annotation,json=isAnnotation,proto3" json:"is_annotation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -160,8 +163,20 @@ type Silence struct {
 	// DEPRECATED: A set of comments made on the silence.
 	Comments []*Comment `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
 	// Comment for the silence.
-	CreatedBy            string   `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Comment              string   `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedBy string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Comment   string `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Lamport logical clock, incremented on every local edit. Used to break
+	// ties deterministically between concurrent edits on different peers
+	// whose updated_at wall clocks may be skewed or equal, so a partitioned
+	// cluster cannot let a stale edit resurrect a silence that was deleted
+	// elsewhere.
+	Version uint64 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	// Recurrence, if set, is a YAML-encoded list of timeinterval.TimeInterval
+	// calendar windows. While set, the silence (bounded overall by
+	// starts_at/ends_at) is only active during moments that fall inside one
+	// of these windows, so a weekly maintenance window doesn't need to be
+	// recreated by hand every week.
+	Recurrence           string   `protobuf:"bytes,11,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -309,6 +324,16 @@ func (m *Matcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IsAnnotation {
+		i--
+		if m.IsAnnotation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Pattern) > 0 {
 		i -= len(m.Pattern)
 		copy(dAtA[i:], m.Pattern)
@@ -404,6 +429,18 @@ func (m *Silence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Recurrence) > 0 {
+		i -= len(m.Recurrence)
+		copy(dAtA[i:], m.Recurrence)
+		i = encodeVarintSilence(dAtA, i, uint64(len(m.Recurrence)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Version != 0 {
+		i = encodeVarintSilence(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.Comment) > 0 {
 		i -= len(m.Comment)
 		copy(dAtA[i:], m.Comment)
@@ -555,6 +592,9 @@ func (m *Matcher) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSilence(uint64(l))
 	}
+	if m.IsAnnotation {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -619,6 +659,13 @@ func (m *Silence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSilence(uint64(l))
 	}
+	if m.Version != 0 {
+		n += 1 + sovSilence(uint64(m.Version))
+	}
+	l = len(m.Recurrence)
+	if l > 0 {
+		n += 1 + l + sovSilence(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -761,6 +808,26 @@ func (m *Matcher) Unmarshal(dAtA []byte) error {
 			}
 			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAnnotation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAnnotation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSilence(dAtA[iNdEx:])
@@ -1229,6 +1296,57 @@ func (m *Silence) Unmarshal(dAtA []byte) error {
 			}
 			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSilence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSilence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSilence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recurrence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSilence(dAtA[iNdEx:])
@@ -0,0 +1,194 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package silencesync lets one Alertmanager mirror the active and pending
+// silences of a designated upstream Alertmanager instance, so that e.g. a
+// staging or DR instance automatically honors maintenance windows created
+// against production without an operator having to create them twice. It
+// reuses the existing v2 "GET /api/v2/silences" endpoint as its wire
+// format, so any Alertmanager can act as a sync source without further
+// configuration. The sync is one-way: local edits to a mirrored silence
+// are not pushed back upstream, and are overwritten on the next pull.
+package silencesync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	open_api_models "github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/silence/silencepb"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// Syncer periodically pulls the active and pending silences from an
+// upstream Alertmanager and mirrors them into a local silence store,
+// creating, updating and expiring local silences to match.
+type Syncer struct {
+	client *http.Client
+	logger log.Logger
+
+	// local maps an upstream silence ID to the ID of the local silence
+	// that mirrors it. Silence IDs are assigned per instance, so an
+	// upstream ID cannot be reused verbatim for the local copy.
+	local map[string]string
+
+	pullsTotal  prometheus.Counter
+	errorsTotal prometheus.Counter
+}
+
+// New returns a Syncer that reports metrics to r, if non-nil.
+func New(r prometheus.Registerer, l log.Logger) *Syncer {
+	s := &Syncer{
+		client: &http.Client{Timeout: 30 * time.Second},
+		logger: l,
+		local:  map[string]string{},
+		pullsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_silence_sync_pulls_total",
+			Help: "Number of successful silence sync pulls from the upstream instance.",
+		}),
+		errorsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_silence_sync_pull_errors_total",
+			Help: "Number of failed silence sync pulls from the upstream instance.",
+		}),
+	}
+	if r != nil {
+		r.MustRegister(s.pullsTotal, s.errorsTotal)
+	}
+	return s
+}
+
+// Run pulls active and pending silences from upstream on every tick of
+// interval, mirroring them into silences, until ctx is canceled.
+func (s *Syncer) Run(ctx context.Context, upstream string, interval time.Duration, silences *silence.Silences) {
+	if upstream == "" {
+		return
+	}
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		s.pull(ctx, upstream, silences)
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (s *Syncer) pull(ctx context.Context, upstream string, silences *silence.Silences) {
+	u := fmt.Sprintf("%s/api/v2/silences", upstream)
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		s.errorsTotal.Inc()
+		level.Error(s.logger).Log("msg", "failed to build silence sync request", "upstream", upstream, "err", err)
+		return
+	}
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		s.errorsTotal.Inc()
+		level.Warn(s.logger).Log("msg", "failed to pull silences from upstream", "upstream", upstream, "err", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		s.errorsTotal.Inc()
+		level.Warn(s.logger).Log("msg", "upstream returned unexpected status", "upstream", upstream, "status", resp.StatusCode)
+		return
+	}
+
+	var gettable []*open_api_models.GettableSilence
+	if err := json.NewDecoder(resp.Body).Decode(&gettable); err != nil {
+		s.errorsTotal.Inc()
+		level.Error(s.logger).Log("msg", "failed to decode upstream silences", "upstream", upstream, "err", err)
+		return
+	}
+
+	seen := make(map[string]struct{}, len(gettable))
+	for _, gs := range gettable {
+		if gs.ID == nil || gs.Status == nil || gs.Status.State == nil {
+			continue
+		}
+		if *gs.Status.State == string(types.SilenceStateExpired) {
+			continue
+		}
+		seen[*gs.ID] = struct{}{}
+		if err := s.mirror(gs, silences); err != nil {
+			s.errorsTotal.Inc()
+			level.Error(s.logger).Log("msg", "failed to mirror upstream silence", "upstream", upstream, "silence", *gs.ID, "err", err)
+		}
+	}
+
+	for upstreamID, localID := range s.local {
+		if _, ok := seen[upstreamID]; ok {
+			continue
+		}
+		if err := silences.Expire(localID); err != nil && err != silence.ErrNotFound {
+			level.Warn(s.logger).Log("msg", "failed to expire local mirror of a removed upstream silence", "upstream_silence", upstreamID, "err", err)
+		}
+		delete(s.local, upstreamID)
+	}
+
+	s.pullsTotal.Inc()
+}
+
+func (s *Syncer) mirror(gs *open_api_models.GettableSilence, silences *silence.Silences) error {
+	sil := &silencepb.Silence{
+		Id:        s.local[*gs.ID],
+		Matchers:  make([]*silencepb.Matcher, 0, len(gs.Matchers)),
+		CreatedBy: safeString(gs.CreatedBy),
+		Comment:   fmt.Sprintf("mirrored from upstream silence %s: %s", *gs.ID, safeString(gs.Comment)),
+	}
+	if gs.StartsAt != nil {
+		sil.StartsAt = time.Time(*gs.StartsAt)
+	}
+	if gs.EndsAt != nil {
+		sil.EndsAt = time.Time(*gs.EndsAt)
+	}
+	for _, m := range gs.Matchers {
+		matcher := &silencepb.Matcher{
+			Name:    safeString(m.Name),
+			Pattern: safeString(m.Value),
+		}
+		if m.IsRegex != nil && *m.IsRegex {
+			matcher.Type = silencepb.Matcher_REGEXP
+		}
+		sil.Matchers = append(sil.Matchers, matcher)
+	}
+
+	id, err := silences.Set(sil)
+	if err != nil {
+		return err
+	}
+	s.local[*gs.ID] = id
+	return nil
+}
+
+func safeString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
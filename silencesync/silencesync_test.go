@@ -0,0 +1,102 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package silencesync
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prometheus/alertmanager/silence"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func newTestSilences(t *testing.T) *silence.Silences {
+	t.Helper()
+	s, err := silence.New(silence.Options{Retention: time.Hour})
+	require.NoError(t, err)
+	return s
+}
+
+func TestSyncerMirrorsUpstreamSilence(t *testing.T) {
+	now := time.Now()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{
+			"id": "upstream-1",
+			"matchers": [{"name": "team", "value": "infra", "isRegex": false}],
+			"startsAt": "` + now.Format(time.RFC3339) + `",
+			"endsAt": "` + now.Add(time.Hour).Format(time.RFC3339) + `",
+			"createdBy": "ops",
+			"comment": "planned maintenance",
+			"status": {"state": "active"}
+		}]`))
+	}))
+	defer srv.Close()
+
+	silences := newTestSilences(t)
+	s := New(nil, log.NewNopLogger())
+	s.pull(context.Background(), srv.URL, silences)
+
+	localID, ok := s.local["upstream-1"]
+	require.True(t, ok, "expected upstream silence to be mirrored locally")
+
+	sils, _, err := silences.Query(silence.QIDs(localID))
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+	require.Equal(t, "team", sils[0].Matchers[0].Name)
+	require.Equal(t, "infra", sils[0].Matchers[0].Pattern)
+}
+
+func TestSyncerExpiresRemovedUpstreamSilence(t *testing.T) {
+	now := time.Now()
+	active := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if active {
+			w.Write([]byte(`[{
+				"id": "upstream-1",
+				"matchers": [{"name": "team", "value": "infra", "isRegex": false}],
+				"startsAt": "` + now.Format(time.RFC3339) + `",
+				"endsAt": "` + now.Add(time.Hour).Format(time.RFC3339) + `",
+				"createdBy": "ops",
+				"comment": "planned maintenance",
+				"status": {"state": "active"}
+			}]`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	silences := newTestSilences(t)
+	s := New(nil, log.NewNopLogger())
+	s.pull(context.Background(), srv.URL, silences)
+
+	localID, ok := s.local["upstream-1"]
+	require.True(t, ok)
+
+	active = false
+	s.pull(context.Background(), srv.URL, silences)
+
+	sils, _, err := silences.Query(silence.QIDs(localID))
+	require.NoError(t, err)
+	require.Len(t, sils, 1)
+	require.Equal(t, types.SilenceStateExpired, types.CalcSilenceState(sils[0].StartsAt, sils[0].EndsAt))
+}
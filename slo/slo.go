@@ -0,0 +1,150 @@
+// Copyright 2022 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slo tracks per-receiver paging latency -- the end-to-end time
+// from an alert's StartsAt to its successful delivery -- exposing it as a
+// Prometheus histogram and reporting when a receiver's tracked p99 newly
+// exceeds its configured objective, so the alerting pipeline can be held to
+// an SLO the same way package breaker reports circuit breaker transitions.
+package slo
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// window is large enough to give a stable p99 estimate without unbounded
+// memory growth; it approximates, rather than computes exactly, the
+// receiver's true p99 latency.
+const window = 200
+
+// Settings configures SLO tracking for a single receiver. The zero value
+// disables tracking: Tracker records the latency histogram but never
+// reports a breach.
+type Settings struct {
+	// Objective is the maximum acceptable p99 paging latency. Zero
+	// disables breach detection for this receiver.
+	Objective time.Duration
+}
+
+type receiverWindow struct {
+	samples   [window]time.Duration
+	next      int
+	full      bool
+	breaching bool
+}
+
+func (w *receiverWindow) observe(latency time.Duration) {
+	w.samples[w.next] = latency
+	w.next++
+	if w.next == window {
+		w.next = 0
+		w.full = true
+	}
+}
+
+// p99 returns the window's 99th-percentile latency and whether it has
+// enough samples to report one.
+func (w *receiverWindow) p99() (time.Duration, bool) {
+	n := w.next
+	if w.full {
+		n = window
+	}
+	if n == 0 {
+		return 0, false
+	}
+	sorted := make([]time.Duration, n)
+	copy(sorted, w.samples[:n])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(float64(n) * 0.99)
+	if idx >= n {
+		idx = n - 1
+	}
+	return sorted[idx], true
+}
+
+// Tracker holds per-receiver paging latency state. It is safe for
+// concurrent use. The zero value is not usable; use New.
+type Tracker struct {
+	mtx      sync.Mutex
+	settings map[string]Settings
+	windows  map[string]*receiverWindow
+
+	latency *prometheus.HistogramVec
+}
+
+// New returns a Tracker with no receivers configured, so Observe always
+// records the latency histogram but never reports a breach until Configure
+// is called.
+func New(r prometheus.Registerer) *Tracker {
+	t := &Tracker{
+		settings: map[string]Settings{},
+		windows:  map[string]*receiverWindow{},
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "alertmanager",
+			Name:      "notification_paging_latency_seconds",
+			Help:      "End-to-end latency from an alert's StartsAt to its successful delivery, per receiver.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+		}, []string{"receiver"}),
+	}
+	if r != nil {
+		r.MustRegister(t.latency)
+	}
+	return t
+}
+
+// Configure (re-)sets the per-receiver SLO settings, e.g. from a
+// configuration reload. A receiver missing from settings (or the zero
+// Settings) is tracked but never reported as breaching.
+func (t *Tracker) Configure(settings map[string]Settings) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.settings = settings
+}
+
+// Observe records a successful delivery to receiver that took latency from
+// the alert firing to being delivered. It returns whether this observation
+// caused the receiver's tracked p99 to newly exceed its configured
+// objective, edge-triggered so a caller firing a meta-alert on true does so
+// once per breach rather than once per notification.
+func (t *Tracker) Observe(receiver string, latency time.Duration) bool {
+	t.latency.WithLabelValues(receiver).Observe(latency.Seconds())
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	w, ok := t.windows[receiver]
+	if !ok {
+		w = &receiverWindow{}
+		t.windows[receiver] = w
+	}
+	w.observe(latency)
+
+	cfg, ok := t.settings[receiver]
+	if !ok || cfg.Objective <= 0 {
+		return false
+	}
+	p99, ok := w.p99()
+	if !ok {
+		return false
+	}
+
+	exceeded := p99 > cfg.Objective
+	breached := exceeded && !w.breaching
+	w.breaching = exceeded
+	return breached
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveWithoutSettingsNeverBreaches(t *testing.T) {
+	tr := New(nil)
+	for i := 0; i < window; i++ {
+		require.False(t, tr.Observe("payments", time.Hour))
+	}
+}
+
+func TestObserveReportsBreachOnce(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {Objective: time.Minute}})
+
+	var breaches int
+	for i := 0; i < window; i++ {
+		if tr.Observe("payments", time.Hour) {
+			breaches++
+		}
+	}
+	require.Equal(t, 1, breaches, "breach should be reported once, not on every observation past the objective")
+}
+
+func TestObserveRecoversAfterBreach(t *testing.T) {
+	tr := New(nil)
+	tr.Configure(map[string]Settings{"payments": {Objective: time.Minute}})
+
+	for i := 0; i < window; i++ {
+		tr.Observe("payments", time.Hour)
+	}
+	for i := 0; i < window; i++ {
+		tr.Observe("payments", time.Second)
+	}
+
+	var breachedAgain bool
+	for i := 0; i < window; i++ {
+		if tr.Observe("payments", time.Hour) {
+			breachedAgain = true
+		}
+	}
+	require.True(t, breachedAgain, "should report a fresh breach after recovering")
+}
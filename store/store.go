@@ -14,13 +14,18 @@
 package store
 
 import (
+	"container/list"
 	"context"
 	"errors"
 	"sync"
 	"time"
 
-	"github.com/prometheus/alertmanager/types"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/types"
 )
 
 var (
@@ -32,17 +37,66 @@ var (
 // their fingerprint. Resolved alerts are removed from the map based on
 // gcInterval. An optional callback can be set which receives a slice of all
 // resolved alerts that have been removed.
+//
+// If limits are configured via SetLimits, Set additionally evicts the
+// least-recently-used resolved alerts, oldest first, to keep the store's
+// alert count and approximate memory footprint under the configured bounds.
+// Firing alerts are never evicted this way; a label set with unbounded
+// cardinality among still-firing alerts will keep growing the store and
+// must be addressed at the source.
 type Alerts struct {
 	sync.Mutex
-	c  map[model.Fingerprint]*types.Alert
-	cb func([]*types.Alert)
+	c     map[model.Fingerprint]*types.Alert
+	index map[model.LabelName]map[model.LabelValue]map[model.Fingerprint]struct{}
+	cb    func([]*types.Alert)
+
+	maxAlerts int
+	maxBytes  int64
+	size      int64
+
+	lru     *list.List
+	lruElem map[model.Fingerprint]*list.Element
+
+	metrics *metrics
+	logger  log.Logger
+}
+
+type metrics struct {
+	size      prometheus.Gauge
+	evictions prometheus.Counter
+	limitHits prometheus.Counter
+}
+
+func newMetrics(r prometheus.Registerer) *metrics {
+	m := &metrics{
+		size: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "alertmanager_alerts_store_size_bytes",
+			Help: "Approximate in-memory size of the alert store.",
+		}),
+		evictions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_alerts_store_evictions_total",
+			Help: "Number of resolved alerts evicted from the store for exceeding the configured count or size limit.",
+		}),
+		limitHits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "alertmanager_alerts_store_limit_hits_total",
+			Help: "Number of times a Set exceeded the configured count or size limit and could not fully evict down to it because no further resolved alerts were available to evict.",
+		}),
+	}
+	if r != nil {
+		r.MustRegister(m.size, m.evictions, m.limitHits)
+	}
+	return m
 }
 
 // NewAlerts returns a new Alerts struct.
 func NewAlerts() *Alerts {
 	a := &Alerts{
-		c:  make(map[model.Fingerprint]*types.Alert),
-		cb: func(_ []*types.Alert) {},
+		c:       make(map[model.Fingerprint]*types.Alert),
+		index:   make(map[model.LabelName]map[model.LabelValue]map[model.Fingerprint]struct{}),
+		cb:      func(_ []*types.Alert) {},
+		lru:     list.New(),
+		lruElem: make(map[model.Fingerprint]*list.Element),
+		logger:  log.NewNopLogger(),
 	}
 
 	return a
@@ -56,6 +110,37 @@ func (a *Alerts) SetGCCallback(cb func([]*types.Alert)) {
 	a.cb = cb
 }
 
+// SetLogger sets the logger used to warn when a limit set by SetLimits is
+// hit and no further resolved alerts are available to evict.
+func (a *Alerts) SetLogger(l log.Logger) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.logger = l
+}
+
+// SetMetrics registers the store's size and eviction counters with r. A nil
+// r is a no-op, leaving the metrics unregistered.
+func (a *Alerts) SetMetrics(r prometheus.Registerer) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.metrics = newMetrics(r)
+}
+
+// SetLimits configures the maximum number of alerts and approximate maximum
+// size in bytes the store may hold. Either may be 0 to leave that dimension
+// unbounded. Limits are enforced by Set, which evicts the least-recently-used
+// resolved alerts until the store is back under both limits, or until no
+// further resolved alerts remain to evict.
+func (a *Alerts) SetLimits(maxAlerts int, maxBytes int64) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.maxAlerts = maxAlerts
+	a.maxBytes = maxBytes
+}
+
 // Run starts the GC loop. The interval must be greater than zero; if not, the function will panic.
 func (a *Alerts) Run(ctx context.Context, interval time.Duration) {
 	t := time.NewTicker(interval)
@@ -77,13 +162,137 @@ func (a *Alerts) gc() {
 	var resolved []*types.Alert
 	for fp, alert := range a.c {
 		if alert.Resolved() {
-			delete(a.c, fp)
+			a.remove(fp, alert)
 			resolved = append(resolved, alert)
 		}
 	}
 	a.cb(resolved)
 }
 
+// remove deletes fp from the map, index, LRU list and running size total.
+// The caller must hold the lock.
+func (a *Alerts) remove(fp model.Fingerprint, alert *types.Alert) {
+	delete(a.c, fp)
+	a.unindex(fp, alert)
+	if e, ok := a.lruElem[fp]; ok {
+		a.lru.Remove(e)
+		delete(a.lruElem, fp)
+		a.size -= sizeOf(alert)
+	}
+}
+
+// touch moves fp to the back of the LRU list, marking it as the most
+// recently used entry, creating its entry if it doesn't exist yet. The
+// caller must hold the lock.
+func (a *Alerts) touch(fp model.Fingerprint) {
+	if e, ok := a.lruElem[fp]; ok {
+		a.lru.MoveToBack(e)
+		return
+	}
+	a.lruElem[fp] = a.lru.PushBack(fp)
+}
+
+// evict removes the least-recently-used resolved alerts, oldest first,
+// until the store is back under the configured count and size limits, or
+// until no further resolved alerts remain to evict. The caller must hold
+// the lock.
+func (a *Alerts) evict() {
+	if a.maxAlerts <= 0 && a.maxBytes <= 0 {
+		return
+	}
+
+	var evicted []*types.Alert
+	e := a.lru.Front()
+	for e != nil && a.overLimit() {
+		next := e.Next()
+		fp := e.Value.(model.Fingerprint)
+		if alert, ok := a.c[fp]; ok && alert.Resolved() {
+			a.remove(fp, alert)
+			evicted = append(evicted, alert)
+		}
+		e = next
+	}
+
+	if len(evicted) > 0 && a.metrics != nil {
+		a.metrics.evictions.Add(float64(len(evicted)))
+	}
+	if a.overLimit() {
+		if a.metrics != nil {
+			a.metrics.limitHits.Inc()
+		}
+		level.Warn(a.logger).Log(
+			"msg", "alert store limit reached, no further resolved alerts available to evict",
+			"count", len(a.c), "max_alerts", a.maxAlerts, "size_bytes", a.size, "max_bytes", a.maxBytes,
+		)
+	}
+	if a.metrics != nil {
+		a.metrics.size.Set(float64(a.size))
+	}
+}
+
+// overLimit reports whether the store currently exceeds either configured
+// limit. The caller must hold the lock.
+func (a *Alerts) overLimit() bool {
+	if a.maxAlerts > 0 && len(a.c) > a.maxAlerts {
+		return true
+	}
+	if a.maxBytes > 0 && a.size > a.maxBytes {
+		return true
+	}
+	return false
+}
+
+// sizeOf approximates the memory footprint of an alert's variable-length
+// fields, which is what differs wildly between a handful of alerts and the
+// label-cardinality blowups this limit exists to catch.
+func sizeOf(alert *types.Alert) int64 {
+	var n int64
+	for name, value := range alert.Labels {
+		n += int64(len(name) + len(value))
+	}
+	for name, value := range alert.Annotations {
+		n += int64(len(name) + len(value))
+	}
+	n += int64(len(alert.GeneratorURL))
+	return n
+}
+
+// indexAlert adds fp to the inverted label index. The caller must hold the
+// lock.
+func (a *Alerts) indexAlert(fp model.Fingerprint, alert *types.Alert) {
+	for name, value := range alert.Labels {
+		values, ok := a.index[name]
+		if !ok {
+			values = make(map[model.LabelValue]map[model.Fingerprint]struct{})
+			a.index[name] = values
+		}
+		fps, ok := values[value]
+		if !ok {
+			fps = make(map[model.Fingerprint]struct{})
+			values[value] = fps
+		}
+		fps[fp] = struct{}{}
+	}
+}
+
+// unindex removes fp from the inverted label index. The caller must hold the
+// lock.
+func (a *Alerts) unindex(fp model.Fingerprint, alert *types.Alert) {
+	for name, value := range alert.Labels {
+		fps, ok := a.index[name][value]
+		if !ok {
+			continue
+		}
+		delete(fps, fp)
+		if len(fps) == 0 {
+			delete(a.index[name], value)
+		}
+		if len(a.index[name]) == 0 {
+			delete(a.index, name)
+		}
+	}
+}
+
 // Get returns the Alert with the matching fingerprint, or an error if it is
 // not found.
 func (a *Alerts) Get(fp model.Fingerprint) (*types.Alert, error) {
@@ -94,15 +303,28 @@ func (a *Alerts) Get(fp model.Fingerprint) (*types.Alert, error) {
 	if !prs {
 		return nil, ErrNotFound
 	}
+	a.touch(fp)
 	return alert, nil
 }
 
-// Set unconditionally sets the alert in memory.
+// Set unconditionally sets the alert in memory. If count or size limits are
+// configured (see SetLimits), it then evicts the least-recently-used
+// resolved alerts until the store is back under both limits.
 func (a *Alerts) Set(alert *types.Alert) error {
 	a.Lock()
 	defer a.Unlock()
 
-	a.c[alert.Fingerprint()] = alert
+	fp := alert.Fingerprint()
+	if old, ok := a.c[fp]; ok {
+		a.unindex(fp, old)
+		a.size -= sizeOf(old)
+	}
+	a.c[fp] = alert
+	a.indexAlert(fp, alert)
+	a.size += sizeOf(alert)
+	a.touch(fp)
+
+	a.evict()
 	return nil
 }
 
@@ -111,10 +333,29 @@ func (a *Alerts) Delete(fp model.Fingerprint) error {
 	a.Lock()
 	defer a.Unlock()
 
-	delete(a.c, fp)
+	if alert, ok := a.c[fp]; ok {
+		a.remove(fp, alert)
+	}
 	return nil
 }
 
+// ByLabel returns the alerts whose label set has name set to exactly value,
+// looked up through the inverted index rather than by scanning every alert
+// in the store.
+func (a *Alerts) ByLabel(name model.LabelName, value model.LabelValue) []*types.Alert {
+	a.Lock()
+	defer a.Unlock()
+
+	fps := a.index[name][value]
+	alerts := make([]*types.Alert, 0, len(fps))
+	for fp := range fps {
+		if alert, ok := a.c[fp]; ok {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
+
 // List returns a slice of Alerts currently held in memory.
 func (a *Alerts) List() []*types.Alert {
 	a.Lock()
@@ -53,6 +53,46 @@ func TestDelete(t *testing.T) {
 	require.Equal(t, ErrNotFound, err)
 }
 
+func TestByLabel(t *testing.T) {
+	a := NewAlerts()
+	alert1 := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"team": "infra", "severity": "page"},
+		},
+	}
+	alert2 := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"team": "infra", "severity": "warning"},
+		},
+	}
+	alert3 := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"team": "payments"},
+		},
+	}
+	require.NoError(t, a.Set(alert1))
+	require.NoError(t, a.Set(alert2))
+	require.NoError(t, a.Set(alert3))
+
+	got := a.ByLabel("team", "infra")
+	require.Len(t, got, 2)
+
+	got = a.ByLabel("team", "payments")
+	require.Len(t, got, 1)
+	require.Equal(t, alert3.Fingerprint(), got[0].Fingerprint())
+
+	require.Empty(t, a.ByLabel("team", "unknown"))
+	require.Empty(t, a.ByLabel("no-such-label", "infra"))
+
+	// Deleting an alert removes it from the index.
+	require.NoError(t, a.Delete(alert1.Fingerprint()))
+	require.Len(t, a.ByLabel("team", "infra"), 1)
+
+	// Re-setting the same alert again doesn't leave stale index entries.
+	require.NoError(t, a.Set(alert2))
+	require.Len(t, a.ByLabel("team", "infra"), 1)
+}
+
 func TestGC(t *testing.T) {
 	now := time.Now()
 	newAlert := func(key string, start, end time.Duration) *types.Alert {
@@ -110,3 +150,94 @@ func TestGC(t *testing.T) {
 	}
 	require.Equal(t, len(resolved), n)
 }
+
+func TestSetEvictsLeastRecentlyUsedResolvedAlertOverCountLimit(t *testing.T) {
+	now := time.Now()
+	resolvedAlert := func(key string) *types.Alert {
+		return &types.Alert{
+			Alert: model.Alert{
+				Labels:   model.LabelSet{model.LabelName(key): "b"},
+				StartsAt: now.Add(-10 * time.Minute),
+				EndsAt:   now.Add(-time.Minute),
+			},
+		}
+	}
+
+	a := NewAlerts()
+	a.SetLimits(2, 0)
+
+	first := resolvedAlert("a")
+	second := resolvedAlert("b")
+	require.NoError(t, a.Set(first))
+	require.NoError(t, a.Set(second))
+
+	// Adding a third resolved alert exceeds the count limit of 2, so the
+	// least-recently-used one (first) should be evicted.
+	third := resolvedAlert("c")
+	require.NoError(t, a.Set(third))
+
+	_, err := a.Get(first.Fingerprint())
+	require.Equal(t, ErrNotFound, err)
+
+	_, err = a.Get(second.Fingerprint())
+	require.NoError(t, err)
+	_, err = a.Get(third.Fingerprint())
+	require.NoError(t, err)
+}
+
+func TestSetDoesNotEvictFiringAlertsOverCountLimit(t *testing.T) {
+	now := time.Now()
+	firing := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"a": "b"},
+			StartsAt: now.Add(-10 * time.Minute),
+			EndsAt:   now.Add(10 * time.Minute),
+		},
+	}
+	resolved := &types.Alert{
+		Alert: model.Alert{
+			Labels:   model.LabelSet{"c": "d"},
+			StartsAt: now.Add(-10 * time.Minute),
+			EndsAt:   now.Add(-time.Minute),
+		},
+	}
+
+	a := NewAlerts()
+	a.SetLimits(1, 0)
+
+	require.NoError(t, a.Set(firing))
+	require.NoError(t, a.Set(resolved))
+
+	// The firing alert is the least recently used, but firing alerts are
+	// never evicted: the resolved one is evicted instead to get back under
+	// the limit.
+	_, err := a.Get(firing.Fingerprint())
+	require.NoError(t, err)
+}
+
+func TestSetEvictsOverByteLimit(t *testing.T) {
+	now := time.Now()
+	resolvedAlert := func(key, value string) *types.Alert {
+		return &types.Alert{
+			Alert: model.Alert{
+				Labels:   model.LabelSet{model.LabelName(key): model.LabelValue(value)},
+				StartsAt: now.Add(-10 * time.Minute),
+				EndsAt:   now.Add(-time.Minute),
+			},
+		}
+	}
+
+	a := NewAlerts()
+	a.SetLimits(0, 15)
+
+	first := resolvedAlert("a", "xxxxxxxxxx")
+	require.NoError(t, a.Set(first))
+
+	second := resolvedAlert("b", "yyyyyyyyyy")
+	require.NoError(t, a.Set(second))
+
+	_, err := a.Get(first.Fingerprint())
+	require.Equal(t, ErrNotFound, err)
+	_, err = a.Get(second.Fingerprint())
+	require.NoError(t, err)
+}
@@ -0,0 +1,95 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"regexp"
+	"strings"
+)
+
+// jinjaSuffix marks a template file, among those matched by a --config.file
+// Templates glob, as written in Jinja2 syntax rather than Go's text/template
+// syntax. It is the per-template opt-in for Jinja mode: everything else
+// continues to be parsed as a plain Go template.
+const jinjaSuffix = ".jinja.tmpl"
+
+// isJinjaTemplate reports whether path should be translated from Jinja2
+// syntax before being parsed.
+func isJinjaTemplate(path string) bool {
+	return strings.HasSuffix(path, jinjaSuffix)
+}
+
+var (
+	jinjaCommentRe = regexp.MustCompile(`(?s)\{#-?\s*(.*?)\s*-?#\}`)
+	jinjaTagRe     = regexp.MustCompile(`(?s)\{%-?\s*(.*?)\s*-?%\}`)
+	jinjaElifRe    = regexp.MustCompile(`^elif\s+(.+)$`)
+	jinjaForRe     = regexp.MustCompile(`^for\s+(\w+)\s+in\s+(.+)$`)
+	jinjaSetRe     = regexp.MustCompile(`^set\s+(\w+)\s*=\s*(.+)$`)
+)
+
+// translateJinja rewrites the subset of Jinja2 syntax that maps cleanly onto
+// Go's text/template -- comments, if/elif/else/endif, for/endfor and set --
+// so that message templates carried over from another alerting system can
+// be dropped in mostly unchanged. Variable interpolation (`{{ ... }}`) and
+// argument-less filters (e.g. `{{ value|upper }}`) already use the same
+// syntax in both languages and are left untouched. Filters called with
+// arguments (e.g. `{{ value|replace('a', 'b') }}`) use Jinja's
+// comma-separated call syntax, which Go templates do not support, and must
+// still be rewritten by hand into Go's pipe form (`{{ value | replace "a"
+// "b" }}`).
+func translateJinja(src string) string {
+	src = jinjaCommentRe.ReplaceAllString(src, `{{/* $1 */}}`)
+	return jinjaTagRe.ReplaceAllStringFunc(src, func(tag string) string {
+		body := strings.TrimSpace(jinjaTagRe.FindStringSubmatch(tag)[1])
+		switch {
+		case body == "endif", body == "endfor":
+			return "{{ end }}"
+		case body == "else":
+			return "{{ else }}"
+		case jinjaElifRe.MatchString(body):
+			return "{{ else if " + jinjaElifRe.FindStringSubmatch(body)[1] + " }}"
+		case jinjaForRe.MatchString(body):
+			m := jinjaForRe.FindStringSubmatch(body)
+			return "{{ range $" + m[1] + " := " + m[2] + " }}"
+		case jinjaSetRe.MatchString(body):
+			m := jinjaSetRe.FindStringSubmatch(body)
+			return "{{ $" + m[1] + " := " + m[2] + " }}"
+		default:
+			return "{{ " + body + " }}"
+		}
+	})
+}
+
+// JinjaFuncs are argument-less Jinja2 filters made available, under their
+// Jinja names, to every template -- Go or Jinja -- so a bare `{{
+// value|upper }}` carried over from a Jinja template keeps working without
+// rewriting.
+var JinjaFuncs = FuncMap{
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"trim":       strings.TrimSpace,
+	"capitalize": jinjaCapitalize,
+}
+
+// jinjaCapitalize matches Jinja2's `capitalize` filter: the first rune is
+// upper-cased and the rest of the string is lower-cased, e.g. "heLLO WORLD"
+// becomes "Hello world". This differs from strings.Title, which title-cases
+// every word and leaves the rest of each word's casing untouched.
+func jinjaCapitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	return strings.ToUpper(string(r[:1])) + strings.ToLower(string(r[1:]))
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package template
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsJinjaTemplate(t *testing.T) {
+	require.True(t, isJinjaTemplate("/etc/alertmanager/slack.jinja.tmpl"))
+	require.False(t, isJinjaTemplate("/etc/alertmanager/slack.tmpl"))
+}
+
+func TestTranslateJinja(t *testing.T) {
+	for _, tc := range []struct {
+		title string
+		in    string
+		exp   string
+	}{
+		{
+			title: "if/endif",
+			in:    `{% if .Alerts.Firing %}firing{% endif %}`,
+			exp:   `{{ if .Alerts.Firing }}firing{{ end }}`,
+		},
+		{
+			title: "if/else/endif",
+			in:    `{% if x %}a{% else %}b{% endif %}`,
+			exp:   `{{ if x }}a{{ else }}b{{ end }}`,
+		},
+		{
+			title: "if/elif/else/endif",
+			in:    `{% if x %}a{% elif y %}b{% else %}c{% endif %}`,
+			exp:   `{{ if x }}a{{ else if y }}b{{ else }}c{{ end }}`,
+		},
+		{
+			title: "for/endfor",
+			in:    `{% for a in .Alerts %}{{ a.Labels.alertname }}{% endfor %}`,
+			exp:   `{{ range $a := .Alerts }}{{ a.Labels.alertname }}{{ end }}`,
+		},
+		{
+			title: "set",
+			in:    `{% set name = .GroupLabels.alertname %}{{ $name }}`,
+			exp:   `{{ $name := .GroupLabels.alertname }}{{ $name }}`,
+		},
+		{
+			title: "comment",
+			in:    `{# this is a comment #}abc`,
+			exp:   `{{/* this is a comment */}}abc`,
+		},
+		{
+			title: "interpolation left untouched",
+			in:    `{{ .CommonAnnotations.summary }}`,
+			exp:   `{{ .CommonAnnotations.summary }}`,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			require.Equal(t, tc.exp, translateJinja(tc.in))
+		})
+	}
+}
+
+func TestJinjaFuncs(t *testing.T) {
+	require.Equal(t, "HELLO WORLD", JinjaFuncs["upper"].(func(string) string)("hello world"))
+	require.Equal(t, "hello world", JinjaFuncs["lower"].(func(string) string)("HELLO WORLD"))
+	require.Equal(t, "hello world", JinjaFuncs["trim"].(func(string) string)("  hello world  "))
+
+	capitalize := JinjaFuncs["capitalize"].(func(string) string)
+	require.Equal(t, "Hello world", capitalize("heLLO WORLD"))
+	require.Equal(t, "Hello", capitalize("hello"))
+	require.Equal(t, "", capitalize(""))
+}
+
+func TestFromGlobsWithJinjaTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jinja")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "custom.jinja.tmpl")
+	content := `{% if true %}{% set msg = "hi" %}{{ $msg }}{% endif %}`
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{{ define "custom" }}`+content+`{{ end }}`), 0666))
+
+	tmpl, err := FromGlobs(filepath.Join(dir, "*.jinja.tmpl"))
+	require.NoError(t, err)
+
+	out, err := tmpl.ExecuteTextString(`{{ template "custom" }}`, nil)
+	require.NoError(t, err)
+	require.Equal(t, "hi", out)
+}
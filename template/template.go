@@ -15,9 +15,13 @@ package template
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	tmplhtml "html/template"
 	"io/ioutil"
 	"net/url"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -37,6 +41,25 @@ type Template struct {
 	html *tmplhtml.Template
 
 	ExternalURL *url.URL
+
+	// RunbookURL, if set, is combined with an alert's alertname label to
+	// populate Alert.Links.Runbook.
+	RunbookURL *url.URL
+	// DashboardURL, if set, is combined with an alert's cluster label to
+	// populate Alert.Links.Dashboard.
+	DashboardURL *url.URL
+
+	// SnoozeSecret, if set, signs the callback URL that populates
+	// Alert.Links.Snooze, so the "/api/v1/alert/:fingerprint/snooze"
+	// endpoint can verify a request came from a link this Alertmanager
+	// itself generated rather than from someone guessing a fingerprint.
+	// Links.Snooze is empty unless this is set.
+	SnoozeSecret string
+
+	// SnoozeTTL is how long a silence created through a Links.Snooze
+	// callback lasts, and how long the link itself stays valid for.
+	// Defaults to 1h if zero.
+	SnoozeTTL time.Duration
 }
 
 // FromGlobs calls ParseGlob on all path globs provided and returns the
@@ -50,6 +73,8 @@ func FromGlobs(paths ...string) (*Template, error) {
 
 	t.text = t.text.Funcs(tmpltext.FuncMap(DefaultFuncs))
 	t.html = t.html.Funcs(tmplhtml.FuncMap(DefaultFuncs))
+	t.text = t.text.Funcs(tmpltext.FuncMap(JinjaFuncs))
+	t.html = t.html.Funcs(tmplhtml.FuncMap(JinjaFuncs))
 
 	f, err := asset.Assets.Open("/templates/default.tmpl")
 	if err != nil {
@@ -74,11 +99,26 @@ func FromGlobs(paths ...string) (*Template, error) {
 		if err != nil {
 			return nil, err
 		}
-		if len(p) > 0 {
-			if t.text, err = t.text.ParseGlob(tp); err != nil {
+		if len(p) == 0 {
+			continue
+		}
+
+		// Files using the Jinja2 compatibility suffix need to be translated to
+		// Go template syntax before parsing, so they can't go through
+		// ParseGlob directly; parse every matched file individually instead.
+		for _, fp := range p {
+			b, err := ioutil.ReadFile(fp)
+			if err != nil {
+				return nil, err
+			}
+			src := string(b)
+			if isJinjaTemplate(fp) {
+				src = translateJinja(src)
+			}
+			if _, err := t.text.New(filepath.Base(fp)).Parse(src); err != nil {
 				return nil, err
 			}
-			if t.html, err = t.html.ParseGlob(tp); err != nil {
+			if _, err := t.html.New(filepath.Base(fp)).Parse(src); err != nil {
 				return nil, err
 			}
 		}
@@ -141,6 +181,16 @@ var DefaultFuncs = FuncMap{
 		re := regexp.MustCompile(pattern)
 		return re.ReplaceAllString(text, repl)
 	},
+	// tz converts t into the named IANA timezone (e.g. "America/New_York"),
+	// for pipelining as in `{{ .StartsAt | tz "America/New_York" }}`. It
+	// returns t unchanged if name can't be resolved.
+	"tz": func(name string, t time.Time) time.Time {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return t
+		}
+		return t.In(loc)
+	},
 }
 
 // Pair is a key/value string pair.
@@ -235,6 +285,38 @@ type Data struct {
 	CommonAnnotations KV `json:"commonAnnotations"`
 
 	ExternalURL string `json:"externalURL"`
+
+	// RelatedGroups is the number of other aggregation groups sharing the
+	// configured correlation labels that are also currently firing. It is
+	// zero both when correlation is disabled and when no other group is
+	// currently related.
+	RelatedGroups int `json:"relatedGroups,omitempty"`
+
+	// NewAlerts holds the alerts in this notification that were not part
+	// of the group's previous notification to this receiver, and
+	// ChangedAlerts the ones that were already firing then but have had
+	// their annotations updated since. Both are empty for a group's first
+	// notification, since there is nothing to diff against yet.
+	NewAlerts     Alerts `json:"newAlerts,omitempty"`
+	ChangedAlerts Alerts `json:"changedAlerts,omitempty"`
+
+	// Timezone is the IANA Time Zone Database name configured on the route
+	// that produced this notification (e.g. "America/New_York"), or "UTC"
+	// if none was configured. It is informational; alert timestamps below
+	// are always in UTC, and templates wanting local time should pipe them
+	// through the "tz" function with this value.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Notes holds any free-form note currently attached to this
+	// notification's aggregation group via the API, e.g. "driver rollback
+	// in progress". It is empty unless an operator has set one.
+	Notes string `json:"notes,omitempty"`
+
+	// TruncatedAlerts is the number of alerts omitted from Alerts because
+	// the route's max_alerts_rendered was reached. Templates can use it to
+	// render a "+N more" style summary. Zero unless max_alerts_rendered is
+	// configured and actually exceeded.
+	TruncatedAlerts int `json:"truncatedAlerts,omitempty"`
 }
 
 // Alert holds one alert for notification templates.
@@ -246,6 +328,25 @@ type Alert struct {
 	EndsAt       time.Time `json:"endsAt"`
 	GeneratorURL string    `json:"generatorURL"`
 	Fingerprint  string    `json:"fingerprint"`
+	Links        Links     `json:"links"`
+	Value        *float64  `json:"value,omitempty"`
+	Threshold    *float64  `json:"threshold,omitempty"`
+}
+
+// Links holds the standard links generated for an alert, so templates and
+// webhook payloads don't each have to build them from label interpolation.
+// Any link whose source data is missing (e.g. no RunbookURL configured, or
+// no cluster label on the alert) is left empty.
+type Links struct {
+	Runbook   string `json:"runbook,omitempty"`
+	Dashboard string `json:"dashboard,omitempty"`
+	Source    string `json:"source,omitempty"`
+
+	// Snooze is a signed callback URL that silences this alert when
+	// requested (POST /api/v1/alert/:fingerprint/snooze), e.g. from a
+	// "snooze" button on a chat notification. Empty unless
+	// Template.SnoozeSecret is set.
+	Snooze string `json:"snooze,omitempty"`
 }
 
 // Alerts is a list of Alert objects.
@@ -273,6 +374,116 @@ func (as Alerts) Resolved() []Alert {
 	return res
 }
 
+// SortByLabelOrder stably reorders as so that alerts whose label value sort
+// ahead in order (e.g. "critical" before "warning" before "info") come
+// first. Alerts whose label value for label isn't listed in order sort
+// after every alert that is, keeping their relative order otherwise.
+func (as Alerts) SortByLabelOrder(label string, order []string) Alerts {
+	rank := make(map[string]int, len(order))
+	for i, v := range order {
+		rank[v] = i
+	}
+	unranked := len(order)
+
+	sorted := make(Alerts, len(as))
+	copy(sorted, as)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return rankOf(sorted[i].Labels[label], rank, unranked) < rankOf(sorted[j].Labels[label], rank, unranked)
+	})
+	return sorted
+}
+
+func rankOf(value string, rank map[string]int, unranked int) int {
+	if r, ok := rank[value]; ok {
+		return r
+	}
+	return unranked
+}
+
+// Truncate returns the first max alerts of as, along with the number left
+// out. A non-positive max returns as unchanged with zero truncated.
+func (as Alerts) Truncate(max int) (Alerts, int) {
+	if max <= 0 || len(as) <= max {
+		return as, 0
+	}
+	return as[:max], len(as) - max
+}
+
+// links builds the standard set of links for alert from whichever of
+// RunbookURL, DashboardURL and the alert's own GeneratorURL apply.
+func (t *Template) links(alert Alert) Links {
+	links := Links{Source: alert.GeneratorURL}
+
+	if t.RunbookURL != nil {
+		if alertname, ok := alert.Labels[string(model.AlertNameLabel)]; ok {
+			u := *t.RunbookURL
+			u.Path = path.Join(u.Path, alertname)
+			links.Runbook = u.String()
+		}
+	}
+
+	if t.DashboardURL != nil {
+		if cluster, ok := alert.Labels["cluster"]; ok {
+			u := *t.DashboardURL
+			q := u.Query()
+			q.Set("cluster", cluster)
+			u.RawQuery = q.Encode()
+			links.Dashboard = u.String()
+		}
+	}
+
+	if t.SnoozeSecret != "" {
+		links.Snooze = t.snoozeURL(alert)
+	}
+
+	return links
+}
+
+// snoozeTokenMAC returns the hex-encoded HMAC-SHA256 binding fingerprint to
+// expires (an RFC3339 timestamp), keyed with secret, so a tampered
+// fingerprint or extended expiry is detectable by the snooze API endpoint.
+func snoozeTokenMAC(secret, fingerprint, expires string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fingerprint))
+	mac.Write([]byte(expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySnoozeToken reports whether sig is the valid, unexpired signature
+// for fingerprint and expires (an RFC3339 timestamp, as produced by a
+// Links.Snooze URL) under secret.
+func VerifySnoozeToken(secret, fingerprint, expires, sig string) bool {
+	if secret == "" || expires == "" || sig == "" {
+		return false
+	}
+	exp, err := time.Parse(time.RFC3339, expires)
+	if err != nil {
+		return false
+	}
+	if time.Now().After(exp) {
+		return false
+	}
+	want := snoozeTokenMAC(secret, fingerprint, expires)
+	return hmac.Equal([]byte(want), []byte(sig))
+}
+
+// snoozeURL builds the signed callback URL for Alert.Links.Snooze.
+func (t *Template) snoozeURL(alert Alert) string {
+	ttl := t.SnoozeTTL
+	if ttl <= 0 {
+		ttl = time.Hour
+	}
+	expires := time.Now().Add(ttl).UTC().Format(time.RFC3339)
+
+	u := *t.ExternalURL
+	u.Path = path.Join(u.Path, "api/v1/alert", alert.Fingerprint, "snooze")
+	q := u.Query()
+	q.Set("expires", expires)
+	q.Set("sig", snoozeTokenMAC(t.SnoozeSecret, alert.Fingerprint, expires))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
 // Data assembles data for template expansion.
 func (t *Template) Data(recv string, groupLabels model.LabelSet, alerts ...*types.Alert) *Data {
 	data := &Data{
@@ -287,7 +498,7 @@ func (t *Template) Data(recv string, groupLabels model.LabelSet, alerts ...*type
 
 	// The call to types.Alert is necessary to correctly resolve the internal
 	// representation to the user representation.
-	for _, a := range types.Alerts(alerts...) {
+	for i, a := range types.Alerts(alerts...) {
 		alert := Alert{
 			Status:       string(a.Status()),
 			Labels:       make(KV, len(a.Labels)),
@@ -296,6 +507,8 @@ func (t *Template) Data(recv string, groupLabels model.LabelSet, alerts ...*type
 			EndsAt:       a.EndsAt,
 			GeneratorURL: a.GeneratorURL,
 			Fingerprint:  a.Fingerprint().String(),
+			Value:        alerts[i].Value,
+			Threshold:    alerts[i].Threshold,
 		}
 		for k, v := range a.Labels {
 			alert.Labels[string(k)] = string(v)
@@ -303,6 +516,7 @@ func (t *Template) Data(recv string, groupLabels model.LabelSet, alerts ...*type
 		for k, v := range a.Annotations {
 			alert.Annotations[string(k)] = string(v)
 		}
+		alert.Links = t.links(alert)
 		data.Alerts = append(data.Alerts, alert)
 	}
 
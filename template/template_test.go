@@ -123,6 +123,47 @@ func TestAlertsResolved(t *testing.T) {
 	}
 }
 
+func TestAlertsSortByLabelOrder(t *testing.T) {
+	alerts := Alerts{
+		{Labels: KV{"severity": "warning"}, Fingerprint: "a"},
+		{Labels: KV{"severity": "info"}, Fingerprint: "b"},
+		{Labels: KV{"severity": "critical"}, Fingerprint: "c"},
+		{Labels: KV{"severity": "warning"}, Fingerprint: "d"},
+		{Labels: KV{}, Fingerprint: "e"},
+	}
+
+	got := alerts.SortByLabelOrder("severity", []string{"critical", "warning", "info"})
+
+	want := []string{"c", "a", "d", "b", "e"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d alerts, got %d", len(want), len(got))
+	}
+	for i, fp := range want {
+		if got[i].Fingerprint != fp {
+			t.Errorf("position %d: expected fingerprint %q, got %q", i, fp, got[i].Fingerprint)
+		}
+	}
+}
+
+func TestAlertsTruncate(t *testing.T) {
+	alerts := Alerts{{Fingerprint: "a"}, {Fingerprint: "b"}, {Fingerprint: "c"}}
+
+	got, truncated := alerts.Truncate(2)
+	if len(got) != 2 || truncated != 1 {
+		t.Errorf("expected 2 alerts and 1 truncated, got %d alerts and %d truncated", len(got), truncated)
+	}
+
+	got, truncated = alerts.Truncate(0)
+	if len(got) != len(alerts) || truncated != 0 {
+		t.Errorf("expected a non-positive max to leave alerts unchanged, got %d alerts and %d truncated", len(got), truncated)
+	}
+
+	got, truncated = alerts.Truncate(10)
+	if len(got) != len(alerts) || truncated != 0 {
+		t.Errorf("expected a max above len(alerts) to leave alerts unchanged, got %d alerts and %d truncated", len(got), truncated)
+	}
+}
+
 func TestData(t *testing.T) {
 	u, err := url.Parse("http://example.com/")
 	require.NoError(t, err)
@@ -276,6 +317,111 @@ func TestData(t *testing.T) {
 	}
 }
 
+func TestDataExposesValueAndThreshold(t *testing.T) {
+	u, err := url.Parse("http://example.com/")
+	require.NoError(t, err)
+	tmpl := &Template{ExternalURL: u}
+
+	value := 97.5
+	threshold := 90.0
+	alert := &types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{"alertname": "HighCPU"},
+		},
+		Value:     &value,
+		Threshold: &threshold,
+	}
+
+	data := tmpl.Data("webhook", model.LabelSet{}, alert)
+	require.Len(t, data.Alerts, 1)
+	require.Equal(t, &value, data.Alerts[0].Value)
+	require.Equal(t, &threshold, data.Alerts[0].Threshold)
+}
+
+func TestDataLinks(t *testing.T) {
+	extURL, err := url.Parse("http://example.com/")
+	require.NoError(t, err)
+	runbookURL, err := url.Parse("https://runbooks.example.com")
+	require.NoError(t, err)
+	dashboardURL, err := url.Parse("https://dashboards.example.com")
+	require.NoError(t, err)
+
+	tmpl := &Template{ExternalURL: extURL, RunbookURL: runbookURL, DashboardURL: dashboardURL}
+
+	alerts := []*types.Alert{
+		{
+			Alert: model.Alert{
+				Labels: model.LabelSet{
+					model.LabelName("alertname"): model.LabelValue("HighLatency"),
+					model.LabelName("cluster"):   model.LabelValue("prod"),
+				},
+				GeneratorURL: "http://prometheus/graph",
+			},
+		},
+	}
+
+	data := tmpl.Data("webhook", model.LabelSet{}, alerts...)
+	require.Equal(t, Links{
+		Runbook:   "https://runbooks.example.com/HighLatency",
+		Dashboard: "https://dashboards.example.com?cluster=prod",
+		Source:    "http://prometheus/graph",
+	}, data.Alerts[0].Links)
+}
+
+func TestDataLinksWithoutConfig(t *testing.T) {
+	extURL, err := url.Parse("http://example.com/")
+	require.NoError(t, err)
+	tmpl := &Template{ExternalURL: extURL}
+
+	alerts := []*types.Alert{
+		{
+			Alert: model.Alert{
+				Labels: model.LabelSet{
+					model.LabelName("alertname"): model.LabelValue("HighLatency"),
+				},
+			},
+		},
+	}
+
+	data := tmpl.Data("webhook", model.LabelSet{}, alerts...)
+	require.Equal(t, Links{}, data.Alerts[0].Links)
+}
+
+func TestDataLinksSnooze(t *testing.T) {
+	extURL, err := url.Parse("http://example.com/")
+	require.NoError(t, err)
+
+	tmpl := &Template{ExternalURL: extURL, SnoozeSecret: "secret", SnoozeTTL: time.Hour}
+
+	alerts := []*types.Alert{
+		{
+			Alert: model.Alert{
+				Labels: model.LabelSet{
+					model.LabelName("alertname"): model.LabelValue("HighLatency"),
+				},
+			},
+		},
+	}
+
+	data := tmpl.Data("webhook", model.LabelSet{}, alerts...)
+	snooze := data.Alerts[0].Links.Snooze
+	require.NotEmpty(t, snooze)
+
+	u, err := url.Parse(snooze)
+	require.NoError(t, err)
+	require.Equal(t, "/api/v1/alert/"+data.Alerts[0].Fingerprint+"/snooze", u.Path)
+
+	q := u.Query()
+	require.True(t, VerifySnoozeToken("secret", data.Alerts[0].Fingerprint, q.Get("expires"), q.Get("sig")))
+	require.False(t, VerifySnoozeToken("wrong-secret", data.Alerts[0].Fingerprint, q.Get("expires"), q.Get("sig")))
+}
+
+func TestVerifySnoozeTokenExpired(t *testing.T) {
+	expires := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	sig := snoozeTokenMAC("secret", "deadbeef", expires)
+	require.False(t, VerifySnoozeToken("secret", "deadbeef", expires, sig))
+}
+
 func TestTemplateExpansion(t *testing.T) {
 	tmpl, err := FromGlobs()
 	require.NoError(t, err)
@@ -357,6 +503,18 @@ func TestTemplateExpansion(t *testing.T) {
 			in:    `{{ reReplaceAll "ab" "AB" "abcdabcda"}}`,
 			exp:   "ABcdABcda",
 		},
+		{
+			title: "Template using tz",
+			in:    `{{ (. | tz "America/New_York").Format "15:04 MST" }}`,
+			data:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			exp:   "19:00 EST",
+		},
+		{
+			title: "Template using tz with an unknown zone",
+			in:    `{{ (. | tz "Not/A_Zone").Format "15:04 MST" }}`,
+			data:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			exp:   "00:00 UTC",
+		},
 	} {
 		tc := tc
 		t.Run(tc.title, func(t *testing.T) {
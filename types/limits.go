@@ -0,0 +1,79 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/prometheus/common/model"
+)
+
+// TruncatedAnnotation is added, set to "true", to an alert whose labels or
+// annotations were shortened to fit within a SizeLimits' bounds.
+const TruncatedAnnotation = "truncated"
+
+// SizeLimits bounds the length of label and annotation values enforced at
+// alert ingestion, protecting grouping keys and notification payloads from
+// oversized values. A zero-valued field disables the corresponding limit.
+type SizeLimits struct {
+	MaxLabelValueLength      int
+	MaxAnnotationValueLength int
+
+	// Truncate, if true, shortens oversized values in place instead of
+	// rejecting the alert.
+	Truncate bool
+}
+
+// Enforce applies l to a's labels and annotations. If l.Truncate is true,
+// oversized values are shortened to fit and a gains a TruncatedAnnotation
+// marker; otherwise Enforce leaves a unmodified and returns an error
+// identifying the first oversized value found.
+func (l SizeLimits) Enforce(a *Alert) error {
+	truncated := false
+
+	if l.MaxLabelValueLength > 0 {
+		for name, value := range a.Labels {
+			if len(value) <= l.MaxLabelValueLength {
+				continue
+			}
+			if !l.Truncate {
+				return fmt.Errorf("label %q value of length %d exceeds the maximum of %d bytes", name, len(value), l.MaxLabelValueLength)
+			}
+			a.Labels[name] = value[:l.MaxLabelValueLength]
+			truncated = true
+		}
+	}
+
+	if l.MaxAnnotationValueLength > 0 {
+		for name, value := range a.Annotations {
+			if len(value) <= l.MaxAnnotationValueLength {
+				continue
+			}
+			if !l.Truncate {
+				return fmt.Errorf("annotation %q value of length %d exceeds the maximum of %d bytes", name, len(value), l.MaxAnnotationValueLength)
+			}
+			a.Annotations[name] = value[:l.MaxAnnotationValueLength]
+			truncated = true
+		}
+	}
+
+	if truncated {
+		if a.Annotations == nil {
+			a.Annotations = model.LabelSet{}
+		}
+		a.Annotations[TruncatedAnnotation] = "true"
+	}
+
+	return nil
+}
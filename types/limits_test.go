@@ -0,0 +1,76 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSizeLimitsEnforceWithinBounds(t *testing.T) {
+	l := SizeLimits{MaxLabelValueLength: 10, MaxAnnotationValueLength: 10}
+	a := &Alert{Alert: model.Alert{
+		Labels:      model.LabelSet{"alertname": "short"},
+		Annotations: model.LabelSet{"summary": "also short"},
+	}}
+
+	require.NoError(t, l.Enforce(a))
+	require.Equal(t, model.LabelValue("short"), a.Labels["alertname"])
+	require.NotContains(t, a.Annotations, TruncatedAnnotation)
+}
+
+func TestSizeLimitsEnforceRejectsOversized(t *testing.T) {
+	l := SizeLimits{MaxLabelValueLength: 4}
+	a := &Alert{Alert: model.Alert{
+		Labels: model.LabelSet{"alertname": "way too long"},
+	}}
+
+	err := l.Enforce(a)
+	require.Error(t, err)
+	require.Equal(t, model.LabelValue("way too long"), a.Labels["alertname"])
+}
+
+func TestSizeLimitsEnforceTruncates(t *testing.T) {
+	l := SizeLimits{MaxLabelValueLength: 4, MaxAnnotationValueLength: 5, Truncate: true}
+	a := &Alert{Alert: model.Alert{
+		Labels:      model.LabelSet{"alertname": "way too long"},
+		Annotations: model.LabelSet{"summary": "way too long"},
+	}}
+
+	require.NoError(t, l.Enforce(a))
+	require.Equal(t, model.LabelValue("way "), a.Labels["alertname"])
+	require.Equal(t, model.LabelValue("way t"), a.Annotations["summary"])
+	require.Equal(t, model.LabelValue("true"), a.Annotations[TruncatedAnnotation])
+}
+
+func TestSizeLimitsEnforceDisabled(t *testing.T) {
+	l := SizeLimits{}
+	a := &Alert{Alert: model.Alert{
+		Labels: model.LabelSet{"alertname": "arbitrarily long value that would otherwise be rejected"},
+	}}
+
+	require.NoError(t, l.Enforce(a))
+}
+
+func TestSizeLimitsEnforceTruncatesIntoNilAnnotations(t *testing.T) {
+	l := SizeLimits{MaxLabelValueLength: 4, Truncate: true}
+	a := &Alert{Alert: model.Alert{
+		Labels: model.LabelSet{"alertname": "way too long"},
+	}}
+
+	require.NoError(t, l.Enforce(a))
+	require.Equal(t, model.LabelValue("true"), a.Annotations[TruncatedAnnotation])
+}
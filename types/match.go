@@ -20,6 +20,8 @@ import (
 	"sort"
 
 	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
 )
 
 // Matcher defines a matching rule for the value of a given label.
@@ -28,6 +30,15 @@ type Matcher struct {
 	Value   string `json:"value"`
 	IsRegex bool   `json:"isRegex"`
 
+	// Negate inverts the match: the matcher is fulfilled when the label
+	// value does *not* equal Value (or does not match it as a regex, if
+	// IsRegex is set).
+	Negate bool `json:"isNegative,omitempty"`
+
+	// Annotation, if set, makes the matcher check the given name/value
+	// against an alert's annotations instead of its labels.
+	Annotation bool `json:"isAnnotation,omitempty"`
+
 	regex *regexp.Regexp
 }
 
@@ -45,10 +56,16 @@ func (m *Matcher) Init() error {
 }
 
 func (m *Matcher) String() string {
-	if m.IsRegex {
-		return fmt.Sprintf("%s=~%q", m.Name, m.Value)
+	op := "="
+	switch {
+	case m.IsRegex && m.Negate:
+		op = "!~"
+	case m.IsRegex:
+		op = "=~"
+	case m.Negate:
+		op = "!="
 	}
-	return fmt.Sprintf("%s=%q", m.Name, m.Value)
+	return fmt.Sprintf("%s%s%q", m.Name, op, m.Value)
 }
 
 // Validate returns true iff all fields of the matcher have valid values.
@@ -66,18 +83,28 @@ func (m *Matcher) Validate() error {
 	return nil
 }
 
-// Match checks whether the label of the matcher has the specified
-// matching value.
-func (m *Matcher) Match(lset model.LabelSet) bool {
+// Match checks whether the label (or, if the matcher targets annotations,
+// the annotation) of the matcher has the specified matching value.
+func (m *Matcher) Match(lset, annotations model.LabelSet) bool {
+	set := lset
+	if m.Annotation {
+		set = annotations
+	}
 	// Unset labels are treated as unset labels globally. Thus, if a
 	// label is not set we retrieve the empty label which is correct
 	// for the comparison below.
-	v := lset[model.LabelName(m.Name)]
+	v := set[model.LabelName(m.Name)]
 
+	var match bool
 	if m.IsRegex {
-		return m.regex.MatchString(string(v))
+		match = m.regex.MatchString(string(v))
+	} else {
+		match = string(v) == m.Value
 	}
-	return string(v) == m.Value
+	if m.Negate {
+		return !match
+	}
+	return match
 }
 
 // NewMatcher returns a new matcher that compares against equality of
@@ -103,6 +130,94 @@ func NewRegexMatcher(name model.LabelName, re *regexp.Regexp) *Matcher {
 	}
 }
 
+// NewNotMatcher returns a new matcher that compares against inequality of
+// the given value.
+func NewNotMatcher(name model.LabelName, value string) *Matcher {
+	return &Matcher{
+		Name:   string(name),
+		Value:  value,
+		Negate: true,
+	}
+}
+
+// NewNotRegexMatcher returns a new matcher that compares values against the
+// negation of a regular expression. The matcher is already initialized.
+func NewNotRegexMatcher(name model.LabelName, re *regexp.Regexp) *Matcher {
+	return &Matcher{
+		Name:    string(name),
+		Value:   re.String(),
+		IsRegex: true,
+		Negate:  true,
+		regex:   re,
+	}
+}
+
+// NewMatcherFromExpr parses expr using the matcher-expression syntax shared
+// with the alerts API and silences (e.g. `foo="bar"`, `baz=~"qux.*"`,
+// `foo!="bar"`, `baz!~"qux.*"`) and returns the equivalent, already
+// initialized Matcher.
+func NewMatcherFromExpr(expr string) (*Matcher, error) {
+	m, err := labels.ParseMatcher(expr)
+	if err != nil {
+		return nil, err
+	}
+	switch m.Type {
+	case labels.MatchEqual:
+		return NewMatcher(model.LabelName(m.Name), m.Value), nil
+	case labels.MatchNotEqual:
+		return NewNotMatcher(model.LabelName(m.Name), m.Value), nil
+	case labels.MatchRegexp:
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
+		if err != nil {
+			return nil, err
+		}
+		return NewRegexMatcher(model.LabelName(m.Name), re), nil
+	case labels.MatchNotRegexp:
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
+		if err != nil {
+			return nil, err
+		}
+		return NewNotRegexMatcher(model.LabelName(m.Name), re), nil
+	default:
+		return nil, fmt.Errorf("unsupported matcher type %q in %q", m.Type, expr)
+	}
+}
+
+// ParseMatchers parses a canonical, brace-delimited matcher list (e.g.
+// `{foo="bar", baz=~"qu.x", team!="infra"}`) into Matchers, using the same
+// UTF-8 safe, quoting-aware syntax as NewMatcherFromExpr.
+func ParseMatchers(s string) (Matchers, error) {
+	ms, err := labels.ParseMatchers(s)
+	if err != nil {
+		return nil, err
+	}
+
+	matchers := make(Matchers, 0, len(ms))
+	for _, m := range ms {
+		switch m.Type {
+		case labels.MatchEqual:
+			matchers = append(matchers, NewMatcher(model.LabelName(m.Name), m.Value))
+		case labels.MatchNotEqual:
+			matchers = append(matchers, NewNotMatcher(model.LabelName(m.Name), m.Value))
+		case labels.MatchRegexp:
+			re, err := regexp.Compile("^(?:" + m.Value + ")$")
+			if err != nil {
+				return nil, err
+			}
+			matchers = append(matchers, NewRegexMatcher(model.LabelName(m.Name), re))
+		case labels.MatchNotRegexp:
+			re, err := regexp.Compile("^(?:" + m.Value + ")$")
+			if err != nil {
+				return nil, err
+			}
+			matchers = append(matchers, NewNotRegexMatcher(model.LabelName(m.Name), re))
+		default:
+			return nil, fmt.Errorf("unsupported matcher type %q in %q", m.Type, s)
+		}
+	}
+	return matchers, nil
+}
+
 // Matchers provides the Match and Fingerprint methods for a slice of Matchers.
 // Matchers must always be sorted.
 type Matchers []*Matcher
@@ -133,10 +248,11 @@ func (ms Matchers) Less(i, j int) bool {
 	return !ms[i].IsRegex && ms[j].IsRegex
 }
 
-// Match checks whether all matchers are fulfilled against the given label set.
-func (ms Matchers) Match(lset model.LabelSet) bool {
+// Match checks whether all matchers are fulfilled against the given label
+// and annotation sets.
+func (ms Matchers) Match(lset, annotations model.LabelSet) bool {
 	for _, m := range ms {
-		if !m.Match(lset) {
+		if !m.Match(lset, annotations) {
 			return false
 		}
 	}
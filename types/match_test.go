@@ -96,17 +96,35 @@ func TestMatcherMatch(t *testing.T) {
 		{matcher: Matcher{Name: "label", Value: "diffval.*", IsRegex: true}, expected: false},
 		//unset label
 		{matcher: Matcher{Name: "difflabel", Value: "value"}, expected: false},
+		//negated
+		{matcher: Matcher{Name: "label", Value: "value", Negate: true}, expected: false},
+		{matcher: Matcher{Name: "label", Value: "val", Negate: true}, expected: true},
+		{matcher: Matcher{Name: "label", Value: "val.*", IsRegex: true, Negate: true}, expected: false},
+		{matcher: Matcher{Name: "label", Value: "diffval.*", IsRegex: true, Negate: true}, expected: true},
 	}
 
 	lset := model.LabelSet{"label": "value"}
 	for _, test := range tests {
 		test.matcher.Init()
 
-		actual := test.matcher.Match(lset)
+		actual := test.matcher.Match(lset, nil)
 		require.EqualValues(t, test.expected, actual)
 	}
 }
 
+func TestMatcherMatchAnnotation(t *testing.T) {
+	m := Matcher{Name: "runbook_url", Value: ".*legacy.*", IsRegex: true, Annotation: true}
+	require.NoError(t, m.Init())
+
+	lset := model.LabelSet{"runbook_url": "http://example.com/legacy"}
+	annotations := model.LabelSet{"runbook_url": "http://example.com/legacy"}
+
+	// An annotation matcher must not match against the label set, even
+	// though the value would satisfy it.
+	require.False(t, m.Match(lset, nil))
+	require.True(t, m.Match(nil, annotations))
+}
+
 func TestMatcherString(t *testing.T) {
 	m := NewMatcher("foo", "bar")
 
@@ -124,6 +142,69 @@ func TestMatcherString(t *testing.T) {
 	if m.String() != "foo=~\".*\"" {
 		t.Errorf("unexpected matcher string %#v", m.String())
 	}
+
+	m = NewNotMatcher("foo", "bar")
+
+	if m.String() != "foo!=\"bar\"" {
+		t.Errorf("unexpected matcher string %#v", m.String())
+	}
+
+	m = NewNotRegexMatcher("foo", re)
+
+	if m.String() != "foo!~\".*\"" {
+		t.Errorf("unexpected matcher string %#v", m.String())
+	}
+}
+
+func TestNewMatcherFromExprNegative(t *testing.T) {
+	m, err := NewMatcherFromExpr(`foo!="bar"`)
+	require.NoError(t, err)
+	require.Equal(t, "foo", m.Name)
+	require.Equal(t, "bar", m.Value)
+	require.True(t, m.Negate)
+	require.False(t, m.IsRegex)
+
+	m, err = NewMatcherFromExpr(`foo!~"bar.*"`)
+	require.NoError(t, err)
+	require.Equal(t, "foo", m.Name)
+	require.Equal(t, "^(?:bar.*)$", m.Value)
+	require.True(t, m.Negate)
+	require.True(t, m.IsRegex)
+	require.True(t, m.Match(model.LabelSet{"foo": "baz"}, nil))
+	require.False(t, m.Match(model.LabelSet{"foo": "bar1"}, nil))
+}
+
+func TestParseMatchers(t *testing.T) {
+	ms, err := ParseMatchers(`{foo="bar", baz=~"qu.x", team!="infra"}`)
+	require.NoError(t, err)
+	require.Len(t, ms, 3)
+
+	require.Equal(t, "foo", ms[0].Name)
+	require.Equal(t, "bar", ms[0].Value)
+	require.False(t, ms[0].IsRegex)
+	require.False(t, ms[0].Negate)
+
+	require.Equal(t, "baz", ms[1].Name)
+	require.True(t, ms[1].IsRegex)
+	require.True(t, ms[1].Match(model.LabelSet{"baz": "quux"}, nil))
+
+	require.Equal(t, "team", ms[2].Name)
+	require.True(t, ms[2].Negate)
+	require.True(t, ms[2].Match(model.LabelSet{"team": "sre"}, nil))
+	require.False(t, ms[2].Match(model.LabelSet{"team": "infra"}, nil))
+}
+
+func TestParseMatchersUTF8(t *testing.T) {
+	ms, err := ParseMatchers(`{"weird name"="日本語"}`)
+	require.NoError(t, err)
+	require.Len(t, ms, 1)
+	require.Equal(t, "weird name", ms[0].Name)
+	require.Equal(t, "日本語", ms[0].Value)
+}
+
+func TestParseMatchersInvalid(t *testing.T) {
+	_, err := ParseMatchers(`{foo="bar}`)
+	require.Error(t, err)
 }
 
 func TestMatchersString(t *testing.T) {
@@ -224,7 +305,7 @@ func TestMatchersMatch(t *testing.T) {
 
 	lset := model.LabelSet{"label1": "value1", "label2": "value2"}
 	for _, test := range tests {
-		actual := test.matchers.Match(lset)
+		actual := test.matchers.Match(lset, nil)
 		require.EqualValues(t, test.expected, actual)
 	}
 }
@@ -14,6 +14,7 @@
 package types
 
 import (
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -319,6 +320,14 @@ type Alert struct {
 	// The authoritative timestamp.
 	UpdatedAt time.Time
 	Timeout   bool
+
+	// Value and Threshold are optional numeric fields carried alongside the
+	// alert (e.g. "CPU at 97, threshold 90"), so templates and webhook
+	// payloads can render them directly instead of the alert source having
+	// to stuff the numbers into annotation text. Either may be nil if not
+	// supplied.
+	Value     *float64 `json:"value,omitempty"`
+	Threshold *float64 `json:"threshold,omitempty"`
 }
 
 // AlertSlice is a sortable slice of Alerts.
@@ -347,6 +356,22 @@ func (as AlertSlice) Less(i, j int) bool {
 func (as AlertSlice) Swap(i, j int) { as[i], as[j] = as[j], as[i] }
 func (as AlertSlice) Len() int      { return len(as) }
 
+// ParseFloatAnnotation parses the annotation named name as a float64, for
+// populating Alert.Value/Alert.Threshold from e.g. a "value" or "threshold"
+// annotation on ingestion. It returns nil if the annotation is absent or
+// not a valid number, without error, since both fields are optional.
+func ParseFloatAnnotation(annotations model.LabelSet, name model.LabelName) *float64 {
+	raw, ok := annotations[name]
+	if !ok {
+		return nil
+	}
+	v, err := strconv.ParseFloat(string(raw), 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
 // Alerts turns a sequence of internal alerts into a list of
 // exposable model.Alert structures.
 func Alerts(alerts ...*Alert) model.Alerts {
@@ -395,16 +420,17 @@ func (a *Alert) Merge(o *Alert) *Alert {
 
 // A Muter determines whether a given label set is muted. Implementers that
 // maintain an underlying Marker are expected to update it during a call of
-// Mutes.
+// Mutes. annotations is passed alongside lset so that a Muter backed by
+// annotation-scoped matchers (see silence.Silencer) can evaluate them.
 type Muter interface {
-	Mutes(model.LabelSet) bool
+	Mutes(lset, annotations model.LabelSet) bool
 }
 
 // A MuteFunc is a function that implements the Muter interface.
-type MuteFunc func(model.LabelSet) bool
+type MuteFunc func(lset, annotations model.LabelSet) bool
 
 // Mutes implements the Muter interface.
-func (f MuteFunc) Mutes(lset model.LabelSet) bool { return f(lset) }
+func (f MuteFunc) Mutes(lset, annotations model.LabelSet) bool { return f(lset, annotations) }
 
 // A Silence determines whether a given label set is muted.
 type Silence struct {
@@ -433,6 +459,11 @@ type Silence struct {
 	CreatedBy string `json:"createdBy"`
 	Comment   string `json:"comment,omitempty"`
 
+	// Recurrence, if set, is a YAML-encoded list of timeinterval.TimeInterval
+	// calendar windows restricting when, within StartsAt/EndsAt, the silence
+	// is actually active.
+	Recurrence string `json:"recurrence,omitempty"`
+
 	Status SilenceStatus `json:"status"`
 }
 
@@ -445,6 +476,11 @@ func (s *Silence) Expired() bool {
 // SilenceStatus stores the state of a silence.
 type SilenceStatus struct {
 	State SilenceState `json:"state"`
+
+	// NextActiveAt is the next time, at or after now, that a recurring
+	// silence becomes active. It is omitted for silences without a
+	// recurrence, or for ones that are already active.
+	NextActiveAt *time.Time `json:"nextActiveAt,omitempty"`
 }
 
 // SilenceState is used as part of SilenceStatus.
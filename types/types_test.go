@@ -261,6 +261,21 @@ func TestAlertMerge(t *testing.T) {
 	}
 }
 
+func TestParseFloatAnnotation(t *testing.T) {
+	annotations := model.LabelSet{"value": "97.5", "threshold": "90", "summary": "CPU high"}
+
+	v := ParseFloatAnnotation(annotations, "value")
+	require.NotNil(t, v)
+	require.Equal(t, 97.5, *v)
+
+	th := ParseFloatAnnotation(annotations, "threshold")
+	require.NotNil(t, th)
+	require.Equal(t, 90.0, *th)
+
+	require.Nil(t, ParseFloatAnnotation(annotations, "missing"))
+	require.Nil(t, ParseFloatAnnotation(annotations, "summary"))
+}
+
 func TestCalcSilenceState(t *testing.T) {
 
 	var (
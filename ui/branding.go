@@ -0,0 +1,76 @@
+// Copyright 2015 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// BrandingOptions lets operators customize the embedded UI's logo, title,
+// and stylesheet, and add links to internal tooling (e.g. runbooks), all via
+// config-pointed files served by the binary, without rebuilding the UI
+// bundle. The zero value means no customization.
+type BrandingOptions struct {
+	// Title overrides the page title shown in the browser tab and header.
+	// Empty means the default "Alertmanager".
+	Title string
+	// LogoPath, if set, points to an image file served in place of the
+	// embedded favicon/logo.
+	LogoPath string
+	// CustomCSSPath, if set, points to a CSS file injected after the
+	// embedded stylesheet, so its rules can override the defaults.
+	CustomCSSPath string
+	// RunbookLinks are rendered in the UI's navigation, each pointing at an
+	// internal runbook or documentation portal.
+	RunbookLinks []RunbookLink
+}
+
+// RunbookLink is a single named link to internal documentation, rendered in
+// the UI's navigation.
+type RunbookLink struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// empty reports whether no branding customization was requested, so callers
+// can skip templating the index page entirely.
+func (o BrandingOptions) empty() bool {
+	return o.Title == "" && o.LogoPath == "" && o.CustomCSSPath == "" && len(o.RunbookLinks) == 0
+}
+
+// renderIndex injects the configured branding into the embedded index page
+// just before </head>, so operators can override the logo, title, and
+// stylesheet, and add links to internal tooling, without rebuilding the UI
+// bundle.
+func (o BrandingOptions) renderIndex(index []byte) []byte {
+	var b bytes.Buffer
+	if o.Title != "" {
+		fmt.Fprintf(&b, "<script>document.title = %q;</script>\n", o.Title)
+	}
+	if o.LogoPath != "" {
+		b.WriteString("<link rel=\"icon\" href=\"/custom/logo\">\n")
+	}
+	if o.CustomCSSPath != "" {
+		b.WriteString("<link rel=\"stylesheet\" href=\"/custom/style.css\">\n")
+	}
+	if len(o.RunbookLinks) > 0 {
+		if links, err := json.Marshal(o.RunbookLinks); err == nil {
+			fmt.Fprintf(&b, "<script>window.runbookLinks = %s;</script>\n", links)
+		}
+	}
+
+	return bytes.Replace(index, []byte("</head>"), append(b.Bytes(), []byte("</head>")...), 1)
+}
@@ -15,6 +15,7 @@ package ui
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	_ "net/http/pprof" // Comment this line to disable pprof endpoint.
 	"path"
@@ -24,15 +25,37 @@ import (
 	"github.com/prometheus/common/route"
 
 	"github.com/prometheus/alertmanager/asset"
+	"github.com/prometheus/alertmanager/config"
 )
 
 // Register registers handlers to serve files for the web interface.
-func Register(r *route.Router, reloadCh chan<- chan error, logger log.Logger) {
+// isReady is consulted by the /-/ready endpoint; it should report false
+// until startup state recovery has fully completed, so load balancers hold
+// off sending traffic to an instance that hasn't caught up yet. isReadOnly
+// is consulted by /-/reload, which is rejected while it reports true.
+// branding customizes the served UI; its zero value serves the UI
+// unmodified. The
+// served single-page app (ui/app) already covers firing alerts grouped by
+// receiver, active silences, and a silence creation form pre-filled from an
+// alert's labels, so operators are not limited to the HTTP API.
+func Register(r *route.Router, reloadCh chan<- chan error, isReady func() bool, isReadOnly func() bool, branding BrandingOptions, logger log.Logger) {
 	r.Get("/metrics", promhttp.Handler().ServeHTTP)
 
 	r.Get("/", func(w http.ResponseWriter, req *http.Request) {
 		disableCaching(w)
 
+		if !branding.empty() {
+			f, err := asset.Assets.Open("/static/index.html")
+			if err == nil {
+				defer f.Close()
+				if index, err := ioutil.ReadAll(f); err == nil {
+					w.Header().Set("Content-Type", "text/html; charset=utf-8")
+					w.Write(branding.renderIndex(index))
+					return
+				}
+			}
+		}
+
 		req.URL.Path = "/static/"
 		fs := http.FileServer(asset.Assets)
 		fs.ServeHTTP(w, req)
@@ -49,11 +72,35 @@ func Register(r *route.Router, reloadCh chan<- chan error, logger log.Logger) {
 	r.Get("/favicon.ico", func(w http.ResponseWriter, req *http.Request) {
 		disableCaching(w)
 
+		if branding.LogoPath != "" {
+			http.ServeFile(w, req, branding.LogoPath)
+			return
+		}
+
 		req.URL.Path = "/static/favicon.ico"
 		fs := http.FileServer(asset.Assets)
 		fs.ServeHTTP(w, req)
 	})
 
+	r.Get("/custom/logo", func(w http.ResponseWriter, req *http.Request) {
+		if branding.LogoPath == "" {
+			http.NotFound(w, req)
+			return
+		}
+		disableCaching(w)
+		http.ServeFile(w, req, branding.LogoPath)
+	})
+
+	r.Get("/custom/style.css", func(w http.ResponseWriter, req *http.Request) {
+		if branding.CustomCSSPath == "" {
+			http.NotFound(w, req)
+			return
+		}
+		disableCaching(w)
+		w.Header().Set("Content-Type", "text/css; charset=utf-8")
+		http.ServeFile(w, req, branding.CustomCSSPath)
+	})
+
 	r.Get("/lib/*path", func(w http.ResponseWriter, req *http.Request) {
 		disableCaching(w)
 
@@ -63,6 +110,11 @@ func Register(r *route.Router, reloadCh chan<- chan error, logger log.Logger) {
 	})
 
 	r.Post("/-/reload", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if isReadOnly() {
+			http.Error(w, "instance is in read-only mode, not reloading configuration", http.StatusServiceUnavailable)
+			return
+		}
+
 		errc := make(chan error)
 		defer close(errc)
 
@@ -72,11 +124,33 @@ func Register(r *route.Router, reloadCh chan<- chan error, logger log.Logger) {
 		}
 	}))
 
+	r.Post("/-/routes/fragments/:team/validate", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		team := route.Param(req.Context(), "team")
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read request body: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		if _, err := config.ParseRouteFragment(team, string(body)); err != nil {
+			http.Error(w, fmt.Sprintf("invalid route fragment: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "OK")
+	}))
+
 	r.Get("/-/healthy", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, "OK")
 	}))
 	r.Get("/-/ready", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !isReady() {
+			http.Error(w, "Not Ready", http.StatusServiceUnavailable)
+			return
+		}
 		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, "OK")
 	}))
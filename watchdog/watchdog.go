@@ -0,0 +1,139 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watchdog implements a dead man's switch: it expects a liveness
+// alert (e.g. a "Watchdog" alert fired continuously by a Prometheus rule
+// such as vector(1)) to keep arriving, and invokes a callback if it stops
+// arriving within a configurable timeout. This closes the blind spot where
+// the monitoring pipeline itself goes down and, as a result, nobody gets
+// paged about anything else either.
+package watchdog
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/provider"
+	"github.com/prometheus/alertmanager/types"
+)
+
+// DefaultTimeout is used when none is configured.
+const DefaultTimeout = 15 * time.Minute
+
+// checkInterval is how often Watcher polls for a missed heartbeat. It is
+// independent of Timeout so that Set can change Timeout without restarting
+// the check loop.
+const checkInterval = time.Minute
+
+// Watcher watches for a configured alertname to keep arriving on alerts. It
+// is safe to reconfigure via Set while Run is executing, e.g. across
+// configuration reloads.
+type Watcher struct {
+	alerts provider.Alerts
+	logger log.Logger
+
+	mtx       sync.Mutex
+	alertname string
+	timeout   time.Duration
+	onMissing func(alertname string, timeout time.Duration)
+	lastSeen  time.Time
+	fired     bool
+}
+
+// New returns a Watcher that is initially disabled. Call Set to configure it
+// with an alertname to watch for before or during Run.
+func New(alerts provider.Alerts, l log.Logger) *Watcher {
+	return &Watcher{
+		alerts: alerts,
+		logger: l,
+	}
+}
+
+// Set (re-)configures the alertname to watch for, how long it may be absent
+// before onMissing is invoked, and onMissing itself. An empty alertname
+// disables the watchdog. It may be called concurrently with Run, e.g. on
+// config reload.
+func (w *Watcher) Set(alertname string, timeout time.Duration, onMissing func(alertname string, timeout time.Duration)) {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.alertname = alertname
+	w.timeout = timeout
+	w.onMissing = onMissing
+	w.lastSeen = time.Now()
+	w.fired = false
+}
+
+// Run subscribes to alerts and checks for a missed heartbeat until ctx is
+// done. It is a no-op for as long as no alertname has been configured via
+// Set.
+func (w *Watcher) Run(ctx context.Context) {
+	it := w.alerts.Subscribe()
+	defer it.Close()
+
+	t := time.NewTicker(checkInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case a := <-it.Next():
+			if err := it.Err(); err != nil {
+				level.Error(w.logger).Log("msg", "Error iterating alerts", "err", err)
+				continue
+			}
+			w.observe(a)
+		case <-t.C:
+			w.check()
+		}
+	}
+}
+
+// observe resets the watchdog's timer if a matches the configured
+// alertname.
+func (w *Watcher) observe(a *types.Alert) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	if w.alertname == "" || a.Labels[model.AlertNameLabel] != model.LabelValue(w.alertname) {
+		return
+	}
+	w.lastSeen = time.Now()
+	w.fired = false
+}
+
+func (w *Watcher) check() {
+	w.mtx.Lock()
+	alertname, timeout, onMissing := w.alertname, w.timeout, w.onMissing
+	missing := alertname != "" && !w.fired && time.Since(w.lastSeen) >= timeout
+	if missing {
+		w.fired = true
+	}
+	w.mtx.Unlock()
+
+	if !missing {
+		return
+	}
+
+	level.Error(w.logger).Log("msg", "watchdog alert has not been seen within its timeout", "alertname", alertname, "timeout", timeout)
+	if onMissing != nil {
+		onMissing(alertname, timeout)
+	}
+}
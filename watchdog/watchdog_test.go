@@ -0,0 +1,121 @@
+// Copyright 2019 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	"github.com/prometheus/alertmanager/provider/mem"
+	"github.com/prometheus/alertmanager/types"
+)
+
+func TestWatcherFiresWhenAlertMissing(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	w := New(alerts, log.NewNopLogger())
+
+	var mtx sync.Mutex
+	var missed int
+	w.Set("Watchdog", time.Millisecond, func(alertname string, timeout time.Duration) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		missed++
+	})
+	time.Sleep(5 * time.Millisecond)
+
+	w.check()
+
+	mtx.Lock()
+	got := missed
+	mtx.Unlock()
+	if got != 1 {
+		t.Fatalf("expected onMissing to fire once, got %d", got)
+	}
+
+	// A second check before the alert reappears must not fire again.
+	w.check()
+	mtx.Lock()
+	got = missed
+	mtx.Unlock()
+	if got != 1 {
+		t.Fatalf("expected onMissing to stay latched at 1, got %d", got)
+	}
+}
+
+func TestWatcherResetsOnObserve(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	w := New(alerts, log.NewNopLogger())
+	w.Set("Watchdog", time.Hour, nil)
+
+	w.observe(&types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{model.AlertNameLabel: "Watchdog"},
+		},
+	})
+
+	w.mtx.Lock()
+	lastSeen := w.lastSeen
+	w.mtx.Unlock()
+	if time.Since(lastSeen) > time.Second {
+		t.Fatalf("expected lastSeen to be updated by observe")
+	}
+}
+
+func TestWatcherIgnoresOtherAlerts(t *testing.T) {
+	marker := types.NewMarker(prometheus.NewRegistry())
+	alerts, err := mem.NewAlerts(context.Background(), marker, time.Hour, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer alerts.Close()
+
+	w := New(alerts, log.NewNopLogger())
+	w.Set("Watchdog", time.Millisecond, nil)
+	time.Sleep(5 * time.Millisecond)
+
+	w.observe(&types.Alert{
+		Alert: model.Alert{
+			Labels: model.LabelSet{model.AlertNameLabel: "SomethingElse"},
+		},
+	})
+
+	var missed int
+	w.mtx.Lock()
+	w.onMissing = func(alertname string, timeout time.Duration) {
+		missed++
+	}
+	w.mtx.Unlock()
+	w.check()
+	if missed != 1 {
+		t.Fatalf("expected a non-matching alert to not reset the watchdog timer")
+	}
+}
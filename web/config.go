@@ -0,0 +1,190 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package web configures server-side TLS and authentication for the
+// Alertmanager HTTP listener, loaded from a separate YAML file (the "web
+// config file") rather than the main Alertmanager configuration, so it can
+// be managed and access-controlled independently of alerting rules.
+package web
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"gopkg.in/yaml.v2"
+)
+
+// TLSConfig configures the certificate Alertmanager presents to clients and,
+// optionally, the CA used to verify client certificates for mutual TLS.
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+
+	// ClientCAFile, if set, is used to verify client certificates. ClientAuth
+	// must also be set to one of the "require" values for the CA to be
+	// enforced; otherwise it is offered but not required.
+	ClientCAFile string `yaml:"client_ca_file,omitempty"`
+	ClientAuth   string `yaml:"client_auth_type,omitempty"`
+}
+
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"":                           tls.NoClientCert,
+	"NoClientCert":               tls.NoClientCert,
+	"RequestClientCert":          tls.RequestClientCert,
+	"RequireAnyClientCert":       tls.RequireAnyClientCert,
+	"VerifyClientCertIfGiven":    tls.VerifyClientCertIfGiven,
+	"RequireAndVerifyClientCert": tls.RequireAndVerifyClientCert,
+}
+
+// Config is the root of the web config file, pointed to by
+// --web.config.file. Its zero value serves plaintext, unauthenticated HTTP,
+// matching Alertmanager's behavior before this file existed.
+type Config struct {
+	TLSConfig *TLSConfig `yaml:"tls_server_config,omitempty"`
+
+	// BasicAuthUsers maps a username to the SHA-256 hash, hex-encoded, of the
+	// password it authenticates. Passwords are hashed rather than stored in
+	// the clear so that the web config file can be handled like any other
+	// non-secret configuration, e.g. checked into a less tightly
+	// access-controlled location than a raw credential would warrant.
+	BasicAuthUsers map[string]string `yaml:"basic_auth_users,omitempty"`
+	BearerToken    string            `yaml:"bearer_token,omitempty"`
+}
+
+// LoadFile parses the web config file at filename. An empty filename
+// returns the zero Config (TLS and authentication disabled), so callers
+// need not special-case the flag being unset.
+func LoadFile(filename string) (*Config, error) {
+	if filename == "" {
+		return &Config{}, nil
+	}
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{}
+	if err := yaml.UnmarshalStrict(content, cfg); err != nil {
+		return nil, fmt.Errorf("parsing web config file: %s", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate checks the config for internal consistency, e.g. that a
+// referenced client_auth_type is recognized.
+func (c *Config) Validate() error {
+	if c.TLSConfig != nil {
+		if c.TLSConfig.CertFile == "" || c.TLSConfig.KeyFile == "" {
+			return fmt.Errorf("tls_server_config requires both cert_file and key_file")
+		}
+		if _, ok := clientAuthTypes[c.TLSConfig.ClientAuth]; !ok {
+			return fmt.Errorf("unknown client_auth_type %q", c.TLSConfig.ClientAuth)
+		}
+		if c.TLSConfig.ClientCAFile == "" && c.TLSConfig.ClientAuth != "" && clientAuthTypes[c.TLSConfig.ClientAuth] != tls.NoClientCert {
+			return fmt.Errorf("client_auth_type %q requires client_ca_file", c.TLSConfig.ClientAuth)
+		}
+	}
+	if len(c.BasicAuthUsers) > 0 && c.BearerToken != "" {
+		return fmt.Errorf("basic_auth_users and bearer_token are mutually exclusive")
+	}
+	return nil
+}
+
+// TLSEnabled reports whether the listener should serve HTTPS rather than
+// plaintext HTTP.
+func (c *Config) TLSEnabled() bool {
+	return c.TLSConfig != nil
+}
+
+// NewTLSConfig builds the *tls.Config to use for the HTTP listener.
+// TLSEnabled must be true before calling this.
+func (c *Config) NewTLSConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.TLSConfig.CertFile, c.TLSConfig.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading server certificate/key: %s", err)
+	}
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   clientAuthTypes[c.TLSConfig.ClientAuth],
+	}
+	if c.TLSConfig.ClientCAFile != "" {
+		b, err := ioutil.ReadFile(c.TLSConfig.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading client_ca_file: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("no certificates found in client_ca_file %q", c.TLSConfig.ClientCAFile)
+		}
+		tlsCfg.ClientCAs = pool
+	}
+	return tlsCfg, nil
+}
+
+// AuthEnabled reports whether requests must be authenticated before
+// reaching the wrapped handler.
+func (c *Config) AuthEnabled() bool {
+	return len(c.BasicAuthUsers) > 0 || c.BearerToken != ""
+}
+
+// Middleware wraps next so that it is only reached by requests that
+// satisfy the configured basic auth or bearer token check. If neither is
+// configured, next is returned unwrapped.
+func (c *Config) Middleware(next http.Handler) http.Handler {
+	if !c.AuthEnabled() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c.BearerToken != "" {
+			if subtle.ConstantTimeCompare([]byte(bearerToken(r)), []byte(c.BearerToken)) != 1 {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, pass, ok := r.BasicAuth()
+		if !ok || !c.checkBasicAuth(user, pass) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="alertmanager"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c *Config) checkBasicAuth(user, pass string) bool {
+	wantHash, ok := c.BasicAuthUsers[user]
+	if !ok {
+		return false
+	}
+	gotHash := sha256.Sum256([]byte(pass))
+	return subtle.ConstantTimeCompare([]byte(fmt.Sprintf("%x", gotHash)), []byte(wantHash)) == 1
+}
+
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+	h := r.Header.Get("Authorization")
+	if len(h) <= len(prefix) || h[:len(prefix)] != prefix {
+		return ""
+	}
+	return h[len(prefix):]
+}
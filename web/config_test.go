@@ -0,0 +1,114 @@
+// Copyright 2026 Prometheus Team
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func passwordHash(pass string) string {
+	h := sha256.Sum256([]byte(pass))
+	return fmt.Sprintf("%x", h)
+}
+
+func TestLoadFileEmptyFilenameIsDisabled(t *testing.T) {
+	cfg, err := LoadFile("")
+	require.NoError(t, err)
+	require.False(t, cfg.TLSEnabled())
+	require.False(t, cfg.AuthEnabled())
+}
+
+func TestMiddlewarePassthroughWhenDisabled(t *testing.T) {
+	cfg := &Config{}
+	called := false
+	h := cfg.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/", nil))
+	require.True(t, called)
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestMiddlewareBasicAuth(t *testing.T) {
+	cfg := &Config{BasicAuthUsers: map[string]string{"alice": passwordHash("hunter2")}}
+	h := cfg.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	req = httptest.NewRequest("GET", "/", nil)
+	req.SetBasicAuth("alice", "wrong")
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	req = httptest.NewRequest("GET", "/", nil)
+	req.SetBasicAuth("alice", "hunter2")
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestMiddlewareBearerToken(t *testing.T) {
+	cfg := &Config{BearerToken: "s3cr3t"}
+	h := cfg.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	req = httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestValidateRejectsBasicAuthAndBearerTogether(t *testing.T) {
+	cfg := &Config{
+		BasicAuthUsers: map[string]string{"alice": passwordHash("x")},
+		BearerToken:    "tok",
+	}
+	require.Error(t, cfg.Validate())
+}
+
+func TestValidateRejectsIncompleteTLSConfig(t *testing.T) {
+	cfg := &Config{TLSConfig: &TLSConfig{CertFile: "server.crt"}}
+	require.Error(t, cfg.Validate())
+}
+
+func TestValidateRejectsUnknownClientAuthType(t *testing.T) {
+	cfg := &Config{TLSConfig: &TLSConfig{
+		CertFile:   "server.crt",
+		KeyFile:    "server.key",
+		ClientAuth: "BogusType",
+	}}
+	require.Error(t, cfg.Validate())
+}